@@ -0,0 +1,7 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+service "keyvault" {
+  name      = "KeyVault"
+  available = ["7.4"]
+}
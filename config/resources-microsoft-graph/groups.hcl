@@ -0,0 +1,44 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+service "Groups" {
+  terraform_package = "groups"
+
+  api "stable" {
+    package "Group" {
+      definition "group" {
+        id = "/groups/{groupId}"
+        display_name = "Group"
+        website_subcategory = "Groups"
+        description = "Manages a Group within Azure Active Directory"
+        overrides "is_assignable_to_role" {
+          force_new = true
+        }
+        overrides "accepted_senders" {
+          exclude = true
+        }
+        overrides "has_members_with_license_errors" {
+          exclude = true
+        }
+        overrides "is_archived" {
+          exclude = true
+        }
+        overrides "is_subscribed_by_mail" {
+          exclude = true
+        }
+        overrides "unseen_count" {
+          exclude = true
+        }
+        overrides "rejected_senders" {
+          exclude = true
+        }
+        overrides "transitive_member_of" {
+          exclude = true
+        }
+        overrides "transitive_members" {
+          exclude = true
+        }
+      }
+    }
+  }
+}
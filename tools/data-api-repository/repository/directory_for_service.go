@@ -47,6 +47,9 @@ var defaultDataDirectories = map[sdkModels.SourceDataType]map[sdkModels.SourceDa
 	// This is to ensure that if the imported data contains a Discriminated Parent Type that we can add
 	// additional Implementations within the HandWritten Data which inherits from that, so this is automatically
 	// unmarshalled as required.
+	sdkModels.DataPlaneSourceDataType: {
+		sdkModels.AzureRestAPISpecsSourceDataOrigin: "data-plane",
+	},
 	sdkModels.MicrosoftGraphSourceDataType: {
 		sdkModels.MicrosoftGraphMetaDataSourceDataOrigin: "microsoft-graph",
 	},
@@ -353,7 +353,13 @@ func parseTerraformDefinitionForServiceWithin(workingDirectory string, terraform
 		resources[parsedResource.ResourceLabel] = *parsedResource
 	}
 
+	framework := sdkModels.PluginSdkTerraformFrameworkType
+	if terraform.Framework != nil {
+		framework = *terraform.Framework
+	}
+
 	return &sdkModels.TerraformDefinition{
+		Framework:            framework,
 		Resources:            resources,
 		TerraformPackageName: terraform.ServicePackageName,
 	}, nil
@@ -15,6 +15,10 @@ type ApiVersionDefinition struct {
 	// Generate specifies whether this API Version should be generated or not.
 	Generate bool `json:"generate"`
 
+	// ParameterizedHost optionally specifies the templated Host which the Operations within this API Version
+	// are relative to - this is only present for Data Plane API Versions.
+	ParameterizedHost *ParameterizedHostDefinition `json:"parameterizedHost,omitempty"`
+
 	// Resources specifies a list of Api Resource names that exist within this API version.
 	Resources []string `json:"resources"`
 
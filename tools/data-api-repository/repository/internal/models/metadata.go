@@ -24,6 +24,9 @@ type MetaData struct {
 type DataSource string
 
 const (
+	// AzureDataPlaneDataSource specifies that this Data is related to an Azure Data Plane API.
+	AzureDataPlaneDataSource DataSource = "AzureDataPlane"
+
 	// AzureResourceManagerDataSource specifies that this Data is related to Azure Resource Manager.
 	AzureResourceManagerDataSource DataSource = "AzureResourceManager"
 
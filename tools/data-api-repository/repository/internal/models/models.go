@@ -43,6 +43,10 @@ type ModelField struct {
 	// JsonName contains the Name following JSON casing convention
 	JsonName string `json:"jsonName"`
 
+	// Mutability optionally specifies the operations during which this field can be used - when
+	// unspecified this field can be used during all operations.
+	Mutability *[]FieldMutability `json:"mutability,omitempty"`
+
 	// Name specifies the name of the field
 	Name string `json:"name"`
 
@@ -95,3 +95,11 @@ const (
 	// TODO: others in the future https://github.com/hashicorp/pandora/issues/8 e.g.
 	// RFC3339NanoDateFormat DateFormat = "RFC3339Nano"
 )
+
+type FieldMutability string
+
+const (
+	CreateFieldMutability FieldMutability = "Create"
+	ReadFieldMutability   FieldMutability = "Read"
+	UpdateFieldMutability FieldMutability = "Update"
+)
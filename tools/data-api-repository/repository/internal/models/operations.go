@@ -14,6 +14,9 @@ type Operation struct {
 	// Description is used to write a comment for the operation method
 	Description string `json:"description"`
 
+	// Examples specifies a list of the example Requests/Responses for this Operation (e.g. sourced from `x-ms-examples`)
+	Examples *[]OperationExample `json:"examples,omitempty"`
+
 	// ExpectedStatusCodes specifies is a list of Status Codes which are expected to be returned (e.g. 200, 201)
 	ExpectedStatusCodes []int `json:"expectedStatusCodes"`
 
@@ -25,6 +28,10 @@ type Operation struct {
 	// should follow any `Location` headers to track the result of this operation
 	LongRunning bool `json:"longRunning"`
 
+	// LongRunningFinalStateVia optionally specifies where the final state of a Long Running Operation
+	// is retrieved from (e.g. `azure-async-operation`, `location` or `original-uri`)
+	LongRunningFinalStateVia *string `json:"longRunningFinalStateVia,omitempty"`
+
 	// HTTPMethod is the Method used for this operation, (e.g. `GET`, `POST`)
 	HTTPMethod string `json:"httpMethod"`
 
@@ -83,3 +90,25 @@ type Option struct {
 	// OptionsObjectDefinition describes the information contained within the Field
 	ObjectDefinition OptionObjectDefinition `json:"optionsObjectDefinition"`
 }
+
+type OperationExample struct {
+	// Name specifies the name of this Example, as defined in the API Definitions (e.g. `Create a Virtual Machine`)
+	Name string `json:"name"`
+
+	// PathParameters is a map of the Path Parameter Name (e.g. `resourceGroupName`) to the value used in this Example
+	PathParameters map[string]string `json:"pathParameters,omitempty"`
+
+	// RequestBody specifies the optional JSON payload sent in the Request
+	RequestBody interface{} `json:"requestBody,omitempty"`
+
+	// Responses is a list of the Responses for this Example, ordered by Status Code
+	Responses *[]OperationExampleResponse `json:"responses,omitempty"`
+}
+
+type OperationExampleResponse struct {
+	// StatusCode specifies the HTTP Status Code for this Response (e.g. 200)
+	StatusCode int `json:"statusCode"`
+
+	// Body specifies the optional JSON payload returned in this Response
+	Body interface{} `json:"body,omitempty"`
+}
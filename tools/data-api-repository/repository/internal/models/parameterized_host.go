@@ -0,0 +1,30 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package models
+
+// ParameterizedHostDefinition defines the templated Host used by a Data Plane API Version.
+type ParameterizedHostDefinition struct {
+	// HostTemplate specifies the template for the Host, for example `{vaultBaseUrl}`.
+	HostTemplate string `json:"hostTemplate"`
+
+	// Parameters specifies the parameters which are used within the HostTemplate.
+	Parameters []ParameterizedHostParameterDefinition `json:"parameters"`
+
+	// UseSchemePrefix specifies whether the scheme (e.g. `https://`) should be prefixed to the HostTemplate.
+	UseSchemePrefix bool `json:"useSchemePrefix"`
+}
+
+type ParameterizedHostParameterDefinition struct {
+	// DefaultValue optionally specifies the default value for this parameter.
+	DefaultValue *string `json:"defaultValue,omitempty"`
+
+	// Description specifies the description for this parameter.
+	Description string `json:"description"`
+
+	// Name specifies the name of this parameter, as used within the HostTemplate.
+	Name string `json:"name"`
+
+	// Required specifies whether a value must be specified for this parameter.
+	Required bool `json:"required"`
+}
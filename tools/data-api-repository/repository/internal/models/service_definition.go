@@ -36,6 +36,11 @@ type TerraformServiceDefinition struct {
 	// Example: `compute`.
 	ServicePackageName string `json:"servicePackageName"`
 
+	// Framework is the Terraform framework which the Terraform Resources should be generated for.
+	// When unspecified the Terraform Plugin SDK is used.
+	// Example: `PluginFramework`.
+	Framework *string `json:"framework,omitempty"`
+
 	// Resources is a list of the Terraform Resources available within this ServiceDefinition.
 	Resources []string `json:"resources"`
 }
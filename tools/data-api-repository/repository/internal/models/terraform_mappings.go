@@ -46,6 +46,26 @@ type TerraformFieldMappingDefinition struct {
 
 	// Manual contains additional metadata when Type is set to ManualTerraformFieldMappingDefinitionType.
 	Manual *TerraformFieldManualMappingDefinition `json:"manual,omitempty"`
+
+	// BooleanEquals specifies the mapping information when Type is set to
+	// BooleanEqualsTerraformFieldMappingDefinitionType.
+	BooleanEquals *TerraformFieldMappingBooleanEqualsDefinition `json:"booleanEquals,omitempty"`
+
+	// BooleanInvert specifies the mapping information when Type is set to
+	// BooleanInvertTerraformFieldMappingDefinitionType.
+	BooleanInvert *TerraformFieldMappingDirectAssignmentDefinition `json:"booleanInvert,omitempty"`
+
+	// SubResourceId specifies the mapping information when Type is set to
+	// SubResourceIdTerraformFieldMappingDefinitionType.
+	SubResourceId *TerraformFieldMappingDirectAssignmentDefinition `json:"subResourceId,omitempty"`
+
+	// SubResourceIdList specifies the mapping information when Type is set to
+	// SubResourceIdListTerraformFieldMappingDefinitionType.
+	SubResourceIdList *TerraformFieldMappingDirectAssignmentDefinition `json:"subResourceIdList,omitempty"`
+
+	// ODataBind specifies the mapping information when Type is set to
+	// ODataBindTerraformFieldMappingDefinitionType.
+	ODataBind *TerraformFieldMappingODataBindDefinition `json:"odataBind,omitempty"`
 }
 
 // TerraformFieldMappingDefinitionType is used to indicate the type of Mapping Definition being expected
@@ -67,7 +87,25 @@ const (
 	// scenarios requiring custom transformations.
 	ManualTerraformFieldMappingDefinitionType TerraformFieldMappingDefinitionType = "Manual"
 
-	// TODO: support for other types of mappings e.g. BooleanEquals, BooleanInvert
+	// BooleanEqualsTerraformFieldMappingDefinitionType specifies that this mapping defines a Boolean Schema Field
+	// which should be mapped to/from a Constant value within an SDK Model (e.g. `Enabled` / `Disabled`).
+	BooleanEqualsTerraformFieldMappingDefinitionType TerraformFieldMappingDefinitionType = "BooleanEquals"
+
+	// BooleanInvertTerraformFieldMappingDefinitionType specifies that this mapping defines a Boolean Schema Field
+	// which should be mapped to/from the inverse of a Boolean Field within an SDK Model.
+	BooleanInvertTerraformFieldMappingDefinitionType TerraformFieldMappingDefinitionType = "BooleanInvert"
+
+	// SubResourceIdTerraformFieldMappingDefinitionType specifies that this mapping defines a String Schema Field
+	// which should be mapped to/from the `Id` field within the SDK Model referenced by a given Field.
+	SubResourceIdTerraformFieldMappingDefinitionType TerraformFieldMappingDefinitionType = "SubResourceId"
+
+	// SubResourceIdListTerraformFieldMappingDefinitionType specifies that this mapping defines a List of Strings
+	// Schema Field which should be mapped to/from the `Id` field within each item in a List of SDK Models.
+	SubResourceIdListTerraformFieldMappingDefinitionType TerraformFieldMappingDefinitionType = "SubResourceIdList"
+
+	// ODataBindTerraformFieldMappingDefinitionType specifies that this mapping defines a String (or List of Strings)
+	// Schema Field containing the ID(s) of other entities, which should be mapped onto an `@odata.bind` SDK Field.
+	ODataBindTerraformFieldMappingDefinitionType TerraformFieldMappingDefinitionType = "ODataBind"
 )
 
 // TerraformFieldMappingDirectAssignmentDefinition is used to define a mapping from a given Schema Field
@@ -93,6 +131,29 @@ type TerraformFieldMappingDirectAssignmentDefinition struct {
 	SdkFieldPath string `json:"sdkFieldPath"`
 }
 
+// TerraformFieldMappingBooleanEqualsDefinition is used to define a mapping between a Boolean Schema Field
+// and a Constant Field within an SDK Model - where `true` maps to/from the Constant value TrueValue and
+// `false` maps to/from the Constant value FalseValue.
+type TerraformFieldMappingBooleanEqualsDefinition struct {
+	TerraformFieldMappingDirectAssignmentDefinition
+
+	// TrueValue specifies the Constant value which the Schema Field being `true` maps to/from.
+	TrueValue string `json:"trueValue"`
+
+	// FalseValue specifies the Constant value which the Schema Field being `false` maps to/from.
+	FalseValue string `json:"falseValue"`
+}
+
+// TerraformFieldMappingODataBindDefinition is used to define a mapping between a Schema Field containing the ID(s)
+// of other entities and an `@odata.bind` Field within an SDK Model - where each ID is sent as the OData URI for the
+// entity within the Entity Set named in EntitySetName.
+type TerraformFieldMappingODataBindDefinition struct {
+	TerraformFieldMappingDirectAssignmentDefinition
+
+	// EntitySetName specifies the name of the Entity Set containing the referenced entities (e.g. `directoryObjects`).
+	EntitySetName string `json:"entitySetName"`
+}
+
 // TerraformFieldMappingModelToModelDefinition is used to define the mapping between a Schema Model
 // and a given SDK Field (within an SDK Model) - indicating that mapping functions should be
 // generated between these types.
@@ -14,6 +14,9 @@ type TerraformResourceDefinition struct {
 	// CreateMethod defines the Create Method associated with this Resource.
 	CreateMethod TerraformMethodDefinition `json:"createMethod"`
 
+	// DataSource optionally defines the Data Source which should be generated for this Resource.
+	DataSource *TerraformDataSourceDefinition `json:"dataSource,omitempty"`
+
 	// DeleteMethod defines the Delete Method associated with this Resource.
 	DeleteMethod TerraformMethodDefinition `json:"deleteMethod"`
 
@@ -23,6 +26,10 @@ type TerraformResourceDefinition struct {
 	// DisplayName specifies the human-readable name for this Resource, used in the Documentation. (e.g. Load Test)
 	DisplayName string `json:"displayName"`
 
+	// EventuallyConsistent specifies whether the API for this Resource is eventually consistent, in which case
+	// the Create and Delete methods wait for the change to be visible via the Read method.
+	EventuallyConsistent bool `json:"eventuallyConsistent,omitempty"`
+
 	// ExampleUsage is the Example Usage snippet for this Resource which can be used in the documentation.
 	ExampleUsage string `json:"exampleUsage"`
 
@@ -71,3 +78,14 @@ type TerraformMethodDefinition struct {
 	// TimeoutInMinutes specifies how long in minutes that the method should run before timing out
 	TimeoutInMinutes int `json:"timeoutInMinutes"`
 }
+
+type TerraformDataSourceDefinition struct {
+	// Description is the description which should be used for this Data Source.
+	Description string `json:"description"`
+
+	// Generate determines whether this Data Source is generated or not
+	Generate bool `json:"generate"`
+
+	// TimeoutInMinutes specifies how long in minutes that the Read method should run before timing out
+	TimeoutInMinutes int `json:"timeoutInMinutes"`
+}
@@ -8,6 +8,9 @@ type TerraformSchemaField struct {
 	// Computed specifies whether this attribute is Computed
 	Computed *bool `json:"computed,omitempty"`
 
+	// Default specifies the default value for this attribute, if any
+	Default any `json:"default,omitempty"`
+
 	// Documentation describes what this attribute is
 	Documentation *TerraformSchemaFieldDocumentation `json:"documentation,omitempty"`
 
@@ -30,6 +33,9 @@ type TerraformSchemaField struct {
 	// Required specifies whether this attribute is Required
 	Required *bool `json:"required,omitempty"`
 
+	// Sensitive specifies whether this attribute is Sensitive
+	Sensitive *bool `json:"sensitive,omitempty"`
+
 	// Validation defines what validation should be applied for this Terraform Schema Field.
 	Validation *TerraformSchemaFieldValidationDefinition `json:"validation,omitempty"`
 }
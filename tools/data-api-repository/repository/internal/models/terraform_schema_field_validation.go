@@ -11,6 +11,20 @@ type TerraformSchemaFieldValidationDefinition struct {
 
 	// PossibleValues describes the list of Possible Values allowed for this field.
 	PossibleValues *TerraformSchemaValidationPossibleValuesDefinition `json:"possibleValues,omitempty"`
+
+	// Range describes the range of values allowed for this field.
+	Range *TerraformSchemaValidationRangeDefinition `json:"range,omitempty"`
+
+	// Regex specifies the Regular Expression which the value for this field must match.
+	Regex *string `json:"regex,omitempty"`
+}
+
+type TerraformSchemaValidationRangeDefinition struct {
+	// Minimum specifies the minimum value (inclusive) allowed for this field, if any.
+	Minimum *float64 `json:"minimum,omitempty"`
+
+	// Maximum specifies the maximum value (inclusive) allowed for this field, if any.
+	Maximum *float64 `json:"maximum,omitempty"`
 }
 
 type TerraformSchemaValidationPossibleValuesDefinition struct {
@@ -39,6 +53,12 @@ const (
 	// allowed for this field.
 	PossibleValuesTerraformSchemaValidationType TerraformSchemaFieldValidationType = "PossibleValues"
 
-	// TODO: we should implement `PossibleValuesFromConstant` and potentially others (NoEmptyValues/Ranges)
+	// RangeTerraformSchemaValidationType specifies that the value for this field must be within a range.
+	RangeTerraformSchemaValidationType TerraformSchemaFieldValidationType = "Range"
+
+	// RegexTerraformSchemaValidationType specifies that the value for this field must match a Regular Expression.
+	RegexTerraformSchemaValidationType TerraformSchemaFieldValidationType = "Regex"
+
+	// TODO: we should implement `PossibleValuesFromConstant` and potentially others (NoEmptyValues)
 	// in the future
 )
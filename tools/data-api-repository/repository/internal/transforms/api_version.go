@@ -18,11 +18,12 @@ func MapAPIVersionFromRepository(input repositoryModels.ApiVersionDefinition, ap
 	}
 
 	return &sdkModels.APIVersion{
-		APIVersion: input.ApiVersion,
-		Generate:   input.Generate,
-		Preview:    input.IsPreview,
-		Resources:  apiResources,
-		Source:     dataOrigin,
+		APIVersion:        input.ApiVersion,
+		Generate:          input.Generate,
+		ParameterizedHost: mapParameterizedHostFromRepository(input.ParameterizedHost),
+		Preview:           input.IsPreview,
+		Resources:         apiResources,
+		Source:            dataOrigin,
 	}, nil
 }
 
@@ -43,11 +44,12 @@ func MapAPIVersionToRepository(input sdkModels.APIVersion) (*repositoryModels.Ap
 	sort.Strings(apiResourceNames)
 
 	versionDefinition := repositoryModels.ApiVersionDefinition{
-		ApiVersion: input.APIVersion,
-		IsPreview:  input.Preview,
-		Generate:   input.Generate,
-		Resources:  apiResourceNames,
-		Source:     dataOrigin,
+		ApiVersion:        input.APIVersion,
+		IsPreview:         input.Preview,
+		Generate:          input.Generate,
+		ParameterizedHost: mapParameterizedHostToRepository(input.ParameterizedHost),
+		Resources:         apiResourceNames,
+		Source:            dataOrigin,
 	}
 
 	return &versionDefinition, nil
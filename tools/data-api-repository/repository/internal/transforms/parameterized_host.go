@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package transforms
+
+import (
+	repositoryModels "github.com/hashicorp/pandora/tools/data-api-repository/repository/internal/models"
+	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+func mapParameterizedHostFromRepository(input *repositoryModels.ParameterizedHostDefinition) *sdkModels.ParameterizedHost {
+	if input == nil {
+		return nil
+	}
+
+	parameters := make([]sdkModels.ParameterizedHostParameter, 0)
+	for _, item := range input.Parameters {
+		parameters = append(parameters, sdkModels.ParameterizedHostParameter{
+			DefaultValue: item.DefaultValue,
+			Description:  item.Description,
+			Name:         item.Name,
+			Required:     item.Required,
+		})
+	}
+
+	return &sdkModels.ParameterizedHost{
+		HostTemplate:    input.HostTemplate,
+		Parameters:      parameters,
+		UseSchemePrefix: input.UseSchemePrefix,
+	}
+}
+
+func mapParameterizedHostToRepository(input *sdkModels.ParameterizedHost) *repositoryModels.ParameterizedHostDefinition {
+	if input == nil {
+		return nil
+	}
+
+	parameters := make([]repositoryModels.ParameterizedHostParameterDefinition, 0)
+	for _, item := range input.Parameters {
+		parameters = append(parameters, repositoryModels.ParameterizedHostParameterDefinition{
+			DefaultValue: item.DefaultValue,
+			Description:  item.Description,
+			Name:         item.Name,
+			Required:     item.Required,
+		})
+	}
+
+	return &repositoryModels.ParameterizedHostDefinition{
+		HostTemplate:    input.HostTemplate,
+		Parameters:      parameters,
+		UseSchemePrefix: input.UseSchemePrefix,
+	}
+}
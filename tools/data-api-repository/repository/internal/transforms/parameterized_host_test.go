@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package transforms
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+func TestMapParameterizedHost_RoundTrip(t *testing.T) {
+	input := &sdkModels.ParameterizedHost{
+		HostTemplate: "{accountName}.blob.{endpointSuffix}",
+		Parameters: []sdkModels.ParameterizedHostParameter{
+			{
+				Description: "The name of the Storage Account.",
+				Name:        "accountName",
+				Required:    true,
+			},
+			{
+				DefaultValue: pointer.To("core.windows.net"),
+				Description:  "The DNS Suffix for the Storage Account.",
+				Name:         "endpointSuffix",
+			},
+		},
+		UseSchemePrefix: true,
+	}
+
+	actual := mapParameterizedHostFromRepository(mapParameterizedHostToRepository(input))
+	if !reflect.DeepEqual(input, actual) {
+		t.Fatalf("expected %+v but got %+v", *input, actual)
+	}
+}
+
+func TestMapParameterizedHost_Nil(t *testing.T) {
+	if actual := mapParameterizedHostToRepository(nil); actual != nil {
+		t.Fatalf("expected no Parameterized Host but got %+v", *actual)
+	}
+	if actual := mapParameterizedHostFromRepository(nil); actual != nil {
+		t.Fatalf("expected no Parameterized Host but got %+v", *actual)
+	}
+}
@@ -39,6 +39,15 @@ func mapSDKFieldFromRepository(input repositoryModels.ModelField) (*sdkModels.SD
 		output.DateFormat = dateFormat
 	}
 
+	if input.Mutability != nil {
+		mutability, err := mapSDKFieldMutabilityFromRepository(*input.Mutability)
+		if err != nil {
+			return nil, fmt.Errorf("mapping the SDK Field Mutability: %+v", err)
+		}
+
+		output.Mutability = mutability
+	}
+
 	return &output, nil
 }
 
@@ -73,6 +82,13 @@ func mapSDKFieldToRepository(fieldName string, input sdkModels.SDKField, isTypeH
 		}
 		output.DateFormat = dateFormat
 	}
+	if len(input.Mutability) > 0 {
+		mutability, err := mapSDKFieldMutabilityToRepository(input.Mutability)
+		if err != nil {
+			return nil, fmt.Errorf("mapping SDK Field Mutability for field %q: %+v", fieldName, err)
+		}
+		output.Mutability = mutability
+	}
 
 	return &output, nil
 }
@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package transforms
+
+import (
+	"fmt"
+
+	repositoryModels "github.com/hashicorp/pandora/tools/data-api-repository/repository/internal/models"
+	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+var fieldMutabilitiesFromRepository = map[repositoryModels.FieldMutability]sdkModels.SDKFieldMutability{
+	repositoryModels.CreateFieldMutability: sdkModels.CreateSDKFieldMutability,
+	repositoryModels.ReadFieldMutability:   sdkModels.ReadSDKFieldMutability,
+	repositoryModels.UpdateFieldMutability: sdkModels.UpdateSDKFieldMutability,
+}
+
+func mapSDKFieldMutabilityFromRepository(input []repositoryModels.FieldMutability) ([]sdkModels.SDKFieldMutability, error) {
+	output := make([]sdkModels.SDKFieldMutability, 0)
+	for _, item := range input {
+		mapped, ok := fieldMutabilitiesFromRepository[item]
+		if !ok {
+			return nil, fmt.Errorf("missing mapping for FieldMutability %q", string(item))
+		}
+		output = append(output, mapped)
+	}
+	return output, nil
+}
+
+func mapSDKFieldMutabilityToRepository(input []sdkModels.SDKFieldMutability) (*[]repositoryModels.FieldMutability, error) {
+	output := make([]repositoryModels.FieldMutability, 0)
+	for _, item := range input {
+		found := false
+		for k, v := range fieldMutabilitiesFromRepository {
+			if v == item {
+				output = append(output, k)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("missing mapping for SDKFieldMutability %q", item)
+		}
+	}
+	return &output, nil
+}
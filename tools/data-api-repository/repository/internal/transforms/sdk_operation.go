@@ -32,6 +32,7 @@ func MapSDKOperationFromRepository(input repositoryModels.Operation, knownData h
 		ExpectedStatusCodes:              input.ExpectedStatusCodes,
 		FieldContainingPaginationDetails: input.FieldContainingPaginationDetails,
 		LongRunning:                      input.LongRunning,
+		LongRunningFinalStateVia:         input.LongRunningFinalStateVia,
 		Method:                           input.HTTPMethod,
 		Options:                          options,
 		RequestObject:                    nil,
@@ -41,6 +42,10 @@ func MapSDKOperationFromRepository(input repositoryModels.Operation, knownData h
 		URISuffix:                        input.UriSuffix,
 	}
 
+	if input.Examples != nil {
+		output.Examples = mapSDKOperationExamplesFromRepository(*input.Examples)
+	}
+
 	if input.ResourceIdName != nil {
 		if known := knownData.ResourceIDExists(*input.ResourceIdName); !known {
 			return nil, fmt.Errorf("the referenced Resource ID %q was not found", *input.ResourceIdName)
@@ -79,6 +84,7 @@ func MapSDKOperationToRepository(operationName string, input sdkModels.SDKOperat
 		ExpectedStatusCodes:              input.ExpectedStatusCodes,
 		FieldContainingPaginationDetails: input.FieldContainingPaginationDetails,
 		LongRunning:                      input.LongRunning,
+		LongRunningFinalStateVia:         input.LongRunningFinalStateVia,
 		HTTPMethod:                       strings.ToUpper(input.Method),
 		ResourceIdName:                   input.ResourceIDName,
 		ResourceIdNameIsCommonType:       input.ResourceIDNameIsCommonType,
@@ -101,6 +107,10 @@ func MapSDKOperationToRepository(operationName string, input sdkModels.SDKOperat
 		output.ResponseObject = responseObject
 	}
 
+	if len(input.Examples) > 0 {
+		output.Examples = pointer.To(mapSDKOperationExamplesToRepository(input.Examples))
+	}
+
 	if len(input.Options) > 0 {
 		options := make([]repositoryModels.Option, 0)
 		sortedOptionsKeys := make([]string, 0)
@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package transforms
+
+import (
+	"sort"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	repositoryModels "github.com/hashicorp/pandora/tools/data-api-repository/repository/internal/models"
+	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+func mapSDKOperationExamplesFromRepository(input []repositoryModels.OperationExample) map[string]sdkModels.SDKOperationExample {
+	output := make(map[string]sdkModels.SDKOperationExample)
+	for _, item := range input {
+		example := sdkModels.SDKOperationExample{
+			PathParameters: item.PathParameters,
+			RequestBody:    item.RequestBody,
+		}
+		if item.Responses != nil {
+			example.Responses = make(map[int]interface{})
+			for _, response := range *item.Responses {
+				example.Responses[response.StatusCode] = response.Body
+			}
+		}
+		output[item.Name] = example
+	}
+	return output
+}
+
+func mapSDKOperationExamplesToRepository(input map[string]sdkModels.SDKOperationExample) []repositoryModels.OperationExample {
+	// Examples are output in a consistent order to avoid unnecessary diffs
+	sortedExampleNames := make([]string, 0)
+	for k := range input {
+		sortedExampleNames = append(sortedExampleNames, k)
+	}
+	sort.Strings(sortedExampleNames)
+
+	output := make([]repositoryModels.OperationExample, 0)
+	for _, exampleName := range sortedExampleNames {
+		example := input[exampleName]
+		item := repositoryModels.OperationExample{
+			Name:           exampleName,
+			PathParameters: example.PathParameters,
+			RequestBody:    example.RequestBody,
+		}
+
+		if len(example.Responses) > 0 {
+			sortedStatusCodes := make([]int, 0)
+			for statusCode := range example.Responses {
+				sortedStatusCodes = append(sortedStatusCodes, statusCode)
+			}
+			sort.Ints(sortedStatusCodes)
+
+			responses := make([]repositoryModels.OperationExampleResponse, 0)
+			for _, statusCode := range sortedStatusCodes {
+				responses = append(responses, repositoryModels.OperationExampleResponse{
+					StatusCode: statusCode,
+					Body:       example.Responses[statusCode],
+				})
+			}
+			item.Responses = pointer.To(responses)
+		}
+
+		output = append(output, item)
+	}
+	return output
+}
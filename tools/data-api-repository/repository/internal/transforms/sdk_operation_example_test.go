@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package transforms
+
+import (
+	"reflect"
+	"testing"
+
+	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+func TestMapSDKOperationExamples_RoundTrip(t *testing.T) {
+	input := map[string]sdkModels.SDKOperationExample{
+		"Update a World": {
+			RequestBody: map[string]interface{}{
+				"name": "example",
+			},
+		},
+		"Create a World": {
+			PathParameters: map[string]string{
+				"worldName": "example",
+			},
+			RequestBody: map[string]interface{}{
+				"name": "example",
+			},
+			Responses: map[int]interface{}{
+				201: nil,
+				200: map[string]interface{}{
+					"id": "/worlds/example",
+				},
+			},
+		},
+	}
+
+	repositoryModels := mapSDKOperationExamplesToRepository(input)
+	if len(repositoryModels) != 2 {
+		t.Fatalf("expected 2 examples but got %d", len(repositoryModels))
+	}
+	// the Examples (and their Responses) are output in a consistent order to avoid unnecessary diffs
+	if repositoryModels[0].Name != "Create a World" || repositoryModels[1].Name != "Update a World" {
+		t.Fatalf("expected the examples to be sorted by name but got %q and %q", repositoryModels[0].Name, repositoryModels[1].Name)
+	}
+	responses := *repositoryModels[0].Responses
+	if len(responses) != 2 || responses[0].StatusCode != 200 || responses[1].StatusCode != 201 {
+		t.Fatalf("expected the responses to be sorted by status code but got %+v", responses)
+	}
+	if repositoryModels[1].Responses != nil {
+		t.Fatalf("expected no responses for an example without any but got %+v", *repositoryModels[1].Responses)
+	}
+
+	actual := mapSDKOperationExamplesFromRepository(repositoryModels)
+	if !reflect.DeepEqual(input, actual) {
+		t.Fatalf("expected %+v but got %+v", input, actual)
+	}
+}
@@ -42,6 +42,9 @@ func MapServiceDefinitionToRepository(input sdkModels.Service) (*repositoryModel
 			ServicePackageName: input.TerraformDefinition.TerraformPackageName,
 			Resources:          terraformResourceNames,
 		}
+		if input.TerraformDefinition.Framework != "" && input.TerraformDefinition.Framework != sdkModels.PluginSdkTerraformFrameworkType {
+			output.Terraform.Framework = pointer.To(input.TerraformDefinition.Framework)
+		}
 	}
 
 	return &output, nil
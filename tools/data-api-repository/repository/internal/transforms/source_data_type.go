@@ -9,11 +9,13 @@ import (
 )
 
 var sourceDataTypesFromRepository = map[repositoryModels.DataSource]sdkModels.SourceDataType{
+	repositoryModels.AzureDataPlaneDataSource:       sdkModels.DataPlaneSourceDataType,
 	repositoryModels.AzureResourceManagerDataSource: sdkModels.ResourceManagerSourceDataType,
 	repositoryModels.MicrosoftGraphDataSource:       sdkModels.MicrosoftGraphSourceDataType,
 }
 
 var sourceDataTypesToRepository = map[sdkModels.SourceDataType]repositoryModels.DataSource{
+	sdkModels.DataPlaneSourceDataType:       repositoryModels.AzureDataPlaneDataSource,
 	sdkModels.ResourceManagerSourceDataType: repositoryModels.AzureResourceManagerDataSource,
 	sdkModels.MicrosoftGraphSourceDataType:  repositoryModels.MicrosoftGraphDataSource,
 }
@@ -0,0 +1,25 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package transforms
+
+import (
+	repositoryModels "github.com/hashicorp/pandora/tools/data-api-repository/repository/internal/models"
+	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+func mapTerraformDataSourceDefinitionFromRepository(input repositoryModels.TerraformDataSourceDefinition) *sdkModels.TerraformDataSourceDefinition {
+	return &sdkModels.TerraformDataSourceDefinition{
+		Description:      input.Description,
+		Generate:         input.Generate,
+		TimeoutInMinutes: input.TimeoutInMinutes,
+	}
+}
+
+func mapTerraformDataSourceDefinitionToRepository(input sdkModels.TerraformDataSourceDefinition) *repositoryModels.TerraformDataSourceDefinition {
+	return &repositoryModels.TerraformDataSourceDefinition{
+		Description:      input.Description,
+		Generate:         input.Generate,
+		TimeoutInMinutes: input.TimeoutInMinutes,
+	}
+}
@@ -44,6 +44,69 @@ func MapTerraformSchemaMappingsFromRepository(input repositoryModels.TerraformMa
 					})
 				}
 
+			case repositoryModels.BooleanEqualsTerraformFieldMappingDefinitionType:
+				{
+					output.Fields = append(output.Fields, sdkModels.TerraformBooleanEqualsFieldMappingDefinition{
+						BooleanEquals: sdkModels.TerraformBooleanEqualsFieldMappingDefinitionImpl{
+							TerraformSchemaModelName: item.BooleanEquals.SchemaModelName,
+							TerraformSchemaFieldName: item.BooleanEquals.SchemaFieldPath,
+							SDKModelName:             item.BooleanEquals.SdkModelName,
+							SDKFieldName:             item.BooleanEquals.SdkFieldPath,
+							TrueValue:                item.BooleanEquals.TrueValue,
+							FalseValue:               item.BooleanEquals.FalseValue,
+						},
+					})
+				}
+
+			case repositoryModels.BooleanInvertTerraformFieldMappingDefinitionType:
+				{
+					output.Fields = append(output.Fields, sdkModels.TerraformBooleanInvertFieldMappingDefinition{
+						BooleanInvert: sdkModels.TerraformBooleanInvertFieldMappingDefinitionImpl{
+							TerraformSchemaModelName: item.BooleanInvert.SchemaModelName,
+							TerraformSchemaFieldName: item.BooleanInvert.SchemaFieldPath,
+							SDKModelName:             item.BooleanInvert.SdkModelName,
+							SDKFieldName:             item.BooleanInvert.SdkFieldPath,
+						},
+					})
+				}
+
+			case repositoryModels.SubResourceIdTerraformFieldMappingDefinitionType:
+				{
+					output.Fields = append(output.Fields, sdkModels.TerraformSubResourceIdFieldMappingDefinition{
+						SubResourceId: sdkModels.TerraformSubResourceIdFieldMappingDefinitionImpl{
+							TerraformSchemaModelName: item.SubResourceId.SchemaModelName,
+							TerraformSchemaFieldName: item.SubResourceId.SchemaFieldPath,
+							SDKModelName:             item.SubResourceId.SdkModelName,
+							SDKFieldName:             item.SubResourceId.SdkFieldPath,
+						},
+					})
+				}
+
+			case repositoryModels.SubResourceIdListTerraformFieldMappingDefinitionType:
+				{
+					output.Fields = append(output.Fields, sdkModels.TerraformSubResourceIdListFieldMappingDefinition{
+						SubResourceIdList: sdkModels.TerraformSubResourceIdListFieldMappingDefinitionImpl{
+							TerraformSchemaModelName: item.SubResourceIdList.SchemaModelName,
+							TerraformSchemaFieldName: item.SubResourceIdList.SchemaFieldPath,
+							SDKModelName:             item.SubResourceIdList.SdkModelName,
+							SDKFieldName:             item.SubResourceIdList.SdkFieldPath,
+						},
+					})
+				}
+
+			case repositoryModels.ODataBindTerraformFieldMappingDefinitionType:
+				{
+					output.Fields = append(output.Fields, sdkModels.TerraformODataBindFieldMappingDefinition{
+						ODataBind: sdkModels.TerraformODataBindFieldMappingDefinitionImpl{
+							TerraformSchemaModelName: item.ODataBind.SchemaModelName,
+							TerraformSchemaFieldName: item.ODataBind.SchemaFieldPath,
+							SDKModelName:             item.ODataBind.SdkModelName,
+							SDKFieldName:             item.ODataBind.SdkFieldPath,
+							EntitySetName:            item.ODataBind.EntitySetName,
+						},
+					})
+				}
+
 			default:
 				{
 					return nil, fmt.Errorf("unimplemented Field Mapping Definition Type %q", string(item.Type))
@@ -127,6 +190,108 @@ func MapTerraformSchemaMappingsToRepository(input sdkModels.TerraformMappingDefi
 			continue
 		}
 
+		if v, ok := item.(sdkModels.TerraformBooleanEqualsFieldMappingDefinition); ok {
+			fieldMappings = append(fieldMappings, repositoryModels.TerraformFieldMappingDefinition{
+				Type: repositoryModels.BooleanEqualsTerraformFieldMappingDefinitionType,
+				BooleanEquals: &repositoryModels.TerraformFieldMappingBooleanEqualsDefinition{
+					TerraformFieldMappingDirectAssignmentDefinition: repositoryModels.TerraformFieldMappingDirectAssignmentDefinition{
+						// todo remove Schema when https://github.com/hashicorp/pandora/issues/3346 is addressed
+						SchemaModelName: fmt.Sprintf("%sSchema", v.BooleanEquals.TerraformSchemaModelName),
+						SchemaFieldPath: v.BooleanEquals.TerraformSchemaFieldName,
+						SdkModelName:    v.BooleanEquals.SDKModelName,
+						SdkFieldPath:    v.BooleanEquals.SDKFieldName,
+					},
+					TrueValue:  v.BooleanEquals.TrueValue,
+					FalseValue: v.BooleanEquals.FalseValue,
+				},
+			})
+			modelToModelMappings = append(modelToModelMappings, repositoryModels.TerraformModelToModelMappingDefinition{
+				// todo remove Schema when https://github.com/hashicorp/pandora/issues/3346 is addressed
+				SchemaModelName: fmt.Sprintf("%sSchema", v.BooleanEquals.TerraformSchemaModelName),
+				SdkModelName:    v.BooleanEquals.SDKModelName,
+			})
+			continue
+		}
+
+		if v, ok := item.(sdkModels.TerraformBooleanInvertFieldMappingDefinition); ok {
+			fieldMappings = append(fieldMappings, repositoryModels.TerraformFieldMappingDefinition{
+				Type: repositoryModels.BooleanInvertTerraformFieldMappingDefinitionType,
+				BooleanInvert: &repositoryModels.TerraformFieldMappingDirectAssignmentDefinition{
+					// todo remove Schema when https://github.com/hashicorp/pandora/issues/3346 is addressed
+					SchemaModelName: fmt.Sprintf("%sSchema", v.BooleanInvert.TerraformSchemaModelName),
+					SchemaFieldPath: v.BooleanInvert.TerraformSchemaFieldName,
+					SdkModelName:    v.BooleanInvert.SDKModelName,
+					SdkFieldPath:    v.BooleanInvert.SDKFieldName,
+				},
+			})
+			modelToModelMappings = append(modelToModelMappings, repositoryModels.TerraformModelToModelMappingDefinition{
+				// todo remove Schema when https://github.com/hashicorp/pandora/issues/3346 is addressed
+				SchemaModelName: fmt.Sprintf("%sSchema", v.BooleanInvert.TerraformSchemaModelName),
+				SdkModelName:    v.BooleanInvert.SDKModelName,
+			})
+			continue
+		}
+
+		if v, ok := item.(sdkModels.TerraformSubResourceIdFieldMappingDefinition); ok {
+			fieldMappings = append(fieldMappings, repositoryModels.TerraformFieldMappingDefinition{
+				Type: repositoryModels.SubResourceIdTerraformFieldMappingDefinitionType,
+				SubResourceId: &repositoryModels.TerraformFieldMappingDirectAssignmentDefinition{
+					// todo remove Schema when https://github.com/hashicorp/pandora/issues/3346 is addressed
+					SchemaModelName: fmt.Sprintf("%sSchema", v.SubResourceId.TerraformSchemaModelName),
+					SchemaFieldPath: v.SubResourceId.TerraformSchemaFieldName,
+					SdkModelName:    v.SubResourceId.SDKModelName,
+					SdkFieldPath:    v.SubResourceId.SDKFieldName,
+				},
+			})
+			modelToModelMappings = append(modelToModelMappings, repositoryModels.TerraformModelToModelMappingDefinition{
+				// todo remove Schema when https://github.com/hashicorp/pandora/issues/3346 is addressed
+				SchemaModelName: fmt.Sprintf("%sSchema", v.SubResourceId.TerraformSchemaModelName),
+				SdkModelName:    v.SubResourceId.SDKModelName,
+			})
+			continue
+		}
+
+		if v, ok := item.(sdkModels.TerraformSubResourceIdListFieldMappingDefinition); ok {
+			fieldMappings = append(fieldMappings, repositoryModels.TerraformFieldMappingDefinition{
+				Type: repositoryModels.SubResourceIdListTerraformFieldMappingDefinitionType,
+				SubResourceIdList: &repositoryModels.TerraformFieldMappingDirectAssignmentDefinition{
+					// todo remove Schema when https://github.com/hashicorp/pandora/issues/3346 is addressed
+					SchemaModelName: fmt.Sprintf("%sSchema", v.SubResourceIdList.TerraformSchemaModelName),
+					SchemaFieldPath: v.SubResourceIdList.TerraformSchemaFieldName,
+					SdkModelName:    v.SubResourceIdList.SDKModelName,
+					SdkFieldPath:    v.SubResourceIdList.SDKFieldName,
+				},
+			})
+			modelToModelMappings = append(modelToModelMappings, repositoryModels.TerraformModelToModelMappingDefinition{
+				// todo remove Schema when https://github.com/hashicorp/pandora/issues/3346 is addressed
+				SchemaModelName: fmt.Sprintf("%sSchema", v.SubResourceIdList.TerraformSchemaModelName),
+				SdkModelName:    v.SubResourceIdList.SDKModelName,
+			})
+			continue
+		}
+
+		if v, ok := item.(sdkModels.TerraformODataBindFieldMappingDefinition); ok {
+			fieldMappings = append(fieldMappings, repositoryModels.TerraformFieldMappingDefinition{
+				Type: repositoryModels.ODataBindTerraformFieldMappingDefinitionType,
+				ODataBind: &repositoryModels.TerraformFieldMappingODataBindDefinition{
+					TerraformFieldMappingDirectAssignmentDefinition: repositoryModels.TerraformFieldMappingDirectAssignmentDefinition{
+						// todo remove Schema when https://github.com/hashicorp/pandora/issues/3346 is addressed
+						SchemaModelName: fmt.Sprintf("%sSchema", v.ODataBind.TerraformSchemaModelName),
+						SchemaFieldPath: v.ODataBind.TerraformSchemaFieldName,
+						SdkModelName:    v.ODataBind.SDKModelName,
+						SdkFieldPath:    v.ODataBind.SDKFieldName,
+					},
+					EntitySetName: v.ODataBind.EntitySetName,
+				},
+			})
+			modelToModelMappings = append(modelToModelMappings, repositoryModels.TerraformModelToModelMappingDefinition{
+				// todo remove Schema when https://github.com/hashicorp/pandora/issues/3346 is addressed
+				SchemaModelName: fmt.Sprintf("%sSchema", v.ODataBind.TerraformSchemaModelName),
+				SdkModelName:    v.ODataBind.SDKModelName,
+			})
+			continue
+		}
+
 		return nil, fmt.Errorf("internal-error: missing mapping implementation for %T", item)
 	}
 
@@ -201,6 +366,31 @@ func orderFieldMappings(input []repositoryModels.TerraformFieldMappingDefinition
 				key = fmt.Sprintf("%s-%s-%s-%s", string(item.Type), item.ModelToModel.SchemaModelName, item.ModelToModel.SdkModelName, item.ModelToModel.SdkFieldName)
 			}
 
+		case repositoryModels.BooleanEqualsTerraformFieldMappingDefinitionType:
+			{
+				key = fmt.Sprintf("%s-%s-%s-%s-%s", string(item.Type), item.BooleanEquals.SchemaModelName, item.BooleanEquals.SchemaFieldPath, item.BooleanEquals.SdkModelName, item.BooleanEquals.SdkFieldPath)
+			}
+
+		case repositoryModels.BooleanInvertTerraformFieldMappingDefinitionType:
+			{
+				key = fmt.Sprintf("%s-%s-%s-%s-%s", string(item.Type), item.BooleanInvert.SchemaModelName, item.BooleanInvert.SchemaFieldPath, item.BooleanInvert.SdkModelName, item.BooleanInvert.SdkFieldPath)
+			}
+
+		case repositoryModels.SubResourceIdTerraformFieldMappingDefinitionType:
+			{
+				key = fmt.Sprintf("%s-%s-%s-%s-%s", string(item.Type), item.SubResourceId.SchemaModelName, item.SubResourceId.SchemaFieldPath, item.SubResourceId.SdkModelName, item.SubResourceId.SdkFieldPath)
+			}
+
+		case repositoryModels.SubResourceIdListTerraformFieldMappingDefinitionType:
+			{
+				key = fmt.Sprintf("%s-%s-%s-%s-%s", string(item.Type), item.SubResourceIdList.SchemaModelName, item.SubResourceIdList.SchemaFieldPath, item.SubResourceIdList.SdkModelName, item.SubResourceIdList.SdkFieldPath)
+			}
+
+		case repositoryModels.ODataBindTerraformFieldMappingDefinitionType:
+			{
+				key = fmt.Sprintf("%s-%s-%s-%s-%s", string(item.Type), item.ODataBind.SchemaModelName, item.ODataBind.SchemaFieldPath, item.ODataBind.SdkModelName, item.ODataBind.SdkFieldPath)
+			}
+
 		case repositoryModels.ManualTerraformFieldMappingDefinitionType:
 			{
 				key = fmt.Sprintf("%s-%s", string(item.Type), item.Manual.MethodName)
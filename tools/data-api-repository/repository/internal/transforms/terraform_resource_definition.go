@@ -24,6 +24,7 @@ func MapTerraformResourceDefinitionFromRepository(resourceName string, input rep
 			ExampleUsageHCL: helpers.TrimNewLinesAround(input.ExampleUsage),
 		},
 		DisplayName:          input.DisplayName,
+		EventuallyConsistent: input.EventuallyConsistent,
 		Generate:             input.Generate,
 		GenerateModel:        input.GenerateModel,
 		GenerateIDValidation: input.GenerateIdValidationFunction,
@@ -38,6 +39,9 @@ func MapTerraformResourceDefinitionFromRepository(resourceName string, input rep
 		Tests:                tests,
 		UpdateMethod:         nil,
 	}
+	if input.DataSource != nil {
+		output.DataSource = mapTerraformDataSourceDefinitionFromRepository(*input.DataSource)
+	}
 	if input.UpdateMethod != nil {
 		updateMethod := mapTerraformMethodDefinitionFromRepository(*input.UpdateMethod)
 		output.UpdateMethod = pointer.To(updateMethod)
@@ -55,6 +59,7 @@ func MapTerraformResourceDefinitionToRepository(resourceLabel string, input sdkM
 		DeleteMethod:                 mapTerraformMethodDefinitionToRepository(input.DeleteMethod),
 		Description:                  input.Documentation.Description,
 		DisplayName:                  input.DisplayName,
+		EventuallyConsistent:         input.EventuallyConsistent,
 		ExampleUsage:                 helpers.TrimNewLinesAround(input.Documentation.ExampleUsageHCL),
 		Generate:                     input.Generate,
 		GenerateIdValidationFunction: input.GenerateIDValidation,
@@ -69,6 +74,9 @@ func MapTerraformResourceDefinitionToRepository(resourceLabel string, input sdkM
 		// TODO: output tests here
 		UpdateMethod: nil,
 	}
+	if input.DataSource != nil {
+		output.DataSource = mapTerraformDataSourceDefinitionToRepository(*input.DataSource)
+	}
 	if input.UpdateMethod != nil {
 		mapped := mapTerraformMethodDefinitionToRepository(*input.UpdateMethod)
 		output.UpdateMethod = pointer.To(mapped)
@@ -19,6 +19,7 @@ func mapTerraformSchemaFieldFromRepository(input repositoryModels.TerraformSchem
 
 	output := sdkModels.TerraformSchemaField{
 		Computed:      pointer.From(input.Computed),
+		Default:       input.Default,
 		Documentation: sdkModels.TerraformSchemaFieldDocumentationDefinition{
 			// intentionally empty by default
 		},
@@ -27,11 +28,23 @@ func mapTerraformSchemaFieldFromRepository(input repositoryModels.TerraformSchem
 		ObjectDefinition: *objectDefinition,
 		Optional:         pointer.From(input.Optional),
 		Required:         pointer.From(input.Required),
+		Sensitive:        pointer.From(input.Sensitive),
 		Validation:       nil,
 	}
 	if input.Documentation != nil {
 		output.Documentation.Markdown = input.Documentation.Markdown
 	}
+	// numbers are decoded as a float64 by default, whereas an Integer field should have an int64 default
+	if v, ok := output.Default.(float64); ok && output.ObjectDefinition.Type == sdkModels.IntegerTerraformSchemaObjectDefinitionType {
+		output.Default = int64(v)
+	}
+	if input.Validation != nil {
+		validation, err := mapTerraformSchemaFieldValidationFromRepository(*input.Validation)
+		if err != nil {
+			return nil, fmt.Errorf("mapping the validation: %+v", err)
+		}
+		output.Validation = validation
+	}
 	return &output, nil
 }
 
@@ -42,6 +55,7 @@ func mapTerraformSchemaFieldToRepository(fieldName string, input sdkModels.Terra
 	}
 
 	output := repositoryModels.TerraformSchemaField{
+		Default:          input.Default,
 		HclName:          input.HCLName,
 		Name:             fieldName,
 		ObjectDefinition: *objectDefinition,
@@ -59,6 +73,9 @@ func mapTerraformSchemaFieldToRepository(fieldName string, input sdkModels.Terra
 	if input.Required {
 		output.Required = pointer.To(true)
 	}
+	if input.Sensitive {
+		output.Sensitive = pointer.To(true)
+	}
 	if input.Documentation.Markdown != "" {
 		output.Documentation = &repositoryModels.TerraformSchemaFieldDocumentation{
 			Markdown: input.Documentation.Markdown,
@@ -6,6 +6,7 @@ package transforms
 import (
 	"fmt"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	repositoryModels "github.com/hashicorp/pandora/tools/data-api-repository/repository/internal/models"
 	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
 )
@@ -26,11 +27,86 @@ func mapTerraformSchemaFieldValidationToRepository(input sdkModels.TerraformSche
 		}, nil
 	}
 
+	if v, ok := input.(sdkModels.TerraformSchemaFieldValidationRangeDefinition); ok {
+		return &repositoryModels.TerraformSchemaFieldValidationDefinition{
+			Type: repositoryModels.RangeTerraformSchemaValidationType,
+			Range: &repositoryModels.TerraformSchemaValidationRangeDefinition{
+				Minimum: v.Minimum,
+				Maximum: v.Maximum,
+			},
+		}, nil
+	}
+
+	if v, ok := input.(sdkModels.TerraformSchemaFieldValidationRegexDefinition); ok {
+		return &repositoryModels.TerraformSchemaFieldValidationDefinition{
+			Type:  repositoryModels.RegexTerraformSchemaValidationType,
+			Regex: pointer.To(v.Regex),
+		}, nil
+	}
+
 	return nil, fmt.Errorf("internal-error: missing mapping for Schema Field Validation Type %T", input)
 }
 
+func mapTerraformSchemaFieldValidationFromRepository(input repositoryModels.TerraformSchemaFieldValidationDefinition) (sdkModels.TerraformSchemaFieldValidationDefinition, error) {
+	switch input.Type {
+	case repositoryModels.PossibleValuesTerraformSchemaValidationType:
+		if input.PossibleValues == nil {
+			return nil, fmt.Errorf("the validation type was %q but no `possibleValues` were specified", string(input.Type))
+		}
+		var possibleValuesType *sdkModels.TerraformSchemaFieldValidationPossibleValuesType
+		for k, v := range terraformSchemaFieldPossibleValuesTypesToRepository {
+			if v == input.PossibleValues.Type {
+				possibleValuesType = pointer.To(k)
+				break
+			}
+		}
+		if possibleValuesType == nil {
+			return nil, fmt.Errorf("internal-error: missing mapping for Validation PossibleValueType %q", string(input.PossibleValues.Type))
+		}
+
+		values := input.PossibleValues.Values
+		if *possibleValuesType == sdkModels.IntegerTerraformSchemaFieldValidationPossibleValuesType {
+			// numbers are decoded as a float64 by default
+			values = make([]any, 0)
+			for _, item := range input.PossibleValues.Values {
+				if v, ok := item.(float64); ok {
+					values = append(values, int64(v))
+					continue
+				}
+				values = append(values, item)
+			}
+		}
+
+		return sdkModels.TerraformSchemaFieldValidationPossibleValuesDefinition{
+			PossibleValues: &sdkModels.TerraformSchemaFieldValidationPossibleValuesDefinitionImpl{
+				Type:   *possibleValuesType,
+				Values: values,
+			},
+		}, nil
+
+	case repositoryModels.RangeTerraformSchemaValidationType:
+		if input.Range == nil {
+			return nil, fmt.Errorf("the validation type was %q but no `range` was specified", string(input.Type))
+		}
+		return sdkModels.TerraformSchemaFieldValidationRangeDefinition{
+			Minimum: input.Range.Minimum,
+			Maximum: input.Range.Maximum,
+		}, nil
+
+	case repositoryModels.RegexTerraformSchemaValidationType:
+		if input.Regex == nil {
+			return nil, fmt.Errorf("the validation type was %q but no `regex` was specified", string(input.Type))
+		}
+		return sdkModels.TerraformSchemaFieldValidationRegexDefinition{
+			Regex: *input.Regex,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("internal-error: missing mapping for Schema Field Validation Type %q", string(input.Type))
+}
+
 var terraformSchemaFieldPossibleValuesTypesToRepository = map[sdkModels.TerraformSchemaFieldValidationPossibleValuesType]repositoryModels.TerraformSchemaValidationPossibleValuesType{
-	sdkModels.IntegerTerraformSchemaFieldValidationPossibleValuesType: repositoryModels.FloatTerraformSchemaValidationPossibleValuesType,
-	sdkModels.FloatTerraformSchemaFieldValidationPossibleValuesType:   repositoryModels.IntegerTerraformSchemaValidationPossibleValuesType,
+	sdkModels.FloatTerraformSchemaFieldValidationPossibleValuesType:   repositoryModels.FloatTerraformSchemaValidationPossibleValuesType,
+	sdkModels.IntegerTerraformSchemaFieldValidationPossibleValuesType: repositoryModels.IntegerTerraformSchemaValidationPossibleValuesType,
 	sdkModels.StringTerraformSchemaFieldValidationPossibleValuesType:  repositoryModels.StringTerraformSchemaValidationPossibleValuesType,
 }
@@ -10,6 +10,7 @@ import "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
 // Types as these are defined here, for example by using this information in CLIs.
 func AvailableSourceDataTypes() []models.SourceDataType {
 	return []models.SourceDataType{
+		models.DataPlaneSourceDataType,
 		models.MicrosoftGraphSourceDataType,
 		models.ResourceManagerSourceDataType,
 	}
@@ -21,6 +21,10 @@ type DetailsForAPIVersionResponse struct {
 }
 
 type DetailsForAPIVersionSummary struct {
+	// ParameterizedHost optionally specifies the templated Host which the Operations within this API Version
+	// are relative to - this is only present for Data Plane API Versions.
+	ParameterizedHost *models.ParameterizedHost `json:"parameterizedHost,omitempty"`
+
 	// Resources is a map of API Resource names (key) to APIResourceSummary (value).
 	// This can be used to retrieve information about the API Resource in question.
 	Resources map[string]APIResourceSummary `json:"resources"`
@@ -137,11 +137,12 @@ func (c *Client) loadAllDetailsForAPIVersion(ctx context.Context, version string
 	}
 
 	return &models.APIVersion{
-		APIVersion: version,
-		Generate:   summary.Generate,
-		Preview:    summary.Preview,
-		Resources:  apiResources,
-		Source:     versionDetails.Model.Source,
+		APIVersion:        version,
+		Generate:          summary.Generate,
+		ParameterizedHost: versionDetails.Model.ParameterizedHost,
+		Preview:           summary.Preview,
+		Resources:         apiResources,
+		Source:            versionDetails.Model.Source,
 	}, nil
 }
 
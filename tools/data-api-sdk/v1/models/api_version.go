@@ -13,6 +13,10 @@ type APIVersion struct {
 	// Generate specifies whether this APIVersion should be generated or not.
 	Generate bool
 
+	// ParameterizedHost optionally specifies the templated Host which the Operations within this APIVersion
+	// are relative to - this is only present when the SourceDataType is DataPlaneSourceDataType.
+	ParameterizedHost *ParameterizedHost
+
 	// Preview specifies whether this APIVersion is a Preview API Version (meaning
 	// `preview`, `publicpreview`, `privatepreview`, `beta` and `alpha`) as opposed
 	// to a Stable API Version.
@@ -0,0 +1,34 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package models
+
+// ParameterizedHost defines the templated Host used by an APIVersion within a Data Plane API, where the
+// Operations are addressed relative to this Host (rather than to the Resource Manager endpoint).
+// This is sourced from the `x-ms-parameterized-host` extension.
+type ParameterizedHost struct {
+	// HostTemplate specifies the template for the Host, where each parameter is wrapped in braces
+	// for example `{vaultBaseUrl}` or `{accountName}.blob.{endpointSuffix}`.
+	HostTemplate string `json:"hostTemplate"`
+
+	// Parameters specifies the parameters which are used within the HostTemplate.
+	Parameters []ParameterizedHostParameter `json:"parameters"`
+
+	// UseSchemePrefix specifies whether the scheme (e.g. `https://`) should be prefixed to the HostTemplate.
+	UseSchemePrefix bool `json:"useSchemePrefix"`
+}
+
+// ParameterizedHostParameter defines a parameter used within the HostTemplate of a ParameterizedHost.
+type ParameterizedHostParameter struct {
+	// DefaultValue optionally specifies the default value for this parameter.
+	DefaultValue *string `json:"defaultValue,omitempty"`
+
+	// Description specifies the description for this parameter.
+	Description string `json:"description"`
+
+	// Name specifies the name of this parameter, as used within the HostTemplate.
+	Name string `json:"name"`
+
+	// Required specifies whether a value must be specified for this parameter.
+	Required bool `json:"required"`
+}
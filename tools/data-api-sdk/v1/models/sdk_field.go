@@ -20,6 +20,10 @@ type SDKField struct {
 	// JsonName specifies the name of this field within the JSON - which is typically in camelCase.
 	JsonName string `json:"jsonName"`
 
+	// Mutability optionally specifies the operations (Create, Read and/or Update) during which this SDKField can be
+	// used - when unspecified this SDKField can be used during all operations.
+	Mutability []SDKFieldMutability `json:"mutability,omitempty"`
+
 	// ObjectDefinition specifies the shape of the Type backing this SDKField.
 	ObjectDefinition SDKObjectDefinition `json:"objectDefinition"`
 
@@ -39,3 +43,24 @@ type SDKField struct {
 	// Sensitive specifies that this field contains a Sensitive value (such as a password or an API Key).
 	Sensitive bool `json:"sensitive"`
 }
+
+// IsCreateOnly returns whether this SDKField can only be specified when creating the resource, meaning that
+// changing the value requires the resource to be recreated.
+func (f SDKField) IsCreateOnly() bool {
+	return f.hasMutability(CreateSDKFieldMutability) && !f.hasMutability(UpdateSDKFieldMutability)
+}
+
+// IsUpdateOnly returns whether this SDKField can only be specified when updating the resource, meaning that
+// it should be omitted when creating the resource.
+func (f SDKField) IsUpdateOnly() bool {
+	return f.hasMutability(UpdateSDKFieldMutability) && !f.hasMutability(CreateSDKFieldMutability)
+}
+
+func (f SDKField) hasMutability(input SDKFieldMutability) bool {
+	for _, v := range f.Mutability {
+		if v == input {
+			return true
+		}
+	}
+	return false
+}
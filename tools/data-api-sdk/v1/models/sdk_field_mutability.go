@@ -0,0 +1,17 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package models
+
+type SDKFieldMutability = string
+
+const (
+	// CreateSDKFieldMutability specifies that the value for this SDKField can be specified when creating the resource.
+	CreateSDKFieldMutability SDKFieldMutability = "Create"
+
+	// ReadSDKFieldMutability specifies that the value for this SDKField is returned when retrieving the resource.
+	ReadSDKFieldMutability SDKFieldMutability = "Read"
+
+	// UpdateSDKFieldMutability specifies that the value for this SDKField can be specified when updating the resource.
+	UpdateSDKFieldMutability SDKFieldMutability = "Update"
+)
@@ -15,6 +15,10 @@ type SDKOperation struct {
 	// Description is used to write a comment for the operation method
 	Description string `json:"description"`
 
+	// Examples is an optional map of Example Name (key) to SDKOperationExample (value) which
+	// contains example Requests/Responses for this Operation, sourced from the API Definitions.
+	Examples map[string]SDKOperationExample `json:"examples,omitempty"`
+
 	// ExpectedStatusCodes specifies the list of Status Codes which are expected to be
 	// returned by this Operation.
 	ExpectedStatusCodes []int `json:"expectedStatusCodes"`
@@ -30,6 +34,12 @@ type SDKOperation struct {
 	// either the HTTP Header `Location` and/or the `provisioningState` value.
 	LongRunning bool `json:"longRunning"`
 
+	// LongRunningFinalStateVia optionally specifies where the final state of a Long Running Operation
+	// is retrieved from once polling has completed, as defined by `final-state-via` within the
+	// `x-ms-long-running-operation-options` extension (e.g. `azure-async-operation`, `location`
+	// or `original-uri`).
+	LongRunningFinalStateVia *string `json:"longRunningFinalStateVia,omitempty"`
+
 	// Method specifies the HTTP Method used for this operation, e.g. GET, POST, PATCH.
 	Method string `json:"method"`
 
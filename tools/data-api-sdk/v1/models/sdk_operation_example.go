@@ -0,0 +1,21 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package models
+
+// SDKOperationExample defines an example Request/Response for an SDKOperation, sourced from the
+// API Definitions (e.g. the `x-ms-examples` extension within Swagger) - which provides realistic
+// values that can be used when generating Examples and Tests.
+type SDKOperationExample struct {
+	// PathParameters is a map of the Path Parameter Name (key) to the value used in this Example (value).
+	// NOTE: the Path Parameter Name is the name used in the API Definitions (e.g. `resourceGroupName`),
+	// which typically (but not always) matches the name of the Resource ID Segment.
+	PathParameters map[string]string `json:"pathParameters,omitempty"`
+
+	// RequestBody optionally specifies the JSON payload sent in the Request for this Example.
+	RequestBody interface{} `json:"requestBody,omitempty"`
+
+	// Responses is a map of HTTP Status Code (key) to the JSON payload returned in the Response
+	// for this Example (value) - which is nil when no payload is returned.
+	Responses map[int]interface{} `json:"responses,omitempty"`
+}
@@ -7,6 +7,10 @@ package models
 type SourceDataType string
 
 const (
+	// DataPlaneSourceDataType defines that this Data is related to an Azure Data Plane API (for example
+	// Key Vault or Storage), where Operations are addressed relative to a (parameterized) Host.
+	DataPlaneSourceDataType SourceDataType = "data-plane"
+
 	// MicrosoftGraphSourceDataType defines that this Data is related to Microsoft Graph.
 	MicrosoftGraphSourceDataType SourceDataType = "microsoft-graph"
 
@@ -20,6 +24,8 @@ func SourceDataTypeIsDataPlane(sourceDataType SourceDataType) bool {
 
 func SourceDataTypeName(sourceDataType SourceDataType) string {
 	switch sourceDataType {
+	case DataPlaneSourceDataType:
+		return "Azure Data Plane"
 	case MicrosoftGraphSourceDataType:
 		return "Microsoft Graph"
 	case ResourceManagerSourceDataType:
@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+var _ json.Marshaler = TerraformBooleanEqualsFieldMappingDefinition{}
+var _ TerraformFieldMappingDefinition = TerraformBooleanEqualsFieldMappingDefinition{}
+
+// TerraformBooleanEqualsFieldMappingDefinition defines that a Boolean TerraformSchemaField should be mapped onto a
+// Constant SDKField - where `true` is mapped to/from the Constant value TrueValue and `false` is mapped to/from the
+// Constant value FalseValue (for example, `Enabled` and `Disabled`).
+type TerraformBooleanEqualsFieldMappingDefinition struct {
+	BooleanEquals TerraformBooleanEqualsFieldMappingDefinitionImpl `json:"booleanEquals"`
+}
+
+type TerraformBooleanEqualsFieldMappingDefinitionImpl struct {
+	// TerraformSchemaModelName specifies the name of the TerraformSchemaModel where the TerraformSchemaField named in
+	// TerraformSchemaFieldName exists.
+	TerraformSchemaModelName string `json:"schemaModelName"`
+
+	// TerraformSchemaFieldName specifies the name of the TerraformSchemaField (within the TerraformSchemaModel named in
+	// TerraformSchemaModelName) where the value for SDKFieldName should be mapped to/from.
+	TerraformSchemaFieldName string `json:"schemaFieldPath"`
+
+	// SDKModelName specifies the name of the SDKModel where the SDKField named in SDKFieldName exists.
+	SDKModelName string `json:"sdkModelName"`
+
+	// SDKFieldName specifies the name of the SDKField (within the SDKModel named in SDKModelName) that should be mapped
+	// to/from the value for the TerraformSchemaField (named in TerraformSchemaFieldName).
+	SDKFieldName string `json:"sdkFieldPath"`
+
+	// TrueValue specifies the Constant value which the TerraformSchemaField being `true` maps to/from.
+	TrueValue string `json:"trueValue"`
+
+	// FalseValue specifies the Constant value which the TerraformSchemaField being `false` maps to/from.
+	FalseValue string `json:"falseValue"`
+}
+
+// mappingDefinitionType specifies the type of TerraformFieldMappingDefinitionType this TerraformFieldMappingType represents.
+func (TerraformBooleanEqualsFieldMappingDefinition) mappingDefinitionType() TerraformFieldMappingDefinitionType {
+	return BooleanEqualsTerraformFieldMappingDefinitionType
+}
+
+func (d TerraformBooleanEqualsFieldMappingDefinition) MarshalJSON() ([]byte, error) {
+	type wrapper TerraformBooleanEqualsFieldMappingDefinition
+	wrapped := wrapper(d)
+	encoded, err := json.Marshal(wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling TerraformBooleanEqualsFieldMappingDefinition: %+v", err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return nil, fmt.Errorf("unmarshaling TerraformBooleanEqualsFieldMappingDefinition: %+v", err)
+	}
+	decoded["type"] = d.mappingDefinitionType()
+
+	encoded, err = json.Marshal(decoded)
+	if err != nil {
+		return nil, fmt.Errorf("re-marshaling TerraformBooleanEqualsFieldMappingDefinition: %+v", err)
+	}
+
+	return encoded, nil
+}
@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+var _ json.Marshaler = TerraformBooleanInvertFieldMappingDefinition{}
+var _ TerraformFieldMappingDefinition = TerraformBooleanInvertFieldMappingDefinition{}
+
+// TerraformBooleanInvertFieldMappingDefinition defines that a Boolean TerraformSchemaField should be mapped onto a
+// Boolean SDKField with the value inverted (for example, `public_network_access_enabled` and `disablePublicAccess`).
+type TerraformBooleanInvertFieldMappingDefinition struct {
+	BooleanInvert TerraformBooleanInvertFieldMappingDefinitionImpl `json:"booleanInvert"`
+}
+
+type TerraformBooleanInvertFieldMappingDefinitionImpl struct {
+	// TerraformSchemaModelName specifies the name of the TerraformSchemaModel where the TerraformSchemaField named in
+	// TerraformSchemaFieldName exists.
+	TerraformSchemaModelName string `json:"schemaModelName"`
+
+	// TerraformSchemaFieldName specifies the name of the TerraformSchemaField (within the TerraformSchemaModel named in
+	// TerraformSchemaModelName) where the value for SDKFieldName should be mapped to/from.
+	TerraformSchemaFieldName string `json:"schemaFieldPath"`
+
+	// SDKModelName specifies the name of the SDKModel where the SDKField named in SDKFieldName exists.
+	SDKModelName string `json:"sdkModelName"`
+
+	// SDKFieldName specifies the name of the SDKField (within the SDKModel named in SDKModelName) that should be mapped
+	// to/from the value for the TerraformSchemaField (named in TerraformSchemaFieldName).
+	SDKFieldName string `json:"sdkFieldPath"`
+}
+
+// mappingDefinitionType specifies the type of TerraformFieldMappingDefinitionType this TerraformFieldMappingType represents.
+func (TerraformBooleanInvertFieldMappingDefinition) mappingDefinitionType() TerraformFieldMappingDefinitionType {
+	return BooleanInvertTerraformFieldMappingDefinitionType
+}
+
+func (d TerraformBooleanInvertFieldMappingDefinition) MarshalJSON() ([]byte, error) {
+	type wrapper TerraformBooleanInvertFieldMappingDefinition
+	wrapped := wrapper(d)
+	encoded, err := json.Marshal(wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling TerraformBooleanInvertFieldMappingDefinition: %+v", err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return nil, fmt.Errorf("unmarshaling TerraformBooleanInvertFieldMappingDefinition: %+v", err)
+	}
+	decoded["type"] = d.mappingDefinitionType()
+
+	encoded, err = json.Marshal(decoded)
+	if err != nil {
+		return nil, fmt.Errorf("re-marshaling TerraformBooleanInvertFieldMappingDefinition: %+v", err)
+	}
+
+	return encoded, nil
+}
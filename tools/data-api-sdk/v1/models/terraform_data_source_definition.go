@@ -0,0 +1,22 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package models
+
+// TerraformDataSourceDefinition defines a Terraform Data Source which is generated alongside (and
+// looks up an existing instance of) a Terraform Resource.
+//
+// The Schema for the Data Source is derived from the Schema for the Terraform Resource, where the
+// fields which make up the Resource ID are Required and all other fields are Computed.
+type TerraformDataSourceDefinition struct {
+	// Description specifies a friendly, human-readable summary for this Terraform Data Source.
+	// This will be output in the documentation and should be both concise yet clear.
+	Description string `json:"description"`
+
+	// Generate specifies whether this Terraform Data Source should be generated or not.
+	Generate bool `json:"generate"`
+
+	// TimeoutInMinutes specifies the Terraform Timeout for the Read method of this Terraform
+	// Data Source (in minutes).
+	TimeoutInMinutes int `json:"timeoutInMinutes"`
+}
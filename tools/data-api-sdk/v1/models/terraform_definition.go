@@ -12,4 +12,8 @@ type TerraformDefinition struct {
 
 	// TerraformPackageName specifies the name of the Terraform Package associated with this Service.
 	TerraformPackageName string `json:"terraformPackageName"`
+
+	// Framework specifies the Terraform framework which the Terraform Resources within this Service
+	// should be generated for. When unspecified this defaults to PluginSdkTerraformFrameworkType.
+	Framework TerraformFrameworkType `json:"framework,omitempty"`
 }
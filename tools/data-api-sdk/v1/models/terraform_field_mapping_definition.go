@@ -47,6 +47,41 @@ func unmarshalTerraformFieldMappingDefinitionImplementation(input []byte) (Terra
 		}
 		return instance, nil
 	}
+	if value == BooleanEqualsTerraformFieldMappingDefinitionType {
+		var instance TerraformBooleanEqualsFieldMappingDefinition
+		if err := json.Unmarshal(input, &instance); err != nil {
+			return nil, fmt.Errorf("unmarshaling %q: %+v", value, err)
+		}
+		return instance, nil
+	}
+	if value == BooleanInvertTerraformFieldMappingDefinitionType {
+		var instance TerraformBooleanInvertFieldMappingDefinition
+		if err := json.Unmarshal(input, &instance); err != nil {
+			return nil, fmt.Errorf("unmarshaling %q: %+v", value, err)
+		}
+		return instance, nil
+	}
+	if value == SubResourceIdTerraformFieldMappingDefinitionType {
+		var instance TerraformSubResourceIdFieldMappingDefinition
+		if err := json.Unmarshal(input, &instance); err != nil {
+			return nil, fmt.Errorf("unmarshaling %q: %+v", value, err)
+		}
+		return instance, nil
+	}
+	if value == SubResourceIdListTerraformFieldMappingDefinitionType {
+		var instance TerraformSubResourceIdListFieldMappingDefinition
+		if err := json.Unmarshal(input, &instance); err != nil {
+			return nil, fmt.Errorf("unmarshaling %q: %+v", value, err)
+		}
+		return instance, nil
+	}
+	if value == ODataBindTerraformFieldMappingDefinitionType {
+		var instance TerraformODataBindFieldMappingDefinition
+		if err := json.Unmarshal(input, &instance); err != nil {
+			return nil, fmt.Errorf("unmarshaling %q: %+v", value, err)
+		}
+		return instance, nil
+	}
 
 	return nil, fmt.Errorf("internal-error: missing implementation for TerraformFieldMappingDefinition %q", value)
 }
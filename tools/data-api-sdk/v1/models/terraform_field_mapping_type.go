@@ -17,5 +17,27 @@ const (
 	// This represents an SDKField needs to be mapped to/from a TerraformSchemaModel.
 	ModelToModelTerraformFieldMappingDefinitionType TerraformFieldMappingDefinitionType = "ModelToModel"
 
-	// TODO: support for other mapping types (e.g. BooleanEquals, BooleanInvert) in the future
+	// BooleanEqualsTerraformFieldMappingDefinitionType specifies a BooleanEquals mapping.
+	// This represents a Boolean TerraformSchemaField which is mapped to/from a Constant SDKField
+	// (e.g. `sdkmodel.SomeField = Enabled` when `schemamodel.SomeField` is true).
+	BooleanEqualsTerraformFieldMappingDefinitionType TerraformFieldMappingDefinitionType = "BooleanEquals"
+
+	// BooleanInvertTerraformFieldMappingDefinitionType specifies a BooleanInvert mapping.
+	// This represents an inverted assignment between two Booleans (e.g. `sdkmodel.SomeField = !schemamodel.SomeField`).
+	BooleanInvertTerraformFieldMappingDefinitionType TerraformFieldMappingDefinitionType = "BooleanInvert"
+
+	// SubResourceIdTerraformFieldMappingDefinitionType specifies a SubResourceId mapping.
+	// This represents a String TerraformSchemaField which is mapped to/from the `Id` field within a nested SDKModel
+	// (e.g. `sdkmodel.SomeField = &SubResource{Id: schemamodel.SomeField}`).
+	SubResourceIdTerraformFieldMappingDefinitionType TerraformFieldMappingDefinitionType = "SubResourceId"
+
+	// SubResourceIdListTerraformFieldMappingDefinitionType specifies a SubResourceIdList mapping.
+	// This represents a List of Strings TerraformSchemaField which is mapped to/from a List of SDKModels, using the
+	// `Id` field within each SDKModel.
+	SubResourceIdListTerraformFieldMappingDefinitionType TerraformFieldMappingDefinitionType = "SubResourceIdList"
+
+	// ODataBindTerraformFieldMappingDefinitionType specifies an ODataBind mapping.
+	// This represents a String (or List of Strings) TerraformSchemaField containing the ID(s) of other entities, which
+	// is mapped onto an `@odata.bind` SDKField as the OData URI for each entity (as used by Microsoft Graph).
+	ODataBindTerraformFieldMappingDefinitionType TerraformFieldMappingDefinitionType = "ODataBind"
 )
@@ -0,0 +1,19 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package models
+
+// TerraformFrameworkType defines the Terraform framework that the Terraform Resources within a Service
+// should be generated for.
+type TerraformFrameworkType = string
+
+const (
+	// PluginSdkTerraformFrameworkType specifies that the Terraform Resources should be generated as Typed
+	// Resources using the Terraform Plugin SDK.
+	// This is the default when no TerraformFrameworkType is specified.
+	PluginSdkTerraformFrameworkType TerraformFrameworkType = "PluginSdk"
+
+	// PluginFrameworkTerraformFrameworkType specifies that the Terraform Resources should be generated
+	// using the Terraform Plugin Framework.
+	PluginFrameworkTerraformFrameworkType TerraformFrameworkType = "PluginFramework"
+)
@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+var _ json.Marshaler = TerraformODataBindFieldMappingDefinition{}
+var _ TerraformFieldMappingDefinition = TerraformODataBindFieldMappingDefinition{}
+
+// TerraformODataBindFieldMappingDefinition defines that a String (or List of Strings) TerraformSchemaField containing
+// the ID(s) of other entities should be mapped onto an `@odata.bind` SDKField (as used by Microsoft Graph), where each
+// ID is sent to the API as the OData URI for the entity (e.g. `https://graph.microsoft.com/v1.0/directoryObjects/{id}`).
+//
+// Since the `@odata.bind` fields are write-only, the values for these are not returned by the API.
+type TerraformODataBindFieldMappingDefinition struct {
+	ODataBind TerraformODataBindFieldMappingDefinitionImpl `json:"odataBind"`
+}
+
+type TerraformODataBindFieldMappingDefinitionImpl struct {
+	// TerraformSchemaModelName specifies the name of the TerraformSchemaModel where the TerraformSchemaField named in
+	// TerraformSchemaFieldName exists.
+	TerraformSchemaModelName string `json:"schemaModelName"`
+
+	// TerraformSchemaFieldName specifies the name of the TerraformSchemaField (within the TerraformSchemaModel named in
+	// TerraformSchemaModelName) where the value for SDKFieldName should be mapped from.
+	TerraformSchemaFieldName string `json:"schemaFieldPath"`
+
+	// SDKModelName specifies the name of the SDKModel where the SDKField named in SDKFieldName exists.
+	SDKModelName string `json:"sdkModelName"`
+
+	// SDKFieldName specifies the name of the `@odata.bind` SDKField (within the SDKModel named in SDKModelName) that
+	// the value for the TerraformSchemaField (named in TerraformSchemaFieldName) should be mapped onto.
+	SDKFieldName string `json:"sdkFieldPath"`
+
+	// EntitySetName specifies the name of the Entity Set containing the referenced entities, used to build
+	// the OData URI for each entity (e.g. `directoryObjects`).
+	EntitySetName string `json:"entitySetName"`
+}
+
+// mappingDefinitionType specifies the type of TerraformFieldMappingDefinitionType this TerraformFieldMappingType represents.
+func (TerraformODataBindFieldMappingDefinition) mappingDefinitionType() TerraformFieldMappingDefinitionType {
+	return ODataBindTerraformFieldMappingDefinitionType
+}
+
+func (d TerraformODataBindFieldMappingDefinition) MarshalJSON() ([]byte, error) {
+	type wrapper TerraformODataBindFieldMappingDefinition
+	wrapped := wrapper(d)
+	encoded, err := json.Marshal(wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling TerraformODataBindFieldMappingDefinition: %+v", err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return nil, fmt.Errorf("unmarshaling TerraformODataBindFieldMappingDefinition: %+v", err)
+	}
+	decoded["type"] = d.mappingDefinitionType()
+
+	encoded, err = json.Marshal(decoded)
+	if err != nil {
+		return nil, fmt.Errorf("re-marshaling TerraformODataBindFieldMappingDefinition: %+v", err)
+	}
+
+	return encoded, nil
+}
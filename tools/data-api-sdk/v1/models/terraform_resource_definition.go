@@ -16,6 +16,10 @@ type TerraformResourceDefinition struct {
 	// This includes whether this should be generated, the SDKMethod to use and the default timeout.
 	CreateMethod TerraformMethodDefinition `json:"createMethod"`
 
+	// DataSource optionally specifies the configuration for a Terraform Data Source which should be
+	// generated for this Terraform Resource, which allows an existing instance to be looked up.
+	DataSource *TerraformDataSourceDefinition `json:"dataSource,omitempty"`
+
 	// DeleteMethod specifies the configuration for the Delete method of the generated Terraform Resource.
 	// This includes whether this should be generated, the SDKMethod to use and the default timeout.
 	DeleteMethod TerraformMethodDefinition `json:"deleteMethod"`
@@ -27,6 +31,11 @@ type TerraformResourceDefinition struct {
 	// Note that any abbreviations (such as `VM`) should be spelled out - e.g. `Virtual Machine` rather than `VM`.
 	DisplayName string `json:"displayName"`
 
+	// EventuallyConsistent specifies whether the API for this Terraform Resource is eventually consistent, where
+	// a newly created/deleted Resource may not be reflected by the Read method immediately (as with Microsoft Graph).
+	// When set, the Create and Delete methods wait for the change to be visible via the Read method.
+	EventuallyConsistent bool `json:"eventuallyConsistent,omitempty"`
+
 	// Generate specifies whether this Terraform Resource should be generated or not.
 	Generate bool `json:"generate"`
 
@@ -20,7 +20,9 @@ type TerraformSchemaField struct {
 	// Note that it's preferable for a field to be Optional with a Default value, rather than Computed.
 	Computed bool `json:"computed"`
 
-	// TODO: support `Default`
+	// Default specifies the default value for this field (when it's Optional) - which is a bool, float64,
+	// int64 or string depending on the Type of this field.
+	Default any `json:"default,omitempty"`
 
 	// Documentation specifies the Documentation available for this field
 	Documentation TerraformSchemaFieldDocumentationDefinition `json:"documentation"`
@@ -42,6 +44,10 @@ type TerraformSchemaField struct {
 	// Requires specifies whether this field is Required, e.g. whether it must be specified.
 	Required bool `json:"required"`
 
+	// Sensitive specifies whether this field is Sensitive, meaning that the value should be
+	// redacted from the output of Terraform.
+	Sensitive bool `json:"sensitive"`
+
 	// Validation specifies the validation criteria for this field, for example a set of fixed values.
 	Validation TerraformSchemaFieldValidationDefinition `json:"validation"`
 }
@@ -54,12 +60,19 @@ func (f *TerraformSchemaField) UnmarshalJSON(bytes []byte) error {
 	}
 
 	f.Computed = decoded.Computed
+	f.Default = decoded.Default
 	f.Documentation = decoded.Documentation
 	f.ForceNew = decoded.ForceNew
 	f.HCLName = decoded.HCLName
 	f.ObjectDefinition = decoded.ObjectDefinition
 	f.Optional = decoded.Optional
 	f.Required = decoded.Required
+	f.Sensitive = decoded.Sensitive
+
+	// numbers are decoded as a float64 by default, whereas an Integer field should have an int64 default
+	if v, ok := f.Default.(float64); ok && f.ObjectDefinition.Type == IntegerTerraformSchemaObjectDefinitionType {
+		f.Default = int64(v)
+	}
 
 	var temp map[string]json.RawMessage
 	if err := json.Unmarshal(bytes, &temp); err != nil {
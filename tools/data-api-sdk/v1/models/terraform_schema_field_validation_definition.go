@@ -39,5 +39,21 @@ func unmarshalTerraformSchemaFieldValidationDefinitionImplementation(input []byt
 		return instance, nil
 	}
 
+	if value == RangeTerraformSchemaFieldValidationType {
+		var instance TerraformSchemaFieldValidationRangeDefinition
+		if err := json.Unmarshal(input, &instance); err != nil {
+			return nil, fmt.Errorf("unmarshaling %q: %+v", value, err)
+		}
+		return instance, nil
+	}
+
+	if value == RegexTerraformSchemaFieldValidationType {
+		var instance TerraformSchemaFieldValidationRegexDefinition
+		if err := json.Unmarshal(input, &instance); err != nil {
+			return nil, fmt.Errorf("unmarshaling %q: %+v", value, err)
+		}
+		return instance, nil
+	}
+
 	return nil, fmt.Errorf("internal-error: missing implementation for TerraformSchemaFieldValidationDefinition %q", value)
 }
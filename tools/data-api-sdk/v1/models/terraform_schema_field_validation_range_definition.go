@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+var _ json.Marshaler = &TerraformSchemaFieldValidationRangeDefinition{}
+var _ TerraformSchemaFieldValidationDefinition = TerraformSchemaFieldValidationRangeDefinition{}
+
+// TerraformSchemaFieldValidationRangeDefinition defines the Range of values allowed for a TerraformSchemaField.
+type TerraformSchemaFieldValidationRangeDefinition struct {
+	// Minimum specifies the minimum value (inclusive) allowed for this field, if any.
+	Minimum *float64 `json:"minimum,omitempty"`
+
+	// Maximum specifies the maximum value (inclusive) allowed for this field, if any.
+	Maximum *float64 `json:"maximum,omitempty"`
+}
+
+// fieldValidationType returns the type of TerraformSchemaFieldValidationType for this implementation.
+func (TerraformSchemaFieldValidationRangeDefinition) fieldValidationType() TerraformSchemaFieldValidationType {
+	return RangeTerraformSchemaFieldValidationType
+}
+
+func (d TerraformSchemaFieldValidationRangeDefinition) MarshalJSON() ([]byte, error) {
+	type wrapper TerraformSchemaFieldValidationRangeDefinition
+	wrapped := wrapper(d)
+	encoded, err := json.Marshal(wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling TerraformSchemaFieldValidationRangeDefinition: %+v", err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return nil, fmt.Errorf("unmarshaling TerraformSchemaFieldValidationRangeDefinition: %+v", err)
+	}
+	decoded["type"] = d.fieldValidationType()
+
+	encoded, err = json.Marshal(decoded)
+	if err != nil {
+		return nil, fmt.Errorf("re-marshaling TerraformSchemaFieldValidationRangeDefinition: %+v", err)
+	}
+
+	return encoded, nil
+}
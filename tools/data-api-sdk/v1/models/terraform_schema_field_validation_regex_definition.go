@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+var _ json.Marshaler = &TerraformSchemaFieldValidationRegexDefinition{}
+var _ TerraformSchemaFieldValidationDefinition = TerraformSchemaFieldValidationRegexDefinition{}
+
+// TerraformSchemaFieldValidationRegexDefinition defines a Regular Expression which the value for a TerraformSchemaField must match.
+type TerraformSchemaFieldValidationRegexDefinition struct {
+	// Regex specifies the Regular Expression which the value for this field must match.
+	Regex string `json:"regex"`
+}
+
+// fieldValidationType returns the type of TerraformSchemaFieldValidationType for this implementation.
+func (TerraformSchemaFieldValidationRegexDefinition) fieldValidationType() TerraformSchemaFieldValidationType {
+	return RegexTerraformSchemaFieldValidationType
+}
+
+func (d TerraformSchemaFieldValidationRegexDefinition) MarshalJSON() ([]byte, error) {
+	type wrapper TerraformSchemaFieldValidationRegexDefinition
+	wrapped := wrapper(d)
+	encoded, err := json.Marshal(wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling TerraformSchemaFieldValidationRegexDefinition: %+v", err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return nil, fmt.Errorf("unmarshaling TerraformSchemaFieldValidationRegexDefinition: %+v", err)
+	}
+	decoded["type"] = d.fieldValidationType()
+
+	encoded, err = json.Marshal(decoded)
+	if err != nil {
+		return nil, fmt.Errorf("re-marshaling TerraformSchemaFieldValidationRegexDefinition: %+v", err)
+	}
+
+	return encoded, nil
+}
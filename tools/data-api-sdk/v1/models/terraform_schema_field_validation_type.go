@@ -11,4 +11,14 @@ const (
 	// be specified for this field.
 	// Example: [`Standard` and `Basic` SKUs] or [`1-5`]
 	PossibleValuesTerraformSchemaFieldValidationType TerraformSchemaFieldValidationType = "PossibleValues"
+
+	// RangeTerraformSchemaFieldValidationType specifies that the value for this field must be within a
+	// range of values, either with a minimum and/or maximum value.
+	// Example: [`1` to `10`]
+	RangeTerraformSchemaFieldValidationType TerraformSchemaFieldValidationType = "Range"
+
+	// RegexTerraformSchemaFieldValidationType specifies that the value for this field must match the
+	// specified Regular Expression.
+	// Example: [`^[a-z]{3,24}$`]
+	RegexTerraformSchemaFieldValidationType TerraformSchemaFieldValidationType = "Regex"
 )
@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+var _ json.Marshaler = TerraformSubResourceIdFieldMappingDefinition{}
+var _ TerraformFieldMappingDefinition = TerraformSubResourceIdFieldMappingDefinition{}
+
+// TerraformSubResourceIdFieldMappingDefinition defines that a String TerraformSchemaField should be mapped onto the
+// `Id` field within the SDKModel referenced by the SDKField - which allows an object containing only a Resource ID
+// (e.g. `{ "id": "..." }`) to be exposed as a string.
+type TerraformSubResourceIdFieldMappingDefinition struct {
+	SubResourceId TerraformSubResourceIdFieldMappingDefinitionImpl `json:"subResourceId"`
+}
+
+type TerraformSubResourceIdFieldMappingDefinitionImpl struct {
+	// TerraformSchemaModelName specifies the name of the TerraformSchemaModel where the TerraformSchemaField named in
+	// TerraformSchemaFieldName exists.
+	TerraformSchemaModelName string `json:"schemaModelName"`
+
+	// TerraformSchemaFieldName specifies the name of the TerraformSchemaField (within the TerraformSchemaModel named in
+	// TerraformSchemaModelName) where the value for SDKFieldName should be mapped to/from.
+	TerraformSchemaFieldName string `json:"schemaFieldPath"`
+
+	// SDKModelName specifies the name of the SDKModel where the SDKField named in SDKFieldName exists.
+	SDKModelName string `json:"sdkModelName"`
+
+	// SDKFieldName specifies the name of the SDKField (within the SDKModel named in SDKModelName) that should be mapped
+	// to/from the value for the TerraformSchemaField (named in TerraformSchemaFieldName).
+	SDKFieldName string `json:"sdkFieldPath"`
+}
+
+// mappingDefinitionType specifies the type of TerraformFieldMappingDefinitionType this TerraformFieldMappingType represents.
+func (TerraformSubResourceIdFieldMappingDefinition) mappingDefinitionType() TerraformFieldMappingDefinitionType {
+	return SubResourceIdTerraformFieldMappingDefinitionType
+}
+
+func (d TerraformSubResourceIdFieldMappingDefinition) MarshalJSON() ([]byte, error) {
+	type wrapper TerraformSubResourceIdFieldMappingDefinition
+	wrapped := wrapper(d)
+	encoded, err := json.Marshal(wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling TerraformSubResourceIdFieldMappingDefinition: %+v", err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return nil, fmt.Errorf("unmarshaling TerraformSubResourceIdFieldMappingDefinition: %+v", err)
+	}
+	decoded["type"] = d.mappingDefinitionType()
+
+	encoded, err = json.Marshal(decoded)
+	if err != nil {
+		return nil, fmt.Errorf("re-marshaling TerraformSubResourceIdFieldMappingDefinition: %+v", err)
+	}
+
+	return encoded, nil
+}
@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+var _ json.Marshaler = TerraformSubResourceIdListFieldMappingDefinition{}
+var _ TerraformFieldMappingDefinition = TerraformSubResourceIdListFieldMappingDefinition{}
+
+// TerraformSubResourceIdListFieldMappingDefinition defines that a List of Strings TerraformSchemaField should be mapped
+// onto a List SDKField, where each item is an SDKModel containing an `Id` field - which allows a list of objects containing
+// only a Resource ID (e.g. `[{ "id": "..." }]`) to be exposed as a list of strings.
+type TerraformSubResourceIdListFieldMappingDefinition struct {
+	SubResourceIdList TerraformSubResourceIdListFieldMappingDefinitionImpl `json:"subResourceIdList"`
+}
+
+type TerraformSubResourceIdListFieldMappingDefinitionImpl struct {
+	// TerraformSchemaModelName specifies the name of the TerraformSchemaModel where the TerraformSchemaField named in
+	// TerraformSchemaFieldName exists.
+	TerraformSchemaModelName string `json:"schemaModelName"`
+
+	// TerraformSchemaFieldName specifies the name of the TerraformSchemaField (within the TerraformSchemaModel named in
+	// TerraformSchemaModelName) where the value for SDKFieldName should be mapped to/from.
+	TerraformSchemaFieldName string `json:"schemaFieldPath"`
+
+	// SDKModelName specifies the name of the SDKModel where the SDKField named in SDKFieldName exists.
+	SDKModelName string `json:"sdkModelName"`
+
+	// SDKFieldName specifies the name of the SDKField (within the SDKModel named in SDKModelName) that should be mapped
+	// to/from the value for the TerraformSchemaField (named in TerraformSchemaFieldName).
+	SDKFieldName string `json:"sdkFieldPath"`
+}
+
+// mappingDefinitionType specifies the type of TerraformFieldMappingDefinitionType this TerraformFieldMappingType represents.
+func (TerraformSubResourceIdListFieldMappingDefinition) mappingDefinitionType() TerraformFieldMappingDefinitionType {
+	return SubResourceIdListTerraformFieldMappingDefinitionType
+}
+
+func (d TerraformSubResourceIdListFieldMappingDefinition) MarshalJSON() ([]byte, error) {
+	type wrapper TerraformSubResourceIdListFieldMappingDefinition
+	wrapped := wrapper(d)
+	encoded, err := json.Marshal(wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling TerraformSubResourceIdListFieldMappingDefinition: %+v", err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return nil, fmt.Errorf("unmarshaling TerraformSubResourceIdListFieldMappingDefinition: %+v", err)
+	}
+	decoded["type"] = d.mappingDefinitionType()
+
+	encoded, err = json.Marshal(decoded)
+	if err != nil {
+		return nil, fmt.Errorf("re-marshaling TerraformSubResourceIdListFieldMappingDefinition: %+v", err)
+	}
+
+	return encoded, nil
+}
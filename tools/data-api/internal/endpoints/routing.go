@@ -15,6 +15,17 @@ import (
 func Router(workingDirectory string, serviceNames *[]string) func(chi.Router) {
 	return func(router chi.Router) {
 		router.Route("/v1", infrastructure.Router)
+		router.Route("/v1/data-plane", func(r chi.Router) {
+			opts := v1.Options{
+				ServiceType: sdkModels.DataPlaneSourceDataType,
+				UriPrefix:   "/v1/data-plane",
+			}
+			serviceRepo, err := repository.NewRepository(workingDirectory, opts.ServiceType, serviceNames, logging.Log)
+			if err != nil {
+				logging.Fatalf("Error: %+v", err)
+			}
+			v1.Router(r, opts, serviceRepo)
+		})
 		router.Route("/v1/microsoft-graph", func(r chi.Router) {
 			opts := v1.Options{
 				ServiceType: sdkModels.MicrosoftGraphSourceDataType,
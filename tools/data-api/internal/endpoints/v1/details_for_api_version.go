@@ -41,8 +41,9 @@ func (api Api) detailsForApiVersion(w http.ResponseWriter, r *http.Request) {
 	}
 
 	payload := v1.DetailsForAPIVersionSummary{
-		Resources: resources,
-		Source:    apiVersion.Source,
+		ParameterizedHost: apiVersion.ParameterizedHost,
+		Resources:         resources,
+		Source:            apiVersion.Source,
 	}
 	render.JSON(w, r, payload)
 }
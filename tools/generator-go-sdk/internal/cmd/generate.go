@@ -62,6 +62,7 @@ func (g GenerateCommand) Run(args []string) int {
 		input.settings.AllowOmittingDiscriminatedValue = true
 		input.settings.DeleteExistingResourcesForVersion = true
 		input.settings.GenerateDescriptionsForModels = true
+		input.settings.GenerateValidationFunctions = true
 		input.settings.RecurseParentModels = false
 
 		input.settings.CanonicalApiVersions = map[string]string{
@@ -72,10 +73,19 @@ func (g GenerateCommand) Run(args []string) int {
 			"stable": models.MicrosoftGraphMetaDataSourceDataOrigin,
 			"beta":   models.MicrosoftGraphMetaDataSourceDataOrigin,
 		}
+	} else if g.sourceDataType == models.DataPlaneSourceDataType {
+		// Data Plane APIs are imported from the same Swagger definitions as Resource Manager, but are only
+		// supported using the `hashicorp/go-azure-sdk` base layer
+		input.settings.AllowOmittingDiscriminatedValue = false
+		input.settings.DeleteExistingResourcesForVersion = false
+		input.settings.GenerateDescriptionsForModels = false
+		input.settings.GenerateValidationFunctions = false
+		input.settings.RecurseParentModels = true
 	} else if g.sourceDataType == models.ResourceManagerSourceDataType {
 		input.settings.AllowOmittingDiscriminatedValue = false
 		input.settings.DeleteExistingResourcesForVersion = false
 		input.settings.GenerateDescriptionsForModels = false
+		input.settings.GenerateValidationFunctions = false
 		input.settings.RecurseParentModels = true
 
 		input.settings.UseOldBaseLayerFor(
@@ -104,6 +114,7 @@ func (g GenerateCommand) Run(args []string) int {
 	f.StringVar(&input.apiServerEndpoint, "data-api", "http://localhost:8080", "-data-api=http://localhost:8080")
 	f.StringVar(&input.outputDirectory, "output-dir", "", "-output-dir=../generated-sdk-dev")
 	f.StringVar(&serviceNames, "services", "", "A list of comma separated Service named from the Data API to generate")
+	f.BoolVar(&input.settings.GenerateExamplePayloadTests, "example-payload-tests", false, "Output a Test for each Operation which unmarshals the Examples from the API Definitions into the SDK Models")
 	if err := f.Parse(args); err != nil {
 		log.Fatalf("parsing arguments: %+v", err)
 	}
@@ -207,13 +218,14 @@ func (g GenerateCommand) run(ctx context.Context, input GeneratorInput) error {
 
 				// then output the Meta Client
 				versionGeneratorInput := generator.VersionGeneratorInput{
-					OutputDirectory: input.outputDirectory,
-					CommonTypes:     commonTypes,
-					ServiceName:     serviceName,
-					VersionName:     versionNumber,
-					Resources:       versionDetails.Resources,
-					Source:          versionDetails.Source,
-					Type:            g.sourceDataType,
+					OutputDirectory:   input.outputDirectory,
+					CommonTypes:       commonTypes,
+					ParameterizedHost: versionDetails.ParameterizedHost,
+					ServiceName:       serviceName,
+					VersionName:       versionNumber,
+					Resources:         versionDetails.Resources,
+					Source:            versionDetails.Source,
+					Type:              g.sourceDataType,
 				}
 				versionGeneratorInput.UseNewBaseLayer = false
 				if input.settings.ShouldUseNewBaseLayer(serviceName, versionNumber) {
@@ -80,6 +80,12 @@ type GeneratorData struct {
 	// whether descriptions should be generated for model fields etc.
 	generateDescriptionsForModels bool
 
+	// whether a Test unmarshaling the Examples for each Operation into the SDK Models should be generated
+	generateExamplePayloadTests bool
+
+	// whether `Validate` methods should be generated for models and options, and called prior to sending requests
+	generateValidationFunctions bool
+
 	// whether this is a data plane SDK (omits certain Resource Manager specific features, currently used in ID parsers)
 	isDataPlane bool
 
@@ -122,6 +128,8 @@ func (i ServiceGeneratorInput) generatorData(settings Settings) GeneratorData {
 		constants:                       i.ResourceDetails.Constants,
 		allowOmittingDiscriminatedValue: settings.AllowOmittingDiscriminatedValue,
 		generateDescriptionsForModels:   settings.GenerateDescriptionsForModels,
+		generateExamplePayloadTests:     settings.GenerateExamplePayloadTests,
+		generateValidationFunctions:     settings.GenerateValidationFunctions,
 		isDataPlane:                     models.SourceDataTypeIsDataPlane(i.Type),
 		models:                          i.ResourceDetails.Models,
 		operations:                      i.ResourceDetails.Operations,
@@ -144,6 +152,10 @@ type VersionGeneratorData struct {
 	// for example {workingDir}/common-types/{version}
 	commonTypesOutputPath string
 
+	// parameterizedHost optionally specifies the templated Host used by a Data Plane API Version, from which
+	// the endpoint for the Client is built
+	parameterizedHost *models.ParameterizedHost
+
 	// resources specifies a map of API Resource Names (key) to APIResource (value).
 	resources map[string]models.APIResource
 
@@ -175,6 +187,8 @@ func (i VersionGeneratorInput) generatorData(settings Settings) VersionGenerator
 			constants:                       i.CommonTypes.Constants,
 			allowOmittingDiscriminatedValue: settings.AllowOmittingDiscriminatedValue,
 			generateDescriptionsForModels:   settings.GenerateDescriptionsForModels,
+			generateExamplePayloadTests:     settings.GenerateExamplePayloadTests,
+			generateValidationFunctions:     settings.GenerateValidationFunctions,
 			isDataPlane:                     models.SourceDataTypeIsDataPlane(i.Type),
 			models:                          i.CommonTypes.Models,
 			packageName:                     versionPackageName,
@@ -187,6 +201,7 @@ func (i VersionGeneratorInput) generatorData(settings Settings) VersionGenerator
 			versionPackageName:              versionPackageName,
 		},
 		commonTypesOutputPath: commonTypesOutputPath,
+		parameterizedHost:     i.ParameterizedHost,
 		resources:             i.Resources,
 		versionOutputPath:     versionOutputPath,
 	}
@@ -55,6 +55,8 @@ func alternateCasingOnEveryLetter(input string) string {
 
 func baseClientPackageForSdk(input models.SourceDataType) string {
 	switch input {
+	case models.DataPlaneSourceDataType:
+		return "dataplane"
 	case models.MicrosoftGraphSourceDataType:
 		return "msgraph"
 	case models.ResourceManagerSourceDataType:
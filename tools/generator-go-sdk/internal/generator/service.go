@@ -42,14 +42,16 @@ func (s *Generator) Generate(input ServiceGeneratorInput) error {
 	}
 
 	stages := map[string]func(data GeneratorData) error{
-		"clients":    s.clients,
-		"constants":  s.constants,
-		"ids":        s.ids,
-		"methods":    s.methods,
-		"models":     s.models,
-		"readmeFile": s.readmeFile,
-		"predicates": s.predicates,
-		"version":    s.version,
+		"clients":      s.clients,
+		"constants":    s.constants,
+		"examples":     s.examples,
+		"ids":          s.ids,
+		"methods":      s.methods,
+		"models":       s.models,
+		"readmeFile":   s.readmeFile,
+		"predicates":   s.predicates,
+		"resumeTokens": s.resumeTokens,
+		"version":      s.version,
 	}
 	for name, stage := range stages {
 		logging.Debugf("Running Stage %q..", name)
@@ -65,14 +67,15 @@ func (s *Generator) Generate(input ServiceGeneratorInput) error {
 }
 
 type VersionGeneratorInput struct {
-	CommonTypes     models.CommonTypes
-	OutputDirectory string
-	Resources       map[string]models.APIResource
-	ServiceName     string
-	Source          models.SourceDataOrigin
-	Type            models.SourceDataType
-	UseNewBaseLayer bool
-	VersionName     string
+	CommonTypes       models.CommonTypes
+	OutputDirectory   string
+	ParameterizedHost *models.ParameterizedHost
+	Resources         map[string]models.APIResource
+	ServiceName       string
+	Source            models.SourceDataOrigin
+	Type              models.SourceDataType
+	UseNewBaseLayer   bool
+	VersionName       string
 }
 
 func (s *Generator) GenerateForVersion(input VersionGeneratorInput) error {
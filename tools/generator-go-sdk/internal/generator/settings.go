@@ -31,6 +31,19 @@ type Settings struct {
 	// GenerateDescriptionsForModels enables nicely-formatted Go comments for model fields to be generated.
 	GenerateDescriptionsForModels bool
 
+	// GenerateExamplePayloadTests toggles whether a Test is output for each Operation containing Examples, which
+	// unmarshals the example Request/Response payloads (sourced from the API Definitions) into the SDK Models. This
+	// is disabled by default since the upstream Examples frequently disagree with the API Definitions (for example
+	// on the type of a field, or the casing of a Constant) - and so is intended for local use when investigating
+	// the quality of the generated Models.
+	GenerateExamplePayloadTests bool
+
+	// GenerateValidationFunctions toggles whether models and operation options structs should have a `Validate`
+	// method generated, which checks that any Required fields have been specified. When enabled, operation methods
+	// call `Validate` on the request payload and options prior to sending the request, so that a missing value is
+	// surfaced client-side rather than as a 400 from the API.
+	GenerateValidationFunctions bool
+
 	// RecurseParentModels is a behavioral toggle for discriminated types. When true, the full ancestry for child
 	// models will be output in the SDK. When false, only the youngest ancestor containing the necessary type
 	// information will be output. Used in Microsoft Graph to properly express model inheritance.
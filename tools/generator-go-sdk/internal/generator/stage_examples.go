@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package generator
+
+import (
+	"fmt"
+	"sort"
+)
+
+func (s *Generator) examples(data GeneratorData) error {
+	if len(data.operations) == 0 {
+		return nil
+	}
+
+	sortedOperationNames := make([]string, 0)
+	for name := range data.operations {
+		sortedOperationNames = append(sortedOperationNames, name)
+	}
+	sort.Strings(sortedOperationNames)
+
+	t := examplesTemplater{
+		sortedOperationNames: sortedOperationNames,
+		operations:           data.operations,
+	}
+	fileName := fmt.Sprintf("example_%s_test.go", data.packageName)
+	if err := s.writeToPathForResource(data.resourceOutputPath, fileName, t, data); err != nil {
+		return fmt.Errorf("templating examples: %+v", err)
+	}
+
+	// when enabled, the Examples from the API Definitions are output as Tests for the Request/Response payloads
+	if !data.generateExamplePayloadTests {
+		return nil
+	}
+	operationNamesWithExamples := operationsWithExamples(data.operations)
+	if len(operationNamesWithExamples) == 0 {
+		return nil
+	}
+	pt := examplePayloadsTemplater{
+		sortedOperationNames: operationNamesWithExamples,
+		operations:           data.operations,
+	}
+	fileName = fmt.Sprintf("example_payloads_%s_test.go", data.packageName)
+	if err := s.writeToPathForResource(data.resourceOutputPath, fileName, pt, data); err != nil {
+		return fmt.Errorf("templating example payloads: %+v", err)
+	}
+
+	return nil
+}
@@ -18,6 +18,7 @@ func (s *Generator) metaClient(data VersionGeneratorData) error {
 			apiVersionDirectoryName: data.versionDirectoryName,
 			apiVersionPackageName:   data.versionPackageName,
 			baseClientPackage:       data.baseClientPackage,
+			parameterizedHost:       data.parameterizedHost,
 			resources:               data.resources,
 			serviceName:             data.servicePackageName,
 			source:                  data.source,
@@ -0,0 +1,32 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package generator
+
+import (
+	"fmt"
+)
+
+func (s *Generator) resumeTokens(data GeneratorData) error {
+	// Resume Tokens are only supported by the Pollers within the new base layer
+	if !data.useNewBaseLayer {
+		return nil
+	}
+
+	hasLongRunningOperations := false
+	for _, operation := range data.operations {
+		if operation.LongRunning {
+			hasLongRunningOperations = true
+			break
+		}
+	}
+	if !hasLongRunningOperations {
+		return nil
+	}
+
+	if err := s.writeToPathForResource(data.resourceOutputPath, "resume_tokens.go", resumeTokensTemplater{}, data); err != nil {
+		return fmt.Errorf("templating resume tokens: %+v", err)
+	}
+
+	return nil
+}
@@ -1,6 +1,10 @@
 package generator
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
 
 var _ templaterForResource = clientsTemplater{}
 
@@ -13,6 +17,10 @@ func (c clientsTemplater) template(data GeneratorData) (*string, error) {
 		return nil, fmt.Errorf("retrieving copyright lines: %+v", err)
 	}
 
+	if data.sourceType == models.DataPlaneSourceDataType {
+		return c.templateForDataPlane(data, *copyrightLines)
+	}
+
 	template := fmt.Sprintf(`package %[1]s
 
 import (
@@ -42,3 +50,34 @@ func New%[2]sWithBaseURI(sdkApi sdkEnv.Api) (*%[2]s, error) {
 }`, data.packageName, data.serviceClientName, data.baseClientPackage, *copyrightLines)
 	return &template, nil
 }
+
+// templateForDataPlane templates the Client for a Data Plane API, which (rather than an Environment) is
+// instantiated using the endpoint for the specific instance, derived from the Parameterized Host.
+func (c clientsTemplater) templateForDataPlane(data GeneratorData, copyrightLines string) (*string, error) {
+	template := fmt.Sprintf(`package %[1]s
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/dataplane"
+)
+
+%[4]s
+
+type %[2]s struct {
+	Client  *%[3]s.Client
+}
+
+func New%[2]sWithBaseURI(endpoint string) (*%[2]s, error) {
+	client, err := %[3]s.NewClient(endpoint, %[1]q, defaultApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating %[2]s: %%+v", err)
+	}
+
+	return &%[2]s{
+		Client: client,
+	}, nil
+}`, data.packageName, data.serviceClientName, data.baseClientPackage, copyrightLines)
+	return &template, nil
+}
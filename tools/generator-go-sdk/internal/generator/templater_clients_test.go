@@ -5,6 +5,8 @@ package generator
 
 import (
 	"testing"
+
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
 )
 
 func TestTemplateClient(t *testing.T) {
@@ -49,3 +51,45 @@ func NewExampleClientWithBaseURI(sdkApi sdkEnv.Api) (*ExampleClient, error) {
 `
 	assertTemplatedCodeMatches(t, expected, *actual)
 }
+
+func TestTemplateClientDataPlane(t *testing.T) {
+	input := GeneratorData{
+		baseClientPackage: "dataplane",
+		packageName:       "somepackage",
+		serviceClientName: "ExampleClient",
+		source:            AccTestLicenceType,
+		sourceType:        models.DataPlaneSourceDataType,
+	}
+
+	actual, err := clientsTemplater{}.template(input)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	expected := `package somepackage
+
+import (
+	"fmt"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/dataplane"
+)
+
+// acctests licence placeholder
+
+type ExampleClient struct {
+	Client  *dataplane.Client
+}
+
+func NewExampleClientWithBaseURI(endpoint string) (*ExampleClient, error) {
+	client, err := dataplane.NewClient(endpoint, "somepackage", defaultApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating ExampleClient: %+v", err)
+	}
+
+	return &ExampleClient{
+		Client: client,
+	}, nil
+}
+`
+	assertTemplatedCodeMatches(t, expected, *actual)
+}
@@ -0,0 +1,231 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+var _ templaterForResource = examplePayloadsTemplater{}
+
+// examplePayloadsTemplater outputs a Test for each Operation containing Examples, which unmarshals the example
+// Request and Response payloads (sourced from the API Definitions) into the SDK Models - to confirm that the
+// generated Models can handle realistic payloads.
+type examplePayloadsTemplater struct {
+	sortedOperationNames []string
+	operations           map[string]models.SDKOperation
+}
+
+func (e examplePayloadsTemplater) template(data GeneratorData) (*string, error) {
+	copyrightLines, err := copyrightLinesForSource(data.source)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving copyright lines: %+v", err)
+	}
+
+	commonTypesInclude := ""
+	if data.commonTypesIncludePath != nil {
+		commonTypesInclude = fmt.Sprintf(`"github.com/hashicorp/go-azure-sdk/%s/%s"`, data.sourceType, *data.commonTypesIncludePath)
+	}
+
+	tests := make([]string, 0)
+	for _, operationName := range e.sortedOperationNames {
+		operation, ok := e.operations[operationName]
+		if !ok {
+			return nil, fmt.Errorf("operation %q was not found", operationName)
+		}
+
+		test, err := e.testForOperation(operationName, operation, data)
+		if err != nil {
+			return nil, fmt.Errorf("building test for operation %q: %+v", operationName, err)
+		}
+		if test != nil {
+			tests = append(tests, *test)
+		}
+	}
+
+	template := fmt.Sprintf(`package %[1]s_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/%[2]s/%[3]s/%[4]s/%[1]s"
+	%[5]s
+)
+
+%[6]s
+
+%[7]s
+`, data.packageName, data.sourceType, data.servicePackageName, data.apiVersion, commonTypesInclude, *copyrightLines, strings.Join(tests, "\n"))
+	return &template, nil
+}
+
+// operationsWithExamples returns the sorted names of the Operations which contain Examples that can be tested.
+func operationsWithExamples(input map[string]models.SDKOperation) []string {
+	output := make([]string, 0)
+	for name, operation := range input {
+		if len(operation.Examples) > 0 && (operation.RequestObject != nil || operation.ResponseObject != nil) {
+			output = append(output, name)
+		}
+	}
+	sort.Strings(output)
+	return output
+}
+
+func (e examplePayloadsTemplater) testForOperation(operationName string, operation models.SDKOperation, data GeneratorData) (*string, error) {
+	exampleNames := make([]string, 0)
+	for name := range operation.Examples {
+		exampleNames = append(exampleNames, name)
+	}
+	sort.Strings(exampleNames)
+
+	subTests := make([]string, 0)
+	for _, exampleName := range exampleNames {
+		example := operation.Examples[exampleName]
+		lines := make([]string, 0)
+
+		if operation.RequestObject != nil && example.RequestBody != nil {
+			code, err := e.unmarshalCodeForPayload(*operation.RequestObject, false, example.RequestBody, "request", "the Request", data)
+			if err != nil {
+				return nil, fmt.Errorf("building the code for the Request in Example %q: %+v", exampleName, err)
+			}
+			if code != nil {
+				lines = append(lines, *code)
+			}
+		}
+
+		if operation.ResponseObject != nil {
+			// only the Responses for the expected Status Codes are returned as the Response Object
+			for _, statusCode := range operation.ExpectedStatusCodes {
+				body, ok := example.Responses[statusCode]
+				if !ok || body == nil {
+					continue
+				}
+
+				paginated := operation.FieldContainingPaginationDetails != nil
+				code, err := e.unmarshalCodeForPayload(*operation.ResponseObject, paginated, body, fmt.Sprintf("response%d", statusCode), fmt.Sprintf("the Response with Status Code %d", statusCode), data)
+				if err != nil {
+					return nil, fmt.Errorf("building the code for the Response %d in Example %q: %+v", statusCode, exampleName, err)
+				}
+				if code != nil {
+					lines = append(lines, *code)
+				}
+			}
+		}
+
+		if len(lines) == 0 {
+			continue
+		}
+
+		subTests = append(subTests, fmt.Sprintf(`
+	t.Run(%[1]q, func(t *testing.T) {
+%[2]s
+	})`, exampleName, strings.Join(lines, "\n")))
+	}
+
+	if len(subTests) == 0 {
+		return nil, nil
+	}
+
+	out := fmt.Sprintf(`
+func Test%[1]s_%[2]sExamples(t *testing.T) {
+%[3]s
+}
+`, data.serviceClientName, operationName, strings.Join(subTests, "\n"))
+	return &out, nil
+}
+
+// unmarshalCodeForPayload returns the code to unmarshal the specified example payload into the Go Type for the
+// Object Definition - or nil if this isn't supported (e.g. for Lists of Discriminated Types).
+func (e examplePayloadsTemplater) unmarshalCodeForPayload(objectDefinition models.SDKObjectDefinition, paginated bool, body interface{}, variableName, description string, data GeneratorData) (*string, error) {
+	payload, err := goLiteralForExamplePayload(body)
+	if err != nil {
+		return nil, fmt.Errorf("building the payload: %+v", err)
+	}
+
+	discriminatedParent, packageName, err := e.discriminatedParentTypeFor(objectDefinition, data)
+	if err != nil {
+		return nil, err
+	}
+
+	if discriminatedParent != nil {
+		// Lists of Discriminated Types are unmarshaled by the Client, so these aren't tested here
+		if paginated || objectDefinition.Type != models.ReferenceSDKObjectDefinitionType {
+			return nil, nil
+		}
+
+		out := fmt.Sprintf(`
+		if _, err := %[1]s.Unmarshal%[2]sImplementation([]byte(%[3]s)); err != nil {
+			t.Fatalf("unmarshaling %[4]s: %%+v", err)
+		}`, *packageName, *discriminatedParent, *payload, description)
+		return &out, nil
+	}
+
+	typeName, err := examplesTemplater{}.golangTypeName(objectDefinition, data)
+	if err != nil {
+		return nil, fmt.Errorf("determining golang type name: %+v", err)
+	}
+	if paginated {
+		// paginated Responses contain a page of items, rather than the Response Object itself
+		typeName = pointer.To(fmt.Sprintf("struct {\n\t\t\tValues *[]%s `json:\"value\"`\n\t\t}", *typeName))
+	}
+
+	out := fmt.Sprintf(`
+		var %[1]s %[2]s
+		if err := json.Unmarshal([]byte(%[3]s), &%[1]s); err != nil {
+			t.Fatalf("unmarshaling %[4]s: %%+v", err)
+		}`, variableName, *typeName, *payload, description)
+	return &out, nil
+}
+
+// discriminatedParentTypeFor returns the name (and package) of the Discriminated Parent Type referenced by this
+// Object Definition, if any.
+func (e examplePayloadsTemplater) discriminatedParentTypeFor(input models.SDKObjectDefinition, data GeneratorData) (*string, *string, error) {
+	inner := input
+	for inner.NestedItem != nil {
+		inner = *inner.NestedItem
+	}
+	if inner.Type != models.ReferenceSDKObjectDefinitionType || inner.ReferenceName == nil {
+		return nil, nil, nil
+	}
+
+	packageName := pointer.To(data.packageName)
+	availableModels := data.models
+	if pointer.From(inner.ReferenceNameIsCommonType) {
+		if data.commonTypesPackageName == nil {
+			return nil, nil, fmt.Errorf("internal error: Common Type %q encountered, but `commonTypesPackageName` was nil", *inner.ReferenceName)
+		}
+		packageName = data.commonTypesPackageName
+		availableModels = data.commonTypes.Models
+	}
+
+	// the Reference can also be to a Constant, which isn't a Discriminated Type
+	model, ok := availableModels[*inner.ReferenceName]
+	if !ok || !model.IsDiscriminatedParentType() {
+		return nil, nil, nil
+	}
+
+	return inner.ReferenceName, packageName, nil
+}
+
+// goLiteralForExamplePayload returns a Go string literal containing the (indented) JSON for the example payload.
+func goLiteralForExamplePayload(input interface{}) (*string, error) {
+	payload, err := json.MarshalIndent(input, "", "\t")
+	if err != nil {
+		return nil, fmt.Errorf("marshaling: %+v", err)
+	}
+
+	// raw strings can't contain backticks, so these are quoted instead
+	if strings.Contains(string(payload), "`") {
+		return pointer.To(strconv.Quote(string(payload))), nil
+	}
+	return pointer.To(fmt.Sprintf("`%s`", string(payload))), nil
+}
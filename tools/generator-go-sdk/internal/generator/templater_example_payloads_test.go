@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package generator
+
+import (
+	"testing"
+
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+func TestExamplePayloadsTemplater(t *testing.T) {
+	diskReference := &models.SDKObjectDefinition{
+		Type:          models.ReferenceSDKObjectDefinitionType,
+		ReferenceName: stringPointer("Disk"),
+	}
+	diskPayload := map[string]interface{}{
+		"location": "westus",
+		"properties": map[string]interface{}{
+			"sizeInGB": 128,
+		},
+	}
+	operations := map[string]models.SDKOperation{
+		"CreateOrUpdate": {
+			Examples: map[string]models.SDKOperationExample{
+				"Create a Disk": {
+					RequestBody: diskPayload,
+					Responses: map[int]interface{}{
+						200: diskPayload,
+						202: nil,
+					},
+				},
+			},
+			ExpectedStatusCodes: []int{200, 202},
+			LongRunning:         true,
+			Method:              "PUT",
+			RequestObject:       diskReference,
+			ResourceIDName:      stringPointer("DiskId"),
+			ResponseObject:      diskReference,
+		},
+		"Delete": {
+			Examples: map[string]models.SDKOperationExample{
+				"Delete a Disk": {},
+			},
+			ExpectedStatusCodes: []int{200},
+			Method:              "DELETE",
+			ResourceIDName:      stringPointer("DiskId"),
+		},
+		"List": {
+			Examples: map[string]models.SDKOperationExample{
+				"List Disks": {
+					Responses: map[int]interface{}{
+						200: map[string]interface{}{
+							"value": []interface{}{
+								diskPayload,
+							},
+						},
+						// non-expected Status Codes (e.g. errors) aren't tested
+						404: map[string]interface{}{
+							"error": "`NotFound`",
+						},
+					},
+				},
+			},
+			ExpectedStatusCodes:              []int{200},
+			FieldContainingPaginationDetails: stringPointer("nextLink"),
+			Method:                           "GET",
+			ResourceIDName:                   stringPointer("ResourceGroupId"),
+			ResponseObject:                   diskReference,
+		},
+		"GetDiskAccess": {
+			Examples: map[string]models.SDKOperationExample{
+				"Get a Disk Access": {
+					Responses: map[int]interface{}{
+						200: map[string]interface{}{
+							"kind": "Private",
+						},
+					},
+				},
+			},
+			ExpectedStatusCodes: []int{200},
+			Method:              "GET",
+			ResourceIDName:      stringPointer("DiskId"),
+			ResponseObject: &models.SDKObjectDefinition{
+				Type:          models.ReferenceSDKObjectDefinitionType,
+				ReferenceName: stringPointer("DiskAccess"),
+			},
+		},
+	}
+
+	sortedOperationNames := operationsWithExamples(operations)
+	if len(sortedOperationNames) != 3 {
+		t.Fatalf("expected 3 Operations with Examples but got %d: %+v", len(sortedOperationNames), sortedOperationNames)
+	}
+
+	actual, err := examplePayloadsTemplater{
+		sortedOperationNames: sortedOperationNames,
+		operations:           operations,
+	}.template(GeneratorData{
+		apiVersion: "2022-02-01",
+		models: map[string]models.SDKModel{
+			"Disk": {},
+			"DiskAccess": {
+				FieldNameContainingDiscriminatedValue: stringPointer("Kind"),
+			},
+		},
+		packageName:        "disks",
+		serviceClientName:  "DisksClient",
+		servicePackageName: "compute",
+		source:             AccTestLicenceType,
+		sourceType:         models.ResourceManagerSourceDataType,
+		useNewBaseLayer:    true,
+	})
+	if err != nil {
+		t.Fatalf("generating example payloads: %+v", err)
+	}
+
+	expected := `package disks_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-02-01/disks"
+)
+
+// acctests licence placeholder
+
+func TestDisksClient_CreateOrUpdateExamples(t *testing.T) {
+	t.Run("Create a Disk", func(t *testing.T) {
+		var request disks.Disk
+		if err := json.Unmarshal([]byte(` + "`" + `{
+			"location": "westus",
+			"properties": {
+				"sizeInGB": 128
+			}
+		}` + "`" + `), &request); err != nil {
+			t.Fatalf("unmarshaling the Request: %+v", err)
+		}
+
+		var response200 disks.Disk
+		if err := json.Unmarshal([]byte(` + "`" + `{
+			"location": "westus",
+			"properties": {
+				"sizeInGB": 128
+			}
+		}` + "`" + `), &response200); err != nil {
+			t.Fatalf("unmarshaling the Response with Status Code 200: %+v", err)
+		}
+	})
+}
+
+func TestDisksClient_GetDiskAccessExamples(t *testing.T) {
+	t.Run("Get a Disk Access", func(t *testing.T) {
+		if _, err := disks.UnmarshalDiskAccessImplementation([]byte(` + "`" + `{
+			"kind": "Private"
+		}` + "`" + `)); err != nil {
+			t.Fatalf("unmarshaling the Response with Status Code 200: %+v", err)
+		}
+	})
+}
+
+func TestDisksClient_ListExamples(t *testing.T) {
+	t.Run("List Disks", func(t *testing.T) {
+		var response200 struct {
+			Values *[]disks.Disk ` + "`json:\"value\"`" + `
+		}
+		if err := json.Unmarshal([]byte(` + "`" + `{
+			"value": [
+				{
+					"location": "westus",
+					"properties": {
+						"sizeInGB": 128
+					}
+				}
+			]
+		}` + "`" + `), &response200); err != nil {
+			t.Fatalf("unmarshaling the Response with Status Code 200: %+v", err)
+		}
+	})
+}
+`
+	assertTemplatedCodeMatches(t, expected, *actual)
+}
+
+func TestGoLiteralForExamplePayload(t *testing.T) {
+	actual, err := goLiteralForExamplePayload(map[string]interface{}{
+		"script": "echo `hostname`",
+	})
+	if err != nil {
+		t.Fatalf("building the literal: %+v", err)
+	}
+	expected := `"{\n\t\"script\": \"echo ` + "`hostname`" + `\"\n}"`
+	if *actual != expected {
+		t.Fatalf("expected %s but got %s", expected, *actual)
+	}
+}
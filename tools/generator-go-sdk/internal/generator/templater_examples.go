@@ -0,0 +1,434 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package generator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/helpers"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+var _ templaterForResource = examplesTemplater{}
+
+// examplesTemplater outputs a runnable Example function for each Operation, built from the same data as the
+// README - so that these are compiled (and vetted) alongside the SDK and can't drift from the generated code.
+type examplesTemplater struct {
+	sortedOperationNames []string
+	operations           map[string]models.SDKOperation
+}
+
+// maximumExamplePayloadDepth limits how deeply nested models are initialized within an example payload, to
+// avoid recursing infinitely for self-referential models.
+const maximumExamplePayloadDepth = 5
+
+func (e examplesTemplater) template(data GeneratorData) (*string, error) {
+	copyrightLines, err := copyrightLinesForSource(data.source)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving copyright lines: %+v", err)
+	}
+
+	commonTypesInclude := ""
+	if data.commonTypesIncludePath != nil {
+		commonTypesInclude = fmt.Sprintf(`"github.com/hashicorp/go-azure-sdk/%s/%s"`, data.sourceType, *data.commonTypesIncludePath)
+	}
+
+	examples := make([]string, 0)
+	for _, operationName := range e.sortedOperationNames {
+		operation, ok := e.operations[operationName]
+		if !ok {
+			return nil, fmt.Errorf("operation %q was not found", operationName)
+		}
+
+		example, err := e.exampleForOperation(operationName, operation, data)
+		if err != nil {
+			return nil, fmt.Errorf("building example for operation %q: %+v", operationName, err)
+		}
+		examples = append(examples, *example)
+	}
+
+	template := fmt.Sprintf(`package %[1]s_test
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/go-azure-sdk/%[2]s/%[3]s/%[4]s/%[1]s"
+	%[5]s
+)
+
+%[6]s
+
+%[7]s
+`, data.packageName, data.sourceType, data.servicePackageName, data.apiVersion, commonTypesInclude, *copyrightLines, strings.Join(examples, "\n"))
+	return &template, nil
+}
+
+func (e examplesTemplater) exampleForOperation(operationName string, operation models.SDKOperation, data GeneratorData) (*string, error) {
+	lines := make([]string, 0)
+	methodArgs := []string{
+		"ctx",
+	}
+
+	if operation.ResourceIDName != nil {
+		resourceId, err := e.resourceIdInitialization(operation, data)
+		if err != nil {
+			return nil, fmt.Errorf("building resource id initialization: %+v", err)
+		}
+
+		methodArgs = append(methodArgs, "id")
+		lines = append(lines, *resourceId)
+	}
+
+	// Operations which aren't addressed by a Resource ID (e.g. Data Plane Operations) take any Path Parameters
+	// within the URI Suffix as arguments
+	pathParameters := pathParametersFromExamples(operation.Examples)
+	for _, parameter := range (methodsPandoraTemplater{operation: operation}).uriSuffixPathParameters() {
+		value := strings.Trim(parameter.placeholder, "{}")
+		if v, ok := pathParameters[value]; ok && v != "" {
+			value = v
+		}
+		methodArgs = append(methodArgs, fmt.Sprintf("%q", value))
+	}
+
+	if operation.RequestObject != nil {
+		payload, err := e.payloadInitialization(*operation.RequestObject, data)
+		if err != nil {
+			return nil, fmt.Errorf("building payload initialization: %+v", err)
+		}
+
+		methodArgs = append(methodArgs, "payload")
+		lines = append(lines, *payload)
+	}
+
+	if len(operation.Options) > 0 {
+		methodArgs = append(methodArgs, fmt.Sprintf("%[1]s.Default%[2]sOperationOptions()", data.packageName, operationName))
+	}
+
+	var invocation string
+	switch {
+	case operation.FieldContainingPaginationDetails != nil:
+		invocation = fmt.Sprintf(`
+	// alternatively client.%[1]s(%[2]s) can be used to do batched pagination
+	items, err := client.%[1]sComplete(%[2]s)
+	if err != nil {
+		log.Fatalf("performing %[1]s: %%+v", err)
+	}
+	for _, item := range items.Items {
+		log.Printf("retrieved %%+v", item)
+	}
+`, operationName, strings.Join(methodArgs, ", "))
+
+	case operation.LongRunning:
+		invocation = fmt.Sprintf(`
+	if err := client.%[1]sThenPoll(%[2]s); err != nil {
+		log.Fatalf("performing %[1]s: %%+v", err)
+	}
+`, operationName, strings.Join(methodArgs, ", "))
+
+	case operation.ResponseObject != nil:
+		invocation = fmt.Sprintf(`
+	read, err := client.%[1]s(%[2]s)
+	if err != nil {
+		log.Fatalf("performing %[1]s: %%+v", err)
+	}
+	if model := read.Model; model != nil {
+		log.Printf("retrieved %%+v", model)
+	}
+`, operationName, strings.Join(methodArgs, ", "))
+
+	default:
+		invocation = fmt.Sprintf(`
+	if _, err := client.%[1]s(%[2]s); err != nil {
+		log.Fatalf("performing %[1]s: %%+v", err)
+	}
+`, operationName, strings.Join(methodArgs, ", "))
+	}
+
+	out := fmt.Sprintf(`
+func Example%[1]s_%[2]s() {
+	ctx := context.TODO()
+%[3]s
+%[4]s
+%[5]s
+}
+`, data.serviceClientName, operationName, e.clientInitialization(data), strings.Join(lines, "\n"), invocation)
+	return &out, nil
+}
+
+func (e examplesTemplater) clientInitialization(data GeneratorData) string {
+	if !data.useNewBaseLayer {
+		return fmt.Sprintf(`
+	client := %[1]s.New%[2]sWithBaseURI("https://management.azure.com")
+`, data.packageName, data.serviceClientName)
+	}
+
+	if data.sourceType == models.DataPlaneSourceDataType {
+		// Data Plane Clients are instantiated using the endpoint for the specific instance, rather than an Environment
+		return fmt.Sprintf(`
+	client, err := %[1]s.New%[2]sWithBaseURI("https://{endpoint}")
+	if err != nil {
+		log.Fatalf("building client: %%+v", err)
+	}
+`, data.packageName, data.serviceClientName)
+	}
+
+	api := "ResourceManager"
+	if data.sourceType == models.MicrosoftGraphSourceDataType {
+		api = "MicrosoftGraph"
+	}
+
+	return fmt.Sprintf(`
+	environment := environments.AzurePublic()
+	client, err := %[1]s.New%[2]sWithBaseURI(environment.%[3]s)
+	if err != nil {
+		log.Fatalf("building client: %%+v", err)
+	}
+`, data.packageName, data.serviceClientName, api)
+}
+
+func (e examplesTemplater) resourceIdInitialization(operation models.SDKOperation, data GeneratorData) (*string, error) {
+	var resourceId models.ResourceID
+	resourceIdPackageName := data.packageName
+	if pointer.From(operation.ResourceIDNameIsCommonType) {
+		if data.commonTypesPackageName == nil {
+			return nil, fmt.Errorf("internal error: Common Type Resource ID %q encountered, but `commonTypesPackageName` was nil", *operation.ResourceIDName)
+		}
+		var ok bool
+		if resourceId, ok = data.commonTypes.ResourceIDs[*operation.ResourceIDName]; !ok {
+			return nil, fmt.Errorf("internal error: Common Type Resource ID %q was not found", *operation.ResourceIDName)
+		}
+		resourceIdPackageName = *data.commonTypesPackageName
+	} else {
+		var ok bool
+		if resourceId, ok = data.resourceIds[*operation.ResourceIDName]; !ok {
+			return nil, fmt.Errorf("internal error: Resource ID %q was not found", *operation.ResourceIDName)
+		}
+	}
+
+	resourceIdTypeName := strings.TrimSuffix(*operation.ResourceIDName, "Id")
+	if resourceId.CommonIDAlias != nil {
+		resourceIdPackageName = "commonids"
+		resourceIdTypeName = *resourceId.CommonIDAlias // NOTE: CommonIds aren't output with an `Id` suffix
+	}
+
+	// when the API Definitions contain an Example for this Operation, the (more realistic) values for the Path
+	// Parameters are used rather than the Example Values for the Resource ID Segments
+	pathParameters := pathParametersFromExamples(operation.Examples)
+	components := make([]string, 0)
+	for _, v := range resourceId.Segments {
+		if v.Type == models.StaticResourceIDSegmentType || v.Type == models.ResourceProviderResourceIDSegmentType {
+			continue
+		}
+		value := v.ExampleValue
+		if pathParameter := pathParameterForSegment(v, pathParameters); pathParameter != nil {
+			value = *pathParameter
+		}
+		components = append(components, fmt.Sprintf("%q", value))
+	}
+	out := fmt.Sprintf(`	id := %[1]s.New%[2]sID(%[3]s)`, resourceIdPackageName, resourceIdTypeName, strings.Join(components, ", "))
+	return &out, nil
+}
+
+// pathParametersFromExamples returns the Path Parameters from the first Example (ordered by name) which defines them.
+func pathParametersFromExamples(input map[string]models.SDKOperationExample) map[string]string {
+	exampleNames := make([]string, 0)
+	for name := range input {
+		exampleNames = append(exampleNames, name)
+	}
+	sort.Strings(exampleNames)
+
+	for _, name := range exampleNames {
+		if example := input[name]; len(example.PathParameters) > 0 {
+			return example.PathParameters
+		}
+	}
+	return nil
+}
+
+// pathParameterForSegment returns the value of the Path Parameter matching the specified Resource ID Segment, if any.
+// Since the Segment Name can be normalized (e.g. the Path Parameter `disk` becomes the Segment `diskName`) both
+// forms are matched.
+func pathParameterForSegment(segment models.ResourceIDSegment, pathParameters map[string]string) *string {
+	for _, segmentName := range []string{segment.Name, strings.TrimSuffix(segment.Name, "Name")} {
+		for name, value := range pathParameters {
+			if value != "" && strings.EqualFold(name, segmentName) {
+				return pointer.To(value)
+			}
+		}
+	}
+	return nil
+}
+
+func (e examplesTemplater) payloadInitialization(input models.SDKObjectDefinition, data GeneratorData) (*string, error) {
+	if input.Type == models.ReferenceSDKObjectDefinitionType {
+		value, err := e.exampleValueForObjectDefinition(input, data, 0)
+		if err != nil {
+			return nil, err
+		}
+		if value != nil {
+			out := fmt.Sprintf(`	payload := %s`, *value)
+			return &out, nil
+		}
+	}
+
+	// for simplicities sake
+
+	typeName, err := e.golangTypeName(input, data)
+	if err != nil {
+		return nil, fmt.Errorf("determining golang type name for request object: %+v", err)
+	}
+	out := fmt.Sprintf(`	var payload %s`, *typeName)
+	return &out, nil
+}
+
+// exampleValueForObjectDefinition returns a Go literal for the specified Object Definition, populating any
+// Required fields within referenced Models - or nil when the zero value should be used.
+func (e examplesTemplater) exampleValueForObjectDefinition(input models.SDKObjectDefinition, data GeneratorData, depth int) (*string, error) {
+	if input.Nullable && input.Type != models.ReferenceSDKObjectDefinitionType {
+		// nullable types are output as `nullable.Type[T]` - where the zero value is fine
+		return nil, nil
+	}
+
+	switch input.Type {
+	case models.BooleanSDKObjectDefinitionType:
+		return pointer.To("true"), nil
+
+	case models.DateTimeSDKObjectDefinitionType:
+		return pointer.To(`"2006-01-02T15:04:05Z"`), nil
+
+	case models.FloatSDKObjectDefinitionType, models.IntegerSDKObjectDefinitionType:
+		return pointer.To("1"), nil
+
+	case models.LocationSDKObjectDefinitionType:
+		return pointer.To(`"westeurope"`), nil
+
+	case models.StringSDKObjectDefinitionType, models.ZoneSDKObjectDefinitionType:
+		return pointer.To(`"example"`), nil
+
+	case models.DictionarySDKObjectDefinitionType, models.ListSDKObjectDefinitionType, models.TagsSDKObjectDefinitionType:
+		typeName, err := e.golangTypeName(input, data)
+		if err != nil {
+			return nil, err
+		}
+		return pointer.To(fmt.Sprintf("%s{}", *typeName)), nil
+
+	case models.ReferenceSDKObjectDefinitionType:
+		return e.exampleValueForReference(input, data, depth)
+	}
+
+	// the zero value is used for everything else (e.g. Common Schema types and Raw Objects)
+	return nil, nil
+}
+
+func (e examplesTemplater) exampleValueForReference(input models.SDKObjectDefinition, data GeneratorData, depth int) (*string, error) {
+	if input.ReferenceName == nil {
+		return nil, fmt.Errorf("missing Reference for a Reference ObjectDefinition")
+	}
+
+	isCommonType := pointer.From(input.ReferenceNameIsCommonType)
+	packageName := data.packageName
+	constants := data.constants
+	availableModels := data.models
+	if isCommonType {
+		if data.commonTypesPackageName == nil {
+			return nil, fmt.Errorf("internal error: Common Type %q encountered, but `commonTypesPackageName` was nil", *input.ReferenceName)
+		}
+		packageName = *data.commonTypesPackageName
+		constants = data.commonTypes.Constants
+		availableModels = data.commonTypes.Models
+	}
+
+	if constant, ok := constants[*input.ReferenceName]; ok {
+		keys := make([]string, 0, len(constant.Values))
+		for key := range constant.Values {
+			keys = append(keys, key)
+		}
+		if len(keys) == 0 {
+			return nil, nil
+		}
+		sort.Strings(keys)
+		return pointer.To(fmt.Sprintf("%s.%s%s", packageName, *input.ReferenceName, keys[0])), nil
+	}
+
+	modelName := *input.ReferenceName
+	model, ok := availableModels[modelName]
+	if !ok {
+		return nil, fmt.Errorf("the Model or Constant %q was not found", modelName)
+	}
+
+	// Parent types are output as an interface, so we use the first Implementation instead
+	if model.IsDiscriminatedParentType() {
+		implementations := make([]string, 0)
+		for name, m := range availableModels {
+			if m.ParentTypeName != nil && *m.ParentTypeName == modelName && m.DiscriminatedValue != nil {
+				implementations = append(implementations, name)
+			}
+		}
+		if len(implementations) == 0 {
+			return nil, nil
+		}
+		sort.Strings(implementations)
+		modelName = implementations[0]
+		model = availableModels[modelName]
+	}
+
+	fields := make([]string, 0)
+	if depth < maximumExamplePayloadDepth {
+		// determine the fields for this model, including any inherited from ancestors (which may be Common Types)
+		modelData := data
+		modelData.models = availableModels
+		requiredFields, err := modelsTemplater{name: modelName, model: model}.fieldsIncludingAncestors(modelData)
+		if err != nil {
+			return nil, fmt.Errorf("determining fields for model %q: %+v", modelName, err)
+		}
+
+		fieldNames := make([]string, 0)
+		for name, field := range requiredFields {
+			// the Discriminated Value is set when marshaling, and pointers/read-only fields aren't required
+			if !field.Required || field.ReadOnly || field.ContainsDiscriminatedValue || field.ObjectDefinition.Nullable {
+				continue
+			}
+			if model.FieldNameContainingDiscriminatedValue != nil && name == *model.FieldNameContainingDiscriminatedValue {
+				continue
+			}
+			fieldNames = append(fieldNames, name)
+		}
+		sort.Strings(fieldNames)
+
+		for _, fieldName := range fieldNames {
+			value, err := e.exampleValueForObjectDefinition(requiredFields[fieldName].ObjectDefinition, data, depth+1)
+			if err != nil {
+				return nil, fmt.Errorf("building example value for field %q in model %q: %+v", fieldName, modelName, err)
+			}
+			if value == nil {
+				continue
+			}
+			fields = append(fields, fmt.Sprintf("%s: %s,", fieldName, *value))
+		}
+	}
+
+	if len(fields) == 0 {
+		return pointer.To(fmt.Sprintf("%s.%s{}", packageName, modelName)), nil
+	}
+
+	out := fmt.Sprintf(`%s.%s{
+	%s
+}`, packageName, modelName, strings.Join(fields, "\n"))
+	return &out, nil
+}
+
+func (e examplesTemplater) golangTypeName(input models.SDKObjectDefinition, data GeneratorData) (*string, error) {
+	// references to common types are prefixed using the common types package name, else the resource's package
+	inner := helpers.InnerMostSDKObjectDefinition(input)
+	if inner.Type == models.ReferenceSDKObjectDefinitionType && pointer.From(inner.ReferenceNameIsCommonType) {
+		return helpers.GolangTypeForSDKObjectDefinition(input, nil, data.commonTypesPackageName)
+	}
+	return helpers.GolangTypeForSDKObjectDefinition(input, pointer.To(data.packageName), data.commonTypesPackageName)
+}
@@ -0,0 +1,419 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package generator
+
+import (
+	"testing"
+
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+func TestExamplesTemplater_Operations(t *testing.T) {
+	actual, err := examplesTemplater{
+		sortedOperationNames: []string{"CreateOrUpdate", "Delete", "Get", "List"},
+		operations: map[string]models.SDKOperation{
+			"CreateOrUpdate": {
+				LongRunning:    true,
+				Method:         "PUT",
+				ResourceIDName: stringPointer("DiskId"),
+				RequestObject: &models.SDKObjectDefinition{
+					Type:          models.ReferenceSDKObjectDefinitionType,
+					ReferenceName: stringPointer("Disk"),
+				},
+			},
+			"Delete": {
+				Method:         "DELETE",
+				ResourceIDName: stringPointer("DiskId"),
+			},
+			"Get": {
+				Method: "GET",
+				Options: map[string]models.SDKOperationOption{
+					"Expand": {
+						ObjectDefinition: models.SDKOperationOptionObjectDefinition{
+							Type: models.StringSDKOperationOptionObjectDefinitionType,
+						},
+						QueryStringName: stringPointer("$expand"),
+					},
+				},
+				ResourceIDName: stringPointer("DiskId"),
+				ResponseObject: &models.SDKObjectDefinition{
+					Type:          models.ReferenceSDKObjectDefinitionType,
+					ReferenceName: stringPointer("Disk"),
+				},
+			},
+			"List": {
+				FieldContainingPaginationDetails: stringPointer("nextLink"),
+				Method:                           "GET",
+				ResourceIDName:                   stringPointer("ResourceGroupId"),
+				ResponseObject: &models.SDKObjectDefinition{
+					Type:          models.ReferenceSDKObjectDefinitionType,
+					ReferenceName: stringPointer("Disk"),
+				},
+			},
+		},
+	}.template(GeneratorData{
+		apiVersion: "2022-02-01",
+		constants: map[string]models.SDKConstant{
+			"SkuName": {
+				Type: models.StringSDKConstantType,
+				Values: map[string]string{
+					"Premium":  "Premium",
+					"Standard": "Standard",
+				},
+			},
+		},
+		models: map[string]models.SDKModel{
+			"Disk": {
+				Fields: map[string]models.SDKField{
+					"Id": {
+						JsonName: "id",
+						ObjectDefinition: models.SDKObjectDefinition{
+							Type: models.StringSDKObjectDefinitionType,
+						},
+						Optional: true,
+						ReadOnly: true,
+					},
+					"Location": {
+						JsonName: "location",
+						ObjectDefinition: models.SDKObjectDefinition{
+							Type: models.LocationSDKObjectDefinitionType,
+						},
+						Required: true,
+					},
+					"Properties": {
+						JsonName: "properties",
+						ObjectDefinition: models.SDKObjectDefinition{
+							Type:          models.ReferenceSDKObjectDefinitionType,
+							ReferenceName: stringPointer("DiskProperties"),
+						},
+						Required: true,
+					},
+					"Tags": {
+						JsonName: "tags",
+						ObjectDefinition: models.SDKObjectDefinition{
+							Type: models.TagsSDKObjectDefinitionType,
+						},
+						Optional: true,
+					},
+				},
+			},
+			"DiskProperties": {
+				Fields: map[string]models.SDKField{
+					"SizeInGB": {
+						JsonName: "sizeInGB",
+						ObjectDefinition: models.SDKObjectDefinition{
+							Type: models.IntegerSDKObjectDefinitionType,
+						},
+						Required: true,
+					},
+					"Sku": {
+						JsonName: "sku",
+						ObjectDefinition: models.SDKObjectDefinition{
+							Type:          models.ReferenceSDKObjectDefinitionType,
+							ReferenceName: stringPointer("SkuName"),
+						},
+						Required: true,
+					},
+				},
+			},
+		},
+		packageName: "disks",
+		resourceIds: map[string]models.ResourceID{
+			"DiskId": {
+				Segments: []models.ResourceIDSegment{
+					models.NewStaticValueResourceIDSegment("subscriptions", "subscriptions"),
+					models.NewSubscriptionIDResourceIDSegment("subscriptionId"),
+					models.NewStaticValueResourceIDSegment("disks", "disks"),
+					models.NewUserSpecifiedResourceIDSegment("diskName", "diskName"),
+				},
+			},
+			"ResourceGroupId": {
+				CommonIDAlias: stringPointer("ResourceGroup"),
+				Segments: []models.ResourceIDSegment{
+					models.NewStaticValueResourceIDSegment("subscriptions", "subscriptions"),
+					models.NewSubscriptionIDResourceIDSegment("subscriptionId"),
+					models.NewStaticValueResourceIDSegment("resourceGroups", "resourceGroups"),
+					models.NewResourceGroupNameResourceIDSegment("resourceGroupName"),
+				},
+			},
+		},
+		serviceClientName:  "DisksClient",
+		servicePackageName: "compute",
+		source:             AccTestLicenceType,
+		sourceType:         models.ResourceManagerSourceDataType,
+		useNewBaseLayer:    true,
+	})
+	if err != nil {
+		t.Fatalf("generating examples: %+v", err)
+	}
+
+	expected := `package disks_test
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-02-01/disks"
+)
+
+// acctests licence placeholder
+
+func ExampleDisksClient_CreateOrUpdate() {
+	ctx := context.TODO()
+	environment := environments.AzurePublic()
+	client, err := disks.NewDisksClientWithBaseURI(environment.ResourceManager)
+	if err != nil {
+		log.Fatalf("building client: %+v", err)
+	}
+	id := disks.NewDiskID("12345678-1234-9876-4563-123456789012", "diskName")
+	payload := disks.Disk{
+		Location: "westeurope",
+		Properties: disks.DiskProperties{
+			SizeInGB: 1,
+			Sku: disks.SkuNamePremium,
+		},
+	}
+	if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+		log.Fatalf("performing CreateOrUpdate: %+v", err)
+	}
+}
+
+func ExampleDisksClient_Delete() {
+	ctx := context.TODO()
+	environment := environments.AzurePublic()
+	client, err := disks.NewDisksClientWithBaseURI(environment.ResourceManager)
+	if err != nil {
+		log.Fatalf("building client: %+v", err)
+	}
+	id := disks.NewDiskID("12345678-1234-9876-4563-123456789012", "diskName")
+	if _, err := client.Delete(ctx, id); err != nil {
+		log.Fatalf("performing Delete: %+v", err)
+	}
+}
+
+func ExampleDisksClient_Get() {
+	ctx := context.TODO()
+	environment := environments.AzurePublic()
+	client, err := disks.NewDisksClientWithBaseURI(environment.ResourceManager)
+	if err != nil {
+		log.Fatalf("building client: %+v", err)
+	}
+	id := disks.NewDiskID("12345678-1234-9876-4563-123456789012", "diskName")
+	read, err := client.Get(ctx, id, disks.DefaultGetOperationOptions())
+	if err != nil {
+		log.Fatalf("performing Get: %+v", err)
+	}
+	if model := read.Model; model != nil {
+		log.Printf("retrieved %+v", model)
+	}
+}
+
+func ExampleDisksClient_List() {
+	ctx := context.TODO()
+	environment := environments.AzurePublic()
+	client, err := disks.NewDisksClientWithBaseURI(environment.ResourceManager)
+	if err != nil {
+		log.Fatalf("building client: %+v", err)
+	}
+	id := commonids.NewResourceGroupID("12345678-1234-9876-4563-123456789012", "example-resource-group")
+	// alternatively client.List(ctx, id) can be used to do batched pagination
+	items, err := client.ListComplete(ctx, id)
+	if err != nil {
+		log.Fatalf("performing List: %+v", err)
+	}
+	for _, item := range items.Items {
+		log.Printf("retrieved %+v", item)
+	}
+}
+`
+	assertTemplatedCodeMatches(t, expected, *actual)
+}
+
+func TestExamplesTemplater_AutoRestBaseLayer(t *testing.T) {
+	actual, err := examplesTemplater{
+		sortedOperationNames: []string{"Delete"},
+		operations: map[string]models.SDKOperation{
+			"Delete": {
+				Method:         "DELETE",
+				ResourceIDName: stringPointer("DiskId"),
+			},
+		},
+	}.template(GeneratorData{
+		apiVersion:  "2022-02-01",
+		packageName: "disks",
+		resourceIds: map[string]models.ResourceID{
+			"DiskId": {
+				Segments: []models.ResourceIDSegment{
+					models.NewStaticValueResourceIDSegment("subscriptions", "subscriptions"),
+					models.NewSubscriptionIDResourceIDSegment("subscriptionId"),
+					models.NewStaticValueResourceIDSegment("disks", "disks"),
+					models.NewUserSpecifiedResourceIDSegment("diskName", "diskName"),
+				},
+			},
+		},
+		serviceClientName:  "DisksClient",
+		servicePackageName: "compute",
+		source:             AccTestLicenceType,
+		sourceType:         models.ResourceManagerSourceDataType,
+		useNewBaseLayer:    false,
+	})
+	if err != nil {
+		t.Fatalf("generating examples: %+v", err)
+	}
+
+	expected := `package disks_test
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-02-01/disks"
+)
+
+// acctests licence placeholder
+
+func ExampleDisksClient_Delete() {
+	ctx := context.TODO()
+	client := disks.NewDisksClientWithBaseURI("https://management.azure.com")
+	id := disks.NewDiskID("12345678-1234-9876-4563-123456789012", "diskName")
+	if _, err := client.Delete(ctx, id); err != nil {
+		log.Fatalf("performing Delete: %+v", err)
+	}
+}
+`
+	assertTemplatedCodeMatches(t, expected, *actual)
+}
+
+func TestExamplesTemplater_PathParametersFromExamples(t *testing.T) {
+	actual, err := examplesTemplater{
+		sortedOperationNames: []string{"Delete"},
+		operations: map[string]models.SDKOperation{
+			"Delete": {
+				Examples: map[string]models.SDKOperationExample{
+					"Delete a Disk": {
+						PathParameters: map[string]string{
+							"disk":           "myDisk",
+							"subscriptionId": "00000000-1111-2222-3333-444444444444",
+						},
+					},
+					"Delete a Disk without Parameters": {},
+				},
+				Method:         "DELETE",
+				ResourceIDName: stringPointer("DiskId"),
+			},
+		},
+	}.template(GeneratorData{
+		apiVersion:  "2022-02-01",
+		packageName: "disks",
+		resourceIds: map[string]models.ResourceID{
+			"DiskId": {
+				Segments: []models.ResourceIDSegment{
+					models.NewStaticValueResourceIDSegment("subscriptions", "subscriptions"),
+					models.NewSubscriptionIDResourceIDSegment("subscriptionId"),
+					models.NewStaticValueResourceIDSegment("disks", "disks"),
+					models.NewUserSpecifiedResourceIDSegment("diskName", "diskName"),
+				},
+			},
+		},
+		serviceClientName:  "DisksClient",
+		servicePackageName: "compute",
+		source:             AccTestLicenceType,
+		sourceType:         models.ResourceManagerSourceDataType,
+		useNewBaseLayer:    false,
+	})
+	if err != nil {
+		t.Fatalf("generating examples: %+v", err)
+	}
+
+	expected := `package disks_test
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-02-01/disks"
+)
+
+// acctests licence placeholder
+
+func ExampleDisksClient_Delete() {
+	ctx := context.TODO()
+	client := disks.NewDisksClientWithBaseURI("https://management.azure.com")
+	id := disks.NewDiskID("00000000-1111-2222-3333-444444444444", "myDisk")
+	if _, err := client.Delete(ctx, id); err != nil {
+		log.Fatalf("performing Delete: %+v", err)
+	}
+}
+`
+	assertTemplatedCodeMatches(t, expected, *actual)
+}
+
+func TestExamplesTemplater_DataPlane(t *testing.T) {
+	actual, err := examplesTemplater{
+		sortedOperationNames: []string{"GetSecret"},
+		operations: map[string]models.SDKOperation{
+			"GetSecret": {
+				Examples: map[string]models.SDKOperationExample{
+					"GetSecret": {
+						PathParameters: map[string]string{
+							"secret-name": "my-secret",
+						},
+					},
+				},
+				Method: "GET",
+				ResponseObject: &models.SDKObjectDefinition{
+					Type: models.StringSDKObjectDefinitionType,
+				},
+				URISuffix: stringPointer("/secrets/{secret-name}/{secret-version}"),
+			},
+		},
+	}.template(GeneratorData{
+		apiVersion:         "7.4",
+		packageName:        "secrets",
+		serviceClientName:  "SecretsClient",
+		servicePackageName: "keyvault",
+		source:             AccTestLicenceType,
+		sourceType:         models.DataPlaneSourceDataType,
+		useNewBaseLayer:    true,
+	})
+	if err != nil {
+		t.Fatalf("generating examples: %+v", err)
+	}
+
+	expected := `package secrets_test
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/go-azure-sdk/data-plane/keyvault/7.4/secrets"
+)
+
+// acctests licence placeholder
+
+func ExampleSecretsClient_GetSecret() {
+	ctx := context.TODO()
+	client, err := secrets.NewSecretsClientWithBaseURI("https://{endpoint}")
+	if err != nil {
+		log.Fatalf("building client: %+v", err)
+	}
+	read, err := client.GetSecret(ctx, "my-secret", "secret-version")
+	if err != nil {
+		log.Fatalf("performing GetSecret: %+v", err)
+	}
+	if model := read.Model; model != nil {
+		log.Printf("retrieved %+v", model)
+	}
+}
+`
+	assertTemplatedCodeMatches(t, expected, *actual)
+}
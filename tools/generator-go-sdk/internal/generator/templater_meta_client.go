@@ -2,6 +2,7 @@ package generator
 
 import (
 	"fmt"
+	"regexp"
 	"sort"
 	"strings"
 
@@ -12,6 +13,7 @@ type metaClientTemplater struct {
 	apiVersionDirectoryName string
 	apiVersionPackageName   string
 	baseClientPackage       string
+	parameterizedHost       *models.ParameterizedHost
 	resources               map[string]models.APIResource
 	serviceName             string
 	source                  models.SourceDataOrigin
@@ -30,6 +32,14 @@ func (m metaClientTemplater) template() (*string, error) {
 	}
 	sort.Strings(resourceNames)
 
+	// Data Plane APIs are instantiated using the endpoint for the specific instance, rather than an Environment
+	baseURIArgument := "sdkApi sdkEnv.Api"
+	baseURIVariableName := "sdkApi"
+	if m.sourceType == models.DataPlaneSourceDataType {
+		baseURIArgument = "endpoint string"
+		baseURIVariableName = "endpoint"
+	}
+
 	imports := make([]string, 0)
 	clientInitialization := make([]string, 0)
 	fields := make([]string, 0)
@@ -39,12 +49,12 @@ func (m metaClientTemplater) template() (*string, error) {
 
 		imports = append(imports, fmt.Sprintf(`"github.com/hashicorp/go-azure-sdk/%s/%s/%s/%s"`, m.sourceType, strings.ToLower(m.serviceName), m.apiVersionDirectoryName, strings.ToLower(resourceName)))
 		fields = append(fields, fmt.Sprintf("%[1]s *%[2]s.%[1]sClient", resourceName, strings.ToLower(resourceName)))
-		clientInitializationTemplate := fmt.Sprintf(`%[1]s, err := %[2]s.New%[3]sClientWithBaseURI(sdkApi)
+		clientInitializationTemplate := fmt.Sprintf(`%[1]s, err := %[2]s.New%[3]sClientWithBaseURI(%[4]s)
 if err != nil {
 	return nil, fmt.Errorf("building %[3]s client: %%+v", err)
 }
 configureFunc(%[1]s.Client)
-`, variableName, strings.ToLower(resourceName), resourceName)
+`, variableName, strings.ToLower(resourceName), resourceName, baseURIVariableName)
 		clientInitialization = append(clientInitialization, clientInitializationTemplate)
 		assignments = append(assignments, fmt.Sprintf("%[1]s: %[2]s,", resourceName, variableName))
 	}
@@ -54,14 +64,25 @@ configureFunc(%[1]s.Client)
 	sort.Strings(fields)
 	sort.Strings(imports)
 
+	parameterizedHostFunctions := ""
+	if m.sourceType == models.DataPlaneSourceDataType && m.parameterizedHost != nil {
+		code, err := m.codeForParameterizedHost(*m.parameterizedHost)
+		if err != nil {
+			return nil, fmt.Errorf("generating the functions for the Parameterized Host: %+v", err)
+		}
+		parameterizedHostFunctions = *code
+	}
+
 	out := fmt.Sprintf(`package %[1]s
 
 %[3]s
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/dataplane"
 	"github.com/hashicorp/go-azure-sdk/sdk/client/msgraph"
 	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
 	sdkEnv "github.com/hashicorp/go-azure-sdk/sdk/environments"
@@ -72,13 +93,87 @@ type Client struct {
 	%[5]s
 }
 
-func NewClientWithBaseURI(sdkApi sdkEnv.Api, configureFunc func(c *%[2]s.Client)) (*Client, error) {
+func NewClientWithBaseURI(%[8]s, configureFunc func(c *%[2]s.Client)) (*Client, error) {
 	%[6]s
 
 	return &Client{
 		%[7]s
 	}, nil
 }
-`, m.apiVersionPackageName, m.baseClientPackage, *copyrightLines, strings.Join(imports, "\n"), strings.Join(fields, "\n"), strings.Join(clientInitialization, "\n"), strings.Join(assignments, "\n"))
+%[9]s
+`, m.apiVersionPackageName, m.baseClientPackage, *copyrightLines, strings.Join(imports, "\n"), strings.Join(fields, "\n"), strings.Join(clientInitialization, "\n"), strings.Join(assignments, "\n"), baseURIArgument, parameterizedHostFunctions)
+	return &out, nil
+}
+
+// codeForParameterizedHost outputs an EndpointParameters struct containing the parameters for the Host Template, a
+// function to build the endpoint from these (validating the required parameters and applying any default values)
+// and a constructor for the Client which uses this endpoint.
+func (m metaClientTemplater) codeForParameterizedHost(input models.ParameterizedHost) (*string, error) {
+	fields := make([]string, 0)
+	defaults := make([]string, 0)
+	replacements := make([]string, 0)
+	for _, parameter := range input.Parameters {
+		if !regexp.MustCompile("^[a-zA-Z][a-zA-Z0-9]*$").MatchString(parameter.Name) {
+			return nil, fmt.Errorf("the parameter %q within the Host Template %q can't be output as a Go field", parameter.Name, input.HostTemplate)
+		}
+		fieldName := capitalizeFirstLetter(parameter.Name)
+
+		description := strings.Join(strings.Fields(parameter.Description), " ")
+		if description == "" {
+			description = fmt.Sprintf("The value for `{%s}` within the Host Template.", parameter.Name)
+		}
+		if parameter.DefaultValue != nil {
+			description = fmt.Sprintf("%s Defaults to `%s` when not specified.", description, *parameter.DefaultValue)
+		}
+		fields = append(fields, fmt.Sprintf(`
+	// %[1]s %[2]s
+	%[1]s string`, fieldName, description))
+
+		if parameter.DefaultValue != nil {
+			defaults = append(defaults, fmt.Sprintf(`
+	if input.%[1]s == "" {
+		input.%[1]s = %[2]q
+	}`, fieldName, *parameter.DefaultValue))
+		} else if parameter.Required {
+			defaults = append(defaults, fmt.Sprintf(`
+	if input.%[1]s == "" {
+		return "", fmt.Errorf("a value must be specified for %[1]s")
+	}`, fieldName))
+		}
+
+		replacements = append(replacements, fmt.Sprintf("%q, input.%s,", fmt.Sprintf("{%s}", parameter.Name), fieldName))
+	}
+
+	endpoint := "host"
+	if input.UseSchemePrefix {
+		endpoint = `fmt.Sprintf("https://%s", host)`
+	}
+
+	out := fmt.Sprintf(`
+// EndpointParameters specifies the values for the parameters within the Host Template %[1]q
+type EndpointParameters struct {
+	%[2]s
+}
+
+// EndpointFromParameters builds the endpoint for this API Version from the Host Template %[1]q
+func EndpointFromParameters(input EndpointParameters) (string, error) {
+	%[3]s
+
+	host := strings.NewReplacer(
+		%[4]s
+	).Replace(%[1]q)
+	return %[5]s, nil
+}
+
+// NewClientWithParameterizedHost returns a Client using the endpoint built from the Host Template %[1]q
+func NewClientWithParameterizedHost(input EndpointParameters, configureFunc func(c *%[6]s.Client)) (*Client, error) {
+	endpoint, err := EndpointFromParameters(input)
+	if err != nil {
+		return nil, fmt.Errorf("building the endpoint: %%+v", err)
+	}
+
+	return NewClientWithBaseURI(endpoint, configureFunc)
+}
+`, input.HostTemplate, strings.Join(fields, "\n"), strings.Join(defaults, "\n"), strings.Join(replacements, "\n"), endpoint, m.baseClientPackage)
 	return &out, nil
 }
@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package generator
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+func TestTemplateMetaClientDataPlaneWithParameterizedHost(t *testing.T) {
+	input := metaClientTemplater{
+		apiVersionDirectoryName: "2023-11-03",
+		apiVersionPackageName:   "v2023_11_03",
+		baseClientPackage:       "dataplane",
+		parameterizedHost: &models.ParameterizedHost{
+			HostTemplate: "{accountName}.blob.{endpointSuffix}",
+			Parameters: []models.ParameterizedHostParameter{
+				{
+					Description: "The name of the Storage Account.",
+					Name:        "accountName",
+					Required:    true,
+				},
+				{
+					DefaultValue: pointer.To("core.windows.net"),
+					Description:  "The DNS Suffix for the Storage Account.",
+					Name:         "endpointSuffix",
+					Required:     true,
+				},
+			},
+			UseSchemePrefix: true,
+		},
+		resources: map[string]models.APIResource{
+			"Blobs": {},
+		},
+		serviceName: "storage",
+		source:      AccTestLicenceType,
+		sourceType:  models.DataPlaneSourceDataType,
+	}
+
+	actual, err := input.template()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	expected := `package v2023_11_03
+
+// acctests licence placeholder
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/dataplane"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/msgraph"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	sdkEnv "github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/go-azure-sdk/data-plane/storage/2023-11-03/blobs"
+)
+
+type Client struct {
+	Blobs *blobs.BlobsClient
+}
+
+func NewClientWithBaseURI(endpoint string, configureFunc func(c *dataplane.Client)) (*Client, error) {
+	blobsClient, err := blobs.NewBlobsClientWithBaseURI(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("building Blobs client: %+v", err)
+	}
+	configureFunc(blobsClient.Client)
+
+	return &Client{
+		Blobs: blobsClient,
+	}, nil
+}
+
+// EndpointParameters specifies the values for the parameters within the Host Template "{accountName}.blob.{endpointSuffix}"
+type EndpointParameters struct {
+	// AccountName The name of the Storage Account.
+	AccountName string
+
+	// EndpointSuffix The DNS Suffix for the Storage Account. Defaults to ` + "`core.windows.net`" + ` when not specified.
+	EndpointSuffix string
+}
+
+// EndpointFromParameters builds the endpoint for this API Version from the Host Template "{accountName}.blob.{endpointSuffix}"
+func EndpointFromParameters(input EndpointParameters) (string, error) {
+	if input.AccountName == "" {
+		return "", fmt.Errorf("a value must be specified for AccountName")
+	}
+
+	if input.EndpointSuffix == "" {
+		input.EndpointSuffix = "core.windows.net"
+	}
+
+	host := strings.NewReplacer(
+		"{accountName}", input.AccountName,
+		"{endpointSuffix}", input.EndpointSuffix,
+	).Replace("{accountName}.blob.{endpointSuffix}")
+	return fmt.Sprintf("https://%s", host), nil
+}
+
+// NewClientWithParameterizedHost returns a Client using the endpoint built from the Host Template "{accountName}.blob.{endpointSuffix}"
+func NewClientWithParameterizedHost(input EndpointParameters, configureFunc func(c *dataplane.Client)) (*Client, error) {
+	endpoint, err := EndpointFromParameters(input)
+	if err != nil {
+		return nil, fmt.Errorf("building the endpoint: %+v", err)
+	}
+
+	return NewClientWithBaseURI(endpoint, configureFunc)
+}
+`
+	assertTemplatedCodeMatches(t, expected, *actual)
+}
+
+func TestTemplateMetaClientDataPlaneWithInvalidParameterName(t *testing.T) {
+	input := metaClientTemplater{
+		apiVersionDirectoryName: "7.4",
+		apiVersionPackageName:   "v7_4",
+		baseClientPackage:       "dataplane",
+		parameterizedHost: &models.ParameterizedHost{
+			HostTemplate: "{vault-base-url}",
+			Parameters: []models.ParameterizedHostParameter{
+				{
+					Name:     "vault-base-url",
+					Required: true,
+				},
+			},
+		},
+		resources: map[string]models.APIResource{
+			"Secrets": {},
+		},
+		serviceName: "keyvault",
+		source:      AccTestLicenceType,
+		sourceType:  models.DataPlaneSourceDataType,
+	}
+
+	if actual, err := input.template(); err == nil {
+		t.Fatalf("expected an error but got %q", *actual)
+	}
+}
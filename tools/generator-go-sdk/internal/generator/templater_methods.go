@@ -2,8 +2,10 @@ package generator
 
 import (
 	"fmt"
+	"regexp"
 	"sort"
 	"strings"
+	"unicode"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/helpers"
@@ -178,6 +180,7 @@ func (c methodsPandoraTemplater) immediateOperationTemplate(data GeneratorData)
 
 %[10]s
 func (c %[1]s) %[2]s(ctx context.Context %[3]s) (result %[2]sOperationResponse, err error) {
+	%[11]s
 	opts := %[4]s
 
 	req, err := c.Client.NewRequest(ctx, opts)
@@ -202,7 +205,7 @@ func (c %[1]s) %[2]s(ctx context.Context %[3]s) (result %[2]sOperationResponse,
 	return
 }
 
-`, data.serviceClientName, c.operationName, *methodArguments, *requestOptions, *marshalerCode, *unmarshalerCode, *responseStruct, *optionsStruct, requestOptionStruct, comment)
+`, data.serviceClientName, c.operationName, *methodArguments, *requestOptions, *marshalerCode, *unmarshalerCode, *responseStruct, *optionsStruct, requestOptionStruct, comment, c.validationTemplate(data))
 	return &templated, nil
 }
 
@@ -249,6 +252,7 @@ func (c methodsPandoraTemplater) longRunningOperationTemplate(data GeneratorData
 
 %[12]s
 func (c %[1]s) %[3]s(ctx context.Context %[4]s) (result %[3]sOperationResponse, err error) {
+	%[13]s
 	opts := %[5]s
 
 	req, err := c.Client.NewRequest(ctx, opts)
@@ -291,7 +295,26 @@ func (c %[1]s) %[3]sThenPoll(ctx context.Context %[4]s) error {
 
 	return nil
 }
-`, data.serviceClientName, data.baseClientPackage, c.operationName, *methodArguments, *requestOptions, *marshalerCode, *unmarshalerCode, argumentsCode, *responseStruct, *optionsStruct, requestOptionStruct, comment)
+
+// ResumeToken returns a token which can be persisted and passed to Resume%[3]sPoller, allowing
+// polling for this %[3]s operation to be resumed (for example from another process)
+func (r %[3]sOperationResponse) ResumeToken() (*string, error) {
+	return newResumeToken(r.HttpResponse, "%[2]s", %[14]q)
+}
+
+// Resume%[3]sPoller rebuilds the Poller for a %[3]s operation from a token obtained from ResumeToken
+func (c %[1]s) Resume%[3]sPoller(ctx context.Context, token string) (result %[3]sOperationResponse, err error) {
+	resp, err := responseFromResumeToken(token, "%[2]s", %[14]q)
+	if err != nil {
+		err = fmt.Errorf("parsing resume token for %[3]s: %%+v", err)
+		return
+	}
+	result.HttpResponse = resp.Response
+
+	result.Poller, err = %[2]s.PollerFromResponse(resp, c.Client)
+	return
+}
+`, data.serviceClientName, data.baseClientPackage, c.operationName, *methodArguments, *requestOptions, *marshalerCode, *unmarshalerCode, argumentsCode, *responseStruct, *optionsStruct, requestOptionStruct, comment, c.validationTemplate(data), pointer.From(c.operation.LongRunningFinalStateVia))
 	return &templated, nil
 }
 
@@ -344,6 +367,7 @@ func (c methodsPandoraTemplater) listOperationTemplate(data GeneratorData) (*str
 
 %[9]s
 func (c %[1]s) %[2]s(ctx context.Context %[3]s) (result %[2]sOperationResponse, err error) {
+	%[10]s
 	opts := %[4]s
 
 	req, err := c.Client.NewRequest(ctx, opts)
@@ -365,7 +389,7 @@ func (c %[1]s) %[2]s(ctx context.Context %[3]s) (result %[2]sOperationResponse,
 
 	return
 }
-`, data.serviceClientName, c.operationName, *methodArguments, *requestOptions, *unmarshalerCode, *responseStruct, *optionsStruct, requestOptionStruct, comment)
+`, data.serviceClientName, c.operationName, *methodArguments, *requestOptions, *unmarshalerCode, *responseStruct, *optionsStruct, requestOptionStruct, comment, c.validationTemplate(data))
 
 	// Only output predicate functions for models and not for base types like string, int etc.
 	if c.operation.ResponseObject.Type == models.ReferenceSDKObjectDefinitionType || c.operation.ResponseObject.Type == models.ListSDKObjectDefinitionType {
@@ -435,6 +459,9 @@ func (c methodsPandoraTemplater) argumentsTemplate() string {
 	if c.operation.ResourceIDName != nil {
 		args = append(args, "id")
 	}
+	for _, parameter := range c.uriSuffixPathParameters() {
+		args = append(args, parameter.argumentName)
+	}
 	if c.operation.RequestObject != nil {
 		args = append(args, "input")
 	}
@@ -455,7 +482,7 @@ func (c methodsPandoraTemplater) requestArgumentsTemplate() string {
 		if c.operation.ResourceIDName != nil {
 			args = append(args, fmt.Sprintf("fmt.Sprintf(\"%%s%s\", id.ID())", *c.operation.URISuffix))
 		} else {
-			args = append(args, *c.operation.URISuffix)
+			args = append(args, c.pathForURISuffix())
 		}
 	} else {
 		if c.operation.ResourceIDName != nil {
@@ -514,6 +541,9 @@ func (c methodsPandoraTemplater) argumentsTemplateForMethod(data GeneratorData)
 
 		arguments = append(arguments, fmt.Sprintf("id %s", idName))
 	}
+	for _, parameter := range c.uriSuffixPathParameters() {
+		arguments = append(arguments, fmt.Sprintf("%s string", parameter.argumentName))
+	}
 	if c.operation.RequestObject != nil {
 		typeName, err := helpers.GolangTypeForSDKObjectDefinition(*c.operation.RequestObject, nil, data.commonTypesPackageName)
 		if err != nil {
@@ -532,6 +562,65 @@ func (c methodsPandoraTemplater) argumentsTemplateForMethod(data GeneratorData)
 	return &out, nil
 }
 
+// uriSuffixPathParameter is a `{placeholder}` within the URISuffix of an Operation which isn't addressed by
+// a Resource ID (e.g. a Data Plane Operation, which is relative to the Parameterized Host), which is exposed
+// as a string argument on the method.
+type uriSuffixPathParameter struct {
+	argumentName string
+	placeholder  string
+}
+
+var uriSuffixPlaceholderRegex = regexp.MustCompile(`\{([^}]+)\}`)
+
+func (c methodsPandoraTemplater) uriSuffixPathParameters() []uriSuffixPathParameter {
+	if c.operation.ResourceIDName != nil || c.operation.URISuffix == nil {
+		return nil
+	}
+
+	output := make([]uriSuffixPathParameter, 0)
+	for _, match := range uriSuffixPlaceholderRegex.FindAllStringSubmatch(*c.operation.URISuffix, -1) {
+		output = append(output, uriSuffixPathParameter{
+			argumentName: argumentNameForPathParameter(match[1]),
+			placeholder:  match[0],
+		})
+	}
+	return output
+}
+
+// pathForURISuffix returns the code for the Path of an Operation which isn't addressed by a Resource ID, where
+// any `{placeholders}` within the URISuffix are replaced by the (escaped) method arguments.
+func (c methodsPandoraTemplater) pathForURISuffix() string {
+	parameters := c.uriSuffixPathParameters()
+	if len(parameters) == 0 {
+		return fmt.Sprintf("%q", *c.operation.URISuffix)
+	}
+
+	format := strings.ReplaceAll(*c.operation.URISuffix, "%", "%%")
+	arguments := make([]string, 0)
+	for _, parameter := range parameters {
+		format = strings.Replace(format, parameter.placeholder, "%s", 1)
+		arguments = append(arguments, fmt.Sprintf("url.PathEscape(%s)", parameter.argumentName))
+	}
+	return fmt.Sprintf("fmt.Sprintf(%q, %s)", format, strings.Join(arguments, ", "))
+}
+
+// argumentNameForPathParameter returns the camelCased argument name for the path parameter (e.g. `secret-name`
+// becomes `secretName`).
+func argumentNameForPathParameter(input string) string {
+	words := strings.FieldsFunc(input, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	output := ""
+	for i, word := range words {
+		if i == 0 {
+			output += camelCase(word)
+			continue
+		}
+		output += strings.ToUpper(word[0:1]) + word[1:]
+	}
+	return output
+}
+
 // define struct used in requestOptions
 func (c methodsPandoraTemplater) requestOptionStruct() string {
 	var output string
@@ -571,7 +660,7 @@ func (c methodsPandoraTemplater) requestOptions() (*string, error) {
 		if c.operation.ResourceIDName != nil {
 			path = fmt.Sprintf(`fmt.Sprintf("%%s%s", id.ID())`, *c.operation.URISuffix)
 		} else {
-			path = fmt.Sprintf("%q", *c.operation.URISuffix)
+			path = c.pathForURISuffix()
 		}
 	} else {
 		if c.operation.ResourceIDName != nil {
@@ -845,6 +934,7 @@ func (c methodsPandoraTemplater) optionsStruct(data GeneratorData) (*string, err
 	odataAssignments := make([]string, 0)
 	queryStringAssignments := make([]string, 0)
 	headerAssignments := make([]string, 0)
+	requiredChecks := make([]string, 0)
 
 	for optionName, option := range c.operation.Options {
 		// Handle special options
@@ -864,6 +954,12 @@ func (c methodsPandoraTemplater) optionsStruct(data GeneratorData) (*string, err
 
 		properties = append(properties, fmt.Sprintf("%s *%s", optionName, *optionType))
 
+		if option.Required {
+			requiredChecks = append(requiredChecks, fmt.Sprintf(`if o.%[1]s == nil {
+	return fmt.Errorf("%[1]s is required but was not specified")
+}`, optionName))
+		}
+
 		if option.ODataFieldName != nil {
 			value := fmt.Sprintf("*o.%s", *option.ODataFieldName)
 			if option.ObjectDefinition.Type == models.IntegerSDKOperationOptionObjectDefinitionType {
@@ -892,6 +988,7 @@ func (c methodsPandoraTemplater) optionsStruct(data GeneratorData) (*string, err
 	sort.Strings(odataAssignments)
 	sort.Strings(headerAssignments)
 	sort.Strings(queryStringAssignments)
+	sort.Strings(requiredChecks)
 
 	out := fmt.Sprintf(`
 type %[1]s struct {
@@ -920,5 +1017,43 @@ func (o %[1]s) ToQuery() *client.QueryParams {
 	return &out
 }
 `, optionsStructName, strings.Join(properties, "\n"), strings.Join(headerAssignments, "\n"), strings.Join(odataAssignments, "\n"), strings.Join(queryStringAssignments, "\n"))
+
+	if data.generateValidationFunctions {
+		out += fmt.Sprintf(`
+// Validate checks that the Required options within %[1]s have been specified
+func (o %[1]s) Validate() error {
+%[2]s
+	return nil
+}
+`, optionsStructName, strings.Join(requiredChecks, "\n"))
+	}
+
 	return &out, nil
 }
+
+// validationTemplate returns the code used to validate the request payload and options (when present)
+// prior to the request being sent
+func (c methodsPandoraTemplater) validationTemplate(data GeneratorData) string {
+	if !data.generateValidationFunctions {
+		return ""
+	}
+
+	output := ""
+	if c.operation.RequestObject != nil {
+		if nested := validationCodeForObjectDefinition(data, *c.operation.RequestObject, "input", `"input"`, "result, ", 0); nested != nil {
+			output += fmt.Sprintf(`
+	%s
+`, *nested)
+		}
+	}
+
+	if len(c.operation.Options) > 0 {
+		output += `
+	if err := options.Validate(); err != nil {
+		return result, fmt.Errorf("validating options: %+v", err)
+	}
+`
+	}
+
+	return output
+}
@@ -4,6 +4,10 @@
 package generator
 
 import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
@@ -24,10 +28,11 @@ func TestTemplateMethodsLROCreate(t *testing.T) {
 
 	actual, err := methodsPandoraTemplater{
 		operation: models.SDKOperation{
-			ContentType:         "application/json",
-			ExpectedStatusCodes: []int{201, 202},
-			LongRunning:         true,
-			Method:              "PUT",
+			ContentType:              "application/json",
+			ExpectedStatusCodes:      []int{201, 202},
+			LongRunning:              true,
+			LongRunningFinalStateVia: stringPointer("azure-async-operation"),
+			Method:                   "PUT",
 			RequestObject: &models.SDKObjectDefinition{
 				Type: models.StringSDKObjectDefinitionType,
 			},
@@ -99,6 +104,25 @@ func (c pandaClient) CreateThenPoll(ctx context.Context , id PandaPop, input str
 
 	return nil
 }
+
+// ResumeToken returns a token which can be persisted and passed to ResumeCreatePoller, allowing
+// polling for this Create operation to be resumed (for example from another process)
+func (r CreateOperationResponse) ResumeToken() (*string, error) {
+	return newResumeToken(r.HttpResponse, "testclient", "azure-async-operation")
+}
+
+// ResumeCreatePoller rebuilds the Poller for a Create operation from a token obtained from ResumeToken
+func (c pandaClient) ResumeCreatePoller(ctx context.Context, token string) (result CreateOperationResponse, err error) {
+	resp, err := responseFromResumeToken(token, "testclient", "azure-async-operation")
+	if err != nil {
+		err = fmt.Errorf("parsing resume token for Create: %+v", err)
+		return
+	}
+	result.HttpResponse = resp.Response
+
+	result.Poller, err = testclient.PollerFromResponse(resp, c.Client)
+	return
+}
 `
 	assertTemplatedCodeMatches(t, expected, *actual)
 }
@@ -184,6 +208,25 @@ func (c pandaClient) RebootThenPoll(ctx context.Context , id PandaPop) error {
 
 	return nil
 }
+
+// ResumeToken returns a token which can be persisted and passed to ResumeRebootPoller, allowing
+// polling for this Reboot operation to be resumed (for example from another process)
+func (r RebootOperationResponse) ResumeToken() (*string, error) {
+	return newResumeToken(r.HttpResponse, "testclient", "")
+}
+
+// ResumeRebootPoller rebuilds the Poller for a Reboot operation from a token obtained from ResumeToken
+func (c pandaClient) ResumeRebootPoller(ctx context.Context, token string) (result RebootOperationResponse, err error) {
+	resp, err := responseFromResumeToken(token, "testclient", "")
+	if err != nil {
+		err = fmt.Errorf("parsing resume token for Reboot: %+v", err)
+		return
+	}
+	result.HttpResponse = resp.Response
+
+	result.Poller, err = testclient.PollerFromResponse(resp, c.Client)
+	return
+}
 `
 	assertTemplatedCodeMatches(t, expected, *actual)
 }
@@ -304,6 +347,176 @@ func (c pandaClient) CreateThenPoll(ctx context.Context , id PandaPop, input Exa
 
 	return nil
 }
+
+// ResumeToken returns a token which can be persisted and passed to ResumeCreatePoller, allowing
+// polling for this Create operation to be resumed (for example from another process)
+func (r CreateOperationResponse) ResumeToken() (*string, error) {
+	return newResumeToken(r.HttpResponse, "testclient", "")
+}
+
+// ResumeCreatePoller rebuilds the Poller for a Create operation from a token obtained from ResumeToken
+func (c pandaClient) ResumeCreatePoller(ctx context.Context, token string) (result CreateOperationResponse, err error) {
+	resp, err := responseFromResumeToken(token, "testclient", "")
+	if err != nil {
+		err = fmt.Errorf("parsing resume token for Create: %+v", err)
+		return
+	}
+	result.HttpResponse = resp.Response
+
+	result.Poller, err = testclient.PollerFromResponse(resp, c.Client)
+	return
+}
 `
 	assertTemplatedCodeMatches(t, expected, *actual)
 }
+
+func TestTemplateResumeTokens(t *testing.T) {
+	actual, err := resumeTokensTemplater{}.template(GeneratorData{
+		packageName: "skinnyPandas",
+		source:      AccTestLicenceType,
+	})
+	if err != nil {
+		t.Fatalf("err %+v", err)
+	}
+
+	expectedLines := []string{
+		"package skinnyPandas",
+		"type resumeToken struct {",
+		"AsyncOperationUri string `json:\"asyncOperationUri,omitempty\"`",
+		"ContentType string `json:\"contentType,omitempty\"`",
+		"FinalStateVia string `json:\"finalStateVia,omitempty\"`",
+		"HttpMethod string `json:\"httpMethod\"`",
+		"LocationUri string `json:\"locationUri,omitempty\"`",
+		"PollerType string `json:\"pollerType\"`",
+		"RequestUri string `json:\"requestUri\"`",
+		"StatusCode int `json:\"statusCode\"`",
+		"func newResumeToken(resp *http.Response, pollerType string, finalStateVia string) (*string, error) {",
+		"func responseFromResumeToken(input string, pollerType string, finalStateVia string) (*client.Response, error) {",
+		`return nil, fmt.Errorf("the resume token is for a %q poller but a %q poller was expected", token.PollerType, pollerType)`,
+		`return nil, fmt.Errorf("the resume token retrieves the final state via %q but %q was expected", token.FinalStateVia, finalStateVia)`,
+	}
+	for _, line := range expectedLines {
+		if !strings.Contains(*actual, line) {
+			t.Fatalf("expected the resume tokens to contain %q but got:\n%s", line, *actual)
+		}
+	}
+}
+
+func TestTemplateResumeTokensRoundTrip(t *testing.T) {
+	// this compiles the templated helpers (against a stub of the base layer) and checks that a
+	// Response rebuilt from a Resume Token matches the Response the token was obtained from
+	if testing.Short() {
+		t.Skip("skipping compiling the templated resume tokens in short mode")
+	}
+	goBinary, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("skipping since the `go` binary wasn't found")
+	}
+
+	code, err := resumeTokensTemplater{}.template(GeneratorData{
+		packageName: "resumetokens",
+		source:      AccTestLicenceType,
+	})
+	if err != nil {
+		t.Fatalf("err %+v", err)
+	}
+
+	directory := t.TempDir()
+	files := map[string]string{
+		"go.mod": `module example.com/resumetokens
+
+go 1.21
+
+require github.com/hashicorp/go-azure-sdk/sdk v0.0.0
+
+replace github.com/hashicorp/go-azure-sdk/sdk => ./sdk
+`,
+		"sdk/go.mod": `module github.com/hashicorp/go-azure-sdk/sdk
+
+go 1.21
+`,
+		"sdk/client/client.go": `package client
+
+import "net/http"
+
+type Response struct {
+	*http.Response
+}
+`,
+		"resume_tokens.go": *code,
+		"resume_tokens_test.go": `package resumetokens
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestRoundTrip(t *testing.T) {
+	requestUri, err := url.Parse("https://management.azure.com/subscriptions/1234/resourceGroups/example?api-version=2020-01-01")
+	if err != nil {
+		t.Fatalf("parsing the request uri: %+v", err)
+	}
+	header := http.Header{}
+	header.Set("Azure-AsyncOperation", "https://management.azure.com/operations/abc?api-version=2020-01-01")
+	header.Set("Content-Type", "application/json")
+	header.Set("Location", "https://management.azure.com/operationResults/abc?api-version=2020-01-01")
+	resp := &http.Response{
+		Header: header,
+		Request: &http.Request{
+			Method: http.MethodPut,
+			URL:    requestUri,
+		},
+		StatusCode: http.StatusAccepted,
+	}
+
+	token, err := newResumeToken(resp, "resourcemanager", "azure-async-operation")
+	if err != nil {
+		t.Fatalf("building the resume token: %+v", err)
+	}
+	actual, err := responseFromResumeToken(*token, "resourcemanager", "azure-async-operation")
+	if err != nil {
+		t.Fatalf("parsing the resume token: %+v", err)
+	}
+
+	for _, key := range []string{"Azure-AsyncOperation", "Content-Type", "Location"} {
+		if actual.Header.Get(key) != header.Get(key) {
+			t.Fatalf("expected the header %q to be %q but got %q", key, header.Get(key), actual.Header.Get(key))
+		}
+	}
+	if actual.Request.Method != http.MethodPut {
+		t.Fatalf("expected the method to be %q but got %q", http.MethodPut, actual.Request.Method)
+	}
+	if actual.Request.URL.String() != requestUri.String() {
+		t.Fatalf("expected the request uri to be %q but got %q", requestUri.String(), actual.Request.URL.String())
+	}
+	if actual.StatusCode != http.StatusAccepted {
+		t.Fatalf("expected the status code to be %d but got %d", http.StatusAccepted, actual.StatusCode)
+	}
+
+	if _, err := responseFromResumeToken(*token, "resourcemanager", "location"); err == nil {
+		t.Fatalf("expected an error for a different final-state-via but didn't get one")
+	}
+	if _, err := responseFromResumeToken(*token, "dataplane", "azure-async-operation"); err == nil {
+		t.Fatalf("expected an error for a different poller type but didn't get one")
+	}
+}
+`,
+	}
+	for fileName, contents := range files {
+		filePath := filepath.Join(directory, fileName)
+		if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+			t.Fatalf("creating the directory for %q: %+v", fileName, err)
+		}
+		if err := os.WriteFile(filePath, []byte(contents), 0644); err != nil {
+			t.Fatalf("writing %q: %+v", fileName, err)
+		}
+	}
+
+	cmd := exec.Command(goBinary, "test", "./...")
+	cmd.Dir = directory
+	cmd.Env = append(os.Environ(), "GOFLAGS=-mod=mod", "GOPROXY=off", "GOWORK=off")
+	if output, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("running the round-trip tests: %+v\n%s", err, string(output))
+	}
+}
@@ -87,6 +87,91 @@ func (c pandaClient) Get(ctx context.Context , id PandaPop) (result GetOperation
 	assertTemplatedCodeMatches(t, expected, *actual)
 }
 
+func TestTemplateMethodsGetWithURISuffixPathParameters(t *testing.T) {
+	// Data Plane Operations are addressed by a path relative to the Parameterized Host rather than a Resource ID
+	input := GeneratorData{
+		baseClientPackage: "testclient",
+		packageName:       "secrets",
+		serviceClientName: "secretsClient",
+		source:            AccTestLicenceType,
+	}
+
+	actual, err := methodsPandoraTemplater{
+		operation: models.SDKOperation{
+			ContentType:         "application/json",
+			ExpectedStatusCodes: []int{200},
+			Method:              "GET",
+			ResponseObject: &models.SDKObjectDefinition{
+				Type: models.StringSDKObjectDefinitionType,
+			},
+			URISuffix: stringPointer("/secrets/{secret-name}/{secret-version}"),
+		},
+		operationName: "GetSecret",
+	}.immediateOperationTemplate(input)
+	if err != nil {
+		t.Fatalf("err %+v", err)
+	}
+
+	expected := `
+type GetSecretOperationResponse struct {
+	HttpResponse *http.Response
+	OData *odata.OData
+	Model *string
+}
+
+// GetSecret ...
+func (c secretsClient) GetSecret(ctx context.Context , secretName string, secretVersion string) (result GetSecretOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		Path: fmt.Sprintf("/secrets/%s/%s", url.PathEscape(secretName), url.PathEscape(secretVersion)),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var model string
+	result.Model = &model
+	if err = resp.Unmarshal(result.Model); err != nil {
+		return
+	}
+
+	return
+}
+`
+	assertTemplatedCodeMatches(t, expected, *actual)
+}
+
+func TestArgumentNameForPathParameter(t *testing.T) {
+	testData := map[string]string{
+		"secret-name":    "secretName",
+		"secret_version": "secretVersion",
+		"keyName":        "keyName",
+		"Name":           "name",
+	}
+	for input, expected := range testData {
+		t.Logf("Testing %q", input)
+		if actual := argumentNameForPathParameter(input); actual != expected {
+			t.Fatalf("expected %q but got %q", expected, actual)
+		}
+	}
+}
+
 func TestTemplateMethodsGetAsTextPowerShell(t *testing.T) {
 	input := GeneratorData{
 		baseClientPackage: "testclient",
@@ -783,3 +868,142 @@ func (c pandaClient) Put(ctx context.Context , id PandaPop, options PutOperation
 `
 	assertTemplatedCodeMatches(t, expected, *actual)
 }
+
+func TestTemplatePutMethodWithValidation(t *testing.T) {
+	input := GeneratorData{
+		baseClientPackage: "testclient",
+		packageName:       "skinnyPandas",
+		serviceClientName: "pandaClient",
+		source:            AccTestLicenceType,
+		models: map[string]models.SDKModel{
+			"Panda": {
+				Fields: map[string]models.SDKField{
+					"Name": {
+						JsonName: "name",
+						ObjectDefinition: models.SDKObjectDefinition{
+							Type: models.StringSDKObjectDefinitionType,
+						},
+						Required: true,
+					},
+				},
+			},
+		},
+		resourceIds: map[string]models.ResourceID{
+			"PandaPop": {
+				ExampleValue: "LingLing",
+			},
+		},
+		generateValidationFunctions: true,
+	}
+
+	actual, err := methodsPandoraTemplater{
+		operation: models.SDKOperation{
+			ContentType:         "application/json",
+			ExpectedStatusCodes: []int{200},
+			Method:              "PUT",
+			Options: map[string]models.SDKOperationOption{
+				"Bamboo": {
+					ObjectDefinition: models.SDKOperationOptionObjectDefinition{
+						Type: models.StringSDKOperationOptionObjectDefinitionType,
+					},
+					QueryStringName: stringPointer("bamboo"),
+					Required:        true,
+					Type:            models.SDKOperationOptionTypeData,
+				},
+			},
+			RequestObject: &models.SDKObjectDefinition{
+				Type:          models.ReferenceSDKObjectDefinitionType,
+				ReferenceName: stringPointer("Panda"),
+			},
+			ResourceIDName: stringPointer("PandaPop"),
+		},
+		operationName: "Put",
+	}.immediateOperationTemplate(input)
+	if err != nil {
+		t.Fatalf("err %+v", err)
+	}
+
+	expected := `
+type PutOperationResponse struct {
+	HttpResponse *http.Response
+	OData *odata.OData
+}
+
+type PutOperationOptions struct {
+	Bamboo *string
+}
+
+func DefaultPutOperationOptions() PutOperationOptions {
+	return PutOperationOptions{}
+}
+
+func (o PutOperationOptions) ToHeaders() *client.Headers {
+	out := client.Headers{}
+	return &out
+}
+
+func (o PutOperationOptions) ToOData() *odata.Query {
+	out := odata.Query{}
+	return &out
+}
+
+func (o PutOperationOptions) ToQuery() *client.QueryParams {
+	out := client.QueryParams{}
+	if o.Bamboo != nil {
+		out.Append("bamboo", fmt.Sprintf("%v", *o.Bamboo))
+	}
+	return &out
+}
+
+// Validate checks that the Required options within PutOperationOptions have been specified
+func (o PutOperationOptions) Validate() error {
+	if o.Bamboo == nil {
+		return fmt.Errorf("Bamboo is required but was not specified")
+	}
+	return nil
+}
+
+// Put ...
+func (c pandaClient) Put(ctx context.Context , id PandaPop, input Panda, options PutOperationOptions) (result PutOperationResponse, err error) {
+	if err := input.Validate(); err != nil {
+		return result, fmt.Errorf("validating input: %+v", err)
+	}
+
+	if err := options.Validate(); err != nil {
+		return result, fmt.Errorf("validating options: %+v", err)
+	}
+
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodPut,
+		OptionsObject: options,
+		Path: id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	if err = req.Marshal(input); err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	return
+}
+`
+	assertTemplatedCodeMatches(t, expected, *actual)
+}
@@ -109,6 +109,11 @@ func (c modelsTemplater) structCode(data GeneratorData) (*string, error) {
 		// Then we implement a method for the base type
 		interfaceLines = append(interfaceLines, fmt.Sprintf(`%[1]s() %[2]s`, c.name, structName))
 
+		// When enabled, implementations must also be able to validate themselves
+		if data.generateValidationFunctions {
+			interfaceLines = append(interfaceLines, "Validate() error")
+		}
+
 		// Output an interface for the parent type
 		out += fmt.Sprintf(`
 type %[1]s interface {
@@ -240,7 +245,17 @@ func (c modelsTemplater) methods(data GeneratorData) (*string, error) {
 	}
 	code = append(code, *unmarshalFunctions)
 
-	// TODO: validation functions (#58)
+	discriminatedParentHelpers, err := c.codeForDiscriminatedParentHelpers(data)
+	if err != nil {
+		return nil, fmt.Errorf("generating discriminated parent helpers: %+v", err)
+	}
+	code = append(code, *discriminatedParentHelpers)
+
+	validationFunctions, err := c.codeForValidationFunctions(data)
+	if err != nil {
+		return nil, fmt.Errorf("generating validation functions: %+v", err)
+	}
+	code = append(code, *validationFunctions)
 
 	output := strings.Join(code, "\n")
 	return &output, nil
@@ -1052,6 +1067,167 @@ func (s *%[1]s) UnmarshalJSON(bytes []byte) error {`, structName))
 	return &output, nil
 }
 
+// codeForDiscriminatedParentHelpers outputs a registry of the known implementations for a parent model, a Visitor
+// interface (and Visit function) which allows callers to exhaustively handle each implementation, and a helper to
+// determine when the discriminated value wasn't recognised (and so was unmarshaled into the Raw{Name}Impl type).
+func (c modelsTemplater) codeForDiscriminatedParentHelpers(data GeneratorData) (*string, error) {
+	output := ""
+
+	if !c.model.IsDiscriminatedParentType() {
+		return &output, nil
+	}
+
+	visitorName := fmt.Sprintf("%sVisitor", c.name)
+	existingIdentifiers := existingPackageLevelIdentifiers(data)
+	helperNames := []string{
+		visitorName,
+		fmt.Sprintf("Known%sImplementations", c.name),
+		fmt.Sprintf("Visit%s", c.name),
+		fmt.Sprintf("Unrecognised%sDiscriminatedValue", c.name),
+	}
+	for _, helperName := range helperNames {
+		if _, hasExisting := existingIdentifiers[helperName]; hasExisting {
+			return nil, fmt.Errorf("existing identifier %q conflicts with the discriminated helpers for %q", helperName, c.name)
+		}
+	}
+
+	// implementations can be nested (e.g. a grandchild implements both its parent and grandparent) - so all
+	// descendants of this model are valid implementations, rather than only the direct children
+	implementations := make(map[string]string)
+	implementationNames := make([]string, 0)
+	rawImplementationNames := make([]string, 0)
+	for modelName, model := range data.models {
+		if model.DiscriminatedValue == nil || modelName == c.name || !modelIsDescendantOf(data.models, modelName, c.name) {
+			continue
+		}
+
+		// an implementation may itself be a parent model, in which case it's output as an interface
+		// with a Base{model}Impl struct, so the struct is used for the type switch
+		structName := modelName
+		if model.IsDiscriminatedParentType() {
+			structName = fmt.Sprintf("Base%sImpl", modelName)
+
+			// any unrecognised implementations of this model are unmarshaled into the Raw{model}Impl type,
+			// which also implements this model
+			rawImplementationNames = append(rawImplementationNames, fmt.Sprintf("Raw%sImpl", modelName))
+		}
+		implementations[modelName] = structName
+		implementationNames = append(implementationNames, modelName)
+	}
+	sort.Strings(implementationNames)
+	sort.Strings(rawImplementationNames)
+
+	discriminatedValues := make(map[string]string)
+	for _, implementationName := range implementationNames {
+		discriminatedValue := *data.models[implementationName].DiscriminatedValue
+		if existing, ok := discriminatedValues[discriminatedValue]; ok {
+			return nil, fmt.Errorf("the implementations %q and %q of %q use the same discriminated value %q", existing, implementationName, c.name, discriminatedValue)
+		}
+		discriminatedValues[discriminatedValue] = implementationName
+	}
+
+	registryLines := make([]string, 0)
+	visitorLines := make([]string, 0)
+	switchLines := make([]string, 0)
+	for _, implementationName := range implementationNames {
+		structName := implementations[implementationName]
+		registryLines = append(registryLines, fmt.Sprintf("%q: %s{},", *data.models[implementationName].DiscriminatedValue, structName))
+		visitorLines = append(visitorLines, fmt.Sprintf("Visit%[1]s(input %[1]s) error", structName))
+		switchLines = append(switchLines, fmt.Sprintf(`case %[1]s:
+		return visitor.Visit%[1]s(v)`, structName))
+	}
+	for _, rawImplementationName := range rawImplementationNames {
+		visitorLines = append(visitorLines, fmt.Sprintf("Visit%[1]s(input %[1]s) error", rawImplementationName))
+		switchLines = append(switchLines, fmt.Sprintf(`case %[1]s:
+		return visitor.Visit%[1]s(v)`, rawImplementationName))
+	}
+
+	rawImplementationName := fmt.Sprintf("Raw%sImpl", c.name)
+	output = fmt.Sprintf(`
+// Known%[1]sImplementations returns the known implementations of %[1]s, keyed by their Discriminated Value
+func Known%[1]sImplementations() map[string]%[1]s {
+	return map[string]%[1]s{
+		%[2]s
+	}
+}
+
+// %[3]s can be used with Visit%[1]s to exhaustively handle each implementation of %[1]s
+type %[3]s interface {
+	%[4]s
+	Visit%[5]s(input %[5]s) error
+}
+
+// Visit%[1]s calls the method on the visitor corresponding to the implementation of %[1]s within input
+func Visit%[1]s(input %[1]s, visitor %[3]s) error {
+	switch v := input.(type) {
+	%[6]s
+	case %[5]s:
+		return visitor.Visit%[5]s(v)
+	}
+
+	return fmt.Errorf("unsupported implementation %%T for %[1]s", input)
+}
+
+// Unrecognised%[1]sDiscriminatedValue returns the Discriminated Value when input is an unrecognised implementation
+// of %[1]s (e.g. a %[5]s), and whether the value was unrecognised
+func Unrecognised%[1]sDiscriminatedValue(input %[1]s) (string, bool) {
+	if raw, ok := input.(%[5]s); ok {
+		return raw.Type, true
+	}
+
+	return "", false
+}
+`, c.name, strings.Join(registryLines, "\n"), visitorName, strings.Join(visitorLines, "\n"), rawImplementationName, strings.Join(switchLines, "\n"))
+
+	return &output, nil
+}
+
+// modelIsDescendantOf returns whether the model named modelName inherits from the model named ancestorName,
+// either directly or through one or more intermediate parent models.
+func modelIsDescendantOf(input map[string]models.SDKModel, modelName, ancestorName string) bool {
+	seen := map[string]struct{}{
+		modelName: {},
+	}
+	model, ok := input[modelName]
+	for ok && model.ParentTypeName != nil {
+		parentTypeName := *model.ParentTypeName
+		if parentTypeName == ancestorName {
+			return true
+		}
+		if _, alreadySeen := seen[parentTypeName]; alreadySeen {
+			return false
+		}
+		seen[parentTypeName] = struct{}{}
+		model, ok = input[parentTypeName]
+	}
+	return false
+}
+
+// existingPackageLevelIdentifiers returns the names of the types, constants and functions output into this package
+// for the Models, Constants and Resource IDs - which generated helpers mustn't conflict with.
+func existingPackageLevelIdentifiers(data GeneratorData) map[string]struct{} {
+	output := make(map[string]struct{})
+	for modelName, model := range data.models {
+		output[modelName] = struct{}{}
+		if model.IsDiscriminatedParentType() {
+			output[fmt.Sprintf("Base%sImpl", modelName)] = struct{}{}
+			output[fmt.Sprintf("Raw%sImpl", modelName)] = struct{}{}
+			output[fmt.Sprintf("Unmarshal%sImplementation", modelName)] = struct{}{}
+		}
+	}
+	for constantName, constant := range data.constants {
+		output[constantName] = struct{}{}
+		output[fmt.Sprintf("PossibleValuesFor%s", constantName)] = struct{}{}
+		for key := range constant.Values {
+			output[fmt.Sprintf("%s%s", constantName, key)] = struct{}{}
+		}
+	}
+	for resourceIdName := range data.resourceIds {
+		output[resourceIdName] = struct{}{}
+	}
+	return output
+}
+
 // findModelAncestry walks the models hierarchy to find all ancestors of the specified model for discriminated types.
 func (c modelsTemplater) findModelAncestry(data GeneratorData, parentModelName, typeHint string) (*models.SDKField, *[]string, error) {
 	if data.recurseParentModels {
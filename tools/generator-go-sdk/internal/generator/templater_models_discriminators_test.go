@@ -146,6 +146,45 @@ func UnmarshalModeOfTransitImplementation(input []byte) (ModeOfTransit, error) {
 		Values: temp,
 	}, nil
 }
+
+// KnownModeOfTransitImplementations returns the known implementations of ModeOfTransit, keyed by their Discriminated Value
+func KnownModeOfTransitImplementations() map[string]ModeOfTransit {
+	return map[string]ModeOfTransit{
+		"car": Car{},
+		"Train": Train{},
+	}
+}
+
+// ModeOfTransitVisitor can be used with VisitModeOfTransit to exhaustively handle each implementation of ModeOfTransit
+type ModeOfTransitVisitor interface {
+	VisitCar(input Car) error
+	VisitTrain(input Train) error
+	VisitRawModeOfTransitImpl(input RawModeOfTransitImpl) error
+}
+
+// VisitModeOfTransit calls the method on the visitor corresponding to the implementation of ModeOfTransit within input
+func VisitModeOfTransit(input ModeOfTransit, visitor ModeOfTransitVisitor) error {
+	switch v := input.(type) {
+	case Car:
+		return visitor.VisitCar(v)
+	case Train:
+		return visitor.VisitTrain(v)
+	case RawModeOfTransitImpl:
+		return visitor.VisitRawModeOfTransitImpl(v)
+	}
+
+	return fmt.Errorf("unsupported implementation %T for ModeOfTransit", input)
+}
+
+// UnrecognisedModeOfTransitDiscriminatedValue returns the Discriminated Value when input is an unrecognised implementation
+// of ModeOfTransit (e.g. a RawModeOfTransitImpl), and whether the value was unrecognised
+func UnrecognisedModeOfTransitDiscriminatedValue(input ModeOfTransit) (string, bool) {
+	if raw, ok := input.(RawModeOfTransitImpl); ok {
+		return raw.Type, true
+	}
+
+	return "", false
+}
 `, "''", "`")
 	assertTemplatedCodeMatches(t, expected, *actual)
 }
@@ -694,3 +733,250 @@ func (s *FirstImplementation) UnmarshalJSON(bytes []byte) error {
 `, "''", "`")
 	assertTemplatedCodeMatches(t, expected, *actual)
 }
+
+func TestTemplaterModelsParentHelpersWithImplementationWhichIsAParent(t *testing.T) {
+	data := GeneratorData{
+		packageName: "somepackage",
+		models: map[string]models.SDKModel{
+			"DirectoryObject": {
+				FieldNameContainingDiscriminatedValue: stringPointer("ODataType"),
+				Fields: map[string]models.SDKField{
+					"ODataType": {
+						ContainsDiscriminatedValue: true,
+						JsonName:                   "@odata.type",
+						ObjectDefinition: models.SDKObjectDefinition{
+							Type: models.StringSDKObjectDefinitionType,
+						},
+						Required: true,
+					},
+				},
+			},
+			"Application": {
+				DiscriminatedValue:                    stringPointer("#microsoft.graph.application"),
+				FieldNameContainingDiscriminatedValue: stringPointer("ODataType"),
+				ParentTypeName:                        stringPointer("DirectoryObject"),
+			},
+			"Group": {
+				DiscriminatedValue:                    stringPointer("#microsoft.graph.group"),
+				FieldNameContainingDiscriminatedValue: stringPointer("ODataType"),
+				IsParent:                              true,
+				ParentTypeName:                        stringPointer("DirectoryObject"),
+			},
+		},
+		source: AccTestLicenceType,
+	}
+	actual, err := modelsTemplater{
+		name:  "DirectoryObject",
+		model: data.models["DirectoryObject"],
+	}.codeForDiscriminatedParentHelpers(data)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	expected := `
+// KnownDirectoryObjectImplementations returns the known implementations of DirectoryObject, keyed by their Discriminated Value
+func KnownDirectoryObjectImplementations() map[string]DirectoryObject {
+	return map[string]DirectoryObject{
+		"#microsoft.graph.application": Application{},
+		"#microsoft.graph.group": BaseGroupImpl{},
+	}
+}
+
+// DirectoryObjectVisitor can be used with VisitDirectoryObject to exhaustively handle each implementation of DirectoryObject
+type DirectoryObjectVisitor interface {
+	VisitApplication(input Application) error
+	VisitBaseGroupImpl(input BaseGroupImpl) error
+	VisitRawGroupImpl(input RawGroupImpl) error
+	VisitRawDirectoryObjectImpl(input RawDirectoryObjectImpl) error
+}
+
+// VisitDirectoryObject calls the method on the visitor corresponding to the implementation of DirectoryObject within input
+func VisitDirectoryObject(input DirectoryObject, visitor DirectoryObjectVisitor) error {
+	switch v := input.(type) {
+	case Application:
+		return visitor.VisitApplication(v)
+	case BaseGroupImpl:
+		return visitor.VisitBaseGroupImpl(v)
+	case RawGroupImpl:
+		return visitor.VisitRawGroupImpl(v)
+	case RawDirectoryObjectImpl:
+		return visitor.VisitRawDirectoryObjectImpl(v)
+	}
+
+	return fmt.Errorf("unsupported implementation %T for DirectoryObject", input)
+}
+
+// UnrecognisedDirectoryObjectDiscriminatedValue returns the Discriminated Value when input is an unrecognised implementation
+// of DirectoryObject (e.g. a RawDirectoryObjectImpl), and whether the value was unrecognised
+func UnrecognisedDirectoryObjectDiscriminatedValue(input DirectoryObject) (string, bool) {
+	if raw, ok := input.(RawDirectoryObjectImpl); ok {
+		return raw.Type, true
+	}
+
+	return "", false
+}
+`
+	assertTemplatedCodeMatches(t, expected, *actual)
+}
+
+func TestTemplaterModelsParentHelpersIncludeNestedImplementations(t *testing.T) {
+	data := GeneratorData{
+		packageName: "somepackage",
+		models: map[string]models.SDKModel{
+			"DirectoryObject": {
+				FieldNameContainingDiscriminatedValue: stringPointer("ODataType"),
+				Fields: map[string]models.SDKField{
+					"ODataType": {
+						ContainsDiscriminatedValue: true,
+						JsonName:                   "@odata.type",
+						ObjectDefinition: models.SDKObjectDefinition{
+							Type: models.StringSDKObjectDefinitionType,
+						},
+						Required: true,
+					},
+				},
+			},
+			"Group": {
+				DiscriminatedValue:                    stringPointer("#microsoft.graph.group"),
+				FieldNameContainingDiscriminatedValue: stringPointer("ODataType"),
+				IsParent:                              true,
+				ParentTypeName:                        stringPointer("DirectoryObject"),
+			},
+			"SecurityGroup": {
+				DiscriminatedValue:                    stringPointer("#microsoft.graph.securityGroup"),
+				FieldNameContainingDiscriminatedValue: stringPointer("ODataType"),
+				ParentTypeName:                        stringPointer("Group"),
+			},
+		},
+		source: AccTestLicenceType,
+	}
+	actual, err := modelsTemplater{
+		name:  "DirectoryObject",
+		model: data.models["DirectoryObject"],
+	}.codeForDiscriminatedParentHelpers(data)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	expected := `
+// KnownDirectoryObjectImplementations returns the known implementations of DirectoryObject, keyed by their Discriminated Value
+func KnownDirectoryObjectImplementations() map[string]DirectoryObject {
+	return map[string]DirectoryObject{
+		"#microsoft.graph.group": BaseGroupImpl{},
+		"#microsoft.graph.securityGroup": SecurityGroup{},
+	}
+}
+
+// DirectoryObjectVisitor can be used with VisitDirectoryObject to exhaustively handle each implementation of DirectoryObject
+type DirectoryObjectVisitor interface {
+	VisitBaseGroupImpl(input BaseGroupImpl) error
+	VisitSecurityGroup(input SecurityGroup) error
+	VisitRawGroupImpl(input RawGroupImpl) error
+	VisitRawDirectoryObjectImpl(input RawDirectoryObjectImpl) error
+}
+
+// VisitDirectoryObject calls the method on the visitor corresponding to the implementation of DirectoryObject within input
+func VisitDirectoryObject(input DirectoryObject, visitor DirectoryObjectVisitor) error {
+	switch v := input.(type) {
+	case BaseGroupImpl:
+		return visitor.VisitBaseGroupImpl(v)
+	case SecurityGroup:
+		return visitor.VisitSecurityGroup(v)
+	case RawGroupImpl:
+		return visitor.VisitRawGroupImpl(v)
+	case RawDirectoryObjectImpl:
+		return visitor.VisitRawDirectoryObjectImpl(v)
+	}
+
+	return fmt.Errorf("unsupported implementation %T for DirectoryObject", input)
+}
+
+// UnrecognisedDirectoryObjectDiscriminatedValue returns the Discriminated Value when input is an unrecognised implementation
+// of DirectoryObject (e.g. a RawDirectoryObjectImpl), and whether the value was unrecognised
+func UnrecognisedDirectoryObjectDiscriminatedValue(input DirectoryObject) (string, bool) {
+	if raw, ok := input.(RawDirectoryObjectImpl); ok {
+		return raw.Type, true
+	}
+
+	return "", false
+}
+`
+	assertTemplatedCodeMatches(t, expected, *actual)
+}
+
+func TestTemplaterModelsParentHelpersConflictingWithExistingIdentifiers(t *testing.T) {
+	testData := []struct {
+		name      string
+		models    []string
+		constants map[string]models.SDKConstant
+	}{
+		{
+			name:   "Visitor Model",
+			models: []string{"AnimalVisitor"},
+		},
+		{
+			name:   "Known Implementations Model",
+			models: []string{"KnownAnimalImplementations"},
+		},
+		{
+			name: "Visit Constant Value",
+			constants: map[string]models.SDKConstant{
+				"Visit": {
+					Type: models.StringSDKConstantType,
+					Values: map[string]string{
+						"Animal": "animal",
+					},
+				},
+			},
+		},
+		{
+			name: "Unrecognised Constant",
+			constants: map[string]models.SDKConstant{
+				"UnrecognisedAnimalDiscriminatedValue": {
+					Type: models.StringSDKConstantType,
+					Values: map[string]string{
+						"Cat": "cat",
+					},
+				},
+			},
+		},
+	}
+	for _, v := range testData {
+		t.Logf("Test %q", v.name)
+
+		data := GeneratorData{
+			packageName: "somepackage",
+			constants:   v.constants,
+			models: map[string]models.SDKModel{
+				"Animal": {
+					FieldNameContainingDiscriminatedValue: stringPointer("Type"),
+					Fields: map[string]models.SDKField{
+						"Type": {
+							ContainsDiscriminatedValue: true,
+							JsonName:                   "type",
+							ObjectDefinition: models.SDKObjectDefinition{
+								Type: models.StringSDKObjectDefinitionType,
+							},
+							Required: true,
+						},
+					},
+				},
+				"Cat": {
+					DiscriminatedValue:                    stringPointer("cat"),
+					FieldNameContainingDiscriminatedValue: stringPointer("Type"),
+					ParentTypeName:                        stringPointer("Animal"),
+				},
+			},
+			source: AccTestLicenceType,
+		}
+		for _, modelName := range v.models {
+			data.models[modelName] = models.SDKModel{}
+		}
+
+		actual, err := modelsTemplater{
+			name:  "Animal",
+			model: data.models["Animal"],
+		}.codeForDiscriminatedParentHelpers(data)
+		if err == nil {
+			t.Fatalf("expected an error but got %q", *actual)
+		}
+	}
+}
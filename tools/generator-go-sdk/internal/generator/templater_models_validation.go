@@ -0,0 +1,269 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package generator
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/helpers"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+func (c modelsTemplater) codeForValidationFunctions(data GeneratorData) (*string, error) {
+	output := ""
+	if !data.generateValidationFunctions {
+		return &output, nil
+	}
+
+	structName := c.name
+
+	// parent models get a Base{model}Impl struct so as not to conflict with their interface name
+	if c.model.IsDiscriminatedParentType() {
+		structName = fmt.Sprintf("Base%sImpl", c.name)
+	}
+
+	fields, err := c.fieldsIncludingAncestors(data)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := fields["Validate"]; ok {
+		return nil, fmt.Errorf("model %q contains a field named `Validate` which conflicts with the validation function", c.name)
+	}
+
+	fieldNames := make([]string, 0, len(fields))
+	for fieldName := range fields {
+		fieldNames = append(fieldNames, fieldName)
+	}
+	sort.Strings(fieldNames)
+
+	lines := make([]string, 0)
+	for _, fieldName := range fieldNames {
+		fieldDetails := fields[fieldName]
+
+		// Read-Only fields are never sent to the API, so there's nothing to validate
+		if fieldDetails.ReadOnly {
+			continue
+		}
+
+		// the Discriminated Value is set when marshaling the implementation, so isn't specified by the caller
+		if fieldDetails.ContainsDiscriminatedValue || (c.model.FieldNameContainingDiscriminatedValue != nil && fieldName == *c.model.FieldNameContainingDiscriminatedValue) {
+			continue
+		}
+
+		fieldType, err := helpers.GolangTypeForSDKObjectDefinition(fieldDetails.ObjectDefinition, nil, data.commonTypesPackageName)
+		if err != nil {
+			return nil, fmt.Errorf("determining golang type for field %q: %+v", fieldName, err)
+		}
+		isPointer := c.fieldIsPointer(data, fieldDetails, *fieldType)
+
+		if fieldDetails.Required {
+			if check := requiredCheckForField(data, fmt.Sprintf("s.%s", fieldName), *fieldType, fieldDetails.ObjectDefinition, isPointer); check != nil {
+				lines = append(lines, fmt.Sprintf(`
+	if %[1]s {
+		return fmt.Errorf("%[2]s is required but was not specified")
+	}`, *check, fieldName))
+			}
+		}
+
+		value := fmt.Sprintf("s.%s", fieldName)
+		if isPointer {
+			value = fmt.Sprintf("(*s.%s)", fieldName)
+		}
+		nested := validationCodeForObjectDefinition(data, fieldDetails.ObjectDefinition, value, fmt.Sprintf("%q", fieldName), "", 0)
+		if nested == nil {
+			continue
+		}
+		if isPointer {
+			lines = append(lines, fmt.Sprintf(`
+	if s.%[1]s != nil {
+		%[2]s
+	}`, fieldName, *nested))
+		} else {
+			lines = append(lines, *nested)
+		}
+	}
+
+	output = fmt.Sprintf(`
+// Validate checks that the Required fields within %[1]s have been specified, including those of any nested models
+func (s %[1]s) Validate() error {
+%[2]s
+
+	return nil
+}
+`, structName, strings.Join(lines, "\n"))
+
+	// Raw{Type}Impl is only used for deserialization, but has to satisfy the parent interface
+	if c.model.IsDiscriminatedParentType() {
+		output += fmt.Sprintf(`
+func (s Raw%[1]sImpl) Validate() error {
+	return s.%[2]s.Validate()
+}
+`, c.name, camelCase(c.name))
+	}
+
+	return &output, nil
+}
+
+// fieldsIncludingAncestors returns the fields defined on this model, together with any fields inherited
+// from ancestor models - where fields defined on a closer ancestor take precedence.
+func (c modelsTemplater) fieldsIncludingAncestors(data GeneratorData) (map[string]models.SDKField, error) {
+	output := make(map[string]models.SDKField)
+	for fieldName, fieldDetails := range c.model.Fields {
+		output[fieldName] = fieldDetails
+	}
+
+	if c.model.ParentTypeName == nil {
+		return output, nil
+	}
+
+	ancestorTypeNames := []string{*c.model.ParentTypeName}
+	if c.model.FieldNameContainingDiscriminatedValue != nil {
+		_, foundAncestorTypeNames, err := c.findModelAncestry(data, *c.model.ParentTypeName, *c.model.FieldNameContainingDiscriminatedValue)
+		if err != nil {
+			return nil, err
+		}
+		ancestorTypeNames = *foundAncestorTypeNames
+	}
+
+	for _, ancestorTypeName := range ancestorTypeNames {
+		parent, ok := data.models[ancestorTypeName]
+		if !ok {
+			return nil, fmt.Errorf("couldn't find Ancestor Model %q for Model %q", ancestorTypeName, c.name)
+		}
+		for fieldName, fieldDetails := range parent.Fields {
+			if _, ok := output[fieldName]; ok {
+				continue
+			}
+			output[fieldName] = fieldDetails
+		}
+	}
+
+	return output, nil
+}
+
+// fieldIsPointer determines whether the specified field is output as a pointer, matching the logic in structLineForField
+func (c modelsTemplater) fieldIsPointer(data GeneratorData, fieldDetails models.SDKField, fieldType string) bool {
+	if strings.HasPrefix(fieldType, "nullable.") {
+		return false
+	}
+	return c.fieldIsOptional(data, fieldDetails) || fieldDetails.ReadOnly || fieldDetails.ObjectDefinition.Nullable
+}
+
+// requiredCheckForField returns a Go expression which evaluates to true when the (Required) field at `value`
+// has not been specified - or nil when this can't be determined from the zero value of the type.
+func requiredCheckForField(data GeneratorData, value, fieldType string, input models.SDKObjectDefinition, isPointer bool) *string {
+	// a nullable type is permitted to be sent as an explicit `null`
+	if strings.HasPrefix(fieldType, "nullable.") {
+		return nil
+	}
+
+	isInterface := fieldType == "interface{}"
+	if model, ok := modelForReference(data, input); ok && model.IsDiscriminatedParentType() {
+		isInterface = true
+	}
+
+	var check string
+	switch {
+	case isPointer, isInterface:
+		check = fmt.Sprintf("%s == nil", value)
+
+	case strings.HasPrefix(fieldType, "[]"), strings.HasPrefix(fieldType, "map["):
+		check = fmt.Sprintf("%s == nil", value)
+
+	case input.Type == models.StringSDKObjectDefinitionType,
+		input.Type == models.DateTimeSDKObjectDefinitionType,
+		input.Type == models.LocationSDKObjectDefinitionType:
+		check = fmt.Sprintf("%s == \"\"", value)
+
+	default:
+		// zero values for other types (e.g. booleans, integers and structs) are valid values, so we can't
+		// determine whether these have been specified
+		return nil
+	}
+
+	return &check
+}
+
+// validationCodeForObjectDefinition returns the Go code to validate any models referenced within `input` (which
+// is accessible as `value`), recursing into Lists and Dictionaries - or nil if there's nothing to validate.
+// The `path` is a Go expression describing the location of the value, used in error messages.
+func validationCodeForObjectDefinition(data GeneratorData, input models.SDKObjectDefinition, value, path, returnPrefix string, depth int) *string {
+	switch input.Type {
+	case models.ReferenceSDKObjectDefinitionType:
+		model, ok := modelForReference(data, input)
+		if !ok {
+			// e.g. a Constant
+			return nil
+		}
+
+		errorCode := fmt.Sprintf(`fmt.Errorf("validating %%s: %%+v", %s, err)`, path)
+		if unquoted, err := strconv.Unquote(path); err == nil {
+			// when the path is a literal we can output it directly
+			errorCode = fmt.Sprintf(`fmt.Errorf("validating %s: %%+v", err)`, unquoted)
+		}
+		validate := fmt.Sprintf(`if err := %[1]s.Validate(); err != nil {
+		return %[3]s%[2]s
+	}`, value, errorCode, returnPrefix)
+		if model.IsDiscriminatedParentType() {
+			// parent types are output as an interface, so may be nil
+			validate = fmt.Sprintf(`if %[1]s != nil {
+		%[2]s
+	}`, value, validate)
+		}
+		return &validate
+
+	case models.ListSDKObjectDefinitionType:
+		if input.NestedItem == nil {
+			return nil
+		}
+		index := fmt.Sprintf("i%d", depth)
+		item := fmt.Sprintf("v%d", depth)
+		nested := validationCodeForObjectDefinition(data, *input.NestedItem, item, fmt.Sprintf(`fmt.Sprintf("%%s[%%d]", %s, %s)`, path, index), returnPrefix, depth+1)
+		if nested == nil {
+			return nil
+		}
+		output := fmt.Sprintf(`for %[1]s, %[2]s := range %[3]s {
+		%[4]s
+	}`, index, item, value, *nested)
+		return &output
+
+	case models.DictionarySDKObjectDefinitionType:
+		if input.NestedItem == nil {
+			return nil
+		}
+		key := fmt.Sprintf("k%d", depth)
+		item := fmt.Sprintf("v%d", depth)
+		nested := validationCodeForObjectDefinition(data, *input.NestedItem, item, fmt.Sprintf(`fmt.Sprintf("%%s[%%q]", %s, %s)`, path, key), returnPrefix, depth+1)
+		if nested == nil {
+			return nil
+		}
+		output := fmt.Sprintf(`for %[1]s, %[2]s := range %[3]s {
+		%[4]s
+	}`, key, item, value, *nested)
+		return &output
+	}
+
+	return nil
+}
+
+// modelForReference returns the Model referenced by `input`, which may be present in either the models for
+// this resource or the common types for this API version.
+func modelForReference(data GeneratorData, input models.SDKObjectDefinition) (*models.SDKModel, bool) {
+	if input.ReferenceName == nil {
+		return nil, false
+	}
+	if input.ReferenceNameIsCommonType != nil && *input.ReferenceNameIsCommonType {
+		if model, ok := data.commonTypes.Models[*input.ReferenceName]; ok {
+			return &model, true
+		}
+		return nil, false
+	}
+	if model, ok := data.models[*input.ReferenceName]; ok {
+		return &model, true
+	}
+	return nil, false
+}
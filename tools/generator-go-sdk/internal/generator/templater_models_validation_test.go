@@ -0,0 +1,240 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package generator
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+func TestModelTemplaterValidationDisabled(t *testing.T) {
+	model := models.SDKModel{
+		Fields: map[string]models.SDKField{
+			"Name": {
+				JsonName: "name",
+				ObjectDefinition: models.SDKObjectDefinition{
+					Type: models.StringSDKObjectDefinitionType,
+				},
+				Required: true,
+			},
+		},
+	}
+	actual, err := modelsTemplater{
+		name:  "Basic",
+		model: model,
+	}.codeForValidationFunctions(GeneratorData{
+		packageName: "somepackage",
+		models: map[string]models.SDKModel{
+			"Basic": model,
+		},
+		source: AccTestLicenceType,
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	assertTemplatedCodeMatches(t, "", *actual)
+}
+
+func TestModelTemplaterValidationRequiredAndNestedFields(t *testing.T) {
+	data := GeneratorData{
+		packageName: "somepackage",
+		models: map[string]models.SDKModel{
+			"Basic": {
+				Fields: map[string]models.SDKField{
+					"Age": {
+						JsonName: "age",
+						ObjectDefinition: models.SDKObjectDefinition{
+							Type: models.IntegerSDKObjectDefinitionType,
+						},
+						Required: true,
+					},
+					"Id": {
+						JsonName: "id",
+						ObjectDefinition: models.SDKObjectDefinition{
+							Type: models.StringSDKObjectDefinitionType,
+						},
+						Optional: true,
+						ReadOnly: true,
+					},
+					"Items": {
+						JsonName: "items",
+						ObjectDefinition: models.SDKObjectDefinition{
+							Type: models.ListSDKObjectDefinitionType,
+							NestedItem: &models.SDKObjectDefinition{
+								Type:          models.ReferenceSDKObjectDefinitionType,
+								ReferenceName: stringPointer("Item"),
+							},
+						},
+						Optional: true,
+					},
+					"Name": {
+						JsonName: "name",
+						ObjectDefinition: models.SDKObjectDefinition{
+							Type: models.StringSDKObjectDefinitionType,
+						},
+						Required: true,
+					},
+					"Properties": {
+						JsonName: "properties",
+						ObjectDefinition: models.SDKObjectDefinition{
+							Type:          models.ReferenceSDKObjectDefinitionType,
+							ReferenceName: stringPointer("Item"),
+						},
+						Required: true,
+					},
+					"Tags": {
+						JsonName: "tags",
+						ObjectDefinition: models.SDKObjectDefinition{
+							Type: models.TagsSDKObjectDefinitionType,
+						},
+						Required: true,
+					},
+				},
+			},
+			"Item": {
+				Fields: map[string]models.SDKField{},
+			},
+		},
+		source:                      AccTestLicenceType,
+		generateValidationFunctions: true,
+	}
+	actual, err := modelsTemplater{
+		name:  "Basic",
+		model: data.models["Basic"],
+	}.codeForValidationFunctions(data)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	expected := `
+// Validate checks that the Required fields within Basic have been specified, including those of any nested models
+func (s Basic) Validate() error {
+	if s.Items != nil {
+		for i0, v0 := range (*s.Items) {
+			if err := v0.Validate(); err != nil {
+				return fmt.Errorf("validating %s: %+v", fmt.Sprintf("%s[%d]", "Items", i0), err)
+			}
+		}
+	}
+
+	if s.Name == "" {
+		return fmt.Errorf("Name is required but was not specified")
+	}
+
+	if err := s.Properties.Validate(); err != nil {
+		return fmt.Errorf("validating Properties: %+v", err)
+	}
+
+	if s.Tags == nil {
+		return fmt.Errorf("Tags is required but was not specified")
+	}
+
+	return nil
+}
+`
+	assertTemplatedCodeMatches(t, expected, *actual)
+}
+
+func TestModelTemplaterValidationDiscriminatedParent(t *testing.T) {
+	data := GeneratorData{
+		packageName: "somepackage",
+		models: map[string]models.SDKModel{
+			"ModeOfTransit": {
+				FieldNameContainingDiscriminatedValue: stringPointer("Type"),
+				Fields: map[string]models.SDKField{
+					"Type": {
+						ContainsDiscriminatedValue: true,
+						JsonName:                   "type",
+						ObjectDefinition: models.SDKObjectDefinition{
+							Type: models.StringSDKObjectDefinitionType,
+						},
+						Required: true,
+					},
+				},
+			},
+			"Train": {
+				DiscriminatedValue:                    stringPointer("train"),
+				FieldNameContainingDiscriminatedValue: stringPointer("Type"),
+				ParentTypeName:                        stringPointer("ModeOfTransit"),
+				Fields: map[string]models.SDKField{
+					"Carriages": {
+						JsonName: "carriages",
+						ObjectDefinition: models.SDKObjectDefinition{
+							Type: models.DictionarySDKObjectDefinitionType,
+							NestedItem: &models.SDKObjectDefinition{
+								Type:          models.ReferenceSDKObjectDefinitionType,
+								ReferenceName: stringPointer("ModeOfTransit"),
+							},
+						},
+						Required: true,
+					},
+				},
+			},
+		},
+		source:                      AccTestLicenceType,
+		generateValidationFunctions: true,
+	}
+
+	t.Run("Parent", func(t *testing.T) {
+		actual, err := modelsTemplater{
+			name:  "ModeOfTransit",
+			model: data.models["ModeOfTransit"],
+		}.structCode(data)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if !strings.Contains(*actual, "Validate() error") {
+			t.Fatalf("expected the ModeOfTransit interface to contain the Validate function but got:\n%s", *actual)
+		}
+
+		actual, err = modelsTemplater{
+			name:  "ModeOfTransit",
+			model: data.models["ModeOfTransit"],
+		}.codeForValidationFunctions(data)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		expected := `
+// Validate checks that the Required fields within BaseModeOfTransitImpl have been specified, including those of any nested models
+func (s BaseModeOfTransitImpl) Validate() error {
+	return nil
+}
+
+func (s RawModeOfTransitImpl) Validate() error {
+	return s.modeOfTransit.Validate()
+}
+`
+		assertTemplatedCodeMatches(t, expected, *actual)
+	})
+
+	t.Run("Implementation", func(t *testing.T) {
+		actual, err := modelsTemplater{
+			name:  "Train",
+			model: data.models["Train"],
+		}.codeForValidationFunctions(data)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		expected := `
+// Validate checks that the Required fields within Train have been specified, including those of any nested models
+func (s Train) Validate() error {
+	if s.Carriages == nil {
+		return fmt.Errorf("Carriages is required but was not specified")
+	}
+
+	for k0, v0 := range s.Carriages {
+		if v0 != nil {
+			if err := v0.Validate(); err != nil {
+				return fmt.Errorf("validating %s: %+v", fmt.Sprintf("%s[%q]", "Carriages", k0), err)
+			}
+		}
+	}
+
+	return nil
+}
+`
+		assertTemplatedCodeMatches(t, expected, *actual)
+	})
+}
@@ -68,6 +68,9 @@ This readme covers example usages, but further information on [using this SDK ca
 func (r readmeTemplater) clientInitialization(sourceType models.SourceDataType, packageName, clientName string) string {
 	var baseUri string
 	switch sourceType {
+	case models.DataPlaneSourceDataType:
+		// Data Plane APIs are relative to a (parameterized) Host which is specific to each instance
+		baseUri = "https://{endpoint}"
 	case models.MicrosoftGraphSourceDataType:
 		baseUri = "https://graph.microsoft.com"
 	case models.ResourceManagerSourceDataType:
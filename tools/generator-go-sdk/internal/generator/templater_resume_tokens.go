@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package generator
+
+import "fmt"
+
+var _ templaterForResource = resumeTokensTemplater{}
+
+// resumeTokensTemplater outputs the (unexported) helpers used to serialise the state of a Long Running
+// Operation into a Resume Token - and to rebuild a Poller from one, which is output once per package
+// and used by the `ResumeToken` and `Resume{Operation}Poller` methods generated for each Long Running Operation.
+type resumeTokensTemplater struct {
+}
+
+func (c resumeTokensTemplater) template(data GeneratorData) (*string, error) {
+	copyrightLines, err := copyrightLinesForSource(data.source)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving copyright lines: %+v", err)
+	}
+
+	template := fmt.Sprintf(`package %[1]s
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+)
+
+%[2]s
+
+// resumeToken contains the details required to resume polling for a Long Running Operation, allowing
+// the Poller to be rebuilt in another process (for example after a restart).
+type resumeToken struct {
+	// AsyncOperationUri is the value of the 'Azure-AsyncOperation' header returned for the original request.
+	AsyncOperationUri string `+"`json:\"asyncOperationUri,omitempty\"`"+`
+
+	// ContentType is the value of the 'Content-Type' header returned for the original request.
+	ContentType string `+"`json:\"contentType,omitempty\"`"+`
+
+	// FinalStateVia is the 'final-state-via' behaviour for this operation (for example 'azure-async-operation',
+	// 'location' or 'original-uri'), which specifies where the final state of the operation is retrieved from.
+	FinalStateVia string `+"`json:\"finalStateVia,omitempty\"`"+`
+
+	// HttpMethod is the HTTP Method used for the original request, which (together with the
+	// LocationUri) determines where the final state of the operation is retrieved from.
+	HttpMethod string `+"`json:\"httpMethod\"`"+`
+
+	// LocationUri is the value of the 'Location' header returned for the original request.
+	LocationUri string `+"`json:\"locationUri,omitempty\"`"+`
+
+	// PollerType is the base layer which is used to poll this operation, for example 'resourcemanager'.
+	PollerType string `+"`json:\"pollerType\"`"+`
+
+	// RequestUri is the URI of the original request, which is polled when neither an AsyncOperationUri
+	// nor a LocationUri were returned.
+	RequestUri string `+"`json:\"requestUri\"`"+`
+
+	// StatusCode is the HTTP Status Code returned for the original request.
+	StatusCode int `+"`json:\"statusCode\"`"+`
+}
+
+// newResumeToken returns a serialised resumeToken for the Long Running Operation which returned resp
+func newResumeToken(resp *http.Response, pollerType string, finalStateVia string) (*string, error) {
+	if resp == nil || resp.Request == nil || resp.Request.URL == nil {
+		return nil, fmt.Errorf("a resume token can only be obtained from the response of a Long Running Operation")
+	}
+
+	token := resumeToken{
+		AsyncOperationUri: resp.Header.Get("Azure-AsyncOperation"),
+		ContentType:       resp.Header.Get("Content-Type"),
+		FinalStateVia:     finalStateVia,
+		HttpMethod:        resp.Request.Method,
+		LocationUri:       resp.Header.Get("Location"),
+		PollerType:        pollerType,
+		RequestUri:        resp.Request.URL.String(),
+		StatusCode:        resp.StatusCode,
+	}
+	encoded, err := json.Marshal(token)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling resume token: %%+v", err)
+	}
+
+	output := base64.StdEncoding.EncodeToString(encoded)
+	return &output, nil
+}
+
+// responseFromResumeToken parses the serialised resumeToken and returns a Response which can be used to rebuild the Poller
+func responseFromResumeToken(input string, pollerType string, finalStateVia string) (*client.Response, error) {
+	decoded, err := base64.StdEncoding.DecodeString(input)
+	if err != nil {
+		return nil, fmt.Errorf("decoding resume token: %%+v", err)
+	}
+
+	var token resumeToken
+	if err := json.Unmarshal(decoded, &token); err != nil {
+		return nil, fmt.Errorf("unmarshaling resume token: %%+v", err)
+	}
+
+	if token.PollerType != pollerType {
+		return nil, fmt.Errorf("the resume token is for a %%q poller but a %%q poller was expected", token.PollerType, pollerType)
+	}
+
+	if token.FinalStateVia != finalStateVia {
+		return nil, fmt.Errorf("the resume token retrieves the final state via %%q but %%q was expected", token.FinalStateVia, finalStateVia)
+	}
+
+	requestUri, err := url.Parse(token.RequestUri)
+	if err != nil {
+		return nil, fmt.Errorf("parsing the request uri %%q from the resume token: %%+v", token.RequestUri, err)
+	}
+
+	header := http.Header{}
+	if token.AsyncOperationUri != "" {
+		header.Set("Azure-AsyncOperation", token.AsyncOperationUri)
+	}
+	if token.ContentType != "" {
+		header.Set("Content-Type", token.ContentType)
+	}
+	if token.LocationUri != "" {
+		header.Set("Location", token.LocationUri)
+	}
+
+	return &client.Response{
+		Response: &http.Response{
+			Body:   http.NoBody,
+			Header: header,
+			Request: &http.Request{
+				Header: http.Header{},
+				Method: token.HttpMethod,
+				URL:    requestUri,
+			},
+			StatusCode: token.StatusCode,
+		},
+	}, nil
+}
+`, data.packageName, *copyrightLines)
+	return &template, nil
+}
@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"strings"
+
+	v1 "github.com/hashicorp/pandora/tools/data-api-sdk/v1"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	"github.com/hashicorp/pandora/tools/generator-openapi/internal/generator"
+	"github.com/mitchellh/cli"
+)
+
+var _ cli.Command = GenerateCommand{}
+
+type GenerateCommand struct {
+	sourceDataType models.SourceDataType
+}
+
+type GeneratorInput struct {
+	apiServerEndpoint string
+	outputDirectory   string
+	services          []string
+}
+
+func NewGenerateCommand(sourceDataType models.SourceDataType) func() (cli.Command, error) {
+	return func() (cli.Command, error) {
+		return GenerateCommand{
+			sourceDataType: sourceDataType,
+		}, nil
+	}
+}
+
+func (g GenerateCommand) Help() string {
+	return `Generates OpenAPI 3.1 Documents for each Service and API Version based on the API Definitions from the Data API
+
+Arguments:
+
+  -data-api=http://localhost:8080  the endpoint of the Data API
+  -output-dir=./openapi            the directory where the OpenAPI Documents should be output
+  -services=Compute,Network        (optional) a comma separated list of Services to generate
+`
+}
+
+func (g GenerateCommand) Run(args []string) int {
+	ctx := context.Background()
+
+	input := GeneratorInput{}
+	var serviceNames string
+
+	f := flag.NewFlagSet("generator-openapi", flag.ExitOnError)
+	f.StringVar(&input.apiServerEndpoint, "data-api", "http://localhost:8080", "-data-api=http://localhost:8080")
+	f.StringVar(&input.outputDirectory, "output-dir", "./openapi", "-output-dir=./openapi")
+	f.StringVar(&serviceNames, "services", "", "A list of comma separated Service named from the Data API to generate")
+	if err := f.Parse(args); err != nil {
+		log.Fatalf("parsing arguments: %+v", err)
+	}
+
+	if serviceNames != "" {
+		input.services = strings.Split(serviceNames, ",")
+	}
+
+	if err := g.run(ctx, input); err != nil {
+		log.Fatalf("running generator: %+v", err)
+	}
+
+	return 0
+}
+
+func (g GenerateCommand) Synopsis() string {
+	return "Generates OpenAPI 3.1 Documents based on the API Definitions from the Data API"
+}
+
+func (g GenerateCommand) run(ctx context.Context, input GeneratorInput) error {
+	client := v1.NewClient(input.apiServerEndpoint, g.sourceDataType)
+	data, err := client.LoadAllData(ctx, input.services)
+	if err != nil {
+		return fmt.Errorf("retrieving API Definitions: %+v", err)
+	}
+
+	return generator.Generate(generator.GenerateInput{
+		Data:            *data,
+		OutputDirectory: input.outputDirectory,
+		SourceDataType:  g.sourceDataType,
+	})
+}
@@ -0,0 +1,195 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package generator
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	"github.com/hashicorp/pandora/tools/generator-openapi/internal/logging"
+)
+
+// commonTypesSchemaPrefix is the prefix used for the names of Schemas sourced from the Common Types
+const commonTypesSchemaPrefix = "CommonTypes"
+
+// canonicalApiVersions is a map of the API Version used internally (key) to the upstream API Version (value),
+// used when building the Server URL for Microsoft Graph.
+var canonicalApiVersions = map[string]string{
+	"stable": "v1.0",
+}
+
+type BuildDocumentInput struct {
+	// APIVersion specifies the API Version which should be output.
+	APIVersion string
+
+	// CommonTypes specifies the Common Types available for this API Version.
+	CommonTypes models.CommonTypes
+
+	// ServiceName specifies the name of the Service which this API Version belongs to.
+	ServiceName string
+
+	// SourceDataType specifies the Source Data Type (e.g. Resource Manager) which this API Version belongs to.
+	SourceDataType models.SourceDataType
+
+	// VersionDetails specifies the details for this API Version.
+	VersionDetails models.APIVersion
+}
+
+type documentBuilder struct {
+	input BuildDocumentInput
+
+	// referencedCommonTypes is the set of Common Types (Constants/Models) referenced within this API Version,
+	// which are output once all of the API Resources have been processed.
+	referencedCommonTypes map[string]struct{}
+
+	document *Document
+}
+
+// BuildDocument builds an OpenAPI 3.1 Document for the API Version defined in input.
+func BuildDocument(input BuildDocumentInput) (*Document, error) {
+	b := documentBuilder{
+		input:                 input,
+		referencedCommonTypes: make(map[string]struct{}),
+		document: &Document{
+			OpenAPI: openApiVersion,
+			Info: Info{
+				Title:   input.ServiceName,
+				Version: input.APIVersion,
+			},
+			Paths: make(map[string]*PathItem),
+			Components: Components{
+				Schemas: make(map[string]*Schema),
+			},
+		},
+	}
+	if server := serverForSourceDataType(input.SourceDataType, input.APIVersion); server != nil {
+		b.document.Servers = []Server{*server}
+	}
+
+	resourceNames := make([]string, 0)
+	for resourceName := range input.VersionDetails.Resources {
+		resourceNames = append(resourceNames, resourceName)
+	}
+	sort.Strings(resourceNames)
+
+	for _, resourceName := range resourceNames {
+		logging.Tracef("Processing Resource %q..", resourceName)
+		resource := input.VersionDetails.Resources[resourceName]
+		if err := b.processResource(resourceName, resource); err != nil {
+			return nil, fmt.Errorf("processing Resource %q: %+v", resourceName, err)
+		}
+	}
+
+	if err := b.processReferencedCommonTypes(); err != nil {
+		return nil, fmt.Errorf("processing the referenced Common Types: %+v", err)
+	}
+
+	return b.document, nil
+}
+
+func (b *documentBuilder) processResource(resourceName string, resource models.APIResource) error {
+	source := schemaSource{
+		resourceName: &resourceName,
+		constants:    resource.Constants,
+		models:       resource.Models,
+	}
+
+	for constantName, constant := range resource.Constants {
+		schema, err := schemaForConstant(constantName, constant)
+		if err != nil {
+			return fmt.Errorf("building Schema for Constant %q: %+v", constantName, err)
+		}
+		b.document.Components.Schemas[source.schemaName(constantName)] = schema
+	}
+
+	for modelName, model := range resource.Models {
+		schema, err := b.schemaForModel(source, modelName, model)
+		if err != nil {
+			return fmt.Errorf("building Schema for Model %q: %+v", modelName, err)
+		}
+		b.document.Components.Schemas[source.schemaName(modelName)] = schema
+	}
+
+	operationNames := make([]string, 0)
+	for operationName := range resource.Operations {
+		operationNames = append(operationNames, operationName)
+	}
+	sort.Strings(operationNames)
+
+	for _, operationName := range operationNames {
+		operation := resource.Operations[operationName]
+		if err := b.processOperation(source, resource, operationName, operation); err != nil {
+			return fmt.Errorf("processing Operation %q: %+v", operationName, err)
+		}
+	}
+
+	return nil
+}
+
+// processReferencedCommonTypes outputs the Common Types which have been referenced, including any Common Types
+// which are referenced by those Common Types.
+func (b *documentBuilder) processReferencedCommonTypes() error {
+	source := schemaSource{
+		constants: b.input.CommonTypes.Constants,
+		models:    b.input.CommonTypes.Models,
+	}
+
+	processed := make(map[string]struct{})
+	for len(processed) < len(b.referencedCommonTypes) {
+		names := make([]string, 0)
+		for name := range b.referencedCommonTypes {
+			if _, ok := processed[name]; !ok {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			processed[name] = struct{}{}
+
+			if constant, ok := source.constants[name]; ok {
+				schema, err := schemaForConstant(name, constant)
+				if err != nil {
+					return fmt.Errorf("building Schema for the Common Constant %q: %+v", name, err)
+				}
+				b.document.Components.Schemas[source.schemaName(name)] = schema
+				continue
+			}
+
+			if model, ok := source.models[name]; ok {
+				// NOTE: building this Schema can reference further Common Types, which are picked up in the next iteration
+				schema, err := b.schemaForModel(source, name, model)
+				if err != nil {
+					return fmt.Errorf("building Schema for the Common Model %q: %+v", name, err)
+				}
+				b.document.Components.Schemas[source.schemaName(name)] = schema
+				continue
+			}
+
+			return fmt.Errorf("the Common Type %q was referenced but was not found", name)
+		}
+	}
+
+	return nil
+}
+
+func serverForSourceDataType(sourceDataType models.SourceDataType, apiVersion string) *Server {
+	switch sourceDataType {
+	case models.MicrosoftGraphSourceDataType:
+		if v, ok := canonicalApiVersions[apiVersion]; ok {
+			apiVersion = v
+		}
+		return &Server{
+			URL: fmt.Sprintf("https://graph.microsoft.com/%s", apiVersion),
+		}
+
+	case models.ResourceManagerSourceDataType:
+		return &Server{
+			URL: "https://management.azure.com",
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,381 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package generator
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+func TestBuildDocument_OperationsUsingResourceIDs(t *testing.T) {
+	document, err := BuildDocument(BuildDocumentInput{
+		APIVersion:     "2022-02-01",
+		ServiceName:    "Compute",
+		SourceDataType: models.ResourceManagerSourceDataType,
+		VersionDetails: models.APIVersion{
+			Resources: map[string]models.APIResource{
+				"Disks": {
+					Models: map[string]models.SDKModel{
+						"Disk": {
+							Fields: map[string]models.SDKField{
+								"Name": {
+									JsonName: "name",
+									ObjectDefinition: models.SDKObjectDefinition{
+										Type: models.StringSDKObjectDefinitionType,
+									},
+									ReadOnly: true,
+								},
+							},
+						},
+					},
+					Operations: map[string]models.SDKOperation{
+						"CreateOrUpdate": {
+							ContentType:         "application/json",
+							ExpectedStatusCodes: []int{202, 200},
+							LongRunning:         true,
+							Method:              "PUT",
+							RequestObject: &models.SDKObjectDefinition{
+								Type:          models.ReferenceSDKObjectDefinitionType,
+								ReferenceName: pointer.To("Disk"),
+							},
+							ResourceIDName: pointer.To("DiskId"),
+						},
+						"List": {
+							ContentType:                      "application/json",
+							ExpectedStatusCodes:              []int{200},
+							FieldContainingPaginationDetails: pointer.To("nextLink"),
+							Method:                           "GET",
+							Options: map[string]models.SDKOperationOption{
+								"Filter": {
+									ObjectDefinition: models.SDKOperationOptionObjectDefinition{
+										Type: models.StringSDKOperationOptionObjectDefinitionType,
+									},
+									QueryStringName: pointer.To("$filter"),
+								},
+							},
+							ResourceIDName: pointer.To("ScopeId"),
+							ResponseObject: &models.SDKObjectDefinition{
+								Type:          models.ReferenceSDKObjectDefinitionType,
+								ReferenceName: pointer.To("Disk"),
+							},
+							URISuffix: pointer.To("/providers/Microsoft.Compute/disks"),
+						},
+					},
+					ResourceIDs: map[string]models.ResourceID{
+						"DiskId": {
+							Segments: []models.ResourceIDSegment{
+								models.NewStaticValueResourceIDSegment("subscriptions", "subscriptions"),
+								models.NewSubscriptionIDResourceIDSegment("subscriptionId"),
+								models.NewStaticValueResourceIDSegment("resourceGroups", "resourceGroups"),
+								models.NewResourceGroupNameResourceIDSegment("resourceGroupName"),
+								models.NewStaticValueResourceIDSegment("providers", "providers"),
+								models.NewResourceProviderResourceIDSegment("resourceProvider", "Microsoft.Compute"),
+								models.NewStaticValueResourceIDSegment("disks", "disks"),
+								models.NewUserSpecifiedResourceIDSegment("diskName", "diskName"),
+							},
+						},
+						"ScopeId": {
+							Segments: []models.ResourceIDSegment{
+								models.NewScopeResourceIDSegment("scope"),
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("building document: %+v", err)
+	}
+
+	createPath, ok := document.Paths["/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Compute/disks/{diskName}"]
+	if !ok {
+		t.Fatalf("expected a Path for the Disk ID but got %+v", document.Paths)
+	}
+	create := createPath.Put
+	if create == nil {
+		t.Fatalf("expected a PUT operation for the Disk ID")
+	}
+	if create.OperationID != "Disks_CreateOrUpdate" {
+		t.Fatalf("expected the Operation ID to be `Disks_CreateOrUpdate` but got %q", create.OperationID)
+	}
+	if !create.LongRunning {
+		t.Fatalf("expected CreateOrUpdate to be Long Running")
+	}
+	expectedParameterNames := []string{"subscriptionId", "resourceGroupName", "diskName", "api-version"}
+	actualParameterNames := make([]string, 0)
+	for _, parameter := range create.Parameters {
+		actualParameterNames = append(actualParameterNames, parameter.Name)
+	}
+	if !reflect.DeepEqual(expectedParameterNames, actualParameterNames) {
+		t.Fatalf("expected the Parameters %+v but got %+v", expectedParameterNames, actualParameterNames)
+	}
+	if create.RequestBody.Content["application/json"].Schema.Ref != "#/components/schemas/Disks.Disk" {
+		t.Fatalf("expected the Request Body to reference `Disks.Disk` but got %+v", create.RequestBody.Content["application/json"].Schema)
+	}
+	if _, ok := create.Responses["202"]; !ok {
+		t.Fatalf("expected a 202 response but got %+v", create.Responses)
+	}
+
+	listPath, ok := document.Paths["/{scope}/providers/Microsoft.Compute/disks"]
+	if !ok {
+		t.Fatalf("expected a Path for the Scope ID but got %+v", document.Paths)
+	}
+	list := listPath.Get
+	if list.Pageable == nil || list.Pageable.NextLinkName != "nextLink" {
+		t.Fatalf("expected List to be Pageable using `nextLink` but got %+v", list.Pageable)
+	}
+	if !list.Parameters[0].SkipUrlEncoding {
+		t.Fatalf("expected the Scope parameter to skip URL encoding")
+	}
+	if list.Parameters[1].Name != "$filter" || list.Parameters[1].In != "query" {
+		t.Fatalf("expected the second parameter to be the `$filter` query string but got %+v", list.Parameters[1])
+	}
+	listSchema := list.Responses["200"].Content["application/json"].Schema
+	if listSchema.Properties["value"].Items.Ref != "#/components/schemas/Disks.Disk" {
+		t.Fatalf("expected the List response to contain an array of `Disks.Disk` but got %+v", listSchema)
+	}
+	if _, ok := listSchema.Properties["nextLink"]; !ok {
+		t.Fatalf("expected the List response to contain `nextLink` but got %+v", listSchema)
+	}
+
+	disk := document.Components.Schemas["Disks.Disk"]
+	if disk == nil || !disk.Properties["name"].ReadOnly {
+		t.Fatalf("expected the `Disks.Disk` schema to contain a Read-Only `name` field but got %+v", disk)
+	}
+}
+
+func TestBuildDocument_ConstantsAsEnums(t *testing.T) {
+	document, err := BuildDocument(BuildDocumentInput{
+		APIVersion:     "2022-02-01",
+		ServiceName:    "Compute",
+		SourceDataType: models.ResourceManagerSourceDataType,
+		VersionDetails: models.APIVersion{
+			Resources: map[string]models.APIResource{
+				"Disks": {
+					Constants: map[string]models.SDKConstant{
+						"SkuName": {
+							Type: models.StringSDKConstantType,
+							Values: map[string]string{
+								"Premium":  "Premium_LRS",
+								"Standard": "Standard_LRS",
+							},
+						},
+						"Size": {
+							Type: models.IntegerSDKConstantType,
+							Values: map[string]string{
+								"Small": "1",
+								"Large": "10",
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("building document: %+v", err)
+	}
+
+	skuName := document.Components.Schemas["Disks.SkuName"]
+	if !reflect.DeepEqual(skuName.Enum, []interface{}{"Premium_LRS", "Standard_LRS"}) {
+		t.Fatalf("expected the enum values for SkuName to be sorted by key but got %+v", skuName.Enum)
+	}
+	if skuName.EnumDetails.Name != "SkuName" || skuName.EnumDetails.Values[0].Name != "Premium" {
+		t.Fatalf("expected the `x-ms-enum` details to retain the names but got %+v", skuName.EnumDetails)
+	}
+
+	size := document.Components.Schemas["Disks.Size"]
+	if size.Type != "integer" || !reflect.DeepEqual(size.Enum, []interface{}{int64(10), int64(1)}) {
+		t.Fatalf("expected Size to be an integer enum but got %+v", size)
+	}
+}
+
+func TestBuildDocument_Discriminators(t *testing.T) {
+	document, err := BuildDocument(BuildDocumentInput{
+		APIVersion:     "2022-02-01",
+		ServiceName:    "Transport",
+		SourceDataType: models.ResourceManagerSourceDataType,
+		VersionDetails: models.APIVersion{
+			Resources: map[string]models.APIResource{
+				"Vehicles": {
+					Models: map[string]models.SDKModel{
+						"ModeOfTransit": {
+							FieldNameContainingDiscriminatedValue: pointer.To("Type"),
+							Fields: map[string]models.SDKField{
+								"Type": {
+									ContainsDiscriminatedValue: true,
+									JsonName:                   "type",
+									ObjectDefinition: models.SDKObjectDefinition{
+										Type: models.StringSDKObjectDefinitionType,
+									},
+									Required: true,
+								},
+							},
+						},
+						"Train": {
+							DiscriminatedValue:                    pointer.To("train"),
+							FieldNameContainingDiscriminatedValue: pointer.To("Type"),
+							ParentTypeName:                        pointer.To("ModeOfTransit"),
+							Fields: map[string]models.SDKField{
+								"Carriages": {
+									JsonName: "carriages",
+									ObjectDefinition: models.SDKObjectDefinition{
+										Type: models.IntegerSDKObjectDefinitionType,
+									},
+									Optional: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("building document: %+v", err)
+	}
+
+	parent := document.Components.Schemas["Vehicles.ModeOfTransit"]
+	expectedDiscriminator := &Discriminator{
+		PropertyName: "type",
+		Mapping: map[string]string{
+			"train": "#/components/schemas/Vehicles.Train",
+		},
+	}
+	if !reflect.DeepEqual(parent.Discriminator, expectedDiscriminator) {
+		t.Fatalf("expected the discriminator %+v but got %+v", expectedDiscriminator, parent.Discriminator)
+	}
+	if len(parent.OneOf) != 1 || parent.OneOf[0].Ref != "#/components/schemas/Vehicles.Train" {
+		t.Fatalf("expected the parent to be `oneOf` the implementations but got %+v", parent.OneOf)
+	}
+
+	implementation := document.Components.Schemas["Vehicles.Train"]
+	if implementation.Properties["type"].Const != "train" {
+		t.Fatalf("expected the implementation to inherit the `type` field with a const value but got %+v", implementation.Properties["type"])
+	}
+	if !reflect.DeepEqual(implementation.Required, []string{"type"}) {
+		t.Fatalf("expected `type` to be required but got %+v", implementation.Required)
+	}
+}
+
+func TestBuildDocument_CommonTypesAreOutputWhenReferenced(t *testing.T) {
+	document, err := BuildDocument(BuildDocumentInput{
+		APIVersion: "stable",
+		CommonTypes: models.CommonTypes{
+			Models: map[string]models.SDKModel{
+				"Entity": {
+					Fields: map[string]models.SDKField{
+						"Kind": {
+							JsonName: "kind",
+							ObjectDefinition: models.SDKObjectDefinition{
+								Type:          models.ReferenceSDKObjectDefinitionType,
+								ReferenceName: pointer.To("EntityKind"),
+							},
+						},
+					},
+				},
+				"Unused": {
+					Fields: map[string]models.SDKField{},
+				},
+			},
+			Constants: map[string]models.SDKConstant{
+				"EntityKind": {
+					Type: models.StringSDKConstantType,
+					Values: map[string]string{
+						"User": "user",
+					},
+				},
+			},
+		},
+		ServiceName:    "Users",
+		SourceDataType: models.MicrosoftGraphSourceDataType,
+		VersionDetails: models.APIVersion{
+			Resources: map[string]models.APIResource{
+				"User": {
+					Operations: map[string]models.SDKOperation{
+						"GetUser": {
+							ExpectedStatusCodes: []int{200},
+							Method:              "GET",
+							ResponseObject: &models.SDKObjectDefinition{
+								Type:                      models.ReferenceSDKObjectDefinitionType,
+								ReferenceName:             pointer.To("Entity"),
+								ReferenceNameIsCommonType: pointer.To(true),
+								Nullable:                  true,
+							},
+							URISuffix: pointer.To("/me"),
+						},
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("building document: %+v", err)
+	}
+
+	if document.Servers[0].URL != "https://graph.microsoft.com/v1.0" {
+		t.Fatalf("expected the Server URL to use the canonical API Version but got %q", document.Servers[0].URL)
+	}
+	get := document.Paths["/me"].Get
+	for _, parameter := range get.Parameters {
+		if parameter.Name == "api-version" {
+			t.Fatalf("the `api-version` parameter should only be output for Resource Manager")
+		}
+	}
+	response, err := json.Marshal(get.Responses["200"].Content["application/json"].Schema)
+	if err != nil {
+		t.Fatalf("marshaling: %+v", err)
+	}
+	expected := `{"anyOf":[{"$ref":"#/components/schemas/CommonTypes.Entity"},{"type":"null"}]}`
+	if string(response) != expected {
+		t.Fatalf("expected the nullable response schema %s but got %s", expected, string(response))
+	}
+
+	for _, name := range []string{"CommonTypes.Entity", "CommonTypes.EntityKind"} {
+		if _, ok := document.Components.Schemas[name]; !ok {
+			t.Fatalf("expected the referenced Common Type %q to be output", name)
+		}
+	}
+	if _, ok := document.Components.Schemas["CommonTypes.Unused"]; ok {
+		t.Fatalf("expected the unreferenced Common Type `Unused` not to be output")
+	}
+}
+
+func TestBuildDocument_OperationsWithTheSamePathAndMethod(t *testing.T) {
+	operations := map[string]models.SDKOperation{
+		"Get": {
+			ExpectedStatusCodes: []int{200},
+			Method:              "GET",
+			URISuffix:           pointer.To("/me"),
+		},
+	}
+	document, err := BuildDocument(BuildDocumentInput{
+		APIVersion:     "stable",
+		ServiceName:    "Users",
+		SourceDataType: models.MicrosoftGraphSourceDataType,
+		VersionDetails: models.APIVersion{
+			Resources: map[string]models.APIResource{
+				// `User` is processed before `UserProfile`, however `UserProfile_Get` is the lower Operation ID
+				"User": {
+					Operations: operations,
+				},
+				"UserProfile": {
+					Operations: operations,
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("building document: %+v", err)
+	}
+
+	if actual := document.Paths["/me"].Get.OperationID; actual != "UserProfile_Get" {
+		t.Fatalf("expected the Operation `UserProfile_Get` to be retained but got %q", actual)
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package generator
+
+// This file contains the subset of the OpenAPI 3.1 specification which is output by this generator.
+// Extensions (e.g. `x-ms-long-running-operation`) are output as fields on the relevant type, since
+// the set of extensions output is known ahead of time.
+
+const openApiVersion = "3.1.0"
+
+type Document struct {
+	OpenAPI    string               `json:"openapi"`
+	Info       Info                 `json:"info"`
+	Servers    []Server             `json:"servers,omitempty"`
+	Paths      map[string]*PathItem `json:"paths"`
+	Components Components           `json:"components"`
+}
+
+type Info struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+type Server struct {
+	URL string `json:"url"`
+}
+
+type Components struct {
+	Schemas map[string]*Schema `json:"schemas,omitempty"`
+}
+
+type PathItem struct {
+	Delete  *Operation `json:"delete,omitempty"`
+	Get     *Operation `json:"get,omitempty"`
+	Head    *Operation `json:"head,omitempty"`
+	Options *Operation `json:"options,omitempty"`
+	Patch   *Operation `json:"patch,omitempty"`
+	Post    *Operation `json:"post,omitempty"`
+	Put     *Operation `json:"put,omitempty"`
+}
+
+type Operation struct {
+	OperationID string               `json:"operationId"`
+	Description string               `json:"description,omitempty"`
+	Tags        []string             `json:"tags,omitempty"`
+	Parameters  []Parameter          `json:"parameters,omitempty"`
+	RequestBody *RequestBody         `json:"requestBody,omitempty"`
+	Responses   map[string]*Response `json:"responses"`
+
+	// LongRunning is output as the `x-ms-long-running-operation` extension, as used by AutoRest.
+	LongRunning bool `json:"x-ms-long-running-operation,omitempty"`
+
+	// Pageable is output as the `x-ms-pageable` extension, as used by AutoRest.
+	Pageable *Pageable `json:"x-ms-pageable,omitempty"`
+}
+
+type Pageable struct {
+	// NextLinkName specifies the name of the field containing the link to the next page of results.
+	NextLinkName string `json:"nextLinkName"`
+}
+
+type Parameter struct {
+	Name     string  `json:"name"`
+	In       string  `json:"in"`
+	Required bool    `json:"required"`
+	Schema   *Schema `json:"schema"`
+
+	// SkipUrlEncoding is output as the `x-ms-skip-url-encoding` extension, and is used for
+	// Path Parameters containing a Scope (which itself contains slashes).
+	SkipUrlEncoding bool `json:"x-ms-skip-url-encoding,omitempty"`
+}
+
+type RequestBody struct {
+	Required bool                  `json:"required"`
+	Content  map[string]*MediaType `json:"content"`
+}
+
+type Response struct {
+	Description string                `json:"description"`
+	Content     map[string]*MediaType `json:"content,omitempty"`
+}
+
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 interface{}        `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Const                interface{}        `json:"const,omitempty"`
+	Enum                 []interface{}      `json:"enum,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	ReadOnly             bool               `json:"readOnly,omitempty"`
+	WriteOnly            bool               `json:"writeOnly,omitempty"`
+	AnyOf                []*Schema          `json:"anyOf,omitempty"`
+	OneOf                []*Schema          `json:"oneOf,omitempty"`
+	Discriminator        *Discriminator     `json:"discriminator,omitempty"`
+
+	// EnumDetails is output as the `x-ms-enum` extension, as used by AutoRest.
+	EnumDetails *EnumDetails `json:"x-ms-enum,omitempty"`
+
+	// PandoraType is output as the `x-pandora-type` extension and specifies the (custom) Object Definition
+	// Type (e.g. `SystemAssignedIdentity`) which this Schema represents.
+	PandoraType string `json:"x-pandora-type,omitempty"`
+}
+
+type Discriminator struct {
+	PropertyName string            `json:"propertyName"`
+	Mapping      map[string]string `json:"mapping,omitempty"`
+}
+
+type EnumDetails struct {
+	Name          string      `json:"name"`
+	ModelAsString bool        `json:"modelAsString"`
+	Values        []EnumValue `json:"values"`
+}
+
+type EnumValue struct {
+	Name  string      `json:"name"`
+	Value interface{} `json:"value"`
+}
@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	v1 "github.com/hashicorp/pandora/tools/data-api-sdk/v1"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	"github.com/hashicorp/pandora/tools/generator-openapi/internal/logging"
+)
+
+type GenerateInput struct {
+	// Data specifies the API Definitions loaded from the Data API.
+	Data v1.LoadAllDataResult
+
+	// OutputDirectory specifies the directory where the OpenAPI Documents should be output, within which
+	// a directory is created for the Source Data Type, then for each Service.
+	OutputDirectory string
+
+	// SourceDataType specifies the Source Data Type which Data is for.
+	SourceDataType models.SourceDataType
+}
+
+// Generate outputs an OpenAPI 3.1 Document for each API Version of each Service into the directory
+// `{OutputDirectory}/{SourceDataType}/{ServiceName}/{APIVersion}.json`.
+func Generate(input GenerateInput) error {
+	serviceNames := make([]string, 0)
+	for serviceName := range input.Data.Services {
+		serviceNames = append(serviceNames, serviceName)
+	}
+	sort.Strings(serviceNames)
+
+	for _, serviceName := range serviceNames {
+		logging.Infof("Generating Service %q..", serviceName)
+		service := input.Data.Services[serviceName]
+		for apiVersion, versionDetails := range service.APIVersions {
+			logging.Debugf("Generating Service %q / API Version %q..", serviceName, apiVersion)
+			var commonTypes models.CommonTypes
+			if v, ok := input.Data.CommonTypes[apiVersion]; ok {
+				commonTypes = v
+			}
+
+			document, err := BuildDocument(BuildDocumentInput{
+				APIVersion:     apiVersion,
+				CommonTypes:    commonTypes,
+				ServiceName:    serviceName,
+				SourceDataType: input.SourceDataType,
+				VersionDetails: versionDetails,
+			})
+			if err != nil {
+				return fmt.Errorf("building the OpenAPI Document for Service %q / API Version %q: %+v", serviceName, apiVersion, err)
+			}
+
+			outputPath := filepath.Join(input.OutputDirectory, string(input.SourceDataType), serviceName, fmt.Sprintf("%s.json", apiVersion))
+			if err := writeDocument(outputPath, *document); err != nil {
+				return fmt.Errorf("writing the OpenAPI Document for Service %q / API Version %q: %+v", serviceName, apiVersion, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func writeDocument(outputPath string, document Document) error {
+	logging.Tracef("Writing OpenAPI Document to %q..", outputPath)
+	if err := os.MkdirAll(filepath.Dir(outputPath), 0755); err != nil {
+		return fmt.Errorf("creating directory %q: %+v", filepath.Dir(outputPath), err)
+	}
+
+	contents, err := json.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshaling: %+v", err)
+	}
+
+	if err := os.WriteFile(outputPath, contents, 0644); err != nil {
+		return fmt.Errorf("writing to %q: %+v", outputPath, err)
+	}
+
+	return nil
+}
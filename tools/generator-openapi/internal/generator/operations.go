@@ -0,0 +1,261 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package generator
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	"github.com/hashicorp/pandora/tools/generator-openapi/internal/logging"
+)
+
+const defaultContentType = "application/json"
+
+func (b *documentBuilder) processOperation(source schemaSource, resource models.APIResource, operationName string, input models.SDKOperation) error {
+	path, parameters, err := b.pathAndParametersForOperation(resource, input)
+	if err != nil {
+		return fmt.Errorf("building the Path: %+v", err)
+	}
+
+	options, err := b.parametersForOptions(source, input.Options)
+	if err != nil {
+		return fmt.Errorf("building the Parameters for the Options: %+v", err)
+	}
+	parameters = append(parameters, options...)
+
+	if b.input.SourceDataType == models.ResourceManagerSourceDataType {
+		parameters = append(parameters, Parameter{
+			Name:     "api-version",
+			In:       "query",
+			Required: true,
+			Schema: &Schema{
+				Type:  "string",
+				Const: b.input.APIVersion,
+			},
+		})
+	}
+
+	contentType := input.ContentType
+	if contentType == "" {
+		contentType = defaultContentType
+	}
+
+	operation := Operation{
+		OperationID: fmt.Sprintf("%s_%s", *source.resourceName, operationName),
+		Description: input.Description,
+		Tags:        []string{*source.resourceName},
+		Parameters:  parameters,
+		Responses:   make(map[string]*Response),
+		LongRunning: input.LongRunning,
+	}
+
+	if input.RequestObject != nil {
+		schema, err := b.schemaForObjectDefinition(source, *input.RequestObject)
+		if err != nil {
+			return fmt.Errorf("building the Schema for the Request Object: %+v", err)
+		}
+		operation.RequestBody = &RequestBody{
+			Required: true,
+			Content: map[string]*MediaType{
+				contentType: {
+					Schema: schema,
+				},
+			},
+		}
+	}
+
+	var responseSchema *Schema
+	if input.ResponseObject != nil {
+		responseSchema, err = b.schemaForObjectDefinition(source, *input.ResponseObject)
+		if err != nil {
+			return fmt.Errorf("building the Schema for the Response Object: %+v", err)
+		}
+
+		if input.FieldContainingPaginationDetails != nil {
+			// the Response Object for a List Operation describes a single item, so we need to wrap this
+			operation.Pageable = &Pageable{
+				NextLinkName: *input.FieldContainingPaginationDetails,
+			}
+			responseSchema = &Schema{
+				Type: "object",
+				Properties: map[string]*Schema{
+					"value": {
+						Type:  "array",
+						Items: responseSchema,
+					},
+					*input.FieldContainingPaginationDetails: {
+						Type: "string",
+					},
+				},
+			}
+		}
+	}
+
+	statusCodes := append([]int{}, input.ExpectedStatusCodes...)
+	sort.Ints(statusCodes)
+	for _, statusCode := range statusCodes {
+		response := Response{
+			Description: http.StatusText(statusCode),
+		}
+		if responseSchema != nil && statusCode != http.StatusNoContent {
+			response.Content = map[string]*MediaType{
+				contentType: {
+					Schema: responseSchema,
+				},
+			}
+		}
+		operation.Responses[strconv.Itoa(statusCode)] = &response
+	}
+	if len(operation.Responses) == 0 {
+		operation.Responses["default"] = &Response{
+			Description: "The response for this operation is not documented",
+		}
+	}
+
+	pathItem, ok := b.document.Paths[path]
+	if !ok {
+		pathItem = &PathItem{}
+		b.document.Paths[path] = pathItem
+	}
+	existing, err := pathItem.operationForMethod(input.Method)
+	if err != nil {
+		return err
+	}
+	if *existing != nil {
+		// an OpenAPI document can only contain a single Operation for each Path and HTTP Method, so the Operation
+		// with the lowest Operation ID (`{Resource}_{Operation}`) is retained, regardless of the processing order
+		retained, skipped := *existing, &operation
+		if operation.OperationID < retained.OperationID {
+			retained, skipped = skipped, retained
+		}
+		logging.Warnf("the Operations %q and %q are both defined as %s %q - only %q will be output", retained.OperationID, skipped.OperationID, input.Method, path, retained.OperationID)
+		*existing = retained
+		return nil
+	}
+	*existing = &operation
+
+	return nil
+}
+
+// operationForMethod returns a pointer to the Operation for the specified HTTP Method within this PathItem
+func (p *PathItem) operationForMethod(method string) (**Operation, error) {
+	switch strings.ToUpper(method) {
+	case http.MethodDelete:
+		return &p.Delete, nil
+	case http.MethodGet:
+		return &p.Get, nil
+	case http.MethodHead:
+		return &p.Head, nil
+	case http.MethodOptions:
+		return &p.Options, nil
+	case http.MethodPatch:
+		return &p.Patch, nil
+	case http.MethodPost:
+		return &p.Post, nil
+	case http.MethodPut:
+		return &p.Put, nil
+	}
+
+	return nil, fmt.Errorf("unsupported HTTP Method %q", method)
+}
+
+// parametersForOptions returns the Query String and Header Parameters for the Options defined on an Operation.
+func (b *documentBuilder) parametersForOptions(source schemaSource, input map[string]models.SDKOperationOption) ([]Parameter, error) {
+	optionNames := make([]string, 0)
+	for optionName := range input {
+		optionNames = append(optionNames, optionName)
+	}
+	sort.Strings(optionNames)
+
+	output := make([]Parameter, 0)
+	for _, optionName := range optionNames {
+		option := input[optionName]
+
+		// Content Type and Retry Func are SDK-specific behaviours rather than being sent to the API
+		if option.Type == models.SDKOperationOptionTypeContentType || option.Type == models.SDKOperationOptionTypeRetryFunc {
+			continue
+		}
+
+		parameter := Parameter{
+			Required: option.Required,
+		}
+		switch {
+		case option.HeaderName != nil:
+			parameter.In = "header"
+			parameter.Name = *option.HeaderName
+		case option.QueryStringName != nil:
+			parameter.In = "query"
+			parameter.Name = *option.QueryStringName
+		case option.ODataFieldName != nil:
+			switch *option.ODataFieldName {
+			case "ConsistencyLevel":
+				parameter.In = "header"
+				parameter.Name = "ConsistencyLevel"
+			case "Metadata":
+				// `Metadata` is sent as part of the `Accept` header, rather than as a separate parameter
+				continue
+			default:
+				parameter.In = "query"
+				parameter.Name = fmt.Sprintf("$%s", strings.ToLower(*option.ODataFieldName))
+			}
+		default:
+			return nil, fmt.Errorf("the Option %q has no Header Name, OData Field Name or Query String Name", optionName)
+		}
+
+		schema, err := b.schemaForOptionObjectDefinition(source, option.ObjectDefinition)
+		if err != nil {
+			return nil, fmt.Errorf("building Schema for the Option %q: %+v", optionName, err)
+		}
+		parameter.Schema = schema
+
+		output = append(output, parameter)
+	}
+
+	return output, nil
+}
+
+func (b *documentBuilder) schemaForOptionObjectDefinition(source schemaSource, input models.SDKOperationOptionObjectDefinition) (*Schema, error) {
+	switch input.Type {
+	case models.BooleanSDKOperationOptionObjectDefinitionType:
+		return &Schema{Type: "boolean"}, nil
+
+	case models.CSVSDKOperationOptionObjectDefinitionType, models.StringSDKOperationOptionObjectDefinitionType:
+		return &Schema{Type: "string"}, nil
+
+	case models.FloatSDKOperationOptionObjectDefinitionType:
+		return &Schema{Type: "number", Format: "double"}, nil
+
+	case models.IntegerSDKOperationOptionObjectDefinitionType:
+		return &Schema{Type: "integer", Format: "int64"}, nil
+
+	case models.ListSDKOperationOptionObjectDefinitionType:
+		if input.NestedItem == nil {
+			return nil, fmt.Errorf("a List must have a Nested Item")
+		}
+		nested, err := b.schemaForOptionObjectDefinition(source, *input.NestedItem)
+		if err != nil {
+			return nil, fmt.Errorf("building Schema for the Nested Item: %+v", err)
+		}
+		return &Schema{
+			Type:  "array",
+			Items: nested,
+		}, nil
+
+	case models.ReferenceSDKOperationOptionObjectDefinitionType:
+		if input.ReferenceName == nil {
+			return nil, fmt.Errorf("a Reference must have a Reference Name")
+		}
+		// the OData types (e.g. `odata.Expand`) are defined in the SDK and are sent as a string
+		if strings.HasPrefix(*input.ReferenceName, "odata.") {
+			return &Schema{Type: "string"}, nil
+		}
+		return b.schemaForReference(source, *input.ReferenceName, false)
+	}
+
+	return nil, fmt.Errorf("unimplemented Option Object Definition Type %q", string(input.Type))
+}
@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+// pathAndParametersForOperation returns the Path Template for this Operation, comprised of the Resource ID (if any)
+// and the URI Suffix (if any) - together with the Path Parameters for any user-specified Resource ID Segments.
+func (b *documentBuilder) pathAndParametersForOperation(resource models.APIResource, input models.SDKOperation) (string, []Parameter, error) {
+	path := ""
+	parameters := make([]Parameter, 0)
+
+	if input.ResourceIDName != nil {
+		resourceIds := resource.ResourceIDs
+		if input.ResourceIDNameIsCommonType != nil && *input.ResourceIDNameIsCommonType {
+			resourceIds = b.input.CommonTypes.ResourceIDs
+		}
+		resourceId, ok := resourceIds[*input.ResourceIDName]
+		if !ok {
+			return "", nil, fmt.Errorf("the Resource ID %q was not found", *input.ResourceIDName)
+		}
+
+		template, params, err := pathTemplateForResourceID(resourceId)
+		if err != nil {
+			return "", nil, fmt.Errorf("building the Path Template for the Resource ID %q: %+v", *input.ResourceIDName, err)
+		}
+		path = *template
+		parameters = append(parameters, params...)
+	}
+
+	if input.URISuffix != nil {
+		path += *input.URISuffix
+	}
+
+	if path == "" {
+		path = "/"
+	}
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+
+	return path, parameters, nil
+}
+
+// pathTemplateForResourceID returns the Path Template (e.g. `/subscriptions/{subscriptionId}`) for the Resource ID,
+// together with a Path Parameter for each of the Resource ID Segments which aren't fixed values.
+func pathTemplateForResourceID(input models.ResourceID) (*string, []Parameter, error) {
+	components := make([]string, 0)
+	parameters := make([]Parameter, 0)
+	for _, segment := range input.Segments {
+		switch segment.Type {
+		case models.ResourceProviderResourceIDSegmentType, models.StaticResourceIDSegmentType:
+			if segment.FixedValue == nil {
+				return nil, nil, fmt.Errorf("the %s Segment %q has no Fixed Value", string(segment.Type), segment.Name)
+			}
+			components = append(components, *segment.FixedValue)
+			continue
+
+		case models.ConstantResourceIDSegmentType:
+			if segment.ConstantReference == nil {
+				return nil, nil, fmt.Errorf("the Constant Segment %q has no Constant Reference", segment.Name)
+			}
+			constant, ok := input.Constants[*segment.ConstantReference]
+			if !ok {
+				return nil, nil, fmt.Errorf("the Constant %q referenced by the Segment %q was not found", *segment.ConstantReference, segment.Name)
+			}
+			schema, err := schemaForConstant(*segment.ConstantReference, constant)
+			if err != nil {
+				return nil, nil, fmt.Errorf("building Schema for the Constant %q: %+v", *segment.ConstantReference, err)
+			}
+			parameters = append(parameters, Parameter{
+				Name:     segment.Name,
+				In:       "path",
+				Required: true,
+				Schema:   schema,
+			})
+
+		case models.ResourceGroupResourceIDSegmentType, models.ScopeResourceIDSegmentType, models.SubscriptionIDResourceIDSegmentType, models.UserSpecifiedResourceIDSegmentType:
+			parameters = append(parameters, Parameter{
+				Name:     segment.Name,
+				In:       "path",
+				Required: true,
+				Schema: &Schema{
+					Type: "string",
+				},
+				// a Scope is itself a Resource ID, so the slashes within it mustn't be encoded
+				SkipUrlEncoding: segment.Type == models.ScopeResourceIDSegmentType,
+			})
+
+		default:
+			return nil, nil, fmt.Errorf("unimplemented Resource ID Segment Type %q", string(segment.Type))
+		}
+
+		components = append(components, fmt.Sprintf("{%s}", segment.Name))
+	}
+
+	output := fmt.Sprintf("/%s", strings.Join(components, "/"))
+	return &output, parameters, nil
+}
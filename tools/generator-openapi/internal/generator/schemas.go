@@ -0,0 +1,309 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package generator
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+// schemaSource defines the set of Constants and Models which References are resolved against - either
+// those within an API Resource, or the Common Types for this API Version.
+type schemaSource struct {
+	// resourceName specifies the name of the API Resource containing these Constants and Models, or is nil
+	// when these are the Common Types.
+	resourceName *string
+
+	constants map[string]models.SDKConstant
+	models    map[string]models.SDKModel
+}
+
+// schemaName returns the name of the Schema within the Components for the Constant/Model named name. Since
+// the same name can be used in multiple API Resources, this is prefixed with the name of the API Resource.
+func (s schemaSource) schemaName(name string) string {
+	if s.resourceName == nil {
+		return fmt.Sprintf("%s.%s", commonTypesSchemaPrefix, name)
+	}
+	return fmt.Sprintf("%s.%s", *s.resourceName, name)
+}
+
+func (s schemaSource) isCommonTypes() bool {
+	return s.resourceName == nil
+}
+
+func referenceTo(schemaName string) *Schema {
+	return &Schema{
+		Ref: fmt.Sprintf("#/components/schemas/%s", schemaName),
+	}
+}
+
+// schemaForConstant returns a Schema representing the Constant as an `enum`, together with the `x-ms-enum`
+// extension so that the names of each value are retained.
+func schemaForConstant(name string, constant models.SDKConstant) (*Schema, error) {
+	schema := Schema{
+		Enum: make([]interface{}, 0),
+		EnumDetails: &EnumDetails{
+			Name:          name,
+			ModelAsString: true,
+			Values:        make([]EnumValue, 0),
+		},
+	}
+	switch constant.Type {
+	case models.FloatSDKConstantType:
+		schema.Type = "number"
+		schema.Format = "double"
+	case models.IntegerSDKConstantType:
+		schema.Type = "integer"
+		schema.Format = "int64"
+	case models.StringSDKConstantType:
+		schema.Type = "string"
+	default:
+		return nil, fmt.Errorf("unimplemented Constant Type %q", constant.Type)
+	}
+
+	keys := make([]string, 0)
+	for key := range constant.Values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		rawValue := constant.Values[key]
+		var value interface{} = rawValue
+		switch constant.Type {
+		case models.FloatSDKConstantType:
+			v, err := strconv.ParseFloat(rawValue, 64)
+			if err != nil {
+				return nil, fmt.Errorf("parsing the value %q for the key %q as a float: %+v", rawValue, key, err)
+			}
+			value = v
+		case models.IntegerSDKConstantType:
+			v, err := strconv.ParseInt(rawValue, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("parsing the value %q for the key %q as an integer: %+v", rawValue, key, err)
+			}
+			value = v
+		}
+
+		schema.Enum = append(schema.Enum, value)
+		schema.EnumDetails.Values = append(schema.EnumDetails.Values, EnumValue{
+			Name:  key,
+			Value: value,
+		})
+	}
+
+	return &schema, nil
+}
+
+// schemaForModel returns a Schema representing the Model. Discriminated Parent Types are output using `oneOf`
+// together with a `discriminator` - and Discriminated Implementations contain the fields of their ancestors,
+// with the Discriminated Value output as a `const`.
+func (b *documentBuilder) schemaForModel(source schemaSource, name string, model models.SDKModel) (*Schema, error) {
+	fields, err := fieldsIncludingAncestors(source, name, model)
+	if err != nil {
+		return nil, err
+	}
+
+	schema := Schema{
+		Type:       "object",
+		Properties: make(map[string]*Schema),
+	}
+	for fieldName, field := range fields {
+		property, err := b.schemaForObjectDefinition(source, field.ObjectDefinition)
+		if err != nil {
+			return nil, fmt.Errorf("building Schema for the Field %q: %+v", fieldName, err)
+		}
+		property.Description = field.Description
+		property.ReadOnly = field.ReadOnly
+
+		if model.DiscriminatedValue != nil && model.FieldNameContainingDiscriminatedValue != nil && fieldName == *model.FieldNameContainingDiscriminatedValue {
+			property.Const = *model.DiscriminatedValue
+		}
+
+		if _, exists := schema.Properties[field.JsonName]; exists {
+			return nil, fmt.Errorf("multiple fields use the JSON Name %q", field.JsonName)
+		}
+		schema.Properties[field.JsonName] = property
+		if field.Required {
+			schema.Required = append(schema.Required, field.JsonName)
+		}
+	}
+	sort.Strings(schema.Required)
+
+	if model.IsDiscriminatedParentType() && model.FieldNameContainingDiscriminatedValue != nil {
+		discriminatorField, ok := fields[*model.FieldNameContainingDiscriminatedValue]
+		if !ok {
+			return nil, fmt.Errorf("the Field %q containing the Discriminated Value was not found", *model.FieldNameContainingDiscriminatedValue)
+		}
+
+		implementationNames := make([]string, 0)
+		for modelName, implementation := range source.models {
+			if implementation.ParentTypeName != nil && *implementation.ParentTypeName == name && implementation.DiscriminatedValue != nil {
+				implementationNames = append(implementationNames, modelName)
+			}
+		}
+		sort.Strings(implementationNames)
+
+		if len(implementationNames) > 0 {
+			schema.Discriminator = &Discriminator{
+				PropertyName: discriminatorField.JsonName,
+				Mapping:      make(map[string]string),
+			}
+			for _, implementationName := range implementationNames {
+				reference := referenceTo(source.schemaName(implementationName))
+				schema.OneOf = append(schema.OneOf, reference)
+				schema.Discriminator.Mapping[*source.models[implementationName].DiscriminatedValue] = reference.Ref
+			}
+		}
+	}
+
+	return &schema, nil
+}
+
+// fieldsIncludingAncestors returns the fields defined on this Model, together with any fields inherited from
+// ancestor Models - where fields defined on a closer ancestor take precedence.
+func fieldsIncludingAncestors(source schemaSource, name string, model models.SDKModel) (map[string]models.SDKField, error) {
+	output := make(map[string]models.SDKField)
+	seen := map[string]struct{}{
+		name: {},
+	}
+	current := model
+	for {
+		for fieldName, field := range current.Fields {
+			if _, exists := output[fieldName]; !exists {
+				output[fieldName] = field
+			}
+		}
+
+		if current.ParentTypeName == nil {
+			break
+		}
+		parentName := *current.ParentTypeName
+		if _, ok := seen[parentName]; ok {
+			break
+		}
+		seen[parentName] = struct{}{}
+
+		parent, ok := source.models[parentName]
+		if !ok {
+			return nil, fmt.Errorf("the Parent Model %q for %q was not found", parentName, name)
+		}
+		current = parent
+	}
+
+	return output, nil
+}
+
+// schemaForObjectDefinition returns a Schema representing the Object Definition - where any References are
+// resolved against source, and Common Types are tracked so that these can be output.
+func (b *documentBuilder) schemaForObjectDefinition(source schemaSource, input models.SDKObjectDefinition) (*Schema, error) {
+	schema, err := b.schemaForObjectDefinitionType(source, input)
+	if err != nil {
+		return nil, err
+	}
+
+	if !input.Nullable {
+		return schema, nil
+	}
+
+	// OpenAPI 3.1 represents nullable values as a union with the `null` type
+	if typeName, ok := schema.Type.(string); ok && schema.Ref == "" {
+		schema.Type = []string{typeName, "null"}
+		return schema, nil
+	}
+	return &Schema{
+		AnyOf: []*Schema{
+			schema,
+			{
+				Type: "null",
+			},
+		},
+	}, nil
+}
+
+func (b *documentBuilder) schemaForObjectDefinitionType(source schemaSource, input models.SDKObjectDefinition) (*Schema, error) {
+	switch input.Type {
+	case models.BooleanSDKObjectDefinitionType:
+		return &Schema{Type: "boolean"}, nil
+
+	case models.CSVSDKObjectDefinitionType, models.LocationSDKObjectDefinitionType, models.StringSDKObjectDefinitionType, models.ZoneSDKObjectDefinitionType:
+		return &Schema{Type: "string"}, nil
+
+	case models.DateTimeSDKObjectDefinitionType:
+		return &Schema{Type: "string", Format: "date-time"}, nil
+
+	case models.FloatSDKObjectDefinitionType:
+		return &Schema{Type: "number", Format: "double"}, nil
+
+	case models.IntegerSDKObjectDefinitionType:
+		return &Schema{Type: "integer", Format: "int64"}, nil
+
+	case models.RawFileSDKObjectDefinitionType:
+		return &Schema{Type: "string", Format: "binary"}, nil
+
+	case models.RawObjectSDKObjectDefinitionType:
+		// an empty Schema allows any value
+		return &Schema{}, nil
+
+	case models.TagsSDKObjectDefinitionType:
+		return &Schema{
+			Type: "object",
+			AdditionalProperties: &Schema{
+				Type: "string",
+			},
+		}, nil
+
+	case models.DictionarySDKObjectDefinitionType, models.ListSDKObjectDefinitionType:
+		if input.NestedItem == nil {
+			return nil, fmt.Errorf("a %s must have a Nested Item", string(input.Type))
+		}
+		nested, err := b.schemaForObjectDefinition(source, *input.NestedItem)
+		if err != nil {
+			return nil, fmt.Errorf("building Schema for the Nested Item: %+v", err)
+		}
+		if input.Type == models.DictionarySDKObjectDefinitionType {
+			return &Schema{
+				Type:                 "object",
+				AdditionalProperties: nested,
+			}, nil
+		}
+		return &Schema{
+			Type:  "array",
+			Items: nested,
+		}, nil
+
+	case models.ReferenceSDKObjectDefinitionType:
+		if input.ReferenceName == nil {
+			return nil, fmt.Errorf("a Reference must have a Reference Name")
+		}
+		return b.schemaForReference(source, *input.ReferenceName, input.ReferenceNameIsCommonType != nil && *input.ReferenceNameIsCommonType)
+	}
+
+	if schema := schemaForCustomObjectDefinitionType(input.Type); schema != nil {
+		return schema, nil
+	}
+
+	return nil, fmt.Errorf("unimplemented Object Definition Type %q", string(input.Type))
+}
+
+// schemaForReference returns a Schema referencing the Constant/Model named referenceName.
+func (b *documentBuilder) schemaForReference(source schemaSource, referenceName string, isCommonType bool) (*Schema, error) {
+	if isCommonType || source.isCommonTypes() {
+		if b.input.CommonTypes.Constants == nil && b.input.CommonTypes.Models == nil {
+			return nil, fmt.Errorf("the Common Type %q was referenced but no Common Types are available for this API Version", referenceName)
+		}
+		b.referencedCommonTypes[referenceName] = struct{}{}
+		return referenceTo(fmt.Sprintf("%s.%s", commonTypesSchemaPrefix, referenceName)), nil
+	}
+
+	_, isConstant := source.constants[referenceName]
+	_, isModel := source.models[referenceName]
+	if !isConstant && !isModel {
+		return nil, fmt.Errorf("the Reference %q was not found", referenceName)
+	}
+	return referenceTo(source.schemaName(referenceName)), nil
+}
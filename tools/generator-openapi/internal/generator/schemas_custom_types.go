@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package generator
+
+import (
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+// schemaForCustomObjectDefinitionType returns a Schema for the Custom Object Definition Types (e.g. Identity and
+// System Data), which are output inline using the `x-pandora-type` extension to retain the type - or nil if
+// this isn't a Custom Object Definition Type.
+func schemaForCustomObjectDefinitionType(input models.SDKObjectDefinitionType) *Schema {
+	var schema *Schema
+	switch input {
+	case models.EdgeZoneSDKObjectDefinitionType:
+		schema = &Schema{
+			Type: "object",
+			Properties: map[string]*Schema{
+				"name": {
+					Type: "string",
+				},
+				"type": {
+					Type: "string",
+					Enum: []interface{}{"EdgeZone"},
+				},
+			},
+		}
+
+	case models.LegacySystemAndUserAssignedIdentityListSDKObjectDefinitionType:
+		schema = identitySchema([]interface{}{"None", "SystemAssigned", "SystemAssigned,UserAssigned", "UserAssigned"}, true, false)
+
+	case models.LegacySystemAndUserAssignedIdentityMapSDKObjectDefinitionType:
+		schema = identitySchema([]interface{}{"None", "SystemAssigned", "SystemAssigned,UserAssigned", "UserAssigned"}, true, true)
+
+	case models.SystemAssignedIdentitySDKObjectDefinitionType:
+		schema = identitySchema([]interface{}{"None", "SystemAssigned"}, false, false)
+
+	case models.SystemAndUserAssignedIdentityListSDKObjectDefinitionType:
+		schema = identitySchema([]interface{}{"None", "SystemAssigned", "SystemAssigned, UserAssigned", "UserAssigned"}, true, false)
+
+	case models.SystemAndUserAssignedIdentityMapSDKObjectDefinitionType:
+		schema = identitySchema([]interface{}{"None", "SystemAssigned", "SystemAssigned, UserAssigned", "UserAssigned"}, true, true)
+
+	case models.SystemOrUserAssignedIdentityListSDKObjectDefinitionType:
+		schema = identitySchema([]interface{}{"None", "SystemAssigned", "UserAssigned"}, true, false)
+
+	case models.SystemOrUserAssignedIdentityMapSDKObjectDefinitionType:
+		schema = identitySchema([]interface{}{"None", "SystemAssigned", "UserAssigned"}, true, true)
+
+	case models.UserAssignedIdentityListSDKObjectDefinitionType:
+		schema = identitySchema([]interface{}{"None", "UserAssigned"}, true, false)
+
+	case models.UserAssignedIdentityMapSDKObjectDefinitionType:
+		schema = identitySchema([]interface{}{"None", "UserAssigned"}, true, true)
+
+	case models.SystemDataSDKObjectDefinitionType:
+		schema = &Schema{
+			Type:     "object",
+			ReadOnly: true,
+			Properties: map[string]*Schema{
+				"createdAt":          {Type: "string", Format: "date-time"},
+				"createdBy":          {Type: "string"},
+				"createdByType":      {Type: "string"},
+				"lastModifiedAt":     {Type: "string", Format: "date-time"},
+				"lastModifiedBy":     {Type: "string"},
+				"lastModifiedByType": {Type: "string"},
+			},
+		}
+
+	case models.ZonesSDKObjectDefinitionType:
+		schema = &Schema{
+			Type: "array",
+			Items: &Schema{
+				Type: "string",
+			},
+		}
+
+	default:
+		return nil
+	}
+
+	schema.PandoraType = string(input)
+	return schema
+}
+
+func identitySchema(types []interface{}, supportsUserAssigned, userAssignedIsMap bool) *Schema {
+	schema := Schema{
+		Type: "object",
+		Properties: map[string]*Schema{
+			"type": {
+				Type: "string",
+				Enum: types,
+			},
+		},
+		Required: []string{"type"},
+	}
+
+	for _, v := range types {
+		if v == "SystemAssigned" {
+			// identities supporting System Assigned return the details of the Service Principal
+			schema.Properties["principalId"] = &Schema{Type: "string", ReadOnly: true}
+			schema.Properties["tenantId"] = &Schema{Type: "string", ReadOnly: true}
+			break
+		}
+	}
+
+	if supportsUserAssigned {
+		if userAssignedIsMap {
+			schema.Properties["userAssignedIdentities"] = &Schema{
+				Type: "object",
+				AdditionalProperties: &Schema{
+					Type: "object",
+					Properties: map[string]*Schema{
+						"clientId":    {Type: "string", ReadOnly: true},
+						"principalId": {Type: "string", ReadOnly: true},
+					},
+				},
+			}
+		} else {
+			schema.Properties["userAssignedIdentities"] = &Schema{
+				Type: "array",
+				Items: &Schema{
+					Type: "string",
+				},
+			}
+		}
+	}
+
+	return &schema
+}
@@ -56,6 +56,9 @@ func (i *GenerateCommand) Run(args []string) int {
 	ctx := context.Background()
 	// no point making this configurable (right now anyway)
 	i.providerPrefix = "azurerm"
+	if i.sourceDataType == models.MicrosoftGraphSourceDataType {
+		i.providerPrefix = "azuread"
+	}
 
 	f := flag.NewFlagSet("generator-terraform", flag.ExitOnError)
 	f.StringVar(&i.apiServerEndpoint, "data-api", "http://localhost:8080", "-data-api=http://localhost:8080")
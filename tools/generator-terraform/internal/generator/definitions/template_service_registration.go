@@ -8,16 +8,27 @@ import (
 	"sort"
 	"strings"
 
+	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
 	"github.com/hashicorp/pandora/tools/generator-terraform/internal/generator/models"
 )
 
 func templateForServiceRegistration(input models.ServiceInput) string {
+	if input.Framework == sdkModels.PluginFrameworkTerraformFrameworkType {
+		return templateForFrameworkServiceRegistration(input)
+	}
+
 	codeForResources := make([]string, 0)
 	for resource := range input.ResourceToApiVersion {
 		codeForResources = append(codeForResources, fmt.Sprintf("%sResource{},", resource))
 	}
 	sort.Strings(codeForResources)
 
+	codeForDataSources := make([]string, 0)
+	for _, dataSource := range input.DataSourceNames {
+		codeForDataSources = append(codeForDataSources, fmt.Sprintf("%sDataSource{},", dataSource))
+	}
+	sort.Strings(codeForDataSources)
+
 	categories := make([]string, 0)
 	for _, v := range input.CategoryNames {
 		categories = append(categories, fmt.Sprintf("%q,", v))
@@ -41,7 +52,9 @@ func (autoRegistration) Name() string {
 }
 
 func (autoRegistration) DataSources() []sdk.DataSource {
-	return []sdk.DataSource{}
+	return []sdk.DataSource{
+		%[6]s
+	}
 }
 
 func (autoRegistration) Resources() []sdk.Resource {
@@ -50,6 +63,58 @@ func (autoRegistration) Resources() []sdk.Resource {
 	}
 }
 
+func (autoRegistration) WebsiteCategories() []string {
+	return []string{
+		%[5]s
+	}
+}
+`, input.ServicePackageName, input.ProviderPrefix, input.ServiceDisplayName, strings.Join(codeForResources, "\n"), strings.Join(categories, "\n"), strings.Join(codeForDataSources, "\n"))
+	return strings.TrimSpace(output)
+}
+
+func templateForFrameworkServiceRegistration(input models.ServiceInput) string {
+	codeForResources := make([]string, 0)
+	for resource := range input.ResourceToApiVersion {
+		codeForResources = append(codeForResources, fmt.Sprintf("New%sResource,", resource))
+	}
+	sort.Strings(codeForResources)
+
+	categories := make([]string, 0)
+	for _, v := range input.CategoryNames {
+		categories = append(categories, fmt.Sprintf("%q,", v))
+	}
+	sort.Strings(categories)
+
+	output := fmt.Sprintf(`
+package %[1]s
+
+// NOTE: this file is generated - manual changes will be overwritten.
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-%[2]s/internal/sdk"
+)
+
+var _ sdk.FrameworkServiceRegistration = autoRegistration{}
+
+type autoRegistration struct {
+}
+
+func (autoRegistration) Name() string {
+	return %[3]q
+}
+
+func (autoRegistration) DataSources() []func() datasource.DataSource {
+	return []func() datasource.DataSource{}
+}
+
+func (autoRegistration) Resources() []func() resource.Resource {
+	return []func() resource.Resource{
+		%[4]s
+	}
+}
+
 func (autoRegistration) WebsiteCategories() []string {
 	return []string{
 		%[5]s
@@ -6,6 +6,7 @@ package definitions
 import (
 	"testing"
 
+	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
 	"github.com/hashicorp/pandora/tools/generator-terraform/internal/generator/models"
 	"github.com/hashicorp/pandora/tools/sdk/testhelpers"
 )
@@ -35,7 +36,8 @@ func (autoRegistration) Name() string {
 }
 
 func (autoRegistration) DataSources() []sdk.DataSource {
-	return []sdk.DataSource{}
+	return []sdk.DataSource{
+	}
 }
 
 func (autoRegistration) Resources() []sdk.Resource {
@@ -57,6 +59,11 @@ func TestTemplateForServiceRegistration(t *testing.T) {
 			"Category3",
 			"Category1",
 		},
+		DataSourceNames: []string{
+			// intentional to check ordering
+			"Third",
+			"First",
+		},
 		ProviderPrefix: "myprovider",
 		ResourceToApiVersion: map[string]string{
 			// intentional to check ordering
@@ -85,7 +92,10 @@ func (autoRegistration) Name() string {
 }
 
 func (autoRegistration) DataSources() []sdk.DataSource {
-	return []sdk.DataSource{}
+	return []sdk.DataSource{
+		FirstDataSource{},
+		ThirdDataSource{},
+	}
 }
 
 func (autoRegistration) Resources() []sdk.Resource {
@@ -105,3 +115,64 @@ func (autoRegistration) WebsiteCategories() []string {
 `
 	testhelpers.AssertTemplatedCodeMatches(t, expected, actual)
 }
+
+func TestTemplateForServiceRegistrationPluginFramework(t *testing.T) {
+	input := models.ServiceInput{
+		CategoryNames: []string{
+			"Category3",
+			"Category1",
+		},
+		DataSourceNames: []string{
+			"First",
+		},
+		Framework:      sdkModels.PluginFrameworkTerraformFrameworkType,
+		ProviderPrefix: "myprovider",
+		ResourceToApiVersion: map[string]string{
+			// intentional to check ordering
+			"Second": "",
+			"First":  "",
+		},
+		ServiceDisplayName: "Awesome Service",
+		ServicePackageName: "mypackage",
+	}
+	actual := templateForServiceRegistration(input)
+	expected := `
+package mypackage
+
+// NOTE: this file is generated - manual changes will be overwritten.
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-myprovider/internal/sdk"
+)
+
+var _ sdk.FrameworkServiceRegistration = autoRegistration{}
+
+type autoRegistration struct {
+}
+
+func (autoRegistration) Name() string {
+	return "Awesome Service"
+}
+
+func (autoRegistration) DataSources() []func() datasource.DataSource {
+	return []func() datasource.DataSource{}
+}
+
+func (autoRegistration) Resources() []func() resource.Resource {
+	return []func() resource.Resource{
+		NewFirstResource,
+		NewSecondResource,
+	}
+}
+
+func (autoRegistration) WebsiteCategories() []string {
+	return []string{
+		"Category1",
+		"Category3",
+	}
+}
+`
+	testhelpers.AssertTemplatedCodeMatches(t, expected, actual)
+}
@@ -5,6 +5,7 @@ package helpers
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 )
 
@@ -30,3 +31,26 @@ func CamelCasedName(input string) string {
 	firstLetter := strings.ToLower(string(input[0]))
 	return firstLetter + input[1:]
 }
+
+// GolangLiteralForValue returns the Go literal for the specified value, which is a bool, float64, int64 or string
+// (for example a Default value or the bounds of a Range).
+func GolangLiteralForValue(input any) (*string, error) {
+	var output string
+	switch v := input.(type) {
+	case bool:
+		output = strconv.FormatBool(v)
+	case float64:
+		output = strconv.FormatFloat(v, 'f', -1, 64)
+		if !strings.ContainsAny(output, ".e") {
+			// ensure this remains a float when used as an untyped constant
+			output = fmt.Sprintf("%s.0", output)
+		}
+	case int64:
+		output = strconv.FormatInt(v, 10)
+	case string:
+		output = strconv.Quote(v)
+	default:
+		return nil, fmt.Errorf("internal-error: unimplemented value type %T", input)
+	}
+	return &output, nil
+}
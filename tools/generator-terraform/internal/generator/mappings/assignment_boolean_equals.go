@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mappings
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+var _ assignmentType = booleanEqualsAssignmentLine{}
+
+// booleanEqualsAssignmentLine maps a Boolean Schema Field to/from a Constant SDK Field, for example `enabled = true`
+// being sent to the API as `"state": "Enabled"`.
+type booleanEqualsAssignmentLine struct{}
+
+func (b booleanEqualsAssignmentLine) assignmentForCreateUpdateMapping(mapping models.TerraformFieldMappingDefinition, schemaModel models.TerraformSchemaModel, sdkModel models.SDKModel, sdkConstant *assignmentConstantDetails, _ string) (*string, error) {
+	booleanEquals, schemaField, sdkField, err := b.fieldsForMapping(mapping, schemaModel, sdkModel)
+	if err != nil {
+		return nil, err
+	}
+	trueValue, falseValue, err := b.constantValuesForMapping(*booleanEquals, sdkConstant)
+	if err != nil {
+		return nil, err
+	}
+
+	if !schemaField.Required && sdkField.Required {
+		// if the SDK Field is Required but the Schema Field is Optional this is a Data Issue
+		return nil, fmt.Errorf("the Sdk Model %q Field %q was Required but Schema Model %q Field %q was Optional but must be Required", booleanEquals.BooleanEquals.SDKModelName, booleanEquals.BooleanEquals.SDKFieldName, booleanEquals.BooleanEquals.TerraformSchemaModelName, booleanEquals.BooleanEquals.TerraformSchemaFieldName)
+	}
+
+	if schemaField.Computed && (!schemaField.Optional && !schemaField.Required) {
+		// Computed-only fields are never sent to the API
+		line := ""
+		return &line, nil
+	}
+
+	trueAssignment := *trueValue
+	falseAssignment := *falseValue
+	if sdkField.Optional {
+		trueAssignment = fmt.Sprintf("pointer.To(%s)", trueAssignment)
+		falseAssignment = fmt.Sprintf("pointer.To(%s)", falseAssignment)
+	}
+
+	line := fmt.Sprintf(`
+output.%[1]s = %[4]s
+if input.%[2]s {
+	output.%[1]s = %[3]s
+}
+`, booleanEquals.BooleanEquals.SDKFieldName, booleanEquals.BooleanEquals.TerraformSchemaFieldName, trueAssignment, falseAssignment)
+	return &line, nil
+}
+
+func (b booleanEqualsAssignmentLine) assignmentForReadMapping(mapping models.TerraformFieldMappingDefinition, schemaModel models.TerraformSchemaModel, sdkModel models.SDKModel, sdkConstant *assignmentConstantDetails, _ string) (*string, error) {
+	booleanEquals, _, sdkField, err := b.fieldsForMapping(mapping, schemaModel, sdkModel)
+	if err != nil {
+		return nil, err
+	}
+	trueValue, _, err := b.constantValuesForMapping(*booleanEquals, sdkConstant)
+	if err != nil {
+		return nil, err
+	}
+
+	line := fmt.Sprintf("output.%[1]s = input.%[2]s == %[3]s", booleanEquals.BooleanEquals.TerraformSchemaFieldName, booleanEquals.BooleanEquals.SDKFieldName, *trueValue)
+	if sdkField.Optional {
+		line = fmt.Sprintf("output.%[1]s = pointer.From(input.%[2]s) == %[3]s", booleanEquals.BooleanEquals.TerraformSchemaFieldName, booleanEquals.BooleanEquals.SDKFieldName, *trueValue)
+	}
+	return &line, nil
+}
+
+func (b booleanEqualsAssignmentLine) fieldsForMapping(mapping models.TerraformFieldMappingDefinition, schemaModel models.TerraformSchemaModel, sdkModel models.SDKModel) (*models.TerraformBooleanEqualsFieldMappingDefinition, *models.TerraformSchemaField, *models.SDKField, error) {
+	booleanEquals, ok := mapping.(models.TerraformBooleanEqualsFieldMappingDefinition)
+	if !ok {
+		return nil, nil, nil, fmt.Errorf("internal-error: expected a BooleanEquals mapping but got %+v", mapping)
+	}
+
+	schemaField, ok := schemaModel.Fields[booleanEquals.BooleanEquals.TerraformSchemaFieldName]
+	if !ok {
+		return nil, nil, nil, fmt.Errorf("the Field %q for Schema Model %q was not found", booleanEquals.BooleanEquals.TerraformSchemaFieldName, booleanEquals.BooleanEquals.TerraformSchemaModelName)
+	}
+	if schemaField.ObjectDefinition.Type != models.BooleanTerraformSchemaObjectDefinitionType {
+		return nil, nil, nil, fmt.Errorf("a BooleanEquals mapping must be from a Boolean Schema Field but got %q", string(schemaField.ObjectDefinition.Type))
+	}
+
+	sdkField, ok := sdkModel.Fields[booleanEquals.BooleanEquals.SDKFieldName]
+	if !ok {
+		return nil, nil, nil, fmt.Errorf("the Field %q for SDK Model %q was not found", booleanEquals.BooleanEquals.SDKFieldName, booleanEquals.BooleanEquals.SDKModelName)
+	}
+
+	return &booleanEquals, &schemaField, &sdkField, nil
+}
+
+// constantValuesForMapping returns the Golang names of the SDK Constant values used for `true` and `false`.
+func (b booleanEqualsAssignmentLine) constantValuesForMapping(mapping models.TerraformBooleanEqualsFieldMappingDefinition, sdkConstant *assignmentConstantDetails) (*string, *string, error) {
+	if sdkConstant == nil {
+		return nil, nil, fmt.Errorf("a BooleanEquals mapping must be to a Constant but the SDK Model %q Field %q isn't a Constant", mapping.BooleanEquals.SDKModelName, mapping.BooleanEquals.SDKFieldName)
+	}
+
+	trueKey := constantKeyForValue(sdkConstant.constantDetails, mapping.BooleanEquals.TrueValue)
+	if trueKey == nil {
+		return nil, nil, fmt.Errorf("the value %q was not found in the Constant %q", mapping.BooleanEquals.TrueValue, sdkConstant.constantName)
+	}
+	falseKey := constantKeyForValue(sdkConstant.constantDetails, mapping.BooleanEquals.FalseValue)
+	if falseKey == nil {
+		return nil, nil, fmt.Errorf("the value %q was not found in the Constant %q", mapping.BooleanEquals.FalseValue, sdkConstant.constantName)
+	}
+
+	trueValue := fmt.Sprintf("%s.%s%s", sdkConstant.apiResourcePackageName, sdkConstant.constantName, *trueKey)
+	falseValue := fmt.Sprintf("%s.%s%s", sdkConstant.apiResourcePackageName, sdkConstant.constantName, *falseKey)
+	return &trueValue, &falseValue, nil
+}
+
+// constantKeyForValue returns the (first, sorted) key within the SDK Constant which has the specified value.
+func constantKeyForValue(input models.SDKConstant, value string) *string {
+	keys := make([]string, 0)
+	for key := range input.Values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if input.Values[key] == value {
+			return &key
+		}
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mappings
+
+import (
+	"testing"
+
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	"github.com/hashicorp/pandora/tools/sdk/testhelpers"
+)
+
+func TestBooleanEquals(t *testing.T) {
+	mapping := models.TerraformBooleanEqualsFieldMappingDefinition{
+		BooleanEquals: models.TerraformBooleanEqualsFieldMappingDefinitionImpl{
+			SDKFieldName:             "ToPath",
+			SDKModelName:             "ToModel",
+			TerraformSchemaFieldName: "FromPath",
+			TerraformSchemaModelName: "FromModel",
+			TrueValue:                "Enabled",
+			FalseValue:               "Disabled",
+		},
+	}
+	constant := assignmentConstantDetails{
+		apiResourcePackageName: "sdkresource",
+		constantName:           "State",
+		constantDetails: models.SDKConstant{
+			Type: models.StringSDKConstantType,
+			Values: map[string]string{
+				"Disabled": "Disabled",
+				"Enabled":  "Enabled",
+			},
+		},
+	}
+	testData := []struct {
+		name                string
+		schemaFieldRequired bool
+		sdkFieldRequired    bool
+		expectedCreate      string
+		expectedRead        string
+		expectError         bool
+	}{
+		{
+			name:                "Required to Required",
+			schemaFieldRequired: true,
+			sdkFieldRequired:    true,
+			expectedCreate: `
+output.ToPath = sdkresource.StateDisabled
+if input.FromPath {
+	output.ToPath = sdkresource.StateEnabled
+}
+`,
+			expectedRead: "output.FromPath = input.ToPath == sdkresource.StateEnabled",
+		},
+		{
+			name:                "Optional to Optional",
+			schemaFieldRequired: false,
+			sdkFieldRequired:    false,
+			expectedCreate: `
+output.ToPath = pointer.To(sdkresource.StateDisabled)
+if input.FromPath {
+	output.ToPath = pointer.To(sdkresource.StateEnabled)
+}
+`,
+			expectedRead: "output.FromPath = pointer.From(input.ToPath) == sdkresource.StateEnabled",
+		},
+		{
+			name:                "Optional to Required",
+			schemaFieldRequired: false,
+			sdkFieldRequired:    true,
+			expectError:         true,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("Test %q", v.name)
+		schemaModel := models.TerraformSchemaModel{
+			Fields: map[string]models.TerraformSchemaField{
+				"FromPath": {
+					ObjectDefinition: models.TerraformSchemaObjectDefinition{
+						Type: models.BooleanTerraformSchemaObjectDefinitionType,
+					},
+					Required: v.schemaFieldRequired,
+					Optional: !v.schemaFieldRequired,
+				},
+			},
+		}
+		sdkModel := models.SDKModel{
+			Fields: map[string]models.SDKField{
+				"ToPath": {
+					ObjectDefinition: models.SDKObjectDefinition{
+						Type: models.ReferenceSDKObjectDefinitionType,
+					},
+					Required: v.sdkFieldRequired,
+					Optional: !v.sdkFieldRequired,
+				},
+			},
+		}
+
+		actualCreate, err := booleanEqualsAssignmentLine{}.assignmentForCreateUpdateMapping(mapping, schemaModel, sdkModel, &constant, "sdkresource")
+		if v.expectError {
+			if err == nil {
+				t.Fatalf("expected an error but didn't get one")
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		testhelpers.AssertTemplatedCodeMatches(t, v.expectedCreate, *actualCreate)
+
+		actualRead, err := booleanEqualsAssignmentLine{}.assignmentForReadMapping(mapping, schemaModel, sdkModel, &constant, "sdkresource")
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		testhelpers.AssertTemplatedCodeMatches(t, v.expectedRead, *actualRead)
+	}
+}
+
+func TestBooleanEquals_ValueNotInConstant(t *testing.T) {
+	mapping := models.TerraformBooleanEqualsFieldMappingDefinition{
+		BooleanEquals: models.TerraformBooleanEqualsFieldMappingDefinitionImpl{
+			SDKFieldName:             "ToPath",
+			SDKModelName:             "ToModel",
+			TerraformSchemaFieldName: "FromPath",
+			TerraformSchemaModelName: "FromModel",
+			TrueValue:                "On",
+			FalseValue:               "Off",
+		},
+	}
+	constant := assignmentConstantDetails{
+		apiResourcePackageName: "sdkresource",
+		constantName:           "State",
+		constantDetails: models.SDKConstant{
+			Type: models.StringSDKConstantType,
+			Values: map[string]string{
+				"Disabled": "Disabled",
+				"Enabled":  "Enabled",
+			},
+		},
+	}
+	schemaModel := models.TerraformSchemaModel{
+		Fields: map[string]models.TerraformSchemaField{
+			"FromPath": {
+				ObjectDefinition: models.TerraformSchemaObjectDefinition{
+					Type: models.BooleanTerraformSchemaObjectDefinitionType,
+				},
+				Required: true,
+			},
+		},
+	}
+	sdkModel := models.SDKModel{
+		Fields: map[string]models.SDKField{
+			"ToPath": {
+				ObjectDefinition: models.SDKObjectDefinition{
+					Type: models.ReferenceSDKObjectDefinitionType,
+				},
+				Required: true,
+			},
+		},
+	}
+	if _, err := (booleanEqualsAssignmentLine{}).assignmentForCreateUpdateMapping(mapping, schemaModel, sdkModel, &constant, "sdkresource"); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+	if _, err := (booleanEqualsAssignmentLine{}).assignmentForReadMapping(mapping, schemaModel, sdkModel, nil, "sdkresource"); err == nil {
+		t.Fatalf("expected an error when the SDK Field isn't a Constant but didn't get one")
+	}
+}
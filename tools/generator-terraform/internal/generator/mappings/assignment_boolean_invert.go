@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mappings
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+var _ assignmentType = booleanInvertAssignmentLine{}
+
+// booleanInvertAssignmentLine maps a Boolean Schema Field to/from the inverse of a Boolean SDK Field, for example
+// `public_network_access_enabled = true` being sent to the API as `"disablePublicNetworkAccess": false`.
+type booleanInvertAssignmentLine struct{}
+
+func (b booleanInvertAssignmentLine) assignmentForCreateUpdateMapping(mapping models.TerraformFieldMappingDefinition, schemaModel models.TerraformSchemaModel, sdkModel models.SDKModel, _ *assignmentConstantDetails, _ string) (*string, error) {
+	booleanInvert, schemaField, sdkField, err := b.fieldsForMapping(mapping, schemaModel, sdkModel)
+	if err != nil {
+		return nil, err
+	}
+
+	if !schemaField.Required && sdkField.Required {
+		// if the SDK Field is Required but the Schema Field is Optional this is a Data Issue
+		return nil, fmt.Errorf("the Sdk Model %q Field %q was Required but Schema Model %q Field %q was Optional but must be Required", booleanInvert.BooleanInvert.SDKModelName, booleanInvert.BooleanInvert.SDKFieldName, booleanInvert.BooleanInvert.TerraformSchemaModelName, booleanInvert.BooleanInvert.TerraformSchemaFieldName)
+	}
+
+	if schemaField.Computed && (!schemaField.Optional && !schemaField.Required) {
+		// Computed-only fields are never sent to the API
+		line := ""
+		return &line, nil
+	}
+
+	line := fmt.Sprintf("output.%[1]s = !input.%[2]s", booleanInvert.BooleanInvert.SDKFieldName, booleanInvert.BooleanInvert.TerraformSchemaFieldName)
+	if sdkField.Optional {
+		line = fmt.Sprintf("output.%[1]s = pointer.To(!input.%[2]s)", booleanInvert.BooleanInvert.SDKFieldName, booleanInvert.BooleanInvert.TerraformSchemaFieldName)
+	}
+	return &line, nil
+}
+
+func (b booleanInvertAssignmentLine) assignmentForReadMapping(mapping models.TerraformFieldMappingDefinition, schemaModel models.TerraformSchemaModel, sdkModel models.SDKModel, _ *assignmentConstantDetails, _ string) (*string, error) {
+	booleanInvert, _, sdkField, err := b.fieldsForMapping(mapping, schemaModel, sdkModel)
+	if err != nil {
+		return nil, err
+	}
+
+	line := fmt.Sprintf("output.%[1]s = !input.%[2]s", booleanInvert.BooleanInvert.TerraformSchemaFieldName, booleanInvert.BooleanInvert.SDKFieldName)
+	if sdkField.Optional {
+		// when the API doesn't return a value it's treated as `false`, so the Schema Field is `true`
+		line = fmt.Sprintf("output.%[1]s = !pointer.From(input.%[2]s)", booleanInvert.BooleanInvert.TerraformSchemaFieldName, booleanInvert.BooleanInvert.SDKFieldName)
+	}
+	return &line, nil
+}
+
+func (b booleanInvertAssignmentLine) fieldsForMapping(mapping models.TerraformFieldMappingDefinition, schemaModel models.TerraformSchemaModel, sdkModel models.SDKModel) (*models.TerraformBooleanInvertFieldMappingDefinition, *models.TerraformSchemaField, *models.SDKField, error) {
+	booleanInvert, ok := mapping.(models.TerraformBooleanInvertFieldMappingDefinition)
+	if !ok {
+		return nil, nil, nil, fmt.Errorf("internal-error: expected a BooleanInvert mapping but got %+v", mapping)
+	}
+
+	schemaField, ok := schemaModel.Fields[booleanInvert.BooleanInvert.TerraformSchemaFieldName]
+	if !ok {
+		return nil, nil, nil, fmt.Errorf("the Field %q for Schema Model %q was not found", booleanInvert.BooleanInvert.TerraformSchemaFieldName, booleanInvert.BooleanInvert.TerraformSchemaModelName)
+	}
+	if schemaField.ObjectDefinition.Type != models.BooleanTerraformSchemaObjectDefinitionType {
+		return nil, nil, nil, fmt.Errorf("a BooleanInvert mapping must be from a Boolean Schema Field but got %q", string(schemaField.ObjectDefinition.Type))
+	}
+
+	sdkField, ok := sdkModel.Fields[booleanInvert.BooleanInvert.SDKFieldName]
+	if !ok {
+		return nil, nil, nil, fmt.Errorf("the Field %q for SDK Model %q was not found", booleanInvert.BooleanInvert.SDKFieldName, booleanInvert.BooleanInvert.SDKModelName)
+	}
+	if sdkField.ObjectDefinition.Type != models.BooleanSDKObjectDefinitionType {
+		return nil, nil, nil, fmt.Errorf("a BooleanInvert mapping must be to a Boolean SDK Field but got %q", string(sdkField.ObjectDefinition.Type))
+	}
+
+	return &booleanInvert, &schemaField, &sdkField, nil
+}
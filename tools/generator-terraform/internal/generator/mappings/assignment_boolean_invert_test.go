@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mappings
+
+import (
+	"testing"
+
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	"github.com/hashicorp/pandora/tools/sdk/testhelpers"
+)
+
+func TestBooleanInvert(t *testing.T) {
+	mapping := models.TerraformBooleanInvertFieldMappingDefinition{
+		BooleanInvert: models.TerraformBooleanInvertFieldMappingDefinitionImpl{
+			SDKFieldName:             "ToPath",
+			SDKModelName:             "ToModel",
+			TerraformSchemaFieldName: "FromPath",
+			TerraformSchemaModelName: "FromModel",
+		},
+	}
+	testData := []struct {
+		name                string
+		schemaFieldRequired bool
+		sdkFieldRequired    bool
+		expectedCreate      string
+		expectedRead        string
+		expectError         bool
+	}{
+		{
+			name:                "Required to Required",
+			schemaFieldRequired: true,
+			sdkFieldRequired:    true,
+			expectedCreate:      "output.ToPath = !input.FromPath",
+			expectedRead:        "output.FromPath = !input.ToPath",
+		},
+		{
+			name:                "Required to Optional",
+			schemaFieldRequired: true,
+			sdkFieldRequired:    false,
+			expectedCreate:      "output.ToPath = pointer.To(!input.FromPath)",
+			expectedRead:        "output.FromPath = !pointer.From(input.ToPath)",
+		},
+		{
+			name:                "Optional to Optional",
+			schemaFieldRequired: false,
+			sdkFieldRequired:    false,
+			expectedCreate:      "output.ToPath = pointer.To(!input.FromPath)",
+			expectedRead:        "output.FromPath = !pointer.From(input.ToPath)",
+		},
+		{
+			name:                "Optional to Required",
+			schemaFieldRequired: false,
+			sdkFieldRequired:    true,
+			expectError:         true,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("Test %q", v.name)
+		schemaModel := models.TerraformSchemaModel{
+			Fields: map[string]models.TerraformSchemaField{
+				"FromPath": {
+					ObjectDefinition: models.TerraformSchemaObjectDefinition{
+						Type: models.BooleanTerraformSchemaObjectDefinitionType,
+					},
+					Required: v.schemaFieldRequired,
+					Optional: !v.schemaFieldRequired,
+				},
+			},
+		}
+		sdkModel := models.SDKModel{
+			Fields: map[string]models.SDKField{
+				"ToPath": {
+					ObjectDefinition: models.SDKObjectDefinition{
+						Type: models.BooleanSDKObjectDefinitionType,
+					},
+					Required: v.sdkFieldRequired,
+					Optional: !v.sdkFieldRequired,
+				},
+			},
+		}
+
+		actualCreate, err := booleanInvertAssignmentLine{}.assignmentForCreateUpdateMapping(mapping, schemaModel, sdkModel, nil, "sdkresource")
+		if v.expectError {
+			if err == nil {
+				t.Fatalf("expected an error but didn't get one")
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		testhelpers.AssertTemplatedCodeMatches(t, v.expectedCreate, *actualCreate)
+
+		actualRead, err := booleanInvertAssignmentLine{}.assignmentForReadMapping(mapping, schemaModel, sdkModel, nil, "sdkresource")
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		testhelpers.AssertTemplatedCodeMatches(t, v.expectedRead, *actualRead)
+	}
+}
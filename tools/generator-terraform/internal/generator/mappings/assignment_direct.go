@@ -167,6 +167,10 @@ output.%[1]s = pointer.To(%[2]s(input.%[3]s))
 		return nil, fmt.Errorf("expected a DirectAssignment between %q and %q but got %q", string(schemaField.ObjectDefinition.Type), string(v), string(sdkField.ObjectDefinition.Type))
 	}
 
+	if sdkField.ObjectDefinition.Nullable {
+		return d.schemaToSdkMappingBetweenNullableFields(mapping, schemaField, sdkField)
+	}
+
 	if schemaField.Required {
 		line := fmt.Sprintf("output.%[1]s = input.%[2]s", mapping.DirectAssignment.SDKFieldName, mapping.DirectAssignment.TerraformSchemaFieldName)
 		if sdkField.Optional {
@@ -192,6 +196,30 @@ output.%[1]s = pointer.To(%[2]s(input.%[3]s))
 	return &line, nil
 }
 
+// schemaToSdkMappingBetweenNullableFields maps a Schema Field into a Nullable SDK Field (e.g. `nullable.Type[string]`),
+// as used for fields which can be explicitly set to `null` within Microsoft Graph.
+func (d directAssignmentLine) schemaToSdkMappingBetweenNullableFields(mapping models.TerraformDirectAssignmentFieldMappingDefinition, schemaField models.TerraformSchemaField, sdkField models.SDKField) (*string, error) {
+	if schemaField.Required {
+		line := fmt.Sprintf("output.%[1]s = nullable.Value(input.%[2]s)", mapping.DirectAssignment.SDKFieldName, mapping.DirectAssignment.TerraformSchemaFieldName)
+		return &line, nil
+	}
+
+	if sdkField.Required {
+		// if the SDK Field is Required but the Schema Field is Optional this is a Data Issue
+		return nil, fmt.Errorf("the Sdk Model %q Field %q was Required but Schema Model %q Field %q was Optional but must be Required", mapping.DirectAssignment.SDKModelName, mapping.DirectAssignment.SDKFieldName, mapping.DirectAssignment.TerraformSchemaModelName, mapping.DirectAssignment.TerraformSchemaFieldName)
+	}
+
+	if schemaField.Computed && !schemaField.Optional {
+		// Computed-only fields are never sent to the API
+		line := ""
+		return &line, nil
+	}
+
+	// optional -> optional, where the zero value is sent as `null`
+	line := fmt.Sprintf("output.%[1]s = nullable.NoZero(input.%[2]s)", mapping.DirectAssignment.SDKFieldName, mapping.DirectAssignment.TerraformSchemaFieldName)
+	return &line, nil
+}
+
 func (d directAssignmentLine) schemaToSdkMappingBetweenListFields(mapping models.TerraformDirectAssignmentFieldMappingDefinition, schemaField models.TerraformSchemaField, sdkField models.SDKField, sdkConstant *assignmentConstantDetails, apiResourcePackageName string) (*string, error) {
 	if sdkConstant != nil {
 		sdkConstantTypeName := fmt.Sprintf("%s.%s", sdkConstant.apiResourcePackageName, sdkConstant.constantName)
@@ -491,6 +519,12 @@ func (d directAssignmentLine) sdkToSchemaMappingBetweenFields(mapping models.Ter
 		return nil, fmt.Errorf("expected a DirectAssignment between %q and %q but got %q", string(schemaField.ObjectDefinition.Type), string(v), string(sdkField.ObjectDefinition.Type))
 	}
 
+	if sdkField.ObjectDefinition.Nullable {
+		// a null value is returned as the zero value, which matches the behaviour of an unset Schema Field
+		line := fmt.Sprintf("output.%[1]s = input.%[2]s.GetOrZero()", mapping.DirectAssignment.TerraformSchemaFieldName, mapping.DirectAssignment.SDKFieldName)
+		return &line, nil
+	}
+
 	if schemaField.Required {
 		line := fmt.Sprintf("output.%[1]s = input.%[2]s", mapping.DirectAssignment.TerraformSchemaFieldName, mapping.DirectAssignment.SDKFieldName)
 		if sdkField.Optional {
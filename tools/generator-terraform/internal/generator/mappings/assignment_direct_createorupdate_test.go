@@ -1771,3 +1771,81 @@ func TestDirectAssignment_CreateOrUpdate_Model_OptionalToOptional_ListOfReferenc
 `
 	testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
 }
+
+func TestDirectAssignment_CreateOrUpdate_Model_NullableSimpleTypes(t *testing.T) {
+	// when mapping into a Nullable SDK Field (e.g. `nullable.Type[string]`), as used within Microsoft Graph
+	testData := []struct {
+		schemaFieldRequired bool
+		sdkFieldRequired    bool
+		expected            string
+		expectError         bool
+	}{
+		{
+			schemaFieldRequired: true,
+			sdkFieldRequired:    true,
+			expected:            "output.ToPath = nullable.Value(input.FromPath)",
+		},
+		{
+			schemaFieldRequired: true,
+			sdkFieldRequired:    false,
+			expected:            "output.ToPath = nullable.Value(input.FromPath)",
+		},
+		{
+			schemaFieldRequired: false,
+			sdkFieldRequired:    false,
+			expected:            "output.ToPath = nullable.NoZero(input.FromPath)",
+		},
+		{
+			schemaFieldRequired: false,
+			sdkFieldRequired:    true,
+			expectError:         true,
+		},
+	}
+	for i, v := range testData {
+		t.Logf("Test %d - Schema Field Required %t / SDK Field Required %t", i, v.schemaFieldRequired, v.sdkFieldRequired)
+		mapping := models.TerraformDirectAssignmentFieldMappingDefinition{
+			DirectAssignment: models.TerraformDirectAssignmentFieldMappingDefinitionImpl{
+				SDKFieldName:             "ToPath",
+				SDKModelName:             "ToModel",
+				TerraformSchemaFieldName: "FromPath",
+				TerraformSchemaModelName: "FromModel",
+			},
+		}
+		schemaModel := models.TerraformSchemaModel{
+			Fields: map[string]models.TerraformSchemaField{
+				"FromPath": {
+					ObjectDefinition: models.TerraformSchemaObjectDefinition{
+						Type: models.StringTerraformSchemaObjectDefinitionType,
+					},
+					HCLName:  "from_path",
+					Optional: !v.schemaFieldRequired,
+					Required: v.schemaFieldRequired,
+				},
+			},
+		}
+		sdkModel := models.SDKModel{
+			Fields: map[string]models.SDKField{
+				"ToPath": {
+					JsonName: "toPath",
+					ObjectDefinition: models.SDKObjectDefinition{
+						Nullable: true,
+						Type:     models.StringSDKObjectDefinitionType,
+					},
+					Optional: !v.sdkFieldRequired,
+					Required: v.sdkFieldRequired,
+				},
+			},
+		}
+		actual, err := directAssignmentLine{}.assignmentForCreateUpdateMapping(mapping, schemaModel, sdkModel, nil, "sdkresource")
+		if v.expectError {
+			if err == nil {
+				t.Fatalf("expected an error but didn't get one")
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("retrieving create/update assignment mapping: %+v", err)
+		}
+		testhelpers.AssertTemplatedCodeMatches(t, v.expected, *actual)
+	}
+}
@@ -1778,3 +1778,49 @@ func TestDirectAssignment_Read_Model_OptionalToOptional_ListOfReference(t *testi
 `
 	testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
 }
+
+func TestDirectAssignment_Read_Model_NullableSimpleTypes(t *testing.T) {
+	// when mapping from a Nullable SDK Field (e.g. `nullable.Type[string]`), as used within Microsoft Graph
+	for _, schemaFieldRequired := range []bool{true, false} {
+		t.Logf("Test - Schema Field Required %t", schemaFieldRequired)
+		mapping := models.TerraformDirectAssignmentFieldMappingDefinition{
+			DirectAssignment: models.TerraformDirectAssignmentFieldMappingDefinitionImpl{
+				SDKFieldName:             "FromPath",
+				SDKModelName:             "FromModel",
+				TerraformSchemaFieldName: "ToPath",
+				TerraformSchemaModelName: "ToModel",
+			},
+		}
+		schemaModel := models.TerraformSchemaModel{
+			Fields: map[string]models.TerraformSchemaField{
+				"ToPath": {
+					ObjectDefinition: models.TerraformSchemaObjectDefinition{
+						Type: models.StringTerraformSchemaObjectDefinitionType,
+					},
+					HCLName:  "to_path",
+					Optional: !schemaFieldRequired,
+					Required: schemaFieldRequired,
+				},
+			},
+		}
+		sdkModel := models.SDKModel{
+			Fields: map[string]models.SDKField{
+				"FromPath": {
+					JsonName: "fromPath",
+					ObjectDefinition: models.SDKObjectDefinition{
+						Nullable: true,
+						Type:     models.StringSDKObjectDefinitionType,
+					},
+					Optional: !schemaFieldRequired,
+					Required: schemaFieldRequired,
+				},
+			},
+		}
+		actual, err := directAssignmentLine{}.assignmentForReadMapping(mapping, schemaModel, sdkModel, nil, "sdkresource")
+		if err != nil {
+			t.Fatalf("retrieving read assignment mapping: %+v", err)
+		}
+		expected := "output.ToPath = input.FromPath.GetOrZero()"
+		testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
+	}
+}
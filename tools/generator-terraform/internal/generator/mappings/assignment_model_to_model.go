@@ -13,6 +13,9 @@ import (
 var _ assignmentType = modelToModelAssignmentLine{}
 
 type modelToModelAssignmentLine struct {
+	// usesMicrosoftGraphEndpoint specifies whether the Microsoft Graph endpoint should be passed to the nested
+	// Schema to SDK mapping function (see UsesMicrosoftGraphEndpoint).
+	usesMicrosoftGraphEndpoint bool
 }
 
 func (m modelToModelAssignmentLine) assignmentForCreateUpdateMapping(mapping models.TerraformFieldMappingDefinition, _ models.TerraformSchemaModel, sdkModel models.SDKModel, _ *assignmentConstantDetails, apiResourcePackageName string) (*string, error) {
@@ -34,21 +37,26 @@ func (m modelToModelAssignmentLine) assignmentForCreateUpdateMapping(mapping mod
 		return nil, fmt.Errorf("determining Golang Type Name for SDK Field: %+v", err)
 	}
 
+	additionalArguments := ""
+	if m.usesMicrosoftGraphEndpoint {
+		additionalArguments = fmt.Sprintf(", %s", MicrosoftGraphEndpointArgumentName)
+	}
+
 	// the variable `output` is a pointer here, so we don't need to pass it by reference
 	output := fmt.Sprintf(`
-		if err := r.map%[1]sTo%[2]s(input, &output.%[3]s); err != nil {
+		if err := r.map%[1]sTo%[2]s(input, &output.%[3]s%[5]s); err != nil {
 			return fmt.Errorf("mapping Schema to SDK Field %%q / Model %%q: %%+v", %[2]q, %[3]q, err)
 		}
-`, modelToModel.ModelToModel.TerraformSchemaModelName, outputModelName, modelToModel.ModelToModel.SDKFieldName)
+`, modelToModel.ModelToModel.TerraformSchemaModelName, outputModelName, modelToModel.ModelToModel.SDKFieldName, *sdkFieldType, additionalArguments)
 	if sdkField.Optional {
 		output = fmt.Sprintf(`
 		if output.%[3]s == nil {
 			output.%[3]s = &%[4]s{}
 		}
-		if err := r.map%[1]sTo%[2]s(input, output.%[3]s); err != nil {
+		if err := r.map%[1]sTo%[2]s(input, output.%[3]s%[5]s); err != nil {
 			return fmt.Errorf("mapping Schema to SDK Field %%q / Model %%q: %%+v", %[2]q, %[3]q, err)
 		}
-`, modelToModel.ModelToModel.TerraformSchemaModelName, outputModelName, modelToModel.ModelToModel.SDKFieldName, *sdkFieldType)
+`, modelToModel.ModelToModel.TerraformSchemaModelName, outputModelName, modelToModel.ModelToModel.SDKFieldName, *sdkFieldType, additionalArguments)
 	}
 	return &output, nil
 }
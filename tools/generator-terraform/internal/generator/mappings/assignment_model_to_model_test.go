@@ -90,6 +90,46 @@ func TestModelToModelMapping_SchemaToSdk_Optional_HappyPath(t *testing.T) {
 	testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
 }
 
+func TestModelToModelMapping_SchemaToSdk_UsesMicrosoftGraphEndpoint(t *testing.T) {
+	mapping := models.TerraformModelToModelFieldMappingDefinition{
+		ModelToModel: models.TerraformModelToModelFieldMappingDefinitionImpl{
+			SDKFieldName:             "SomeSdkField",
+			SDKModelName:             "TheSdkModel",
+			TerraformSchemaModelName: "TheSchemaModel",
+		},
+	}
+	schemaModel := models.TerraformSchemaModel{
+		// not used for this one, just a placeholder
+	}
+	sdkModel := models.SDKModel{
+		Fields: map[string]models.SDKField{
+			"SomeSdkField": {
+				JsonName: "someSdkField",
+				ObjectDefinition: models.SDKObjectDefinition{
+					Type:          models.ReferenceSDKObjectDefinitionType,
+					ReferenceName: pointer.To("SomeOtherModel"),
+				},
+				Required: true,
+			},
+		},
+	}
+	actual, err := modelToModelAssignmentLine{
+		usesMicrosoftGraphEndpoint: true,
+	}.assignmentForCreateUpdateMapping(mapping, schemaModel, sdkModel, nil, "someresource")
+	if err != nil {
+		t.Fatalf("expected no error but got %+v", err)
+	}
+	if actual == nil {
+		t.Fatalf("expected `actual` to have a value but was nil")
+	}
+	expected := `
+        if err := r.mapTheSchemaModelToSomeOtherModel(input, &output.SomeSdkField, microsoftGraphEndpoint); err != nil {
+        	return fmt.Errorf("mapping Schema to SDK Field %q / Model %q: %+v", "SomeOtherModel", "SomeSdkField", err)
+        }
+`
+	testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
+}
+
 func TestModelToModelMapping_SchemaToSdk_WrongType(t *testing.T) {
 	mapping := models.TerraformDirectAssignmentFieldMappingDefinition{
 		DirectAssignment: models.TerraformDirectAssignmentFieldMappingDefinitionImpl{
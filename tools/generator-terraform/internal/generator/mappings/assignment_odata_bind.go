@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mappings
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	generatorHelpers "github.com/hashicorp/pandora/tools/generator-terraform/internal/generator/helpers"
+)
+
+var _ assignmentType = oDataBindAssignmentLine{}
+
+// oDataBindAssignmentLine maps a Schema Field containing the ID(s) of related entities into an `@odata.bind` SDK Field
+// within Microsoft Graph, which references each entity by its URI - for example `owners = ["00000000-..."]` being
+// sent to the API as `"owners@odata.bind": ["https://graph.microsoft.com/v1.0/directoryObjects/00000000-..."]`.
+//
+// The Microsoft Graph endpoint is configured in the Provider (to support National Clouds), so is passed into the
+// Schema to SDK mapping functions as MicrosoftGraphEndpointArgumentName - whereas the version segment is determined
+// from the API Version used for this Terraform Resource.
+type oDataBindAssignmentLine struct {
+	apiVersion string
+}
+
+// MicrosoftGraphEndpointArgumentName is the name of the argument containing the Microsoft Graph endpoint, which is
+// passed to the Schema to SDK mapping functions when UsesMicrosoftGraphEndpoint is true.
+const MicrosoftGraphEndpointArgumentName = "microsoftGraphEndpoint"
+
+// microsoftGraphUriVersions is a map of the Microsoft Graph API Version (key) to the version segment used in URIs (value).
+var microsoftGraphUriVersions = map[string]string{
+	"beta":   "beta",
+	"stable": "v1.0",
+}
+
+// UsesMicrosoftGraphEndpoint returns whether the Schema to SDK mapping functions for this Terraform Resource require
+// the Microsoft Graph endpoint, which is the case when any ODataBind mappings are defined.
+func UsesMicrosoftGraphEndpoint(input models.TerraformMappingDefinition) bool {
+	for _, mapping := range input.Fields {
+		if _, ok := mapping.(models.TerraformODataBindFieldMappingDefinition); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (o oDataBindAssignmentLine) assignmentForCreateUpdateMapping(mapping models.TerraformFieldMappingDefinition, schemaModel models.TerraformSchemaModel, sdkModel models.SDKModel, _ *assignmentConstantDetails, _ string) (*string, error) {
+	oDataBind, schemaField, sdkField, err := o.fieldsForMapping(mapping, schemaModel, sdkModel)
+	if err != nil {
+		return nil, err
+	}
+
+	if !schemaField.Required && sdkField.Required {
+		// if the SDK Field is Required but the Schema Field is Optional this is a Data Issue
+		return nil, fmt.Errorf("the Sdk Model %q Field %q was Required but Schema Model %q Field %q was Optional but must be Required", oDataBind.ODataBind.SDKModelName, oDataBind.ODataBind.SDKFieldName, oDataBind.ODataBind.TerraformSchemaModelName, oDataBind.ODataBind.TerraformSchemaFieldName)
+	}
+
+	uriVersion, ok := microsoftGraphUriVersions[o.apiVersion]
+	if !ok {
+		return nil, fmt.Errorf("an ODataBind mapping is only supported for the Microsoft Graph API Versions `beta` and `stable` but got %q", o.apiVersion)
+	}
+	uriFormat := fmt.Sprintf("%%s/%s/%s/%%s", uriVersion, oDataBind.ODataBind.EntitySetName)
+	if sdkField.ObjectDefinition.Type == models.ListSDKObjectDefinitionType {
+		// when Optional the relationship is only sent when specified
+		line := fmt.Sprintf(`
+if len(input.%[2]s) > 0 {
+	%[3]s := make([]string, 0)
+	for _, v := range input.%[2]s {
+		%[3]s = append(%[3]s, fmt.Sprintf(%[4]q, %[5]s, v))
+	}
+	output.%[1]s = &%[3]s
+}
+`, oDataBind.ODataBind.SDKFieldName, oDataBind.ODataBind.TerraformSchemaFieldName, generatorHelpers.CamelCasedName(oDataBind.ODataBind.SDKFieldName), uriFormat, MicrosoftGraphEndpointArgumentName)
+		if sdkField.Required {
+			line = fmt.Sprintf(`
+%[3]s := make([]string, 0)
+for _, v := range input.%[2]s {
+	%[3]s = append(%[3]s, fmt.Sprintf(%[4]q, %[5]s, v))
+}
+output.%[1]s = %[3]s
+`, oDataBind.ODataBind.SDKFieldName, oDataBind.ODataBind.TerraformSchemaFieldName, generatorHelpers.CamelCasedName(oDataBind.ODataBind.SDKFieldName), uriFormat, MicrosoftGraphEndpointArgumentName)
+		}
+		return &line, nil
+	}
+
+	if schemaField.Required {
+		line := fmt.Sprintf("output.%[1]s = fmt.Sprintf(%[3]q, %[4]s, input.%[2]s)", oDataBind.ODataBind.SDKFieldName, oDataBind.ODataBind.TerraformSchemaFieldName, uriFormat, MicrosoftGraphEndpointArgumentName)
+		if sdkField.Optional {
+			line = fmt.Sprintf("output.%[1]s = pointer.To(fmt.Sprintf(%[3]q, %[4]s, input.%[2]s))", oDataBind.ODataBind.SDKFieldName, oDataBind.ODataBind.TerraformSchemaFieldName, uriFormat, MicrosoftGraphEndpointArgumentName)
+		}
+		return &line, nil
+	}
+
+	// optional -> optional, where the relationship is only sent when specified
+	line := fmt.Sprintf(`
+if input.%[2]s != "" {
+	output.%[1]s = pointer.To(fmt.Sprintf(%[3]q, %[4]s, input.%[2]s))
+}
+`, oDataBind.ODataBind.SDKFieldName, oDataBind.ODataBind.TerraformSchemaFieldName, uriFormat, MicrosoftGraphEndpointArgumentName)
+	return &line, nil
+}
+
+func (o oDataBindAssignmentLine) assignmentForReadMapping(mapping models.TerraformFieldMappingDefinition, schemaModel models.TerraformSchemaModel, sdkModel models.SDKModel, _ *assignmentConstantDetails, _ string) (*string, error) {
+	if _, _, _, err := o.fieldsForMapping(mapping, schemaModel, sdkModel); err != nil {
+		return nil, err
+	}
+
+	// `@odata.bind` fields are write-only, so the values for these are retained from the existing state during a Read
+	line := ""
+	return &line, nil
+}
+
+func (o oDataBindAssignmentLine) fieldsForMapping(mapping models.TerraformFieldMappingDefinition, schemaModel models.TerraformSchemaModel, sdkModel models.SDKModel) (*models.TerraformODataBindFieldMappingDefinition, *models.TerraformSchemaField, *models.SDKField, error) {
+	oDataBind, ok := mapping.(models.TerraformODataBindFieldMappingDefinition)
+	if !ok {
+		return nil, nil, nil, fmt.Errorf("internal-error: expected an ODataBind mapping but got %+v", mapping)
+	}
+	if oDataBind.ODataBind.EntitySetName == "" {
+		return nil, nil, nil, fmt.Errorf("an ODataBind mapping must specify the Entity Set Name")
+	}
+
+	schemaField, ok := schemaModel.Fields[oDataBind.ODataBind.TerraformSchemaFieldName]
+	if !ok {
+		return nil, nil, nil, fmt.Errorf("the Field %q for Schema Model %q was not found", oDataBind.ODataBind.TerraformSchemaFieldName, oDataBind.ODataBind.TerraformSchemaModelName)
+	}
+	sdkField, ok := sdkModel.Fields[oDataBind.ODataBind.SDKFieldName]
+	if !ok {
+		return nil, nil, nil, fmt.Errorf("the Field %q for SDK Model %q was not found", oDataBind.ODataBind.SDKFieldName, oDataBind.ODataBind.SDKModelName)
+	}
+
+	switch sdkField.ObjectDefinition.Type {
+	case models.ListSDKObjectDefinitionType:
+		if sdkField.ObjectDefinition.NestedItem == nil || sdkField.ObjectDefinition.NestedItem.Type != models.StringSDKObjectDefinitionType {
+			return nil, nil, nil, fmt.Errorf("an ODataBind mapping must be to a List of Strings")
+		}
+		schemaType := schemaField.ObjectDefinition.Type
+		if schemaType != models.ListTerraformSchemaObjectDefinitionType && schemaType != models.SetTerraformSchemaObjectDefinitionType {
+			return nil, nil, nil, fmt.Errorf("an ODataBind mapping to a List must be from a List or Set Schema Field but got %q", string(schemaType))
+		}
+		if schemaField.ObjectDefinition.NestedObject == nil || schemaField.ObjectDefinition.NestedObject.Type != models.StringTerraformSchemaObjectDefinitionType {
+			return nil, nil, nil, fmt.Errorf("an ODataBind mapping to a List must be from a List or Set of Strings")
+		}
+
+	case models.StringSDKObjectDefinitionType:
+		if schemaField.ObjectDefinition.Type != models.StringTerraformSchemaObjectDefinitionType {
+			return nil, nil, nil, fmt.Errorf("an ODataBind mapping to a String must be from a String Schema Field but got %q", string(schemaField.ObjectDefinition.Type))
+		}
+
+	default:
+		return nil, nil, nil, fmt.Errorf("an ODataBind mapping must be to a List of Strings or a String but got %q", string(sdkField.ObjectDefinition.Type))
+	}
+	if sdkField.ObjectDefinition.Nullable {
+		return nil, nil, nil, fmt.Errorf("an ODataBind mapping to a Nullable SDK Field isn't supported at this time")
+	}
+
+	return &oDataBind, &schemaField, &sdkField, nil
+}
@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mappings
+
+import (
+	"testing"
+
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	"github.com/hashicorp/pandora/tools/sdk/testhelpers"
+)
+
+func TestODataBind(t *testing.T) {
+	mapping := models.TerraformODataBindFieldMappingDefinition{
+		ODataBind: models.TerraformODataBindFieldMappingDefinitionImpl{
+			EntitySetName:            "directoryObjects",
+			SDKFieldName:             "Owners_ODataBind",
+			SDKModelName:             "ToModel",
+			TerraformSchemaFieldName: "Owners",
+			TerraformSchemaModelName: "FromModel",
+		},
+	}
+	testData := []struct {
+		name                string
+		apiVersion          string
+		schemaFieldRequired bool
+		sdkFieldRequired    bool
+		list                bool
+		expectedCreate      string
+		expectError         bool
+	}{
+		{
+			name:                "List - Required to Required",
+			schemaFieldRequired: true,
+			sdkFieldRequired:    true,
+			list:                true,
+			expectedCreate: `
+owners_ODataBind := make([]string, 0)
+for _, v := range input.Owners {
+	owners_ODataBind = append(owners_ODataBind, fmt.Sprintf("%s/v1.0/directoryObjects/%s", microsoftGraphEndpoint, v))
+}
+output.Owners_ODataBind = owners_ODataBind
+`,
+		},
+		{
+			name:                "List - Optional to Optional",
+			schemaFieldRequired: false,
+			sdkFieldRequired:    false,
+			list:                true,
+			expectedCreate: `
+if len(input.Owners) > 0 {
+	owners_ODataBind := make([]string, 0)
+	for _, v := range input.Owners {
+		owners_ODataBind = append(owners_ODataBind, fmt.Sprintf("%s/v1.0/directoryObjects/%s", microsoftGraphEndpoint, v))
+	}
+	output.Owners_ODataBind = &owners_ODataBind
+}
+`,
+		},
+		{
+			name:                "Single - Required to Optional",
+			schemaFieldRequired: true,
+			sdkFieldRequired:    false,
+			expectedCreate:      `output.Owners_ODataBind = pointer.To(fmt.Sprintf("%s/v1.0/directoryObjects/%s", microsoftGraphEndpoint, input.Owners))`,
+		},
+		{
+			name:                "Single - Optional to Optional",
+			schemaFieldRequired: false,
+			sdkFieldRequired:    false,
+			expectedCreate: `
+if input.Owners != "" {
+	output.Owners_ODataBind = pointer.To(fmt.Sprintf("%s/v1.0/directoryObjects/%s", microsoftGraphEndpoint, input.Owners))
+}
+`,
+		},
+		{
+			name:                "Single - Required to Optional using Beta",
+			apiVersion:          "beta",
+			schemaFieldRequired: true,
+			sdkFieldRequired:    false,
+			expectedCreate:      `output.Owners_ODataBind = pointer.To(fmt.Sprintf("%s/beta/directoryObjects/%s", microsoftGraphEndpoint, input.Owners))`,
+		},
+		{
+			name:                "Single - Unsupported API Version",
+			apiVersion:          "2020-01-01",
+			schemaFieldRequired: true,
+			sdkFieldRequired:    false,
+			expectError:         true,
+		},
+		{
+			name:                "Single - Optional to Required",
+			schemaFieldRequired: false,
+			sdkFieldRequired:    true,
+			expectError:         true,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("Test %q", v.name)
+		assignment := oDataBindAssignmentLine{
+			apiVersion: "stable",
+		}
+		if v.apiVersion != "" {
+			assignment.apiVersion = v.apiVersion
+		}
+		schemaObjectDefinition := models.TerraformSchemaObjectDefinition{
+			Type: models.StringTerraformSchemaObjectDefinitionType,
+		}
+		sdkObjectDefinition := models.SDKObjectDefinition{
+			Type: models.StringSDKObjectDefinitionType,
+		}
+		if v.list {
+			schemaObjectDefinition = models.TerraformSchemaObjectDefinition{
+				NestedObject: &models.TerraformSchemaObjectDefinition{
+					Type: models.StringTerraformSchemaObjectDefinitionType,
+				},
+				Type: models.SetTerraformSchemaObjectDefinitionType,
+			}
+			sdkObjectDefinition = models.SDKObjectDefinition{
+				NestedItem: &models.SDKObjectDefinition{
+					Type: models.StringSDKObjectDefinitionType,
+				},
+				Type: models.ListSDKObjectDefinitionType,
+			}
+		}
+		schemaModel := models.TerraformSchemaModel{
+			Fields: map[string]models.TerraformSchemaField{
+				"Owners": {
+					ObjectDefinition: schemaObjectDefinition,
+					Required:         v.schemaFieldRequired,
+					Optional:         !v.schemaFieldRequired,
+				},
+			},
+		}
+		sdkModel := models.SDKModel{
+			Fields: map[string]models.SDKField{
+				"Owners_ODataBind": {
+					JsonName:         "owners@odata.bind",
+					ObjectDefinition: sdkObjectDefinition,
+					Required:         v.sdkFieldRequired,
+					Optional:         !v.sdkFieldRequired,
+				},
+			},
+		}
+
+		actualCreate, err := assignment.assignmentForCreateUpdateMapping(mapping, schemaModel, sdkModel, nil, "sdkresource")
+		if v.expectError {
+			if err == nil {
+				t.Fatalf("expected an error but didn't get one")
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		testhelpers.AssertTemplatedCodeMatches(t, v.expectedCreate, *actualCreate)
+
+		// `@odata.bind` fields are write-only, so nothing is mapped during a Read
+		actualRead, err := assignment.assignmentForReadMapping(mapping, schemaModel, sdkModel, nil, "sdkresource")
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if *actualRead != "" {
+			t.Fatalf("expected no Read assignment but got %q", *actualRead)
+		}
+	}
+}
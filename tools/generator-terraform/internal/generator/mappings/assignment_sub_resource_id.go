@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mappings
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/helpers"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+var _ assignmentType = subResourceIdAssignmentLine{}
+
+// subResourceIdAssignmentLine maps a String Schema Field to/from the `Id` field within a nested SDK Model, for
+// example `subnet_id = "..."` being sent to the API as `"subnet": { "id": "..." }`.
+type subResourceIdAssignmentLine struct {
+	// sdkModels is required to look up the `Id` field within the nested SDK Model.
+	sdkModels map[string]models.SDKModel
+}
+
+func (s subResourceIdAssignmentLine) assignmentForCreateUpdateMapping(mapping models.TerraformFieldMappingDefinition, schemaModel models.TerraformSchemaModel, sdkModel models.SDKModel, _ *assignmentConstantDetails, apiResourcePackageName string) (*string, error) {
+	subResourceId, schemaField, sdkField, idField, err := s.fieldsForMapping(mapping, schemaModel, sdkModel)
+	if err != nil {
+		return nil, err
+	}
+
+	if !schemaField.Required && sdkField.Required {
+		// if the SDK Field is Required but the Schema Field is Optional this is a Data Issue
+		return nil, fmt.Errorf("the Sdk Model %q Field %q was Required but Schema Model %q Field %q was Optional but must be Required", subResourceId.SubResourceId.SDKModelName, subResourceId.SubResourceId.SDKFieldName, subResourceId.SubResourceId.TerraformSchemaModelName, subResourceId.SubResourceId.TerraformSchemaFieldName)
+	}
+
+	if schemaField.Computed && (!schemaField.Optional && !schemaField.Required) {
+		// Computed-only fields are never sent to the API
+		line := ""
+		return &line, nil
+	}
+
+	sdkFieldType, err := helpers.GolangTypeForSDKObjectDefinition(sdkField.ObjectDefinition, &apiResourcePackageName, nil)
+	if err != nil {
+		return nil, fmt.Errorf("determining Golang Type Name for SDK Field: %+v", err)
+	}
+	idValue := fmt.Sprintf("input.%s", subResourceId.SubResourceId.TerraformSchemaFieldName)
+	if idField.Optional {
+		idValue = fmt.Sprintf("pointer.To(%s)", idValue)
+	}
+
+	if schemaField.Required {
+		line := fmt.Sprintf(`
+output.%[1]s = %[2]s{
+	Id: %[3]s,
+}
+`, subResourceId.SubResourceId.SDKFieldName, *sdkFieldType, idValue)
+		if sdkField.Optional {
+			line = fmt.Sprintf(`
+output.%[1]s = &%[2]s{
+	Id: %[3]s,
+}
+`, subResourceId.SubResourceId.SDKFieldName, *sdkFieldType, idValue)
+		}
+		return &line, nil
+	}
+
+	// optional -> optional
+	line := fmt.Sprintf(`
+if input.%[4]s != "" {
+	output.%[1]s = &%[2]s{
+		Id: %[3]s,
+	}
+}
+`, subResourceId.SubResourceId.SDKFieldName, *sdkFieldType, idValue, subResourceId.SubResourceId.TerraformSchemaFieldName)
+	return &line, nil
+}
+
+func (s subResourceIdAssignmentLine) assignmentForReadMapping(mapping models.TerraformFieldMappingDefinition, schemaModel models.TerraformSchemaModel, sdkModel models.SDKModel, _ *assignmentConstantDetails, _ string) (*string, error) {
+	subResourceId, _, sdkField, idField, err := s.fieldsForMapping(mapping, schemaModel, sdkModel)
+	if err != nil {
+		return nil, err
+	}
+
+	idValue := fmt.Sprintf("input.%s.Id", subResourceId.SubResourceId.SDKFieldName)
+	if idField.Optional {
+		idValue = fmt.Sprintf("pointer.From(%s)", idValue)
+	}
+
+	line := fmt.Sprintf("output.%[1]s = %[2]s", subResourceId.SubResourceId.TerraformSchemaFieldName, idValue)
+	if sdkField.Optional {
+		line = fmt.Sprintf(`
+if input.%[3]s != nil {
+	output.%[1]s = %[2]s
+}
+`, subResourceId.SubResourceId.TerraformSchemaFieldName, idValue, subResourceId.SubResourceId.SDKFieldName)
+	}
+	return &line, nil
+}
+
+func (s subResourceIdAssignmentLine) fieldsForMapping(mapping models.TerraformFieldMappingDefinition, schemaModel models.TerraformSchemaModel, sdkModel models.SDKModel) (*models.TerraformSubResourceIdFieldMappingDefinition, *models.TerraformSchemaField, *models.SDKField, *models.SDKField, error) {
+	subResourceId, ok := mapping.(models.TerraformSubResourceIdFieldMappingDefinition)
+	if !ok {
+		return nil, nil, nil, nil, fmt.Errorf("internal-error: expected a SubResourceId mapping but got %+v", mapping)
+	}
+
+	schemaField, ok := schemaModel.Fields[subResourceId.SubResourceId.TerraformSchemaFieldName]
+	if !ok {
+		return nil, nil, nil, nil, fmt.Errorf("the Field %q for Schema Model %q was not found", subResourceId.SubResourceId.TerraformSchemaFieldName, subResourceId.SubResourceId.TerraformSchemaModelName)
+	}
+	if schemaField.ObjectDefinition.Type != models.StringTerraformSchemaObjectDefinitionType {
+		return nil, nil, nil, nil, fmt.Errorf("a SubResourceId mapping must be from a String Schema Field but got %q", string(schemaField.ObjectDefinition.Type))
+	}
+
+	sdkField, ok := sdkModel.Fields[subResourceId.SubResourceId.SDKFieldName]
+	if !ok {
+		return nil, nil, nil, nil, fmt.Errorf("the Field %q for SDK Model %q was not found", subResourceId.SubResourceId.SDKFieldName, subResourceId.SubResourceId.SDKModelName)
+	}
+	if sdkField.ObjectDefinition.Type != models.ReferenceSDKObjectDefinitionType || sdkField.ObjectDefinition.ReferenceName == nil {
+		return nil, nil, nil, nil, fmt.Errorf("a SubResourceId mapping must be to a Reference SDK Field but got %q", string(sdkField.ObjectDefinition.Type))
+	}
+
+	idField, err := subResourceIdFieldWithinSdkModel(s.sdkModels, *sdkField.ObjectDefinition.ReferenceName)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	return &subResourceId, &schemaField, &sdkField, idField, nil
+}
+
+// subResourceIdFieldWithinSdkModel returns the `Id` field within the SDK Model named `sdkModelName`.
+func subResourceIdFieldWithinSdkModel(sdkModels map[string]models.SDKModel, sdkModelName string) (*models.SDKField, error) {
+	sdkModel, ok := sdkModels[sdkModelName]
+	if !ok {
+		return nil, fmt.Errorf("the SDK Model %q was not found", sdkModelName)
+	}
+	idField, ok := sdkModel.Fields["Id"]
+	if !ok {
+		return nil, fmt.Errorf("the SDK Model %q doesn't contain an `Id` field", sdkModelName)
+	}
+	if idField.ObjectDefinition.Type != models.StringSDKObjectDefinitionType {
+		return nil, fmt.Errorf("the `Id` field within the SDK Model %q must be a String but got %q", sdkModelName, string(idField.ObjectDefinition.Type))
+	}
+	return &idField, nil
+}
@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mappings
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/helpers"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+var _ assignmentType = subResourceIdListAssignmentLine{}
+
+// subResourceIdListAssignmentLine maps a List of Strings Schema Field to/from a List of nested SDK Models, using the
+// `Id` field within each SDK Model - for example `subnet_ids = ["..."]` being sent to the API as `"subnets": [{ "id": "..." }]`.
+type subResourceIdListAssignmentLine struct {
+	// sdkModels is required to look up the `Id` field within the nested SDK Model.
+	sdkModels map[string]models.SDKModel
+}
+
+func (s subResourceIdListAssignmentLine) assignmentForCreateUpdateMapping(mapping models.TerraformFieldMappingDefinition, schemaModel models.TerraformSchemaModel, sdkModel models.SDKModel, _ *assignmentConstantDetails, apiResourcePackageName string) (*string, error) {
+	subResourceIdList, schemaField, sdkField, idField, err := s.fieldsForMapping(mapping, schemaModel, sdkModel)
+	if err != nil {
+		return nil, err
+	}
+
+	if !schemaField.Required && sdkField.Required {
+		// if the SDK Field is Required but the Schema Field is Optional this is a Data Issue
+		return nil, fmt.Errorf("the Sdk Model %q Field %q was Required but Schema Model %q Field %q was Optional but must be Required", subResourceIdList.SubResourceIdList.SDKModelName, subResourceIdList.SubResourceIdList.SDKFieldName, subResourceIdList.SubResourceIdList.TerraformSchemaModelName, subResourceIdList.SubResourceIdList.TerraformSchemaFieldName)
+	}
+
+	if schemaField.Computed && (!schemaField.Optional && !schemaField.Required) {
+		// Computed-only fields are never sent to the API
+		line := ""
+		return &line, nil
+	}
+
+	listItemType, err := helpers.GolangTypeForSDKObjectDefinition(*sdkField.ObjectDefinition.NestedItem, &apiResourcePackageName, nil)
+	if err != nil {
+		return nil, fmt.Errorf("determining Golang Type for Sdk Model %q / Field %q Nested Object Definition: %+v", subResourceIdList.SubResourceIdList.SDKModelName, subResourceIdList.SubResourceIdList.SDKFieldName, err)
+	}
+	idValue := "v"
+	if idField.Optional {
+		idValue = "pointer.To(v)"
+	}
+	variableName := localVariableNameForSDKField(subResourceIdList.SubResourceIdList.SDKFieldName, "Ids")
+
+	line := fmt.Sprintf(`
+%[3]s := make([]%[4]s, 0)
+for _, v := range input.%[2]s {
+	%[3]s = append(%[3]s, %[4]s{
+		Id: %[5]s,
+	})
+}
+output.%[1]s = %[3]s
+`, subResourceIdList.SubResourceIdList.SDKFieldName, subResourceIdList.SubResourceIdList.TerraformSchemaFieldName, variableName, *listItemType, idValue)
+	if sdkField.Optional {
+		line = fmt.Sprintf(`
+%[3]s := make([]%[4]s, 0)
+for _, v := range input.%[2]s {
+	%[3]s = append(%[3]s, %[4]s{
+		Id: %[5]s,
+	})
+}
+output.%[1]s = &%[3]s
+`, subResourceIdList.SubResourceIdList.SDKFieldName, subResourceIdList.SubResourceIdList.TerraformSchemaFieldName, variableName, *listItemType, idValue)
+	}
+	return &line, nil
+}
+
+func (s subResourceIdListAssignmentLine) assignmentForReadMapping(mapping models.TerraformFieldMappingDefinition, schemaModel models.TerraformSchemaModel, sdkModel models.SDKModel, _ *assignmentConstantDetails, _ string) (*string, error) {
+	subResourceIdList, _, sdkField, idField, err := s.fieldsForMapping(mapping, schemaModel, sdkModel)
+	if err != nil {
+		return nil, err
+	}
+
+	idValue := "v.Id"
+	if idField.Optional {
+		idValue = "pointer.From(v.Id)"
+	}
+	variableName := localVariableNameForSDKField(subResourceIdList.SubResourceIdList.SDKFieldName, "Ids")
+
+	line := fmt.Sprintf(`
+%[3]s := make([]string, 0)
+for _, v := range input.%[1]s {
+	%[3]s = append(%[3]s, %[4]s)
+}
+output.%[2]s = %[3]s
+`, subResourceIdList.SubResourceIdList.SDKFieldName, subResourceIdList.SubResourceIdList.TerraformSchemaFieldName, variableName, idValue)
+	if sdkField.Optional {
+		line = fmt.Sprintf(`
+%[3]s := make([]string, 0)
+if input.%[1]s != nil {
+	for _, v := range *input.%[1]s {
+		%[3]s = append(%[3]s, %[4]s)
+	}
+}
+output.%[2]s = %[3]s
+`, subResourceIdList.SubResourceIdList.SDKFieldName, subResourceIdList.SubResourceIdList.TerraformSchemaFieldName, variableName, idValue)
+	}
+	return &line, nil
+}
+
+func (s subResourceIdListAssignmentLine) fieldsForMapping(mapping models.TerraformFieldMappingDefinition, schemaModel models.TerraformSchemaModel, sdkModel models.SDKModel) (*models.TerraformSubResourceIdListFieldMappingDefinition, *models.TerraformSchemaField, *models.SDKField, *models.SDKField, error) {
+	subResourceIdList, ok := mapping.(models.TerraformSubResourceIdListFieldMappingDefinition)
+	if !ok {
+		return nil, nil, nil, nil, fmt.Errorf("internal-error: expected a SubResourceIdList mapping but got %+v", mapping)
+	}
+
+	schemaField, ok := schemaModel.Fields[subResourceIdList.SubResourceIdList.TerraformSchemaFieldName]
+	if !ok {
+		return nil, nil, nil, nil, fmt.Errorf("the Field %q for Schema Model %q was not found", subResourceIdList.SubResourceIdList.TerraformSchemaFieldName, subResourceIdList.SubResourceIdList.TerraformSchemaModelName)
+	}
+	isListOrSet := schemaField.ObjectDefinition.Type == models.ListTerraformSchemaObjectDefinitionType || schemaField.ObjectDefinition.Type == models.SetTerraformSchemaObjectDefinitionType
+	if !isListOrSet || schemaField.ObjectDefinition.NestedObject == nil || schemaField.ObjectDefinition.NestedObject.Type != models.StringTerraformSchemaObjectDefinitionType {
+		return nil, nil, nil, nil, fmt.Errorf("a SubResourceIdList mapping must be from a List or Set of Strings Schema Field")
+	}
+
+	sdkField, ok := sdkModel.Fields[subResourceIdList.SubResourceIdList.SDKFieldName]
+	if !ok {
+		return nil, nil, nil, nil, fmt.Errorf("the Field %q for SDK Model %q was not found", subResourceIdList.SubResourceIdList.SDKFieldName, subResourceIdList.SubResourceIdList.SDKModelName)
+	}
+	nestedItem := sdkField.ObjectDefinition.NestedItem
+	if sdkField.ObjectDefinition.Type != models.ListSDKObjectDefinitionType || nestedItem == nil || nestedItem.Type != models.ReferenceSDKObjectDefinitionType || nestedItem.ReferenceName == nil {
+		return nil, nil, nil, nil, fmt.Errorf("a SubResourceIdList mapping must be to a List of References SDK Field")
+	}
+
+	idField, err := subResourceIdFieldWithinSdkModel(s.sdkModels, *nestedItem.ReferenceName)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	return &subResourceIdList, &schemaField, &sdkField, idField, nil
+}
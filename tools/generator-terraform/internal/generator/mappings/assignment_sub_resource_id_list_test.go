@@ -0,0 +1,199 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mappings
+
+import (
+	"testing"
+
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	"github.com/hashicorp/pandora/tools/sdk/testhelpers"
+)
+
+func TestSubResourceIdList(t *testing.T) {
+	mapping := models.TerraformSubResourceIdListFieldMappingDefinition{
+		SubResourceIdList: models.TerraformSubResourceIdListFieldMappingDefinitionImpl{
+			SDKFieldName:             "ToPath",
+			SDKModelName:             "ToModel",
+			TerraformSchemaFieldName: "FromPath",
+			TerraformSchemaModelName: "FromModel",
+		},
+	}
+	testData := []struct {
+		name                string
+		schemaFieldRequired bool
+		sdkFieldRequired    bool
+		expectedCreate      string
+		expectedRead        string
+		expectError         bool
+	}{
+		{
+			name:                "Required to Required",
+			schemaFieldRequired: true,
+			sdkFieldRequired:    true,
+			expectedCreate: `
+toPathIds := make([]sdkresource.SubResource, 0)
+for _, v := range input.FromPath {
+	toPathIds = append(toPathIds, sdkresource.SubResource{
+		Id: pointer.To(v),
+	})
+}
+output.ToPath = toPathIds
+`,
+			expectedRead: `
+toPathIds := make([]string, 0)
+for _, v := range input.ToPath {
+	toPathIds = append(toPathIds, pointer.From(v.Id))
+}
+output.FromPath = toPathIds
+`,
+		},
+		{
+			name:                "Optional to Optional",
+			schemaFieldRequired: false,
+			sdkFieldRequired:    false,
+			expectedCreate: `
+toPathIds := make([]sdkresource.SubResource, 0)
+for _, v := range input.FromPath {
+	toPathIds = append(toPathIds, sdkresource.SubResource{
+		Id: pointer.To(v),
+	})
+}
+output.ToPath = &toPathIds
+`,
+			expectedRead: `
+toPathIds := make([]string, 0)
+if input.ToPath != nil {
+	for _, v := range *input.ToPath {
+		toPathIds = append(toPathIds, pointer.From(v.Id))
+	}
+}
+output.FromPath = toPathIds
+`,
+		},
+		{
+			name:                "Optional to Required",
+			schemaFieldRequired: false,
+			sdkFieldRequired:    true,
+			expectError:         true,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("Test %q", v.name)
+		schemaModel := models.TerraformSchemaModel{
+			Fields: map[string]models.TerraformSchemaField{
+				"FromPath": {
+					ObjectDefinition: models.TerraformSchemaObjectDefinition{
+						Type: models.ListTerraformSchemaObjectDefinitionType,
+						NestedObject: &models.TerraformSchemaObjectDefinition{
+							Type: models.StringTerraformSchemaObjectDefinitionType,
+						},
+					},
+					Required: v.schemaFieldRequired,
+					Optional: !v.schemaFieldRequired,
+				},
+			},
+		}
+		sdkModels := sdkModelsForSubResourceIdTesting(models.ListSDKObjectDefinitionType, v.sdkFieldRequired)
+		assignment := subResourceIdListAssignmentLine{
+			sdkModels: sdkModels,
+		}
+
+		actualCreate, err := assignment.assignmentForCreateUpdateMapping(mapping, schemaModel, sdkModels["ToModel"], nil, "sdkresource")
+		if v.expectError {
+			if err == nil {
+				t.Fatalf("expected an error but didn't get one")
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		testhelpers.AssertTemplatedCodeMatches(t, v.expectedCreate, *actualCreate)
+
+		actualRead, err := assignment.assignmentForReadMapping(mapping, schemaModel, sdkModels["ToModel"], nil, "sdkresource")
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		testhelpers.AssertTemplatedCodeMatches(t, v.expectedRead, *actualRead)
+	}
+}
+
+func TestSubResourceIdList_JsonNameIsAGoKeyword(t *testing.T) {
+	mapping := models.TerraformSubResourceIdListFieldMappingDefinition{
+		SubResourceIdList: models.TerraformSubResourceIdListFieldMappingDefinitionImpl{
+			SDKFieldName:             "Type",
+			SDKModelName:             "ToModel",
+			TerraformSchemaFieldName: "FromPath",
+			TerraformSchemaModelName: "FromModel",
+		},
+	}
+	schemaModel := models.TerraformSchemaModel{
+		Fields: map[string]models.TerraformSchemaField{
+			"FromPath": {
+				ObjectDefinition: models.TerraformSchemaObjectDefinition{
+					Type: models.ListTerraformSchemaObjectDefinitionType,
+					NestedObject: &models.TerraformSchemaObjectDefinition{
+						Type: models.StringTerraformSchemaObjectDefinitionType,
+					},
+				},
+				Required: true,
+			},
+		},
+	}
+	sdkModels := sdkModelsForSubResourceIdTesting(models.ListSDKObjectDefinitionType, true)
+	toModel := sdkModels["ToModel"]
+	toModel.Fields = map[string]models.SDKField{
+		"Type": {
+			JsonName:         "type",
+			ObjectDefinition: toModel.Fields["ToPath"].ObjectDefinition,
+			Required:         true,
+		},
+	}
+	sdkModels["ToModel"] = toModel
+	assignment := subResourceIdListAssignmentLine{
+		sdkModels: sdkModels,
+	}
+
+	expectedCreate := `
+typeIds := make([]sdkresource.SubResource, 0)
+for _, v := range input.FromPath {
+	typeIds = append(typeIds, sdkresource.SubResource{
+		Id: pointer.To(v),
+	})
+}
+output.Type = typeIds
+`
+	actualCreate, err := assignment.assignmentForCreateUpdateMapping(mapping, schemaModel, sdkModels["ToModel"], nil, "sdkresource")
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	testhelpers.AssertTemplatedCodeMatches(t, expectedCreate, *actualCreate)
+
+	expectedRead := `
+typeIds := make([]string, 0)
+for _, v := range input.Type {
+	typeIds = append(typeIds, pointer.From(v.Id))
+}
+output.FromPath = typeIds
+`
+	actualRead, err := assignment.assignmentForReadMapping(mapping, schemaModel, sdkModels["ToModel"], nil, "sdkresource")
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	testhelpers.AssertTemplatedCodeMatches(t, expectedRead, *actualRead)
+}
+
+func TestLocalVariableNameForSDKField(t *testing.T) {
+	testData := map[string]string{
+		"Type":    "typeValue",
+		"Default": "defaultValue",
+		"Subnets": "subnets",
+	}
+	for input, expected := range testData {
+		actual := localVariableNameForSDKField(input, "")
+		if actual != expected {
+			t.Fatalf("expected %q but got %q for %q", expected, actual, input)
+		}
+	}
+}
@@ -0,0 +1,185 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mappings
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	"github.com/hashicorp/pandora/tools/sdk/testhelpers"
+)
+
+func sdkModelsForSubResourceIdTesting(sdkFieldType models.SDKObjectDefinitionType, sdkFieldRequired bool) map[string]models.SDKModel {
+	objectDefinition := models.SDKObjectDefinition{
+		Type:          models.ReferenceSDKObjectDefinitionType,
+		ReferenceName: pointer.To("SubResource"),
+	}
+	if sdkFieldType == models.ListSDKObjectDefinitionType {
+		nestedItem := objectDefinition
+		objectDefinition = models.SDKObjectDefinition{
+			Type:       models.ListSDKObjectDefinitionType,
+			NestedItem: &nestedItem,
+		}
+	}
+	return map[string]models.SDKModel{
+		"ToModel": {
+			Fields: map[string]models.SDKField{
+				"ToPath": {
+					JsonName:         "toPath",
+					ObjectDefinition: objectDefinition,
+					Required:         sdkFieldRequired,
+					Optional:         !sdkFieldRequired,
+				},
+			},
+		},
+		"SubResource": {
+			Fields: map[string]models.SDKField{
+				"Id": {
+					JsonName: "id",
+					ObjectDefinition: models.SDKObjectDefinition{
+						Type: models.StringSDKObjectDefinitionType,
+					},
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func TestSubResourceId(t *testing.T) {
+	mapping := models.TerraformSubResourceIdFieldMappingDefinition{
+		SubResourceId: models.TerraformSubResourceIdFieldMappingDefinitionImpl{
+			SDKFieldName:             "ToPath",
+			SDKModelName:             "ToModel",
+			TerraformSchemaFieldName: "FromPath",
+			TerraformSchemaModelName: "FromModel",
+		},
+	}
+	testData := []struct {
+		name                string
+		schemaFieldRequired bool
+		sdkFieldRequired    bool
+		expectedCreate      string
+		expectedRead        string
+		expectError         bool
+	}{
+		{
+			name:                "Required to Required",
+			schemaFieldRequired: true,
+			sdkFieldRequired:    true,
+			expectedCreate: `
+output.ToPath = sdkresource.SubResource{
+	Id: pointer.To(input.FromPath),
+}
+`,
+			expectedRead: "output.FromPath = pointer.From(input.ToPath.Id)",
+		},
+		{
+			name:                "Required to Optional",
+			schemaFieldRequired: true,
+			sdkFieldRequired:    false,
+			expectedCreate: `
+output.ToPath = &sdkresource.SubResource{
+	Id: pointer.To(input.FromPath),
+}
+`,
+			expectedRead: `
+if input.ToPath != nil {
+	output.FromPath = pointer.From(input.ToPath.Id)
+}
+`,
+		},
+		{
+			name:                "Optional to Optional",
+			schemaFieldRequired: false,
+			sdkFieldRequired:    false,
+			expectedCreate: `
+if input.FromPath != "" {
+	output.ToPath = &sdkresource.SubResource{
+		Id: pointer.To(input.FromPath),
+	}
+}
+`,
+			expectedRead: `
+if input.ToPath != nil {
+	output.FromPath = pointer.From(input.ToPath.Id)
+}
+`,
+		},
+		{
+			name:                "Optional to Required",
+			schemaFieldRequired: false,
+			sdkFieldRequired:    true,
+			expectError:         true,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("Test %q", v.name)
+		schemaModel := models.TerraformSchemaModel{
+			Fields: map[string]models.TerraformSchemaField{
+				"FromPath": {
+					ObjectDefinition: models.TerraformSchemaObjectDefinition{
+						Type: models.StringTerraformSchemaObjectDefinitionType,
+					},
+					Required: v.schemaFieldRequired,
+					Optional: !v.schemaFieldRequired,
+				},
+			},
+		}
+		sdkModels := sdkModelsForSubResourceIdTesting(models.ReferenceSDKObjectDefinitionType, v.sdkFieldRequired)
+		assignment := subResourceIdAssignmentLine{
+			sdkModels: sdkModels,
+		}
+
+		actualCreate, err := assignment.assignmentForCreateUpdateMapping(mapping, schemaModel, sdkModels["ToModel"], nil, "sdkresource")
+		if v.expectError {
+			if err == nil {
+				t.Fatalf("expected an error but didn't get one")
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		testhelpers.AssertTemplatedCodeMatches(t, v.expectedCreate, *actualCreate)
+
+		actualRead, err := assignment.assignmentForReadMapping(mapping, schemaModel, sdkModels["ToModel"], nil, "sdkresource")
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		testhelpers.AssertTemplatedCodeMatches(t, v.expectedRead, *actualRead)
+	}
+}
+
+func TestSubResourceId_ReferencedModelWithoutAnId(t *testing.T) {
+	mapping := models.TerraformSubResourceIdFieldMappingDefinition{
+		SubResourceId: models.TerraformSubResourceIdFieldMappingDefinitionImpl{
+			SDKFieldName:             "ToPath",
+			SDKModelName:             "ToModel",
+			TerraformSchemaFieldName: "FromPath",
+			TerraformSchemaModelName: "FromModel",
+		},
+	}
+	schemaModel := models.TerraformSchemaModel{
+		Fields: map[string]models.TerraformSchemaField{
+			"FromPath": {
+				ObjectDefinition: models.TerraformSchemaObjectDefinition{
+					Type: models.StringTerraformSchemaObjectDefinitionType,
+				},
+				Required: true,
+			},
+		},
+	}
+	sdkModels := sdkModelsForSubResourceIdTesting(models.ReferenceSDKObjectDefinitionType, true)
+	sdkModels["SubResource"] = models.SDKModel{
+		Fields: map[string]models.SDKField{},
+	}
+	assignment := subResourceIdAssignmentLine{
+		sdkModels: sdkModels,
+	}
+	if _, err := assignment.assignmentForCreateUpdateMapping(mapping, schemaModel, sdkModels["ToModel"], nil, "sdkresource"); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+}
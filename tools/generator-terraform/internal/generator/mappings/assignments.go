@@ -10,11 +10,6 @@ import (
 	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
 )
 
-var assignmentTypes = map[models.TerraformFieldMappingDefinitionType]assignmentType{
-	models.DirectAssignmentTerraformFieldMappingDefinitionType: directAssignmentLine{},
-	models.ModelToModelTerraformFieldMappingDefinitionType:     modelToModelAssignmentLine{},
-}
-
 type assignmentConstantDetails struct {
 	apiResourcePackageName string
 	constantName           string
@@ -33,89 +28,109 @@ func (m *Mappings) SchemaModelToSdkModelAssignmentLine(mappings []models.Terrafo
 	lines := make([]string, 0)
 
 	for _, mapping := range mappings {
-		summary, err := summaryForMapping(mapping)
+		assignmentLine, err := m.schemaModelToSdkModelAssignmentLineForMapping(mapping)
 		if err != nil {
-			return nil, fmt.Errorf("internal-error: populating summary for mapping: %+v", err)
+			return nil, err
 		}
-
-		schemaModel, ok := m.schemaModels[summary.terraformSchemaModelName]
-		if !ok {
-			return nil, fmt.Errorf("the schema model %q referenced in mapping was not found", summary.terraformSchemaModelName)
-		}
-		sdkModel, ok := m.sdkModels[summary.sdkModelName]
-		if !ok {
-			return nil, fmt.Errorf("the SDK Model %q referenced in mapping was not found", summary.sdkModelName)
+		if assignmentLine != nil {
+			lines = append(lines, *assignmentLine)
 		}
+	}
 
-		sdkField, ok := sdkModel.Fields[summary.sdkFieldName]
-		if !ok {
-			return nil, fmt.Errorf("the SDK Model %q Field %q was not found", summary.sdkModelName, summary.sdkFieldName)
-		}
+	out := strings.Join(lines, "\n")
+	return &out, nil
+}
 
-		var sdkConstantName *string
-		if sdkField.ObjectDefinition.Type == models.ReferenceSDKObjectDefinitionType {
-			if sdkField.ObjectDefinition.ReferenceName == nil {
-				return nil, fmt.Errorf("the SDK Model %q Field %q was a reference with no ReferenceName", summary.sdkModelName, summary.sdkFieldName)
-			}
+// schemaModelToSdkModelAssignmentLineForMapping returns the Create/Update assignment line for a single mapping, or
+// nil if no assignment line is required for this mapping (e.g. a ModelToModel mapping to a RawObject)
+func (m *Mappings) schemaModelToSdkModelAssignmentLineForMapping(mapping models.TerraformFieldMappingDefinition) (*string, error) {
+	summary, err := summaryForMapping(mapping)
+	if err != nil {
+		return nil, fmt.Errorf("internal-error: populating summary for mapping: %+v", err)
+	}
 
-			sdkConstantName = sdkField.ObjectDefinition.ReferenceName
+	schemaModel, ok := m.schemaModels[summary.terraformSchemaModelName]
+	if !ok {
+		return nil, fmt.Errorf("the schema model %q referenced in mapping was not found", summary.terraformSchemaModelName)
+	}
+	sdkModel, ok := m.sdkModels[summary.sdkModelName]
+	if !ok {
+		return nil, fmt.Errorf("the SDK Model %q referenced in mapping was not found", summary.sdkModelName)
+	}
+
+	sdkField, ok := sdkModel.Fields[summary.sdkFieldName]
+	if !ok {
+		return nil, fmt.Errorf("the SDK Model %q Field %q was not found", summary.sdkModelName, summary.sdkFieldName)
+	}
+
+	var sdkConstantName *string
+	if sdkField.ObjectDefinition.Type == models.ReferenceSDKObjectDefinitionType {
+		if sdkField.ObjectDefinition.ReferenceName == nil {
+			return nil, fmt.Errorf("the SDK Model %q Field %q was a reference with no ReferenceName", summary.sdkModelName, summary.sdkFieldName)
 		}
-		if sdkField.ObjectDefinition.Type == models.ListSDKObjectDefinitionType {
-			if sdkField.ObjectDefinition.NestedItem == nil {
-				return nil, fmt.Errorf("the SDK Model %q Field %q was a List with no NestedItem", summary.sdkModelName, summary.sdkFieldName)
-			}
 
-			// we're only interested if it's a List<Constant> not a List<string>
-			if sdkField.ObjectDefinition.NestedItem.Type == models.ReferenceSDKObjectDefinitionType {
-				if sdkField.ObjectDefinition.NestedItem.ReferenceName == nil {
-					return nil, fmt.Errorf("the SDK Model %q Field %q was a nested list reference with no ReferenceName", summary.sdkModelName, summary.sdkFieldName)
-				}
+		sdkConstantName = sdkField.ObjectDefinition.ReferenceName
+	}
+	if sdkField.ObjectDefinition.Type == models.ListSDKObjectDefinitionType {
+		if sdkField.ObjectDefinition.NestedItem == nil {
+			return nil, fmt.Errorf("the SDK Model %q Field %q was a List with no NestedItem", summary.sdkModelName, summary.sdkFieldName)
+		}
 
-				sdkConstantName = sdkField.ObjectDefinition.NestedItem.ReferenceName
+		// we're only interested if it's a List<Constant> not a List<string>
+		if sdkField.ObjectDefinition.NestedItem.Type == models.ReferenceSDKObjectDefinitionType {
+			if sdkField.ObjectDefinition.NestedItem.ReferenceName == nil {
+				return nil, fmt.Errorf("the SDK Model %q Field %q was a nested list reference with no ReferenceName", summary.sdkModelName, summary.sdkFieldName)
 			}
+
+			sdkConstantName = sdkField.ObjectDefinition.NestedItem.ReferenceName
 		}
+	}
 
-		var sdkConstant *assignmentConstantDetails
-		if sdkConstantName != nil {
-			// NOTE: references to Models are handled by a different Mapping Type, so shouldn't be included here
-			constantDetails, ok := m.sdkConstants[*sdkConstantName]
-			if ok {
-				sdkConstant = &assignmentConstantDetails{
-					apiResourcePackageName: m.apiResourcePackageName,
-					constantName:           *sdkConstantName,
-					constantDetails:        constantDetails,
-				}
+	var sdkConstant *assignmentConstantDetails
+	if sdkConstantName != nil {
+		// NOTE: references to Models are handled by a different Mapping Type, so shouldn't be included here
+		constantDetails, ok := m.sdkConstants[*sdkConstantName]
+		if ok {
+			sdkConstant = &assignmentConstantDetails{
+				apiResourcePackageName: m.apiResourcePackageName,
+				constantName:           *sdkConstantName,
+				constantDetails:        constantDetails,
 			}
 		}
+	}
 
-		if _, ok := mapping.(models.TerraformDirectAssignmentFieldMappingDefinition); ok {
-			assignmentLine, err := directAssignmentLine{}.assignmentForCreateUpdateMapping(mapping, schemaModel, sdkModel, sdkConstant, m.apiResourcePackageName)
-			if err != nil {
-				return nil, fmt.Errorf("building create/update direct assignment line for %+v: %+v", summary, err)
-			}
-			lines = append(lines, *assignmentLine)
-			continue
+	if _, ok := mapping.(models.TerraformDirectAssignmentFieldMappingDefinition); ok {
+		assignmentLine, err := directAssignmentLine{}.assignmentForCreateUpdateMapping(mapping, schemaModel, sdkModel, sdkConstant, m.apiResourcePackageName)
+		if err != nil {
+			return nil, fmt.Errorf("building create/update direct assignment line for %+v: %+v", summary, err)
 		}
+		return assignmentLine, nil
+	}
 
-		if v, ok := mapping.(models.TerraformModelToModelFieldMappingDefinition); ok {
-			field := sdkModel.Fields[v.ModelToModel.SDKFieldName]
-			if field.ObjectDefinition.Type == models.RawObjectSDKObjectDefinitionType {
-				continue
-			}
+	if v, ok := mapping.(models.TerraformModelToModelFieldMappingDefinition); ok {
+		field := sdkModel.Fields[v.ModelToModel.SDKFieldName]
+		if field.ObjectDefinition.Type == models.RawObjectSDKObjectDefinitionType {
+			return nil, nil
+		}
 
-			assignmentLine, err := modelToModelAssignmentLine{}.assignmentForCreateUpdateMapping(mapping, schemaModel, sdkModel, sdkConstant, m.apiResourcePackageName)
-			if err != nil {
-				return nil, fmt.Errorf("building create/update direct assignment line for %+v: %+v", summary, err)
-			}
-			lines = append(lines, *assignmentLine)
-			continue
+		assignmentLine, err := modelToModelAssignmentLine{
+			usesMicrosoftGraphEndpoint: m.usesMicrosoftGraphEndpoint,
+		}.assignmentForCreateUpdateMapping(mapping, schemaModel, sdkModel, sdkConstant, m.apiResourcePackageName)
+		if err != nil {
+			return nil, fmt.Errorf("building create/update direct assignment line for %+v: %+v", summary, err)
 		}
+		return assignmentLine, nil
+	}
 
-		return nil, fmt.Errorf("internal-error: missing create/update assignment implementation for %+v", mapping)
+	if assignment := m.valueTransformAssignmentForMapping(mapping); assignment != nil {
+		assignmentLine, err := assignment.assignmentForCreateUpdateMapping(mapping, schemaModel, sdkModel, sdkConstant, m.apiResourcePackageName)
+		if err != nil {
+			return nil, fmt.Errorf("building create/update assignment line for %+v: %+v", summary, err)
+		}
+		return assignmentLine, nil
 	}
 
-	out := strings.Join(lines, "\n")
-	return &out, nil
+	return nil, fmt.Errorf("internal-error: missing create/update assignment implementation for %+v", mapping)
 }
 
 func (m *Mappings) SdkModelToSchemaModelAssignmentLine(mappings []models.TerraformFieldMappingDefinition) (*string, error) {
@@ -200,6 +215,15 @@ func (m *Mappings) SdkModelToSchemaModelAssignmentLine(mappings []models.Terrafo
 			continue
 		}
 
+		if assignment := m.valueTransformAssignmentForMapping(mapping); assignment != nil {
+			assignmentLine, err := assignment.assignmentForReadMapping(mapping, schemaModel, sdkModel, sdkConstant, m.apiResourcePackageName)
+			if err != nil {
+				return nil, fmt.Errorf("building read assignment line for %+v: %+v", summary, err)
+			}
+			lines = append(lines, *assignmentLine)
+			continue
+		}
+
 		return nil, fmt.Errorf("internal-error: missing read assignment implementation for %+v", mapping)
 	}
 
@@ -207,6 +231,30 @@ func (m *Mappings) SdkModelToSchemaModelAssignmentLine(mappings []models.Terrafo
 	return &out, nil
 }
 
+// valueTransformAssignmentForMapping returns the assignmentType for mappings which transform the value between the
+// Schema Field and the SDK Field (e.g. a Boolean to/from a Constant), or nil if this isn't one of those mappings.
+func (m *Mappings) valueTransformAssignmentForMapping(mapping models.TerraformFieldMappingDefinition) assignmentType {
+	switch mapping.(type) {
+	case models.TerraformBooleanEqualsFieldMappingDefinition:
+		return booleanEqualsAssignmentLine{}
+	case models.TerraformBooleanInvertFieldMappingDefinition:
+		return booleanInvertAssignmentLine{}
+	case models.TerraformSubResourceIdFieldMappingDefinition:
+		return subResourceIdAssignmentLine{
+			sdkModels: m.sdkModels,
+		}
+	case models.TerraformSubResourceIdListFieldMappingDefinition:
+		return subResourceIdListAssignmentLine{
+			sdkModels: m.sdkModels,
+		}
+	case models.TerraformODataBindFieldMappingDefinition:
+		return oDataBindAssignmentLine{
+			apiVersion: m.apiVersion,
+		}
+	}
+	return nil
+}
+
 type mappingSummary struct {
 	sdkFieldName             string
 	sdkModelName             string
@@ -230,5 +278,45 @@ func summaryForMapping(input models.TerraformFieldMappingDefinition) (*mappingSu
 		}, nil
 	}
 
+	if v, ok := input.(models.TerraformBooleanEqualsFieldMappingDefinition); ok {
+		return &mappingSummary{
+			sdkFieldName:             v.BooleanEquals.SDKFieldName,
+			sdkModelName:             v.BooleanEquals.SDKModelName,
+			terraformSchemaModelName: v.BooleanEquals.TerraformSchemaModelName,
+		}, nil
+	}
+
+	if v, ok := input.(models.TerraformBooleanInvertFieldMappingDefinition); ok {
+		return &mappingSummary{
+			sdkFieldName:             v.BooleanInvert.SDKFieldName,
+			sdkModelName:             v.BooleanInvert.SDKModelName,
+			terraformSchemaModelName: v.BooleanInvert.TerraformSchemaModelName,
+		}, nil
+	}
+
+	if v, ok := input.(models.TerraformSubResourceIdFieldMappingDefinition); ok {
+		return &mappingSummary{
+			sdkFieldName:             v.SubResourceId.SDKFieldName,
+			sdkModelName:             v.SubResourceId.SDKModelName,
+			terraformSchemaModelName: v.SubResourceId.TerraformSchemaModelName,
+		}, nil
+	}
+
+	if v, ok := input.(models.TerraformSubResourceIdListFieldMappingDefinition); ok {
+		return &mappingSummary{
+			sdkFieldName:             v.SubResourceIdList.SDKFieldName,
+			sdkModelName:             v.SubResourceIdList.SDKModelName,
+			terraformSchemaModelName: v.SubResourceIdList.TerraformSchemaModelName,
+		}, nil
+	}
+
+	if v, ok := input.(models.TerraformODataBindFieldMappingDefinition); ok {
+		return &mappingSummary{
+			sdkFieldName:             v.ODataBind.SDKFieldName,
+			sdkModelName:             v.ODataBind.SDKModelName,
+			terraformSchemaModelName: v.ODataBind.TerraformSchemaModelName,
+		}, nil
+	}
+
 	return nil, fmt.Errorf("internal-error: unimplemented mapping type %+v", input)
 }
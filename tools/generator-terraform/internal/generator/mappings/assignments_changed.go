@@ -0,0 +1,232 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mappings
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/helpers"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+// SchemaModelToSdkModelChangedAssignmentLines returns the Create/Update assignment lines between the Schema Model and
+// SDK Model defined in `modelToModel` - where each assignment is only performed when the Terraform Schema Field(s) it's
+// sourced from have changed. This allows only the changed fields to be sent in the payload for a PATCH request.
+//
+// Only the fields within the top-level Schema Model are change-filtered, since `HasChange` is called with the HCL Name
+// of the field, which is only the full path to the field at the top-level. Fields which are nested blocks are sent
+// whole when any value within the block has changed.
+//
+// Nested SDK Models (defined using ModelToModel mappings) are assigned by calling `r.mapChanged{Schema}To{Sdk}` - which
+// the caller is expected to generate using this function, for each of the SDK Models returned in `nestedSdkModelNames`.
+//
+// NOTE: this uses `metadata.ResourceData` and as such is only supported for Resources using the Plugin SDK, Resources
+// using the Plugin Framework send the full payload.
+func (m *Mappings) SchemaModelToSdkModelChangedAssignmentLines(modelToModel models.TerraformModelToModelMappingDefinition, fieldMappings []models.TerraformFieldMappingDefinition) (lines *string, nestedSdkModelNames *[]string, err error) {
+	if modelToModel.TerraformSchemaModelName != m.topLevelSchemaModelName {
+		return nil, nil, fmt.Errorf("only the top-level Schema Model %q can be mapped when only the changed fields should be sent but got %q", m.topLevelSchemaModelName, modelToModel.TerraformSchemaModelName)
+	}
+
+	mappingsForThisModel, err := FindMappingsBetween(modelToModel, fieldMappings)
+	if err != nil {
+		return nil, nil, fmt.Errorf("finding mappings between Schema Model %q and SDK Model %q: %+v", modelToModel.TerraformSchemaModelName, modelToModel.SDKModelName, err)
+	}
+
+	schemaModel, ok := m.schemaModels[modelToModel.TerraformSchemaModelName]
+	if !ok {
+		return nil, nil, fmt.Errorf("the schema model %q referenced in mapping was not found", modelToModel.TerraformSchemaModelName)
+	}
+
+	output := make([]string, 0)
+	nestedModels := make([]string, 0)
+	for _, mapping := range *mappingsForThisModel {
+		if schemaFieldName := schemaFieldNameForMapping(mapping); schemaFieldName != nil {
+			schemaField, ok := schemaModel.Fields[*schemaFieldName]
+			if !ok {
+				return nil, nil, fmt.Errorf("the Field %q for Schema Model %q was not found", *schemaFieldName, modelToModel.TerraformSchemaModelName)
+			}
+
+			assignmentLine, err := m.schemaModelToSdkModelAssignmentLineForMapping(mapping)
+			if err != nil {
+				return nil, nil, err
+			}
+			if assignmentLine == nil {
+				continue
+			}
+
+			// when this field is a nested block, the block is sent whole when any value within it has changed
+			output = append(output, fmt.Sprintf(`
+	if metadata.ResourceData.HasChange(%[1]q) {
+		%[2]s
+	}
+`, schemaField.HCLName, strings.TrimSpace(*assignmentLine)))
+			continue
+		}
+
+		if v, ok := mapping.(models.TerraformModelToModelFieldMappingDefinition); ok {
+			assignmentLine, nestedModelName, err := m.changedAssignmentLineForModelToModel(v, fieldMappings)
+			if err != nil {
+				return nil, nil, fmt.Errorf("building changed assignment line for ModelToModel mapping %+v: %+v", v, err)
+			}
+			if assignmentLine == nil {
+				continue
+			}
+
+			output = append(output, *assignmentLine)
+			nestedModels = append(nestedModels, *nestedModelName)
+			continue
+		}
+
+		return nil, nil, fmt.Errorf("internal-error: missing changed assignment implementation for %+v", mapping)
+	}
+
+	out := strings.Join(output, "\n")
+	return &out, &nestedModels, nil
+}
+
+func (m *Mappings) changedAssignmentLineForModelToModel(mapping models.TerraformModelToModelFieldMappingDefinition, fieldMappings []models.TerraformFieldMappingDefinition) (*string, *string, error) {
+	if mapping.ModelToModel.TerraformSchemaModelName != m.topLevelSchemaModelName {
+		return nil, nil, fmt.Errorf("only the top-level Schema Model %q can be mapped when only the changed fields should be sent but got %q", m.topLevelSchemaModelName, mapping.ModelToModel.TerraformSchemaModelName)
+	}
+	sdkModel, ok := m.sdkModels[mapping.ModelToModel.SDKModelName]
+	if !ok {
+		return nil, nil, fmt.Errorf("the SDK Model %q referenced in mapping was not found", mapping.ModelToModel.SDKModelName)
+	}
+	sdkField, ok := sdkModel.Fields[mapping.ModelToModel.SDKFieldName]
+	if !ok {
+		return nil, nil, fmt.Errorf("couldn't find SDK Field %q in Model %q", mapping.ModelToModel.SDKFieldName, mapping.ModelToModel.SDKModelName)
+	}
+	if sdkField.ObjectDefinition.Type == models.RawObjectSDKObjectDefinitionType {
+		return nil, nil, nil
+	}
+	if sdkField.ObjectDefinition.Type != models.ReferenceSDKObjectDefinitionType || sdkField.ObjectDefinition.ReferenceName == nil {
+		return nil, nil, fmt.Errorf("a ModelToModel mapping must be a Reference but got %q", string(sdkField.ObjectDefinition.Type))
+	}
+	nestedModelName := *sdkField.ObjectDefinition.ReferenceName
+
+	// the nested SDK Model only needs to be sent when one of the fields mapped into it has changed
+	hclNames, err := m.hclNamesMappedInto(mapping.ModelToModel.TerraformSchemaModelName, nestedModelName, fieldMappings, map[string]struct{}{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("determining the Schema Fields mapped into SDK Model %q: %+v", nestedModelName, err)
+	}
+	if len(hclNames) == 0 {
+		return nil, nil, nil
+	}
+	quotedHclNames := make([]string, 0)
+	for _, name := range hclNames {
+		quotedHclNames = append(quotedHclNames, fmt.Sprintf("%q", name))
+	}
+	additionalArguments := ""
+	if m.usesMicrosoftGraphEndpoint {
+		additionalArguments = fmt.Sprintf(", %s", MicrosoftGraphEndpointArgumentName)
+	}
+
+	output := fmt.Sprintf(`
+	if metadata.ResourceData.HasChanges(%[4]s) {
+		if err := r.mapChanged%[1]sTo%[2]s(input, &output.%[3]s, metadata%[5]s); err != nil {
+			return fmt.Errorf("mapping changed Schema to SDK Field %%q / Model %%q: %%+v", %[2]q, %[3]q, err)
+		}
+	}
+`, mapping.ModelToModel.TerraformSchemaModelName, nestedModelName, mapping.ModelToModel.SDKFieldName, strings.Join(quotedHclNames, ", "), additionalArguments)
+	if sdkField.Optional {
+		sdkFieldType, err := helpers.GolangTypeForSDKObjectDefinition(sdkField.ObjectDefinition, &m.apiResourcePackageName, nil)
+		if err != nil {
+			return nil, nil, fmt.Errorf("determining Golang Type Name for SDK Field: %+v", err)
+		}
+
+		output = fmt.Sprintf(`
+	if metadata.ResourceData.HasChanges(%[5]s) {
+		if output.%[3]s == nil {
+			output.%[3]s = &%[4]s{}
+		}
+		if err := r.mapChanged%[1]sTo%[2]s(input, output.%[3]s, metadata%[6]s); err != nil {
+			return fmt.Errorf("mapping changed Schema to SDK Field %%q / Model %%q: %%+v", %[2]q, %[3]q, err)
+		}
+	}
+`, mapping.ModelToModel.TerraformSchemaModelName, nestedModelName, mapping.ModelToModel.SDKFieldName, *sdkFieldType, strings.Join(quotedHclNames, ", "), additionalArguments)
+	}
+
+	return &output, &nestedModelName, nil
+}
+
+// hclNamesMappedInto returns the (sorted) HCL Names of the Schema Fields within the Schema Model `schemaModelName`
+// which are mapped into the SDK Model `sdkModelName`, either directly or via a nested SDK Model.
+func (m *Mappings) hclNamesMappedInto(schemaModelName, sdkModelName string, fieldMappings []models.TerraformFieldMappingDefinition, seen map[string]struct{}) ([]string, error) {
+	if _, ok := seen[sdkModelName]; ok {
+		return []string{}, nil
+	}
+	seen[sdkModelName] = struct{}{}
+
+	schemaModel, ok := m.schemaModels[schemaModelName]
+	if !ok {
+		return nil, fmt.Errorf("the schema model %q referenced in mapping was not found", schemaModelName)
+	}
+	mappingsForThisModel, err := FindMappingsBetween(models.TerraformModelToModelMappingDefinition{
+		TerraformSchemaModelName: schemaModelName,
+		SDKModelName:             sdkModelName,
+	}, fieldMappings)
+	if err != nil {
+		return nil, fmt.Errorf("finding mappings between Schema Model %q and SDK Model %q: %+v", schemaModelName, sdkModelName, err)
+	}
+
+	uniqueNames := make(map[string]struct{})
+	for _, mapping := range *mappingsForThisModel {
+		if schemaFieldName := schemaFieldNameForMapping(mapping); schemaFieldName != nil {
+			schemaField, ok := schemaModel.Fields[*schemaFieldName]
+			if !ok {
+				return nil, fmt.Errorf("the Field %q for Schema Model %q was not found", *schemaFieldName, schemaModelName)
+			}
+			uniqueNames[schemaField.HCLName] = struct{}{}
+			continue
+		}
+
+		if v, ok := mapping.(models.TerraformModelToModelFieldMappingDefinition); ok {
+			sdkModel, ok := m.sdkModels[sdkModelName]
+			if !ok {
+				return nil, fmt.Errorf("the SDK Model %q referenced in mapping was not found", sdkModelName)
+			}
+			sdkField, ok := sdkModel.Fields[v.ModelToModel.SDKFieldName]
+			if !ok || sdkField.ObjectDefinition.ReferenceName == nil {
+				continue
+			}
+
+			nestedNames, err := m.hclNamesMappedInto(schemaModelName, *sdkField.ObjectDefinition.ReferenceName, fieldMappings, seen)
+			if err != nil {
+				return nil, err
+			}
+			for _, name := range nestedNames {
+				uniqueNames[name] = struct{}{}
+			}
+		}
+	}
+
+	output := make([]string, 0)
+	for name := range uniqueNames {
+		output = append(output, name)
+	}
+	sort.Strings(output)
+	return output, nil
+}
+
+// schemaFieldNameForMapping returns the name of the Schema Field which this mapping is sourced from, or nil when
+// this mapping isn't sourced from a single Schema Field (e.g. a ModelToModel mapping).
+func schemaFieldNameForMapping(mapping models.TerraformFieldMappingDefinition) *string {
+	switch v := mapping.(type) {
+	case models.TerraformDirectAssignmentFieldMappingDefinition:
+		return &v.DirectAssignment.TerraformSchemaFieldName
+	case models.TerraformBooleanEqualsFieldMappingDefinition:
+		return &v.BooleanEquals.TerraformSchemaFieldName
+	case models.TerraformBooleanInvertFieldMappingDefinition:
+		return &v.BooleanInvert.TerraformSchemaFieldName
+	case models.TerraformSubResourceIdFieldMappingDefinition:
+		return &v.SubResourceId.TerraformSchemaFieldName
+	case models.TerraformSubResourceIdListFieldMappingDefinition:
+		return &v.SubResourceIdList.TerraformSchemaFieldName
+	case models.TerraformODataBindFieldMappingDefinition:
+		return &v.ODataBind.TerraformSchemaFieldName
+	}
+	return nil
+}
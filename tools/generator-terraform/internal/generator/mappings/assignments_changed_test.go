@@ -0,0 +1,336 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mappings
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	"github.com/hashicorp/pandora/tools/sdk/testhelpers"
+)
+
+func TestSchemaModelToSdkModelChangedAssignmentLines(t *testing.T) {
+	schemaModels := map[string]models.TerraformSchemaModel{
+		"FromModel": {
+			Fields: map[string]models.TerraformSchemaField{
+				"Enabled": {
+					HCLName: "enabled",
+					ObjectDefinition: models.TerraformSchemaObjectDefinition{
+						Type: models.BooleanTerraformSchemaObjectDefinitionType,
+					},
+					Optional: true,
+				},
+				"Name": {
+					HCLName: "name",
+					ObjectDefinition: models.TerraformSchemaObjectDefinition{
+						Type: models.StringTerraformSchemaObjectDefinitionType,
+					},
+					Required: true,
+				},
+				"Size": {
+					HCLName: "size",
+					ObjectDefinition: models.TerraformSchemaObjectDefinition{
+						Type: models.IntegerTerraformSchemaObjectDefinitionType,
+					},
+					Required: true,
+				},
+			},
+		},
+		"FromModelBlock": {
+			Fields: map[string]models.TerraformSchemaField{
+				"Size": {
+					HCLName: "size",
+					ObjectDefinition: models.TerraformSchemaObjectDefinition{
+						Type: models.IntegerTerraformSchemaObjectDefinitionType,
+					},
+					Required: true,
+				},
+			},
+		},
+	}
+	sdkModels := map[string]models.SDKModel{
+		"ToModel": {
+			Fields: map[string]models.SDKField{
+				"Name": {
+					JsonName: "name",
+					ObjectDefinition: models.SDKObjectDefinition{
+						Type: models.StringSDKObjectDefinitionType,
+					},
+					Required: true,
+				},
+				"Properties": {
+					JsonName: "properties",
+					ObjectDefinition: models.SDKObjectDefinition{
+						Type:          models.ReferenceSDKObjectDefinitionType,
+						ReferenceName: pointer.To("ToModelProperties"),
+					},
+					Optional: true,
+				},
+				"RequiredProperties": {
+					JsonName: "requiredProperties",
+					ObjectDefinition: models.SDKObjectDefinition{
+						Type:          models.ReferenceSDKObjectDefinitionType,
+						ReferenceName: pointer.To("ToModelProperties"),
+					},
+					Required: true,
+				},
+				"Raw": {
+					JsonName: "raw",
+					ObjectDefinition: models.SDKObjectDefinition{
+						Type: models.RawObjectSDKObjectDefinitionType,
+					},
+					Optional: true,
+				},
+				"Unmapped": {
+					JsonName: "unmapped",
+					ObjectDefinition: models.SDKObjectDefinition{
+						Type:          models.ReferenceSDKObjectDefinitionType,
+						ReferenceName: pointer.To("UnmappedModel"),
+					},
+					Optional: true,
+				},
+			},
+		},
+		"ToModelProperties": {
+			Fields: map[string]models.SDKField{
+				"Enabled": {
+					JsonName: "enabled",
+					ObjectDefinition: models.SDKObjectDefinition{
+						Type: models.BooleanSDKObjectDefinitionType,
+					},
+					Optional: true,
+				},
+				"Nested": {
+					JsonName: "nested",
+					ObjectDefinition: models.SDKObjectDefinition{
+						Type:          models.ReferenceSDKObjectDefinitionType,
+						ReferenceName: pointer.To("ToModelNested"),
+					},
+					Optional: true,
+				},
+			},
+		},
+		"ToModelNested": {
+			Fields: map[string]models.SDKField{
+				"Size": {
+					JsonName: "size",
+					ObjectDefinition: models.SDKObjectDefinition{
+						Type: models.IntegerSDKObjectDefinitionType,
+					},
+					Required: true,
+				},
+			},
+		},
+		"UnmappedModel": {
+			Fields: map[string]models.SDKField{},
+		},
+	}
+	directAssignment := func(schemaFieldName, sdkModelName, sdkFieldName string) models.TerraformFieldMappingDefinition {
+		return models.TerraformDirectAssignmentFieldMappingDefinition{
+			DirectAssignment: models.TerraformDirectAssignmentFieldMappingDefinitionImpl{
+				SDKFieldName:             sdkFieldName,
+				SDKModelName:             sdkModelName,
+				TerraformSchemaFieldName: schemaFieldName,
+				TerraformSchemaModelName: "FromModel",
+			},
+		}
+	}
+	modelToModel := func(sdkModelName, sdkFieldName string) models.TerraformFieldMappingDefinition {
+		return models.TerraformModelToModelFieldMappingDefinition{
+			ModelToModel: models.TerraformModelToModelFieldMappingDefinitionImpl{
+				SDKFieldName:             sdkFieldName,
+				SDKModelName:             sdkModelName,
+				TerraformSchemaModelName: "FromModel",
+			},
+		}
+	}
+	nestedMappings := []models.TerraformFieldMappingDefinition{
+		directAssignment("Enabled", "ToModelProperties", "Enabled"),
+		modelToModel("ToModelProperties", "Nested"),
+		directAssignment("Size", "ToModelNested", "Size"),
+	}
+
+	testData := []struct {
+		name            string
+		schemaModelName string
+		sdkModelName    string
+		mappings        []models.TerraformFieldMappingDefinition
+		expected        string
+		expectedNested  []string
+		expectError     bool
+	}{
+		{
+			name:         "No Mappings",
+			sdkModelName: "ToModel",
+			mappings:     []models.TerraformFieldMappingDefinition{},
+			expected:     "",
+		},
+		{
+			name:         "Direct Assignment",
+			sdkModelName: "ToModel",
+			mappings: []models.TerraformFieldMappingDefinition{
+				directAssignment("Name", "ToModel", "Name"),
+			},
+			expected: `
+if metadata.ResourceData.HasChange("name") {
+	output.Name = input.Name
+}
+`,
+		},
+		{
+			name:         "Direct Assignment into a Nested Model",
+			sdkModelName: "ToModelProperties",
+			mappings:     nestedMappings,
+			expected: `
+if metadata.ResourceData.HasChange("enabled") {
+	output.Enabled = &input.Enabled
+}
+if metadata.ResourceData.HasChanges("size") {
+	if output.Nested == nil {
+		output.Nested = &sdkresource.ToModelNested{}
+	}
+	if err := r.mapChangedFromModelToToModelNested(input, output.Nested, metadata); err != nil {
+		return fmt.Errorf("mapping changed Schema to SDK Field %q / Model %q: %+v", "ToModelNested", "Nested", err)
+	}
+}
+`,
+			expectedNested: []string{"ToModelNested"},
+		},
+		{
+			name:         "Boolean Invert",
+			sdkModelName: "ToModelProperties",
+			mappings: []models.TerraformFieldMappingDefinition{
+				models.TerraformBooleanInvertFieldMappingDefinition{
+					BooleanInvert: models.TerraformBooleanInvertFieldMappingDefinitionImpl{
+						SDKFieldName:             "Enabled",
+						SDKModelName:             "ToModelProperties",
+						TerraformSchemaFieldName: "Enabled",
+						TerraformSchemaModelName: "FromModel",
+					},
+				},
+			},
+			expected: `
+if metadata.ResourceData.HasChange("enabled") {
+	output.Enabled = pointer.To(!input.Enabled)
+}
+`,
+		},
+		{
+			name:         "Model To Model which is Optional",
+			sdkModelName: "ToModel",
+			mappings: append([]models.TerraformFieldMappingDefinition{
+				directAssignment("Name", "ToModel", "Name"),
+				modelToModel("ToModel", "Properties"),
+			}, nestedMappings...),
+			expected: `
+if metadata.ResourceData.HasChange("name") {
+	output.Name = input.Name
+}
+if metadata.ResourceData.HasChanges("enabled", "size") {
+	if output.Properties == nil {
+		output.Properties = &sdkresource.ToModelProperties{}
+	}
+	if err := r.mapChangedFromModelToToModelProperties(input, output.Properties, metadata); err != nil {
+		return fmt.Errorf("mapping changed Schema to SDK Field %q / Model %q: %+v", "ToModelProperties", "Properties", err)
+	}
+}
+`,
+			expectedNested: []string{"ToModelProperties"},
+		},
+		{
+			name:         "Model To Model which is Required",
+			sdkModelName: "ToModel",
+			mappings: append([]models.TerraformFieldMappingDefinition{
+				modelToModel("ToModel", "RequiredProperties"),
+			}, nestedMappings...),
+			expected: `
+if metadata.ResourceData.HasChanges("enabled", "size") {
+	if err := r.mapChangedFromModelToToModelProperties(input, &output.RequiredProperties, metadata); err != nil {
+		return fmt.Errorf("mapping changed Schema to SDK Field %q / Model %q: %+v", "ToModelProperties", "RequiredProperties", err)
+	}
+}
+`,
+			expectedNested: []string{"ToModelProperties"},
+		},
+		{
+			name:         "Model To Model with no Fields mapped into it",
+			sdkModelName: "ToModel",
+			mappings: []models.TerraformFieldMappingDefinition{
+				modelToModel("ToModel", "Unmapped"),
+			},
+			expected: "",
+		},
+		{
+			name:         "Model To Model which is a Raw Object",
+			sdkModelName: "ToModel",
+			mappings: []models.TerraformFieldMappingDefinition{
+				modelToModel("ToModel", "Raw"),
+			},
+			expected: "",
+		},
+		{
+			// `HasChange` requires the full path to a nested field, so only the top-level Schema Model is supported
+			name:            "Nested Schema Model",
+			schemaModelName: "FromModelBlock",
+			sdkModelName:    "ToModelNested",
+			mappings: []models.TerraformFieldMappingDefinition{
+				models.TerraformDirectAssignmentFieldMappingDefinition{
+					DirectAssignment: models.TerraformDirectAssignmentFieldMappingDefinitionImpl{
+						SDKFieldName:             "Size",
+						SDKModelName:             "ToModelNested",
+						TerraformSchemaFieldName: "Size",
+						TerraformSchemaModelName: "FromModelBlock",
+					},
+				},
+			},
+			expectError: true,
+		},
+		{
+			name:         "Direct Assignment from a Schema Field which doesn't exist",
+			sdkModelName: "ToModel",
+			mappings: []models.TerraformFieldMappingDefinition{
+				directAssignment("DoesNotExist", "ToModel", "Name"),
+			},
+			expectError: true,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("Test %q", v.name)
+		helper := Mappings{
+			apiResourcePackageName:  "sdkresource",
+			schemaModels:            schemaModels,
+			sdkModels:               sdkModels,
+			topLevelSchemaModelName: "FromModel",
+		}
+		schemaModelName := "FromModel"
+		if v.schemaModelName != "" {
+			schemaModelName = v.schemaModelName
+		}
+		input := models.TerraformModelToModelMappingDefinition{
+			TerraformSchemaModelName: schemaModelName,
+			SDKModelName:             v.sdkModelName,
+		}
+		actual, actualNested, err := helper.SchemaModelToSdkModelChangedAssignmentLines(input, v.mappings)
+		if v.expectError {
+			if err == nil {
+				t.Fatalf("expected an error but didn't get one")
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		testhelpers.AssertTemplatedCodeMatches(t, v.expected, *actual)
+
+		expectedNested := v.expectedNested
+		if expectedNested == nil {
+			expectedNested = []string{}
+		}
+		if !reflect.DeepEqual(expectedNested, *actualNested) {
+			t.Fatalf("expected the nested SDK Models to be %+v but got %+v", expectedNested, *actualNested)
+		}
+	}
+}
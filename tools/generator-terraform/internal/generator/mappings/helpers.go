@@ -5,6 +5,8 @@ package mappings
 
 import (
 	"fmt"
+	"go/token"
+	"strings"
 
 	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
 )
@@ -29,8 +31,55 @@ func FindMappingsBetween(input models.TerraformModelToModelMappingDefinition, ma
 			continue
 		}
 
+		if v, ok := item.(models.TerraformBooleanEqualsFieldMappingDefinition); ok {
+			if v.BooleanEquals.TerraformSchemaModelName == input.TerraformSchemaModelName && v.BooleanEquals.SDKModelName == input.SDKModelName {
+				output = append(output, item)
+			}
+			continue
+		}
+
+		if v, ok := item.(models.TerraformBooleanInvertFieldMappingDefinition); ok {
+			if v.BooleanInvert.TerraformSchemaModelName == input.TerraformSchemaModelName && v.BooleanInvert.SDKModelName == input.SDKModelName {
+				output = append(output, item)
+			}
+			continue
+		}
+
+		if v, ok := item.(models.TerraformSubResourceIdFieldMappingDefinition); ok {
+			if v.SubResourceId.TerraformSchemaModelName == input.TerraformSchemaModelName && v.SubResourceId.SDKModelName == input.SDKModelName {
+				output = append(output, item)
+			}
+			continue
+		}
+
+		if v, ok := item.(models.TerraformSubResourceIdListFieldMappingDefinition); ok {
+			if v.SubResourceIdList.TerraformSchemaModelName == input.TerraformSchemaModelName && v.SubResourceIdList.SDKModelName == input.SDKModelName {
+				output = append(output, item)
+			}
+			continue
+		}
+
+		if v, ok := item.(models.TerraformODataBindFieldMappingDefinition); ok {
+			if v.ODataBind.TerraformSchemaModelName == input.TerraformSchemaModelName && v.ODataBind.SDKModelName == input.SDKModelName {
+				output = append(output, item)
+			}
+			continue
+		}
+
 		return nil, fmt.Errorf("internal-error: unimplemented mapping type %+v", item)
 	}
 
 	return &output, nil
 }
+
+// localVariableNameForSDKField returns the name of a local variable used to hold the value for the SDK Field named
+// sdkFieldName. This is based on the (Go) name of the SDK Field rather than the JSON name, since the JSON name can be
+// a Go keyword (e.g. `type`) - and since the SDK Field name is unique within the SDK Model, this is unique within
+// the mapping function.
+func localVariableNameForSDKField(sdkFieldName, suffix string) string {
+	output := fmt.Sprintf("%s%s%s", strings.ToLower(sdkFieldName[:1]), sdkFieldName[1:], suffix)
+	if token.IsKeyword(output) {
+		output = fmt.Sprintf("%sValue", output)
+	}
+	return output
+}
@@ -16,16 +16,28 @@ import (
 
 type Mappings struct {
 	apiResourcePackageName string
+	apiVersion             string
 	sdkConstants           map[string]models.SDKConstant
 	sdkModels              map[string]models.SDKModel
 	schemaModels           map[string]models.TerraformSchemaModel
+
+	// topLevelSchemaModelName is the name of the top-level Schema Model for this Terraform Resource
+	topLevelSchemaModelName string
+
+	// usesMicrosoftGraphEndpoint specifies whether the Schema to SDK mapping functions take the Microsoft Graph
+	// endpoint as an argument (see UsesMicrosoftGraphEndpoint).
+	usesMicrosoftGraphEndpoint bool
 }
 
 func NewResourceMappings(terraformDefinition models.TerraformResourceDefinition, sdkConstants map[string]models.SDKConstant, sdkModels map[string]models.SDKModel) Mappings {
 	return Mappings{
 		apiResourcePackageName: strings.ToLower(terraformDefinition.APIResource),
+		apiVersion:             terraformDefinition.APIVersion,
 		schemaModels:           terraformDefinition.SchemaModels,
 		sdkConstants:           sdkConstants,
 		sdkModels:              sdkModels,
+
+		topLevelSchemaModelName:    terraformDefinition.SchemaModelName,
+		usesMicrosoftGraphEndpoint: UsesMicrosoftGraphEndpoint(terraformDefinition.Mappings),
 	}
 }
@@ -11,12 +11,24 @@ import (
 )
 
 type ResourceInput struct {
+	// CommonTypes contains the Common Types (for the SdkApiVersion) which are used by this SDK Resource, which are
+	// output into the package CommonTypesPackageName rather than the package for the SdkResourceName.
+	// NOTE: these are also present within Constants, Models and ResourceIds.
+	CommonTypes models.CommonTypes
+
+	// CommonTypesPackageName is the name of the package containing the CommonTypes (e.g. `stable`), or nil
+	// when Common Types aren't used for this Resource.
+	CommonTypesPackageName *string
+
 	// Constants is a map of Constant Name (key) to SDKConstant (value) for the constants used within this SDK Resource
 	Constants map[string]models.SDKConstant
 
 	// Details contains information about the Terraform Resource which should be generated.
 	Details models.TerraformResourceDefinition
 
+	// Framework is the Terraform framework which this Terraform Resource should be generated for.
+	Framework models.TerraformFrameworkType
+
 	// Models is a map of Model Name (key) to SDKModel (value) for the models used within this SDK Resource
 	Models map[string]models.SDKModel
 
@@ -44,6 +56,10 @@ type ResourceInput struct {
 	// SchemaModels is a map of Schema Model Name (key) to TerraformSchemaModel (value).
 	SchemaModels map[string]models.TerraformSchemaModel
 
+	// SchemaVersion is the Schema Version for this Resource, which is incremented (and a State Upgrader
+	// generated) when the Schema changes in a manner which is incompatible with existing State.
+	SchemaVersion int
+
 	// SdkApiVersion is the API Version within the SdkServiceName which should be used.
 	SdkApiVersion string
 
@@ -58,6 +74,37 @@ type ResourceInput struct {
 
 	// ServicePackageName is the name of the Service Package within the Terraform Provider repository.
 	ServicePackageName string
+
+	// SourceDataOrigin is the origin of the Source Data for the SdkApiVersion, which determines the
+	// packages within `github.com/hashicorp/go-azure-sdk` which should be used.
+	SourceDataOrigin models.SourceDataOrigin
+}
+
+// CommonTypesPackageNameFor returns the name of the package containing the Common Types when the Constant, Model or
+// Resource ID named `name` is a Common Type - or nil when it's defined within the package for the SdkResourceName.
+func (id ResourceInput) CommonTypesPackageNameFor(name string) *string {
+	if id.CommonTypesPackageName == nil {
+		return nil
+	}
+
+	_, isConstant := id.CommonTypes.Constants[name]
+	_, isModel := id.CommonTypes.Models[name]
+	_, isResourceId := id.CommonTypes.ResourceIDs[name]
+	if isConstant || isModel || isResourceId {
+		return id.CommonTypesPackageName
+	}
+
+	return nil
+}
+
+// SdkPackageNameFor returns the name of the package containing the Constant, Model or Resource ID named `name` - which
+// is the CommonTypesPackageName for Common Types, else the package for the SdkResourceName.
+func (id ResourceInput) SdkPackageNameFor(name string) string {
+	if packageName := id.CommonTypesPackageNameFor(name); packageName != nil {
+		return *packageName
+	}
+
+	return strings.ToLower(id.SdkResourceName)
 }
 
 func (id ResourceInput) ParseResourceIdFuncName() (*string, error) {
@@ -71,7 +118,7 @@ func (id ResourceInput) ParseResourceIdFuncName() (*string, error) {
 		return &out, nil
 	}
 
-	out := fmt.Sprintf("%[1]s.Parse%[2]sID", strings.ToLower(id.SdkResourceName), strings.TrimSuffix(id.Details.ResourceIDName, "Id"))
+	out := fmt.Sprintf("%[1]s.Parse%[2]sID", id.SdkPackageNameFor(id.Details.ResourceIDName), strings.TrimSuffix(id.Details.ResourceIDName, "Id"))
 	return &out, nil
 }
 
@@ -86,7 +133,22 @@ func (id ResourceInput) NewResourceIdFuncName() (*string, error) {
 		return &out, nil
 	}
 
-	out := fmt.Sprintf("%[1]s.New%[2]sID", strings.ToLower(id.SdkResourceName), strings.TrimSuffix(id.Details.ResourceIDName, "Id"))
+	out := fmt.Sprintf("%[1]s.New%[2]sID", id.SdkPackageNameFor(id.Details.ResourceIDName), strings.TrimSuffix(id.Details.ResourceIDName, "Id"))
+	return &out, nil
+}
+
+func (id ResourceInput) ResourceIdTypeName() (*string, error) {
+	resourceId, ok := id.ResourceIds[id.Details.ResourceIDName]
+	if !ok {
+		return nil, fmt.Errorf("missing Resource ID %q", id.Details.ResourceIDName)
+	}
+
+	if resourceId.CommonIDAlias != nil {
+		out := fmt.Sprintf("commonids.%[1]sId", *resourceId.CommonIDAlias)
+		return &out, nil
+	}
+
+	out := fmt.Sprintf("%[1]s.%[2]sId", id.SdkPackageNameFor(id.Details.ResourceIDName), strings.TrimSuffix(id.Details.ResourceIDName, "Id"))
 	return &out, nil
 }
 
@@ -101,6 +163,6 @@ func (id ResourceInput) ValidateResourceIdFuncName() (*string, error) {
 		return &out, nil
 	}
 
-	out := fmt.Sprintf("%[1]s.Validate%[2]sID", strings.ToLower(id.SdkResourceName), strings.TrimSuffix(id.Details.ResourceIDName, "Id"))
+	out := fmt.Sprintf("%[1]s.Validate%[2]sID", id.SdkPackageNameFor(id.Details.ResourceIDName), strings.TrimSuffix(id.Details.ResourceIDName, "Id"))
 	return &out, nil
 }
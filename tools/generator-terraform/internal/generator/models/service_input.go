@@ -3,10 +3,18 @@
 
 package models
 
+import "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+
 type ServiceInput struct {
 	// CategoryNames is a slice of Category Names the Data Sources and Resources contain.
 	CategoryNames []string
 
+	// DataSourceNames is a slice of the names of the Data Sources within this Service.
+	DataSourceNames []string
+
+	// Framework is the Terraform framework which the Resources within this Service are generated for.
+	Framework models.TerraformFrameworkType
+
 	// ProviderPrefix is the prefix used for the Resources within this Terraform Provider.
 	ProviderPrefix string
 
@@ -0,0 +1,403 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pluginframeworkattributes
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	generatorHelpers "github.com/hashicorp/pandora/tools/generator-terraform/internal/generator/helpers"
+)
+
+type PluginFrameworkAttributesHelpers struct {
+	SchemaModels map[string]models.TerraformSchemaModel
+
+	// AllArgumentsRequireReplace specifies that every argument (that is, a Required or Optional field) requires
+	// the Resource to be replaced when changed, which is the case when the Resource can't be updated.
+	AllArgumentsRequireReplace bool
+}
+
+// attributeKind describes the Plugin Framework types used for a single Schema Attribute.
+type attributeKind struct {
+	// name is the suffix used across the Plugin Framework packages for this kind (e.g. `String`)
+	name string
+
+	// packagePrefix is the lower-cased prefix used for the planmodifier/validator packages (e.g. `string`)
+	packagePrefix string
+}
+
+var (
+	boolAttributeKind    = attributeKind{name: "Bool", packagePrefix: "bool"}
+	float64AttributeKind = attributeKind{name: "Float64", packagePrefix: "float64"}
+	int64AttributeKind   = attributeKind{name: "Int64", packagePrefix: "int64"}
+	listAttributeKind    = attributeKind{name: "List", packagePrefix: "list"}
+	mapAttributeKind     = attributeKind{name: "Map", packagePrefix: "map"}
+	setAttributeKind     = attributeKind{name: "Set", packagePrefix: "set"}
+	stringAttributeKind  = attributeKind{name: "String", packagePrefix: "string"}
+)
+
+// CodeForModel returns the Plugin Framework Schema Attributes for the specified TerraformSchemaModel.
+// Top-level models additionally include the Computed `id` attribute used by the Plugin Framework.
+func (h PluginFrameworkAttributesHelpers) CodeForModel(input models.TerraformSchemaModel, isTopLevel bool) (*string, error) {
+	lines := make([]string, 0)
+
+	if isTopLevel {
+		lines = append(lines, strings.TrimSpace(`
+"id": schema.StringAttribute{
+	Computed: true,
+	PlanModifiers: []planmodifier.String{
+		stringplanmodifier.UseStateForUnknown(),
+	},
+}`))
+	}
+
+	// fields should be sorted Required -> Optional -> Computed, and alphabetically within each category
+	requiredFields := make([]string, 0)
+	optionalFields := make([]string, 0)
+	computedFields := make([]string, 0)
+	for fieldName, details := range input.Fields {
+		if details.Required {
+			requiredFields = append(requiredFields, fieldName)
+			continue
+		}
+
+		if details.Optional {
+			optionalFields = append(optionalFields, fieldName)
+			continue
+		}
+
+		if details.Computed {
+			computedFields = append(computedFields, fieldName)
+			continue
+		}
+
+		return nil, fmt.Errorf("field %q is neither required/optional/computed", fieldName)
+	}
+	sort.Strings(requiredFields)
+	sort.Strings(optionalFields)
+	sort.Strings(computedFields)
+
+	sortedNames := make([]string, 0)
+	sortedNames = append(sortedNames, requiredFields...)
+	sortedNames = append(sortedNames, optionalFields...)
+	sortedNames = append(sortedNames, computedFields...)
+
+	for _, fieldName := range sortedNames {
+		field := input.Fields[fieldName]
+		line, err := h.codeForPluginFrameworkAttribute(field)
+		if err != nil {
+			return nil, fmt.Errorf("building attribute code for field %q: %+v", fieldName, err)
+		}
+
+		lines = append(lines, fmt.Sprintf(`%[1]q: %[2]s`, field.HCLName, *line))
+	}
+
+	if len(lines) == 0 {
+		out := "map[string]schema.Attribute{}"
+		return &out, nil
+	}
+
+	output := strings.TrimSpace(fmt.Sprintf(`
+map[string]schema.Attribute{
+	%[1]s,
+}
+`, strings.Join(lines, ",\n")))
+	return &output, nil
+}
+
+func (h PluginFrameworkAttributesHelpers) codeForPluginFrameworkAttribute(field models.TerraformSchemaField) (*string, error) {
+	attributeTypeName, kind, attributes, err := h.attributesForObjectDefinition(field.ObjectDefinition)
+	if err != nil {
+		return nil, fmt.Errorf("building attributes for object definition: %+v", err)
+	}
+
+	if field.Required {
+		attributes = append(attributes, fmt.Sprintf("Required: %t", field.Required))
+	}
+	if field.Optional {
+		attributes = append(attributes, fmt.Sprintf("Optional: %t", field.Optional))
+	}
+	// the Plugin Framework requires that any attribute with a Default value is also Computed
+	if field.Computed || field.Default != nil {
+		attributes = append(attributes, "Computed: true")
+	}
+	if field.Default != nil {
+		value, err := generatorHelpers.GolangLiteralForValue(field.Default)
+		if err != nil {
+			return nil, fmt.Errorf("building the Default value: %+v", err)
+		}
+		attributes = append(attributes, fmt.Sprintf("Default: %[1]sdefault.Static%[2]s(%[3]s)", kind.packagePrefix, kind.name, *value))
+	}
+	if field.Sensitive {
+		attributes = append(attributes, fmt.Sprintf("Sensitive: %t", field.Sensitive))
+	}
+	if field.Documentation.Markdown != "" {
+		attributes = append(attributes, fmt.Sprintf("MarkdownDescription: %q", field.Documentation.Markdown))
+	}
+	if field.ForceNew || (h.AllArgumentsRequireReplace && (field.Required || field.Optional)) {
+		attributes = append(attributes, strings.TrimSpace(fmt.Sprintf(`
+PlanModifiers: []planmodifier.%[1]s{
+	%[2]splanmodifier.RequiresReplace(),
+}
+`, kind.name, kind.packagePrefix)))
+	}
+
+	validators, err := validatorsForField(field, *kind)
+	if err != nil {
+		return nil, fmt.Errorf("building validators: %+v", err)
+	}
+	if len(validators) > 0 {
+		attributes = append(attributes, strings.TrimSpace(fmt.Sprintf(`
+Validators: []validator.%[1]s{
+	%[2]s,
+}
+`, kind.name, strings.Join(validators, ",\n"))))
+	}
+
+	sort.Strings(attributes)
+	output := strings.TrimSpace(fmt.Sprintf(`
+schema.%[1]s{
+	%[2]s,
+}
+`, *attributeTypeName, strings.Join(attributes, ",\n")))
+	return &output, nil
+}
+
+func validatorsForField(field models.TerraformSchemaField, kind attributeKind) ([]string, error) {
+	output := make([]string, 0)
+
+	// References are output as a List containing at most a single item
+	if field.ObjectDefinition.Type == models.ReferenceTerraformSchemaObjectDefinitionType {
+		output = append(output, "listvalidator.SizeAtMost(1)")
+	}
+
+	switch val := field.Validation.(type) {
+	case nil:
+		break
+
+	case models.TerraformSchemaFieldValidationPossibleValuesDefinition:
+		line, err := validatorForPossibleValuesDefinition(val, kind)
+		if err != nil {
+			return nil, fmt.Errorf("building validator for possible values definition: %+v", err)
+		}
+		output = append(output, *line)
+
+	case models.TerraformSchemaFieldValidationRangeDefinition:
+		line, err := validatorForRangeDefinition(val, kind)
+		if err != nil {
+			return nil, fmt.Errorf("building validator for range definition: %+v", err)
+		}
+		output = append(output, *line)
+
+	case models.TerraformSchemaFieldValidationRegexDefinition:
+		if kind != stringAttributeKind {
+			return nil, fmt.Errorf("a regex can only be used with a String attribute but got %q", kind.name)
+		}
+		output = append(output, fmt.Sprintf("stringvalidator.RegexMatches(regexp.MustCompile(%q), \"\")", val.Regex))
+
+	default:
+		return nil, fmt.Errorf("internal-error: unimplemented validation type %+v", field.Validation)
+	}
+
+	return output, nil
+}
+
+func validatorForRangeDefinition(input models.TerraformSchemaFieldValidationRangeDefinition, kind attributeKind) (*string, error) {
+	if kind != float64AttributeKind && kind != int64AttributeKind {
+		return nil, fmt.Errorf("a range can only be used with a Float64 or Int64 attribute but got %q", kind.name)
+	}
+
+	literalFor := func(input float64) string {
+		if kind == int64AttributeKind {
+			return fmt.Sprintf("%d", int64(input))
+		}
+		value, _ := generatorHelpers.GolangLiteralForValue(input)
+		return *value
+	}
+
+	if input.Minimum != nil && input.Maximum != nil {
+		return pointer.To(fmt.Sprintf("%svalidator.Between(%s, %s)", kind.packagePrefix, literalFor(*input.Minimum), literalFor(*input.Maximum))), nil
+	}
+	if input.Minimum != nil {
+		return pointer.To(fmt.Sprintf("%svalidator.AtLeast(%s)", kind.packagePrefix, literalFor(*input.Minimum))), nil
+	}
+	if input.Maximum != nil {
+		return pointer.To(fmt.Sprintf("%svalidator.AtMost(%s)", kind.packagePrefix, literalFor(*input.Maximum))), nil
+	}
+
+	return nil, fmt.Errorf("internal-error: a Range must have a Minimum and/or Maximum value")
+}
+
+func validatorForPossibleValuesDefinition(input models.TerraformSchemaFieldValidationPossibleValuesDefinition, kind attributeKind) (*string, error) {
+	if input.PossibleValues == nil {
+		return nil, fmt.Errorf("internal-error: type was PossibleValues but no PossibleValues were defined")
+	}
+
+	values := make([]string, 0)
+	switch input.PossibleValues.Type {
+	case models.FloatTerraformSchemaFieldValidationPossibleValuesType:
+		if kind != float64AttributeKind {
+			return nil, fmt.Errorf("float possible values can only be used with a Float64 attribute but got %q", kind.name)
+		}
+		for i, v := range input.PossibleValues.Values {
+			val, ok := v.(float64)
+			if !ok {
+				return nil, fmt.Errorf("expected PossibleValues value to be an float64 but was %+v for index %d", v, i)
+			}
+			values = append(values, fmt.Sprintf("%f,", val))
+		}
+
+	case models.IntegerTerraformSchemaFieldValidationPossibleValuesType:
+		if kind != int64AttributeKind {
+			return nil, fmt.Errorf("integer possible values can only be used with an Int64 attribute but got %q", kind.name)
+		}
+		for i, v := range input.PossibleValues.Values {
+			val, ok := v.(int64)
+			if !ok {
+				return nil, fmt.Errorf("expected PossibleValues value to be an int64 but was %+v for index %d", v, i)
+			}
+			values = append(values, fmt.Sprintf("%d,", val))
+		}
+
+	case models.StringTerraformSchemaFieldValidationPossibleValuesType:
+		if kind != stringAttributeKind {
+			return nil, fmt.Errorf("string possible values can only be used with a String attribute but got %q", kind.name)
+		}
+		for i, v := range input.PossibleValues.Values {
+			val, ok := v.(string)
+			if !ok {
+				return nil, fmt.Errorf("expected PossibleValues value to be a string but was %+v for index %d", v, i)
+			}
+			values = append(values, fmt.Sprintf("%q,", val))
+		}
+
+	default:
+		return nil, fmt.Errorf("internal-error: unimplemented validation possible values type: %q", string(input.PossibleValues.Type))
+	}
+
+	line := fmt.Sprintf(`%[1]svalidator.OneOf(
+%[2]s
+)`, kind.packagePrefix, strings.Join(values, "\n"))
+	return pointer.To(line), nil
+}
+
+// attributesForObjectDefinition returns the name of the Plugin Framework Schema Attribute type, the kind
+// used for its Plan Modifiers and Validators, and any type-specific attributes for this ObjectDefinition.
+func (h PluginFrameworkAttributesHelpers) attributesForObjectDefinition(input models.TerraformSchemaObjectDefinition) (*string, *attributeKind, []string, error) {
+	switch input.Type {
+	case models.BooleanTerraformSchemaObjectDefinitionType:
+		return pointer.To("BoolAttribute"), &boolAttributeKind, []string{}, nil
+
+	case models.FloatTerraformSchemaObjectDefinitionType:
+		return pointer.To("Float64Attribute"), &float64AttributeKind, []string{}, nil
+
+	case models.IntegerTerraformSchemaObjectDefinitionType:
+		return pointer.To("Int64Attribute"), &int64AttributeKind, []string{}, nil
+
+	case models.DateTimeTerraformSchemaObjectDefinitionType,
+		models.EdgeZoneTerraformSchemaObjectDefinitionType,
+		models.LocationTerraformSchemaObjectDefinitionType,
+		models.ResourceGroupTerraformSchemaObjectDefinitionType,
+		models.StringTerraformSchemaObjectDefinitionType,
+		models.ZoneTerraformSchemaObjectDefinitionType:
+		// TODO: should/can we also output validation for DateTime/Location here?
+		return pointer.To("StringAttribute"), &stringAttributeKind, []string{}, nil
+
+	case models.TagsTerraformSchemaObjectDefinitionType:
+		return pointer.To("MapAttribute"), &mapAttributeKind, []string{"ElementType: types.StringType"}, nil
+
+	case models.ZonesTerraformSchemaObjectDefinitionType:
+		return pointer.To("ListAttribute"), &listAttributeKind, []string{"ElementType: types.StringType"}, nil
+
+	case models.ReferenceTerraformSchemaObjectDefinitionType:
+		nestedObject, err := h.nestedAttributeObjectForReference(input.ReferenceName)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		// references are output as a List with at most 1 item for now, matching the Plugin SDK
+		return pointer.To("ListNestedAttribute"), &listAttributeKind, []string{*nestedObject}, nil
+
+	case models.DictionaryTerraformSchemaObjectDefinitionType, models.ListTerraformSchemaObjectDefinitionType, models.SetTerraformSchemaObjectDefinitionType:
+		if input.NestedObject == nil {
+			return nil, nil, nil, fmt.Errorf("internal-error: %s type with no nested object", strings.ToLower(string(input.Type)))
+		}
+
+		kind := listAttributeKind
+		if input.Type == models.DictionaryTerraformSchemaObjectDefinitionType {
+			kind = mapAttributeKind
+		}
+		if input.Type == models.SetTerraformSchemaObjectDefinitionType {
+			kind = setAttributeKind
+		}
+
+		if input.NestedObject.Type == models.ReferenceTerraformSchemaObjectDefinitionType {
+			nestedObject, err := h.nestedAttributeObjectForReference(input.NestedObject.ReferenceName)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+
+			return pointer.To(fmt.Sprintf("%sNestedAttribute", kind.name)), &kind, []string{*nestedObject}, nil
+		}
+
+		elementType, err := ElementTypeForObjectDefinition(*input.NestedObject)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("determining element type for nested object definition: %+v", err)
+		}
+		return pointer.To(fmt.Sprintf("%sAttribute", kind.name)), &kind, []string{fmt.Sprintf("ElementType: %s", *elementType)}, nil
+
+	case models.SystemAssignedIdentityTerraformSchemaObjectDefinitionType,
+		models.SystemAndUserAssignedIdentityTerraformSchemaObjectDefinitionType,
+		models.SystemOrUserAssignedIdentityTerraformSchemaObjectDefinitionType,
+		models.UserAssignedIdentityTerraformSchemaObjectDefinitionType:
+		return nil, nil, nil, fmt.Errorf("not-supported: %q fields are not yet supported when generating for the Plugin Framework", string(input.Type))
+	}
+
+	return nil, nil, nil, fmt.Errorf("internal-error: unimplemented schema field definition type %q", string(input.Type))
+}
+
+func (h PluginFrameworkAttributesHelpers) nestedAttributeObjectForReference(referenceName *string) (*string, error) {
+	if referenceName == nil {
+		return nil, fmt.Errorf("missing name for reference")
+	}
+	reference, ok := h.SchemaModels[*referenceName]
+	if !ok {
+		return nil, fmt.Errorf("schema model %q was not found", *referenceName)
+	}
+
+	codeForModel, err := h.CodeForModel(reference, false)
+	if err != nil {
+		return nil, fmt.Errorf("building code for nested model %q: %+v", *referenceName, err)
+	}
+
+	output := strings.TrimSpace(fmt.Sprintf(`
+NestedObject: schema.NestedAttributeObject{
+	Attributes: %[1]s,
+}
+`, *codeForModel))
+	return &output, nil
+}
+
+// ElementTypeForObjectDefinition returns the Plugin Framework `attr.Type` used for a basic ObjectDefinition
+// when it's nested within a List, Map or Set.
+func ElementTypeForObjectDefinition(input models.TerraformSchemaObjectDefinition) (*string, error) {
+	switch input.Type {
+	case models.BooleanTerraformSchemaObjectDefinitionType:
+		return pointer.To("types.BoolType"), nil
+
+	case models.FloatTerraformSchemaObjectDefinitionType:
+		return pointer.To("types.Float64Type"), nil
+
+	case models.IntegerTerraformSchemaObjectDefinitionType:
+		return pointer.To("types.Int64Type"), nil
+
+	case models.DateTimeTerraformSchemaObjectDefinitionType, models.StringTerraformSchemaObjectDefinitionType:
+		return pointer.To("types.StringType"), nil
+	}
+
+	return nil, fmt.Errorf("internal-error: unimplemented element type %q", string(input.Type))
+}
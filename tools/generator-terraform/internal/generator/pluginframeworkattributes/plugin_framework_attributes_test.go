@@ -0,0 +1,490 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pluginframeworkattributes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	"github.com/hashicorp/pandora/tools/sdk/testhelpers"
+)
+
+func TestPluginFrameworkAttributes_CodeForBasicFields(t *testing.T) {
+	basicFieldTypes := map[models.TerraformSchemaObjectDefinitionType]string{
+		models.BooleanTerraformSchemaObjectDefinitionType:  "Bool",
+		models.DateTimeTerraformSchemaObjectDefinitionType: "String",
+		models.FloatTerraformSchemaObjectDefinitionType:    "Float64",
+		models.IntegerTerraformSchemaObjectDefinitionType:  "Int64",
+		models.StringTerraformSchemaObjectDefinitionType:   "String",
+	}
+	for fieldType, frameworkType := range basicFieldTypes {
+		t.Run(fmt.Sprintf("Field Type %s", string(fieldType)), func(t *testing.T) {
+			testData := []struct {
+				input    models.TerraformSchemaField
+				expected string
+			}{
+				{
+					input: models.TerraformSchemaField{
+						ObjectDefinition: models.TerraformSchemaObjectDefinition{
+							Type: fieldType,
+						},
+						Required: true,
+					},
+					expected: fmt.Sprintf(`
+schema.%[1]sAttribute{
+	Required: true,
+}
+`, frameworkType),
+				},
+				{
+					input: models.TerraformSchemaField{
+						ObjectDefinition: models.TerraformSchemaObjectDefinition{
+							Type: fieldType,
+						},
+						ForceNew: true,
+						Required: true,
+					},
+					expected: fmt.Sprintf(`
+schema.%[1]sAttribute{
+	PlanModifiers: []planmodifier.%[1]s{
+		%[2]splanmodifier.RequiresReplace(),
+	},
+	Required: true,
+}
+`, frameworkType, map[string]string{"Bool": "bool", "Float64": "float64", "Int64": "int64", "String": "string"}[frameworkType]),
+				},
+				{
+					input: models.TerraformSchemaField{
+						ObjectDefinition: models.TerraformSchemaObjectDefinition{
+							Type: fieldType,
+						},
+						Optional: true,
+					},
+					expected: fmt.Sprintf(`
+schema.%[1]sAttribute{
+	Optional: true,
+}
+`, frameworkType),
+				},
+				{
+					input: models.TerraformSchemaField{
+						ObjectDefinition: models.TerraformSchemaObjectDefinition{
+							Type: fieldType,
+						},
+						Computed: true,
+						Optional: true,
+					},
+					expected: fmt.Sprintf(`
+schema.%[1]sAttribute{
+	Computed: true,
+	Optional: true,
+}
+`, frameworkType),
+				},
+				{
+					input: models.TerraformSchemaField{
+						ObjectDefinition: models.TerraformSchemaObjectDefinition{
+							Type: fieldType,
+						},
+						Computed: true,
+					},
+					expected: fmt.Sprintf(`
+schema.%[1]sAttribute{
+	Computed: true,
+}
+`, frameworkType),
+				},
+			}
+			for i, v := range testData {
+				t.Logf("Test %d", i)
+				actual, err := PluginFrameworkAttributesHelpers{}.codeForPluginFrameworkAttribute(v.input)
+				if err != nil {
+					t.Fatalf("unexpected error: %+v", err)
+				}
+				testhelpers.AssertTemplatedCodeMatches(t, v.expected, *actual)
+			}
+		})
+	}
+}
+
+func TestPluginFrameworkAttributes_CodeForStringWithPossibleValues(t *testing.T) {
+	input := models.TerraformSchemaField{
+		Documentation: models.TerraformSchemaFieldDocumentationDefinition{
+			Markdown: "The SKU which should be used.",
+		},
+		ObjectDefinition: models.TerraformSchemaObjectDefinition{
+			Type: models.StringTerraformSchemaObjectDefinitionType,
+		},
+		Required: true,
+		Validation: models.TerraformSchemaFieldValidationPossibleValuesDefinition{
+			PossibleValues: &models.TerraformSchemaFieldValidationPossibleValuesDefinitionImpl{
+				Type:   models.StringTerraformSchemaFieldValidationPossibleValuesType,
+				Values: []interface{}{"Basic", "Standard"},
+			},
+		},
+	}
+	expected := `
+schema.StringAttribute{
+	MarkdownDescription: "The SKU which should be used.",
+	Required: true,
+	Validators: []validator.String{
+		stringvalidator.OneOf(
+			"Basic",
+			"Standard",
+		),
+	},
+}
+`
+	actual, err := PluginFrameworkAttributesHelpers{}.codeForPluginFrameworkAttribute(input)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
+}
+
+func TestPluginFrameworkAttributes_CodeForPossibleValuesMismatchedType(t *testing.T) {
+	input := models.TerraformSchemaField{
+		ObjectDefinition: models.TerraformSchemaObjectDefinition{
+			Type: models.BooleanTerraformSchemaObjectDefinitionType,
+		},
+		Required: true,
+		Validation: models.TerraformSchemaFieldValidationPossibleValuesDefinition{
+			PossibleValues: &models.TerraformSchemaFieldValidationPossibleValuesDefinitionImpl{
+				Type:   models.StringTerraformSchemaFieldValidationPossibleValuesType,
+				Values: []interface{}{"Basic"},
+			},
+		},
+	}
+	actual, err := PluginFrameworkAttributesHelpers{}.codeForPluginFrameworkAttribute(input)
+	if err == nil {
+		t.Fatalf("expected an error but got %q", *actual)
+	}
+}
+
+func TestPluginFrameworkAttributes_CodeForIntegerWithRangeAndDefault(t *testing.T) {
+	input := models.TerraformSchemaField{
+		Default: int64(5),
+		ObjectDefinition: models.TerraformSchemaObjectDefinition{
+			Type: models.IntegerTerraformSchemaObjectDefinitionType,
+		},
+		Optional: true,
+		Validation: models.TerraformSchemaFieldValidationRangeDefinition{
+			Minimum: pointer.To(1.0),
+			Maximum: pointer.To(10.0),
+		},
+	}
+	expected := `
+schema.Int64Attribute{
+	Computed: true,
+	Default: int64default.StaticInt64(5),
+	Optional: true,
+	Validators: []validator.Int64{
+		int64validator.Between(1, 10),
+	},
+}
+`
+	actual, err := PluginFrameworkAttributesHelpers{}.codeForPluginFrameworkAttribute(input)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
+}
+
+func TestPluginFrameworkAttributes_CodeForStringWithRegex(t *testing.T) {
+	input := models.TerraformSchemaField{
+		ObjectDefinition: models.TerraformSchemaObjectDefinition{
+			Type: models.StringTerraformSchemaObjectDefinitionType,
+		},
+		Required: true,
+		Validation: models.TerraformSchemaFieldValidationRegexDefinition{
+			Regex: "^[a-z]+$",
+		},
+	}
+	expected := `
+schema.StringAttribute{
+	Required: true,
+	Validators: []validator.String{
+		stringvalidator.RegexMatches(regexp.MustCompile("^[a-z]+$"), ""),
+	},
+}
+`
+	actual, err := PluginFrameworkAttributesHelpers{}.codeForPluginFrameworkAttribute(input)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
+}
+
+func TestPluginFrameworkAttributes_CodeForRangeMismatchedType(t *testing.T) {
+	input := models.TerraformSchemaField{
+		ObjectDefinition: models.TerraformSchemaObjectDefinition{
+			Type: models.StringTerraformSchemaObjectDefinitionType,
+		},
+		Required: true,
+		Validation: models.TerraformSchemaFieldValidationRangeDefinition{
+			Minimum: pointer.To(1.0),
+		},
+	}
+	actual, err := PluginFrameworkAttributesHelpers{}.codeForPluginFrameworkAttribute(input)
+	if err == nil {
+		t.Fatalf("expected an error but got %q", *actual)
+	}
+}
+
+func TestPluginFrameworkAttributes_CodeForSensitiveString(t *testing.T) {
+	input := models.TerraformSchemaField{
+		ObjectDefinition: models.TerraformSchemaObjectDefinition{
+			Type: models.StringTerraformSchemaObjectDefinitionType,
+		},
+		Optional:  true,
+		Sensitive: true,
+	}
+	expected := `
+schema.StringAttribute{
+	Optional: true,
+	Sensitive: true,
+}
+`
+	actual, err := PluginFrameworkAttributesHelpers{}.codeForPluginFrameworkAttribute(input)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
+}
+
+func TestPluginFrameworkAttributes_CodeForCollectionsOfBasicTypes(t *testing.T) {
+	testData := []struct {
+		input    models.TerraformSchemaObjectDefinition
+		expected string
+	}{
+		{
+			input: models.TerraformSchemaObjectDefinition{
+				Type: models.ListTerraformSchemaObjectDefinitionType,
+				NestedObject: &models.TerraformSchemaObjectDefinition{
+					Type: models.StringTerraformSchemaObjectDefinitionType,
+				},
+			},
+			expected: `
+schema.ListAttribute{
+	ElementType: types.StringType,
+	Optional: true,
+}
+`,
+		},
+		{
+			input: models.TerraformSchemaObjectDefinition{
+				Type: models.SetTerraformSchemaObjectDefinitionType,
+				NestedObject: &models.TerraformSchemaObjectDefinition{
+					Type: models.IntegerTerraformSchemaObjectDefinitionType,
+				},
+			},
+			expected: `
+schema.SetAttribute{
+	ElementType: types.Int64Type,
+	Optional: true,
+}
+`,
+		},
+		{
+			input: models.TerraformSchemaObjectDefinition{
+				Type: models.DictionaryTerraformSchemaObjectDefinitionType,
+				NestedObject: &models.TerraformSchemaObjectDefinition{
+					Type: models.BooleanTerraformSchemaObjectDefinitionType,
+				},
+			},
+			expected: `
+schema.MapAttribute{
+	ElementType: types.BoolType,
+	Optional: true,
+}
+`,
+		},
+		{
+			input: models.TerraformSchemaObjectDefinition{
+				Type: models.TagsTerraformSchemaObjectDefinitionType,
+			},
+			expected: `
+schema.MapAttribute{
+	ElementType: types.StringType,
+	Optional: true,
+}
+`,
+		},
+		{
+			input: models.TerraformSchemaObjectDefinition{
+				Type: models.ZonesTerraformSchemaObjectDefinitionType,
+			},
+			expected: `
+schema.ListAttribute{
+	ElementType: types.StringType,
+	Optional: true,
+}
+`,
+		},
+	}
+	for i, v := range testData {
+		t.Logf("Test %d", i)
+		field := models.TerraformSchemaField{
+			ObjectDefinition: v.input,
+			Optional:         true,
+		}
+		actual, err := PluginFrameworkAttributesHelpers{}.codeForPluginFrameworkAttribute(field)
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		testhelpers.AssertTemplatedCodeMatches(t, v.expected, *actual)
+	}
+}
+
+func TestPluginFrameworkAttributes_CodeForModel(t *testing.T) {
+	helpers := PluginFrameworkAttributesHelpers{
+		SchemaModels: map[string]models.TerraformSchemaModel{
+			"ExampleResourceNestedItem": {
+				Fields: map[string]models.TerraformSchemaField{
+					"Value": {
+						HCLName: "value",
+						ObjectDefinition: models.TerraformSchemaObjectDefinition{
+							Type: models.IntegerTerraformSchemaObjectDefinitionType,
+						},
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+	input := models.TerraformSchemaModel{
+		Fields: map[string]models.TerraformSchemaField{
+			"Name": {
+				ForceNew: true,
+				HCLName:  "name",
+				ObjectDefinition: models.TerraformSchemaObjectDefinition{
+					Type: models.StringTerraformSchemaObjectDefinitionType,
+				},
+				Required: true,
+			},
+			"NestedItem": {
+				HCLName: "nested_item",
+				ObjectDefinition: models.TerraformSchemaObjectDefinition{
+					Type:          models.ReferenceTerraformSchemaObjectDefinitionType,
+					ReferenceName: pointer.To("ExampleResourceNestedItem"),
+				},
+				Optional: true,
+			},
+			"NestedItems": {
+				HCLName: "nested_items",
+				ObjectDefinition: models.TerraformSchemaObjectDefinition{
+					Type: models.SetTerraformSchemaObjectDefinitionType,
+					NestedObject: &models.TerraformSchemaObjectDefinition{
+						Type:          models.ReferenceTerraformSchemaObjectDefinitionType,
+						ReferenceName: pointer.To("ExampleResourceNestedItem"),
+					},
+				},
+				Computed: true,
+			},
+		},
+	}
+	expected := `
+map[string]schema.Attribute{
+	"id": schema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	},
+	"name": schema.StringAttribute{
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		Required: true,
+	},
+	"nested_item": schema.ListNestedAttribute{
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"value": schema.Int64Attribute{
+					Optional: true,
+				},
+			},
+		},
+		Optional: true,
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+	},
+	"nested_items": schema.SetNestedAttribute{
+		Computed: true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"value": schema.Int64Attribute{
+					Optional: true,
+				},
+			},
+		},
+	},
+}
+`
+	actual, err := helpers.CodeForModel(input, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
+}
+
+func TestPluginFrameworkAttributes_IdentityIsNotSupported(t *testing.T) {
+	input := models.TerraformSchemaField{
+		ObjectDefinition: models.TerraformSchemaObjectDefinition{
+			Type: models.SystemAssignedIdentityTerraformSchemaObjectDefinitionType,
+		},
+		Optional: true,
+	}
+	actual, err := PluginFrameworkAttributesHelpers{}.codeForPluginFrameworkAttribute(input)
+	if err == nil {
+		t.Fatalf("expected an error but got %q", *actual)
+	}
+}
+
+func TestPluginFrameworkAttributes_AllArgumentsRequireReplace(t *testing.T) {
+	helpers := PluginFrameworkAttributesHelpers{
+		AllArgumentsRequireReplace: true,
+	}
+
+	optional := models.TerraformSchemaField{
+		ObjectDefinition: models.TerraformSchemaObjectDefinition{
+			Type: models.StringTerraformSchemaObjectDefinitionType,
+		},
+		Optional: true,
+	}
+	expected := `
+schema.StringAttribute{
+	Optional: true,
+	PlanModifiers: []planmodifier.String{
+		stringplanmodifier.RequiresReplace(),
+	},
+}
+`
+	actual, err := helpers.codeForPluginFrameworkAttribute(optional)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
+
+	// Computed fields aren't arguments, so don't require replacement
+	computed := models.TerraformSchemaField{
+		Computed: true,
+		ObjectDefinition: models.TerraformSchemaObjectDefinition{
+			Type: models.StringTerraformSchemaObjectDefinitionType,
+		},
+	}
+	expected = `
+schema.StringAttribute{
+	Computed: true,
+}
+`
+	actual, err = helpers.codeForPluginFrameworkAttribute(computed)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	generatorHelpers "github.com/hashicorp/pandora/tools/generator-terraform/internal/generator/helpers"
 )
 
 type PluginSdkAttributesHelpers struct {
@@ -135,8 +136,19 @@ func (h PluginSdkAttributesHelpers) codeForPluginSdkAttribute(field models.Terra
 	if field.Computed {
 		attributes = append(attributes, fmt.Sprintf("Computed: %t", field.Computed))
 	}
+	if field.Sensitive {
+		attributes = append(attributes, fmt.Sprintf("Sensitive: %t", field.Sensitive))
+	}
 
-	validationAttributes, err := attributesForValidation(field.Validation)
+	if field.Default != nil {
+		value, err := generatorHelpers.GolangLiteralForValue(field.Default)
+		if err != nil {
+			return nil, fmt.Errorf("building the Default value: %+v", err)
+		}
+		attributes = append(attributes, fmt.Sprintf("Default: %s", *value))
+	}
+
+	validationAttributes, err := attributesForValidation(field.Validation, field.ObjectDefinition.Type)
 	if err != nil {
 		return nil, fmt.Errorf("building attributes for validation: %+v", err)
 	}
@@ -158,24 +170,68 @@ func (h PluginSdkAttributesHelpers) codeForPluginSdkAttribute(field models.Terra
 	return &output, nil
 }
 
-func attributesForValidation(input models.TerraformSchemaFieldValidationDefinition) (*[]string, error) {
+func attributesForValidation(input models.TerraformSchemaFieldValidationDefinition, fieldType models.TerraformSchemaObjectDefinitionType) (*[]string, error) {
 	output := make([]string, 0)
-	if input != nil {
-		val, ok := input.(models.TerraformSchemaFieldValidationPossibleValuesDefinition)
-		if !ok {
-			return nil, fmt.Errorf("internal-error: unimplemented validation type %+v", input)
-		}
+	if input == nil {
+		return &output, nil
+	}
 
+	switch val := input.(type) {
+	case models.TerraformSchemaFieldValidationPossibleValuesDefinition:
 		line, err := attributesForPossibleValuesDefinition(val)
 		if err != nil {
 			return nil, fmt.Errorf("building validation attribute for possible values definition: %+v", err)
 		}
 		output = append(output, *line)
+
+	case models.TerraformSchemaFieldValidationRangeDefinition:
+		line, err := attributesForRangeDefinition(val, fieldType)
+		if err != nil {
+			return nil, fmt.Errorf("building validation attribute for range definition: %+v", err)
+		}
+		output = append(output, *line)
+
+	case models.TerraformSchemaFieldValidationRegexDefinition:
+		output = append(output, fmt.Sprintf("ValidateFunc: validation.StringMatch(regexp.MustCompile(%q), \"\")", val.Regex))
+
+	default:
+		return nil, fmt.Errorf("internal-error: unimplemented validation type %+v", input)
 	}
 
 	return &output, nil
 }
 
+func attributesForRangeDefinition(input models.TerraformSchemaFieldValidationRangeDefinition, fieldType models.TerraformSchemaObjectDefinitionType) (*string, error) {
+	functionPrefixes := map[models.TerraformSchemaObjectDefinitionType]string{
+		models.FloatTerraformSchemaObjectDefinitionType:   "Float",
+		models.IntegerTerraformSchemaObjectDefinitionType: "Int",
+	}
+	functionPrefix, ok := functionPrefixes[fieldType]
+	if !ok {
+		return nil, fmt.Errorf("a Range can only be used with a Float or Integer field but got %q", string(fieldType))
+	}
+
+	literalFor := func(input float64) string {
+		if fieldType == models.IntegerTerraformSchemaObjectDefinitionType {
+			return fmt.Sprintf("%d", int64(input))
+		}
+		value, _ := generatorHelpers.GolangLiteralForValue(input)
+		return *value
+	}
+
+	if input.Minimum != nil && input.Maximum != nil {
+		return pointer.To(fmt.Sprintf("ValidateFunc: validation.%sBetween(%s, %s)", functionPrefix, literalFor(*input.Minimum), literalFor(*input.Maximum))), nil
+	}
+	if input.Minimum != nil {
+		return pointer.To(fmt.Sprintf("ValidateFunc: validation.%sAtLeast(%s)", functionPrefix, literalFor(*input.Minimum))), nil
+	}
+	if input.Maximum != nil {
+		return pointer.To(fmt.Sprintf("ValidateFunc: validation.%sAtMost(%s)", functionPrefix, literalFor(*input.Maximum))), nil
+	}
+
+	return nil, fmt.Errorf("internal-error: a Range must have a Minimum and/or Maximum value")
+}
+
 func attributesForPossibleValuesDefinition(input models.TerraformSchemaFieldValidationPossibleValuesDefinition) (*string, error) {
 	if input.PossibleValues == nil {
 		return nil, fmt.Errorf("internal-error: type was PossibleValues but no PossibleValues were defined")
@@ -154,6 +154,29 @@ func TestPluginSdkAttributes_CodeForBasicFields(t *testing.T) {
 	}
 }
 
+func TestPluginSdkAttributes_CodeForSensitiveField(t *testing.T) {
+	input := models.TerraformSchemaField{
+		ObjectDefinition: models.TerraformSchemaObjectDefinition{
+			Type: models.StringTerraformSchemaObjectDefinitionType,
+		},
+		Optional:  true,
+		Sensitive: true,
+	}
+	expected := `
+{
+	Optional: true,
+	Sensitive: true,
+	Type: pluginsdk.TypeString,
+}
+`
+	helper := PluginSdkAttributesHelpers{}
+	actual, err := helper.codeForPluginSdkAttribute(input)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
+}
+
 func TestPluginSdkAttributes_CodeForReference(t *testing.T) {
 	testData := []struct {
 		input    models.TerraformSchemaField
@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pluginsdkattributes
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	"github.com/hashicorp/pandora/tools/sdk/testhelpers"
+)
+
+func TestPluginSdkAttributes_CodeForValidationAndDefaults(t *testing.T) {
+	testData := []struct {
+		name     string
+		input    models.TerraformSchemaField
+		expected string
+	}{
+		{
+			name: "Integer with a Range",
+			input: models.TerraformSchemaField{
+				ObjectDefinition: models.TerraformSchemaObjectDefinition{
+					Type: models.IntegerTerraformSchemaObjectDefinitionType,
+				},
+				Required: true,
+				Validation: models.TerraformSchemaFieldValidationRangeDefinition{
+					Minimum: pointer.To(1.0),
+					Maximum: pointer.To(10.0),
+				},
+			},
+			expected: `
+{
+	Required: true,
+	Type: pluginsdk.TypeInt,
+	ValidateFunc: validation.IntBetween(1, 10),
+}
+`,
+		},
+		{
+			name: "Integer with a Minimum",
+			input: models.TerraformSchemaField{
+				ObjectDefinition: models.TerraformSchemaObjectDefinition{
+					Type: models.IntegerTerraformSchemaObjectDefinitionType,
+				},
+				Required: true,
+				Validation: models.TerraformSchemaFieldValidationRangeDefinition{
+					Minimum: pointer.To(1.0),
+				},
+			},
+			expected: `
+{
+	Required: true,
+	Type: pluginsdk.TypeInt,
+	ValidateFunc: validation.IntAtLeast(1),
+}
+`,
+		},
+		{
+			name: "Float with a Maximum",
+			input: models.TerraformSchemaField{
+				ObjectDefinition: models.TerraformSchemaObjectDefinition{
+					Type: models.FloatTerraformSchemaObjectDefinitionType,
+				},
+				Required: true,
+				Validation: models.TerraformSchemaFieldValidationRangeDefinition{
+					Maximum: pointer.To(2.5),
+				},
+			},
+			expected: `
+{
+	Required: true,
+	Type: pluginsdk.TypeFloat,
+	ValidateFunc: validation.FloatAtMost(2.5),
+}
+`,
+		},
+		{
+			name: "String with a Range",
+			input: models.TerraformSchemaField{
+				ObjectDefinition: models.TerraformSchemaObjectDefinition{
+					Type: models.StringTerraformSchemaObjectDefinitionType,
+				},
+				Required: true,
+				Validation: models.TerraformSchemaFieldValidationRangeDefinition{
+					Maximum: pointer.To(2.5),
+				},
+			},
+			expected: "",
+		},
+		{
+			name: "String with a Regex",
+			input: models.TerraformSchemaField{
+				ObjectDefinition: models.TerraformSchemaObjectDefinition{
+					Type: models.StringTerraformSchemaObjectDefinitionType,
+				},
+				Required: true,
+				Validation: models.TerraformSchemaFieldValidationRegexDefinition{
+					Regex: "^[a-z]+$",
+				},
+			},
+			expected: `
+{
+	Required: true,
+	Type: pluginsdk.TypeString,
+	ValidateFunc: validation.StringMatch(regexp.MustCompile("^[a-z]+$"), ""),
+}
+`,
+		},
+		{
+			name: "Boolean with a Default",
+			input: models.TerraformSchemaField{
+				Default: true,
+				ObjectDefinition: models.TerraformSchemaObjectDefinition{
+					Type: models.BooleanTerraformSchemaObjectDefinitionType,
+				},
+				Optional: true,
+			},
+			expected: `
+{
+	Default: true,
+	Optional: true,
+	Type: pluginsdk.TypeBool,
+}
+`,
+		},
+		{
+			name: "Float with a Default",
+			input: models.TerraformSchemaField{
+				Default: float64(2),
+				ObjectDefinition: models.TerraformSchemaObjectDefinition{
+					Type: models.FloatTerraformSchemaObjectDefinitionType,
+				},
+				Optional: true,
+			},
+			expected: `
+{
+	Default: 2.0,
+	Optional: true,
+	Type: pluginsdk.TypeFloat,
+}
+`,
+		},
+		{
+			name: "String with a Default",
+			input: models.TerraformSchemaField{
+				Default: "Standard",
+				ObjectDefinition: models.TerraformSchemaObjectDefinition{
+					Type: models.StringTerraformSchemaObjectDefinitionType,
+				},
+				Optional: true,
+			},
+			expected: `
+{
+	Default: "Standard",
+	Optional: true,
+	Type: pluginsdk.TypeString,
+}
+`,
+		},
+	}
+	for _, testCase := range testData {
+		t.Logf("Test %q", testCase.name)
+		helper := PluginSdkAttributesHelpers{}
+		actual, err := helper.codeForPluginSdkAttribute(testCase.input)
+		if err != nil {
+			if testCase.expected == "" {
+				continue
+			}
+
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if testCase.expected == "" {
+			t.Fatalf("expected an error but didn't get one")
+		}
+		testhelpers.AssertTemplatedCodeMatches(t, testCase.expected, *actual)
+	}
+}
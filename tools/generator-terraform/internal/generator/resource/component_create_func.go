@@ -20,10 +20,27 @@ type createFunctionComponents struct {
 	readMethod     models.SDKOperation
 	readMethodName string
 
+	// displayName is the Display Name of this Resource, used in error messages prior to the Resource ID being known
+	displayName string
+
+	// eventuallyConsistent specifies that the Read method should be polled until the Resource exists after creation
+	eventuallyConsistent bool
+
+	// idFromResponse specifies that the Resource ID is obtained from the response of the Create method, rather than
+	// being built from the Schema - which is the case when the Resource is created by POSTing to the collection
+	idFromResponse bool
+
+	// payloadCommonTypesPackageName is the name of the package containing the Common Types, when the SDK Model used
+	// as the payload is a Common Type - otherwise the SDK Model is within the package for the SDK Resource.
+	payloadCommonTypesPackageName *string
+
 	resourceTypeName       string
 	sdkResourceName        string
 	sdkResourceNameLowered string
 
+	// subscriptionIdSource is the expression used to obtain the Subscription ID when building the Resource ID
+	subscriptionIdSource string
+
 	models                map[string]models.SDKModel
 	mappings              models.TerraformMappingDefinition
 	newResourceIdFuncName string
@@ -71,21 +88,29 @@ func createFunctionForResource(input generatorModels.ResourceInput) (*string, er
 		return nil, fmt.Errorf("internal-error: top level model named %q was not found", *createOperation.RequestObject.ReferenceName)
 	}
 
+	// Resources created by POSTing to a collection (e.g. within Microsoft Graph) aren't created using their own Resource ID
+	idFromResponse := createOperation.ResourceIDName == nil || *createOperation.ResourceIDName != input.Details.ResourceIDName
+
 	helper := createFunctionComponents{
-		createMethod:           createOperation,
-		createMethodName:       input.Details.CreateMethod.SDKOperationName,
-		readMethod:             readOperation,
-		readMethodName:         input.Details.ReadMethod.SDKOperationName,
-		resourceTypeName:       input.ResourceTypeName,
-		sdkResourceName:        input.SdkResourceName,
-		sdkResourceNameLowered: strings.ToLower(input.SdkResourceName),
-		mappings:               input.Details.Mappings,
-		models:                 input.Models,
-		newResourceIdFuncName:  *newResourceIdFuncName,
-		resourceId:             resourceId,
-		terraformModel:         terraformModel,
-		terraformModelName:     input.SchemaModelName,
-		topLevelModel:          topLevelModel,
+		createMethod:                  createOperation,
+		createMethodName:              input.Details.CreateMethod.SDKOperationName,
+		readMethod:                    readOperation,
+		readMethodName:                input.Details.ReadMethod.SDKOperationName,
+		displayName:                   input.Details.DisplayName,
+		eventuallyConsistent:          input.Details.EventuallyConsistent,
+		idFromResponse:                idFromResponse,
+		payloadCommonTypesPackageName: input.CommonTypesPackageNameFor(topLevelModelName),
+		resourceTypeName:              input.ResourceTypeName,
+		sdkResourceName:               input.SdkResourceName,
+		sdkResourceNameLowered:        strings.ToLower(input.SdkResourceName),
+		subscriptionIdSource:          "metadata.Client.Account.SubscriptionId",
+		mappings:                      input.Details.Mappings,
+		models:                        input.Models,
+		newResourceIdFuncName:         *newResourceIdFuncName,
+		resourceId:                    resourceId,
+		terraformModel:                terraformModel,
+		terraformModelName:            input.SchemaModelName,
+		topLevelModel:                 topLevelModel,
 	}
 	components := []func() (*string, error){
 		helper.schemaDeserialization,
@@ -94,6 +119,17 @@ func createFunctionForResource(input generatorModels.ResourceInput) (*string, er
 		helper.payloadDefinition,
 		helper.create,
 	}
+	if idFromResponse {
+		// the Resource ID isn't known until the Resource has been created, so it's not possible to check for an existing Resource
+		components = []func() (*string, error){
+			helper.schemaDeserialization,
+			helper.payloadDefinition,
+			helper.createWithIdFromResponse,
+		}
+	}
+	if input.Details.EventuallyConsistent {
+		components = append(components, helper.waitForCreation)
+	}
 	lines := make([]string, 0)
 	for i, component := range components {
 		result, err := component()
@@ -138,6 +174,37 @@ func (h createFunctionComponents) create() (*string, error) {
 	return &output, nil
 }
 
+func (h createFunctionComponents) createWithIdFromResponse() (*string, error) {
+	if h.createMethod.LongRunning {
+		return nil, fmt.Errorf("obtaining the Resource ID from the response of a Long Running Create method isn't supported at this time")
+	}
+
+	userSpecifiedSegments := 0
+	for _, v := range h.resourceId.Segments {
+		if v.Type != models.StaticResourceIDSegmentType && v.Type != models.ResourceProviderResourceIDSegmentType {
+			userSpecifiedSegments++
+		}
+	}
+	if userSpecifiedSegments != 1 {
+		// TODO: support for nested Resources, where the segments for the parent Resource ID come from the Schema
+		return nil, fmt.Errorf("obtaining the Resource ID from the response is only supported for Resource IDs containing a single user-specified segment at this time but got %d", userSpecifiedSegments)
+	}
+
+	methodArguments := argumentsForApiOperationMethod(h.createMethod, h.sdkResourceNameLowered, h.createMethodName, false)
+	output := fmt.Sprintf(`
+			resp, err := client.%[1]s(%[2]s)
+			if err != nil {
+				return fmt.Errorf("creating %[3]s: %%+v", err)
+			}
+			if resp.Model == nil || resp.Model.Id == nil {
+				return fmt.Errorf("creating %[3]s: the ID was not returned by the API")
+			}
+
+			id := %[4]s(*resp.Model.Id)
+`, h.createMethodName, methodArguments, h.displayName, h.newResourceIdFuncName)
+	return &output, nil
+}
+
 func (h createFunctionComponents) idDefinitionAndMapping() (*string, error) {
 	newIdFuncName := h.newResourceIdFuncName
 	segments := make([]string, 0)
@@ -153,7 +220,7 @@ func (h createFunctionComponents) idDefinitionAndMapping() (*string, error) {
 		case models.SubscriptionIDResourceIDSegmentType:
 			{
 				segments = append(segments, "subscriptionId")
-				subscriptionIdDefinition = "subscriptionId := metadata.Client.Account.SubscriptionId"
+				subscriptionIdDefinition = fmt.Sprintf("subscriptionId := %s", h.subscriptionIdSource)
 				continue
 			}
 
@@ -199,17 +266,26 @@ id := %[3]s(%[4]s)
 func (h createFunctionComponents) payloadDefinition() (*string, error) {
 	// NOTE: whilst Payload is _technically_ optional in the API endpoint it's not, else it
 	// wouldn't be a Create method
-	createObjectName, err := helpers.GolangTypeForSDKObjectDefinition(*h.createMethod.RequestObject, &h.sdkResourceNameLowered, nil)
+	payloadPackageName := h.sdkResourceNameLowered
+	if h.payloadCommonTypesPackageName != nil {
+		payloadPackageName = *h.payloadCommonTypesPackageName
+	}
+	createObjectName, err := helpers.GolangTypeForSDKObjectDefinition(*h.createMethod.RequestObject, &payloadPackageName, nil)
 	if err != nil {
 		return nil, fmt.Errorf("determining Golang Type name for Create Request Object: %+v", err)
 	}
 
 	output := fmt.Sprintf(`
 			var payload %[1]s
-			if err := r.map%[2]sTo%[3]s(config, &payload); err != nil {
+			if err := r.map%[2]sTo%[3]s(config, &payload%[4]s); err != nil {
 				return fmt.Errorf("mapping schema model to sdk model: %%+v", err)
 			}
-`, *createObjectName, h.terraformModelName, *h.createMethod.RequestObject.ReferenceName)
+`, *createObjectName, h.terraformModelName, *h.createMethod.RequestObject.ReferenceName, schemaToSdkMappingAdditionalValues(h.mappings))
+	return &output, nil
+}
+
+func (h createFunctionComponents) waitForCreation() (*string, error) {
+	output := codeForWaitingForConsistency(h.readMethod, h.sdkResourceNameLowered, h.readMethodName, false, "WaitForUpdate", "creation")
 	return &output, nil
 }
 
@@ -444,6 +444,7 @@ func (r ExampleResource) Create() sdk.ResourceFunc {
 
 func TestComponentCreate_CreateFunc_Immediate_PayloadResourceIdNoOptions(t *testing.T) {
 	actual, err := createFunctionComponents{
+		subscriptionIdSource: "metadata.Client.Account.SubscriptionId",
 		createMethod: models.SDKOperation{
 			LongRunning:    false,
 			RequestObject:  &models.SDKObjectDefinition{},
@@ -466,6 +467,7 @@ func TestComponentCreate_CreateFunc_Immediate_PayloadResourceIdNoOptions(t *test
 
 func TestComponentCreate_CreateFunc_Immediate_PayloadResourceIdOptions(t *testing.T) {
 	actual, err := createFunctionComponents{
+		subscriptionIdSource: "metadata.Client.Account.SubscriptionId",
 		createMethod: models.SDKOperation{
 			LongRunning: false,
 			Options: map[string]models.SDKOperationOption{
@@ -491,6 +493,7 @@ func TestComponentCreate_CreateFunc_Immediate_PayloadResourceIdOptions(t *testin
 
 func TestComponentCreate_CreateFunc_LongRunning_PayloadResourceIdNoOptions(t *testing.T) {
 	actual, err := createFunctionComponents{
+		subscriptionIdSource: "metadata.Client.Account.SubscriptionId",
 		createMethod: models.SDKOperation{
 			LongRunning:    true,
 			RequestObject:  &models.SDKObjectDefinition{},
@@ -513,6 +516,7 @@ func TestComponentCreate_CreateFunc_LongRunning_PayloadResourceIdNoOptions(t *te
 
 func TestComponentCreate_CreateFunc_LongRunning_PayloadResourceIdOptions(t *testing.T) {
 	actual, err := createFunctionComponents{
+		subscriptionIdSource: "metadata.Client.Account.SubscriptionId",
 		createMethod: models.SDKOperation{
 			LongRunning: true,
 			Options: map[string]models.SDKOperationOption{
@@ -536,8 +540,103 @@ func TestComponentCreate_CreateFunc_LongRunning_PayloadResourceIdOptions(t *test
 	testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
 }
 
+func TestComponentCreate_CreateFunc_IdFromResponse(t *testing.T) {
+	actual, err := createFunctionComponents{
+		createMethod: models.SDKOperation{
+			LongRunning: false,
+			Options: map[string]models.SDKOperationOption{
+				"example": {},
+			},
+			RequestObject: &models.SDKObjectDefinition{},
+			URISuffix:     pointer.To("/groups"),
+		},
+		createMethodName:      "CreateGroup",
+		displayName:           "Group",
+		idFromResponse:        true,
+		newResourceIdFuncName: "stable.NewGroupID",
+		resourceId: models.ResourceID{
+			Segments: []models.ResourceIDSegment{
+				models.NewStaticValueResourceIDSegment("groups", "groups"),
+				models.NewUserSpecifiedResourceIDSegment("groupId", "groupId"),
+			},
+		},
+		sdkResourceNameLowered: "group",
+	}.createWithIdFromResponse()
+	if err != nil {
+		t.Fatalf("error: %+v", err)
+	}
+	expected := `
+			resp, err := client.CreateGroup(ctx, payload, group.DefaultCreateGroupOperationOptions())
+			if err != nil {
+				return fmt.Errorf("creating Group: %+v", err)
+			}
+			if resp.Model == nil || resp.Model.Id == nil {
+				return fmt.Errorf("creating Group: the ID was not returned by the API")
+			}
+
+			id := stable.NewGroupID(*resp.Model.Id)
+`
+	testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
+}
+
+func TestComponentCreate_CreateFunc_IdFromResponseNestedResource(t *testing.T) {
+	_, err := createFunctionComponents{
+		createMethod: models.SDKOperation{
+			RequestObject:  &models.SDKObjectDefinition{},
+			ResourceIDName: pointer.To("ApplicationId"),
+			URISuffix:      pointer.To("/owners"),
+		},
+		createMethodName: "CreateOwner",
+		idFromResponse:   true,
+		resourceId: models.ResourceID{
+			Segments: []models.ResourceIDSegment{
+				models.NewStaticValueResourceIDSegment("applications", "applications"),
+				models.NewUserSpecifiedResourceIDSegment("applicationId", "applicationId"),
+				models.NewStaticValueResourceIDSegment("owners", "owners"),
+				models.NewUserSpecifiedResourceIDSegment("directoryObjectId", "directoryObjectId"),
+			},
+		},
+		sdkResourceNameLowered: "owner",
+	}.createWithIdFromResponse()
+	if err == nil {
+		t.Fatalf("expected an error for a nested Resource but didn't get one")
+	}
+}
+
+func TestComponentCreate_WaitForCreation(t *testing.T) {
+	actual, err := createFunctionComponents{
+		readMethod: models.SDKOperation{
+			Options: map[string]models.SDKOperationOption{
+				"example": {},
+			},
+			ResourceIDName: pointer.To("GroupId"),
+		},
+		readMethodName:         "GetGroup",
+		sdkResourceNameLowered: "group",
+	}.waitForCreation()
+	if err != nil {
+		t.Fatalf("error: %+v", err)
+	}
+	expected := `
+			if err := consistency.WaitForUpdate(ctx, func(ctx context.Context) (*bool, error) {
+				resp, err := client.GetGroup(ctx, id, group.DefaultGetGroupOperationOptions())
+				if err != nil {
+					if response.WasNotFound(resp.HttpResponse) {
+						return pointer.To(false), nil
+					}
+					return nil, err
+				}
+				return pointer.To(resp.Model != nil), nil
+			}); err != nil {
+				return fmt.Errorf("waiting for the creation of %s: %+v", id, err)
+			}
+`
+	testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
+}
+
 func TestComponentCreate_RequiresImport_ResourceIdNoOptions(t *testing.T) {
 	actual, err := createFunctionComponents{
+		subscriptionIdSource: "metadata.Client.Account.SubscriptionId",
 		readMethod: models.SDKOperation{
 			LongRunning:    false,
 			ResourceIDName: pointer.To("SomeResourceId"),
@@ -564,6 +663,7 @@ func TestComponentCreate_RequiresImport_ResourceIdNoOptions(t *testing.T) {
 
 func TestComponentCreate_RequiresImport_ResourceIdOptions(t *testing.T) {
 	actual, err := createFunctionComponents{
+		subscriptionIdSource: "metadata.Client.Account.SubscriptionId",
 		readMethod: models.SDKOperation{
 			LongRunning: false,
 			Options: map[string]models.SDKOperationOption{
@@ -593,6 +693,7 @@ func TestComponentCreate_RequiresImport_ResourceIdOptions(t *testing.T) {
 
 func TestComponentCreate_IdDefinitionAndMapping_CommonResourceIDWithSubscription(t *testing.T) {
 	actual, err := createFunctionComponents{
+		subscriptionIdSource:  "metadata.Client.Account.SubscriptionId",
 		newResourceIdFuncName: "commonids.NewCommonResourceID",
 		resourceId: models.ResourceID{
 			CommonIDAlias: pointer.To("CommonResource"),
@@ -637,6 +738,7 @@ func TestComponentCreate_IdDefinitionAndMapping_CommonResourceIDWithSubscription
 
 func TestComponentCreate_IdDefinitionAndMapping_CommonResourceIDWithoutSubscription(t *testing.T) {
 	actual, err := createFunctionComponents{
+		subscriptionIdSource:  "metadata.Client.Account.SubscriptionId",
 		newResourceIdFuncName: "commonids.NewCommonResourceID",
 		resourceId: models.ResourceID{
 			CommonIDAlias: pointer.To("CommonResource"),
@@ -678,6 +780,7 @@ func TestComponentCreate_IdDefinitionAndMapping_CommonResourceIDWithoutSubscript
 
 func TestComponentCreate_IdDefinitionAndMapping_RegularResourceIDWithSubscription(t *testing.T) {
 	actual, err := createFunctionComponents{
+		subscriptionIdSource:  "metadata.Client.Account.SubscriptionId",
 		newResourceIdFuncName: "sdkresource.NewSomeResourceID",
 		resourceId: models.ResourceID{
 			CommonIDAlias: nil,
@@ -722,6 +825,7 @@ func TestComponentCreate_IdDefinitionAndMapping_RegularResourceIDWithSubscriptio
 
 func TestComponentCreate_IdDefinitionAndMapping_RegularResourceIDWithoutSubscription(t *testing.T) {
 	actual, err := createFunctionComponents{
+		subscriptionIdSource:  "metadata.Client.Account.SubscriptionId",
 		newResourceIdFuncName: "sdkresource.NewSomeResourceID",
 		resourceId: models.ResourceID{
 			CommonIDAlias: nil,
@@ -763,6 +867,7 @@ func TestComponentCreate_IdDefinitionAndMapping_RegularResourceIDWithoutSubscrip
 
 func TestComponentCreate_IdDefinitionAndMapping_RegularResourceIDConstantSegment(t *testing.T) {
 	actual, err := createFunctionComponents{
+		subscriptionIdSource:   "metadata.Client.Account.SubscriptionId",
 		newResourceIdFuncName:  "sdkresource.NewSomeResourceID",
 		sdkResourceNameLowered: "sdkresource",
 		resourceId: models.ResourceID{
@@ -806,6 +911,7 @@ func TestComponentCreate_IdDefinitionAndMapping_RegularResourceIDConstantSegment
 
 func TestComponentCreate_IdDefinitionAndMapping_ParentResourceID(t *testing.T) {
 	actual, err := createFunctionComponents{
+		subscriptionIdSource:  "metadata.Client.Account.SubscriptionId",
 		newResourceIdFuncName: "sdkresource.NewSomeResourceID",
 		resourceId: models.ResourceID{
 			CommonIDAlias: nil,
@@ -876,6 +982,7 @@ func TestComponentCreate_IdDefinitionAndMapping_ParentResourceID(t *testing.T) {
 
 func TestComponentCreate_IdDefinitionAndMapping_ParentResourceIDKubernetesExample(t *testing.T) {
 	actual, err := createFunctionComponents{
+		subscriptionIdSource:  "metadata.Client.Account.SubscriptionId",
 		newResourceIdFuncName: "trustedAccess.NewTrustedAccessRoleBindingID",
 		resourceId: models.ResourceID{
 			CommonIDAlias: nil,
@@ -948,6 +1055,7 @@ func TestComponentCreate_IdDefinitionAndMapping_ParentResourceIDKubernetesExampl
 
 func TestComponentCreate_PayloadDefinition(t *testing.T) {
 	actual, err := createFunctionComponents{
+		subscriptionIdSource: "metadata.Client.Account.SubscriptionId",
 		createMethod: models.SDKOperation{
 			RequestObject: &models.SDKObjectDefinition{
 				ReferenceName: pointer.To("SomeModel"),
@@ -969,10 +1077,75 @@ func TestComponentCreate_PayloadDefinition(t *testing.T) {
 	testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
 }
 
+func TestComponentCreate_PayloadDefinitionCommonType(t *testing.T) {
+	actual, err := createFunctionComponents{
+		createMethod: models.SDKOperation{
+			RequestObject: &models.SDKObjectDefinition{
+				ReferenceName:             pointer.To("Group"),
+				ReferenceNameIsCommonType: pointer.To(true),
+				Type:                      models.ReferenceSDKObjectDefinitionType,
+			},
+		},
+		payloadCommonTypesPackageName: pointer.To("stable"),
+		terraformModelName:            "GroupResource",
+		sdkResourceNameLowered:        "group",
+	}.payloadDefinition()
+	if err != nil {
+		t.Fatalf("error: %+v", err)
+	}
+	expected := `
+	var payload stable.Group
+	if err := r.mapGroupResourceToGroup(config, &payload); err != nil {
+		return fmt.Errorf("mapping schema model to sdk model: %+v", err)
+	}
+`
+	testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
+}
+
+func TestComponentCreate_PayloadDefinitionODataBind(t *testing.T) {
+	// the Microsoft Graph endpoint is configured in the Provider, so is passed into the mapping function
+	actual, err := createFunctionComponents{
+		createMethod: models.SDKOperation{
+			RequestObject: &models.SDKObjectDefinition{
+				ReferenceName:             pointer.To("Group"),
+				ReferenceNameIsCommonType: pointer.To(true),
+				Type:                      models.ReferenceSDKObjectDefinitionType,
+			},
+		},
+		mappings: models.TerraformMappingDefinition{
+			Fields: []models.TerraformFieldMappingDefinition{
+				models.TerraformODataBindFieldMappingDefinition{
+					ODataBind: models.TerraformODataBindFieldMappingDefinitionImpl{
+						EntitySetName:            "directoryObjects",
+						SDKFieldName:             "Owners_ODataBind",
+						SDKModelName:             "Group",
+						TerraformSchemaFieldName: "Owners",
+						TerraformSchemaModelName: "GroupResource",
+					},
+				},
+			},
+		},
+		payloadCommonTypesPackageName: pointer.To("stable"),
+		terraformModelName:            "GroupResource",
+		sdkResourceNameLowered:        "group",
+	}.payloadDefinition()
+	if err != nil {
+		t.Fatalf("error: %+v", err)
+	}
+	expected := `
+	var payload stable.Group
+	if err := r.mapGroupResourceToGroup(config, &payload, client.Client.BaseUri); err != nil {
+		return fmt.Errorf("mapping schema model to sdk model: %+v", err)
+	}
+`
+	testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
+}
+
 func TestComponentCreate_SchemaDeserialization(t *testing.T) {
 	actual, err := createFunctionComponents{
-		resourceTypeName:   "AwesomeResource",
-		terraformModelName: "AwesomeResourceTypedModel",
+		subscriptionIdSource: "metadata.Client.Account.SubscriptionId",
+		resourceTypeName:     "AwesomeResource",
+		terraformModelName:   "AwesomeResourceTypedModel",
 	}.schemaDeserialization()
 	if err != nil {
 		t.Fatalf("error: %+v", err)
@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/pandora/tools/generator-terraform/internal/generator/models"
+)
+
+func definitionForDataSource(input models.ResourceInput) (*string, error) {
+	// NOTE: the Data Source reuses the Typed Models (and Mappings) defined for the Resource, since
+	// the Schema Models only differ in whether each field is Required/Computed.
+	output := fmt.Sprintf(`
+var _ sdk.DataSource = %[1]sDataSource{}
+
+type %[1]sDataSource struct {}
+
+func (d %[1]sDataSource) ModelObject() interface{} {
+	return &%[2]s{}
+}
+
+func (d %[1]sDataSource) ResourceType() string {
+	return "%[3]s_%[4]s"
+}
+`, input.ResourceTypeName, input.SchemaModelName, input.ProviderPrefix, input.ResourceLabel)
+	return &output, nil
+}
@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/pandora/tools/generator-terraform/internal/generator/helpers"
+	generatorModels "github.com/hashicorp/pandora/tools/generator-terraform/internal/generator/models"
+)
+
+func readFunctionForDataSource(input generatorModels.ResourceInput) (*string, error) {
+	if input.Details.DataSource == nil || !input.Details.DataSource.Generate {
+		return nil, nil
+	}
+
+	readOperation, ok := input.Operations[input.Details.ReadMethod.SDKOperationName]
+	if !ok {
+		return nil, fmt.Errorf("couldn't find read operation named %q", input.Details.ReadMethod.SDKOperationName)
+	}
+	if readOperation.ResponseObject == nil || readOperation.ResponseObject.ReferenceName == nil {
+		return nil, fmt.Errorf("the read operation %q must return a Reference", input.Details.ReadMethod.SDKOperationName)
+	}
+
+	resourceId, ok := input.ResourceIds[input.Details.ResourceIDName]
+	if !ok {
+		return nil, fmt.Errorf("the Resource ID named %q was not found", input.Details.ResourceIDName)
+	}
+
+	newResourceIdFuncName, err := input.NewResourceIdFuncName()
+	if err != nil {
+		return nil, fmt.Errorf("obtaining New Resource ID Function for Data Source: %+v", err)
+	}
+
+	// the Resource ID is built from the configuration in the same way as the Create function
+	idHelper := createFunctionComponents{
+		sdkResourceNameLowered: strings.ToLower(input.SdkResourceName),
+		subscriptionIdSource:   "metadata.Client.Account.SubscriptionId",
+		mappings:               input.Details.Mappings,
+		newResourceIdFuncName:  *newResourceIdFuncName,
+		resourceId:             resourceId,
+	}
+	idDefinition, err := idHelper.idDefinitionAndMapping()
+	if err != nil {
+		return nil, fmt.Errorf("building code for the Resource ID: %+v", err)
+	}
+
+	// the fields making up the Resource ID are set from the configuration, since these may not be
+	// returned by the API (or may be returned in a different casing)
+	resourceIdFieldAssignments := make([]string, 0)
+	resourceIdFieldsSeen := make(map[string]struct{})
+	for _, v := range input.Details.Mappings.ResourceID {
+		if _, ok := resourceIdFieldsSeen[v.TerraformSchemaFieldName]; ok {
+			continue
+		}
+		resourceIdFieldsSeen[v.TerraformSchemaFieldName] = struct{}{}
+		resourceIdFieldAssignments = append(resourceIdFieldAssignments, fmt.Sprintf("schema.%[1]s = config.%[1]s", v.TerraformSchemaFieldName))
+	}
+	sort.Strings(resourceIdFieldAssignments)
+
+	methodArguments := argumentsForApiOperationMethod(readOperation, input.SdkResourceName, input.Details.ReadMethod.SDKOperationName, false)
+	output := fmt.Sprintf(`
+func (d %[1]sDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: %[2]d * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.%[3]s.%[4]s.%[5]s
+
+			var config %[6]s
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %%+v", err)
+			}
+
+			%[7]s
+
+			resp, err := client.%[8]s(%[9]s)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("%%s was not found", id)
+				}
+				return fmt.Errorf("retrieving %%s: %%+v", id, err)
+			}
+
+			schema := %[6]s{}
+			if model := resp.Model; model != nil {
+				if err := (%[1]sResource{}).map%[10]sTo%[6]s(*model, &schema); err != nil {
+					return fmt.Errorf("flattening model: %%+v", err)
+				}
+			}
+			%[11]s
+
+			metadata.SetID(id)
+			return metadata.Encode(&schema)
+		},
+	}
+}
+`, input.ResourceTypeName, input.Details.DataSource.TimeoutInMinutes, input.ServiceName, strings.Title(helpers.NamespaceForApiVersion(input.SdkApiVersion)), input.SdkResourceName, input.SchemaModelName, strings.TrimSpace(*idDefinition), input.Details.ReadMethod.SDKOperationName, methodArguments, *readOperation.ResponseObject.ReferenceName, strings.Join(resourceIdFieldAssignments, "\n"))
+	return &output, nil
+}
@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	generatorModels "github.com/hashicorp/pandora/tools/generator-terraform/internal/generator/models"
+	"github.com/hashicorp/pandora/tools/sdk/testhelpers"
+)
+
+func TestComponentDataSourceReadFunc_Disabled(t *testing.T) {
+	input := generatorModels.ResourceInput{
+		ResourceTypeName: "Example",
+		SdkResourceName:  "SdkResource",
+		ServiceName:      "Resources",
+		Details: models.TerraformResourceDefinition{
+			ReadMethod: models.TerraformMethodDefinition{
+				Generate:         true,
+				SDKOperationName: "Get",
+				TimeoutInMinutes: 10,
+			},
+			ResourceIDName: "ExampleId",
+		},
+	}
+	actual, err := readFunctionForDataSource(input)
+	if err != nil {
+		t.Fatalf("error: %+v", err)
+	}
+	if actual != nil {
+		t.Fatalf("expected `actual` to be nil but got %q", *actual)
+	}
+}
+
+func TestComponentDataSourceReadFunc_RegularResourceId(t *testing.T) {
+	input := generatorModels.ResourceInput{
+		ResourceTypeName: "Example",
+		SdkResourceName:  "SdkResource",
+		ServiceName:      "Resources",
+		SdkApiVersion:    "2021-01-01",
+		Details: models.TerraformResourceDefinition{
+			DataSource: &models.TerraformDataSourceDefinition{
+				Description:      "Gets information about an existing Example",
+				Generate:         true,
+				TimeoutInMinutes: 5,
+			},
+			ReadMethod: models.TerraformMethodDefinition{
+				Generate:         true,
+				SDKOperationName: "Get",
+				TimeoutInMinutes: 10,
+			},
+			ResourceIDName: "ExampleId",
+			Mappings: models.TerraformMappingDefinition{
+				ResourceID: []models.TerraformResourceIDMappingDefinition{
+					{
+						SegmentName:              "resourceGroupName",
+						TerraformSchemaFieldName: "ResourceGroupName",
+					},
+					{
+						SegmentName:              "exampleName",
+						TerraformSchemaFieldName: "Name",
+					},
+				},
+			},
+		},
+		Operations: map[string]models.SDKOperation{
+			"Get": {
+				LongRunning:    false,
+				ResourceIDName: pointer.To("ExampleId"),
+				ResponseObject: &models.SDKObjectDefinition{
+					Type:          models.ReferenceSDKObjectDefinitionType,
+					ReferenceName: pointer.To("GetModel"),
+				},
+			},
+		},
+		ResourceIds: map[string]models.ResourceID{
+			"ExampleId": {
+				ExampleValue: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Example/examples/{exampleName}",
+				Segments: []models.ResourceIDSegment{
+					models.NewStaticValueResourceIDSegment("subscriptions", "subscriptions"),
+					models.NewSubscriptionIDResourceIDSegment("subscriptionId"),
+					models.NewStaticValueResourceIDSegment("resourceGroups", "resourceGroups"),
+					models.NewResourceGroupNameResourceIDSegment("resourceGroupName"),
+					models.NewStaticValueResourceIDSegment("providers", "providers"),
+					models.NewResourceProviderResourceIDSegment("resourceProvider", "Microsoft.Example"),
+					models.NewStaticValueResourceIDSegment("examples", "examples"),
+					models.NewUserSpecifiedResourceIDSegment("exampleName", "exampleName"),
+				},
+			},
+		},
+		SchemaModelName: "ExampleModel",
+	}
+	actual, err := readFunctionForDataSource(input)
+	if err != nil {
+		t.Fatalf("error: %+v", err)
+	}
+	expected := `
+func (d ExampleDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resources.V20210101.SdkResource
+			var config ExampleModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+			subscriptionId := metadata.Client.Account.SubscriptionId
+			id := sdkresource.NewExampleID(subscriptionId, config.ResourceGroupName, config.Name)
+			resp, err := client.Get(ctx, id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("%s was not found", id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+			schema := ExampleModel{}
+			if model := resp.Model; model != nil {
+				if err := (ExampleResource{}).mapGetModelToExampleModel(*model, &schema); err != nil {
+					return fmt.Errorf("flattening model: %+v", err)
+				}
+			}
+			schema.Name = config.Name
+			schema.ResourceGroupName = config.ResourceGroupName
+			metadata.SetID(id)
+			return metadata.Encode(&schema)
+		},
+	}
+}
+`
+	testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
+}
@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/pandora/tools/generator-terraform/internal/generator/models"
+)
+
+func testDataSourceStruct(input models.ResourceInput) (*string, error) {
+	output := fmt.Sprintf("type %sTestDataSource struct{}", input.ResourceTypeName)
+	return &output, nil
+}
+
+func codeForDataSourceTestFunctions(input models.ResourceInput) (*string, error) {
+	if !input.Details.Tests.Generate {
+		return nil, nil
+	}
+
+	output := fmt.Sprintf(`
+func TestAcc%[1]sDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.%[2]s_%[3]s", "test")
+	d := %[1]sTestDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("id").Exists(),
+			),
+		},
+	})
+}
+`, input.ResourceTypeName, input.ProviderPrefix, input.ResourceLabel)
+	return &output, nil
+}
+
+func codeForDataSourceTestConfigurationFunctions(input models.ResourceInput) (*string, error) {
+	if !input.Details.Tests.Generate {
+		return nil, nil
+	}
+
+	schemaModel, ok := input.SchemaModels[input.SchemaModelName]
+	if !ok {
+		return nil, fmt.Errorf("the Schema Model named %q was not found", input.SchemaModelName)
+	}
+
+	// the Data Source looks up the Resource provisioned in the `basic` test for the Resource
+	// using the fields which make up the Resource ID
+	lines := make([]string, 0)
+	seen := make(map[string]struct{})
+	for _, v := range input.Details.Mappings.ResourceID {
+		field, ok := schemaModel.Fields[v.TerraformSchemaFieldName]
+		if !ok {
+			return nil, fmt.Errorf("the Resource ID field %q was not found in the Schema Model %q", v.TerraformSchemaFieldName, input.SchemaModelName)
+		}
+		if _, ok := seen[field.HCLName]; ok {
+			continue
+		}
+		seen[field.HCLName] = struct{}{}
+		lines = append(lines, fmt.Sprintf("  %[1]s = %[2]s_%[3]s.test.%[1]s", field.HCLName, input.ProviderPrefix, input.ResourceLabel))
+	}
+	sort.Strings(lines)
+
+	output := fmt.Sprintf(`
+func (d %[1]sTestDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf('
+%%s
+
+data "%[2]s_%[3]s" "test" {
+%[4]s
+}
+', %[1]sTestResource{}.basic(data))
+}
+`, input.ResourceTypeName, input.ProviderPrefix, input.ResourceLabel, strings.Join(lines, "\n"))
+	output = strings.ReplaceAll(output, "'", "`")
+	return &output, nil
+}
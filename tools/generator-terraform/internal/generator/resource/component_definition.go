@@ -18,7 +18,9 @@ func definitionForResource(input models.ResourceInput) (*string, error) {
 	if input.Details.UpdateMethod != nil && input.Details.UpdateMethod.Generate {
 		lines = append(lines, fmt.Sprintf("var _ sdk.ResourceWithUpdate = %[1]sResource{}", input.ResourceTypeName))
 	}
-	// TODO: do we need to support state migrations etc in the future? likely not within the generator due to the type hints
+	if input.SchemaVersion > 0 {
+		lines = append(lines, fmt.Sprintf("var _ sdk.ResourceWithStateMigration = %[1]sResource{}", input.ResourceTypeName))
+	}
 
 	sort.Strings(lines)
 	output := fmt.Sprintf(`
@@ -74,3 +74,21 @@ type ExampleResource struct {}
 `)
 	testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
 }
+
+func TestComponentDefinitionWithSchemaVersion(t *testing.T) {
+	input := generatorModels.ResourceInput{
+		ResourceTypeName: "Example",
+		SchemaVersion:    1,
+	}
+	actual, err := definitionForResource(input)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	expected := strings.TrimSpace(`
+var _ sdk.Resource = ExampleResource{}
+var _ sdk.ResourceWithStateMigration = ExampleResource{}
+
+type ExampleResource struct {}
+`)
+	testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
+}
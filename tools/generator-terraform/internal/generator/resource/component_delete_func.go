@@ -33,6 +33,15 @@ func deleteFunctionForResource(input models.ResourceInput) (*string, error) {
 		variablesForMethod = "_, err"
 	}
 
+	waitForDeletion := ""
+	if input.Details.EventuallyConsistent {
+		readOperation, ok := input.Operations[input.Details.ReadMethod.SDKOperationName]
+		if !ok {
+			return nil, fmt.Errorf("couldn't find read operation named %q", input.Details.ReadMethod.SDKOperationName)
+		}
+		waitForDeletion = codeForWaitingForConsistency(readOperation, input.SdkResourceName, input.Details.ReadMethod.SDKOperationName, true, "WaitForDeletion", "deletion")
+	}
+
 	output := fmt.Sprintf(`
 func (r %[1]sResource) Delete() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
@@ -48,11 +57,11 @@ func (r %[1]sResource) Delete() sdk.ResourceFunc {
 			if %[8]s := client.%[6]s(%[7]s); err != nil {
 				return fmt.Errorf("deleting %%s: %%+v", *id, err)
 			}
-
+%[10]s
 			return nil
 		},
 	}
 }
-`, input.ResourceTypeName, input.Details.DeleteMethod.TimeoutInMinutes, input.ServiceName, input.SdkResourceName, *idParseLine, deleteMethodName, methodArguments, variablesForMethod, strings.Title(helpers.NamespaceForApiVersion(input.SdkApiVersion)))
+`, input.ResourceTypeName, input.Details.DeleteMethod.TimeoutInMinutes, input.ServiceName, input.SdkResourceName, *idParseLine, deleteMethodName, methodArguments, variablesForMethod, strings.Title(helpers.NamespaceForApiVersion(input.SdkApiVersion)), waitForDeletion)
 	return &output, nil
 }
@@ -0,0 +1,556 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/pandora/tools/generator-terraform/internal/generator/helpers"
+	"github.com/hashicorp/pandora/tools/generator-terraform/internal/generator/models"
+	"github.com/hashicorp/pandora/tools/generator-terraform/internal/generator/pluginframeworkattributes"
+)
+
+func importsForFrameworkResource(input models.ResourceInput) (*string, error) {
+	output := fmt.Sprintf(`
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/zones"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/%[1]s/%[2]s/%[3]s"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-%[4]s/internal/clients"
+)
+`, strings.ToLower(input.SdkServiceName), input.SdkApiVersion, strings.ToLower(input.SdkResourceName), input.ProviderPrefix)
+	return &output, nil
+}
+
+func definitionForFrameworkResource(input models.ResourceInput) (*string, error) {
+	output := fmt.Sprintf(`
+var (
+	_ resource.Resource                = &%[1]sResource{}
+	_ resource.ResourceWithConfigure   = &%[1]sResource{}
+	_ resource.ResourceWithImportState = &%[1]sResource{}
+)
+
+func New%[1]sResource() resource.Resource {
+	return &%[1]sResource{}
+}
+
+type %[1]sResource struct {
+	client *clients.Client
+}
+
+func (r *%[1]sResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "%[2]s_%[3]s"
+}
+
+func (r *%[1]sResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*clients.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Provider Data", fmt.Sprintf("expected *clients.Client but got %%T", req.ProviderData))
+		return
+	}
+
+	r.client = client
+}
+`, input.ResourceTypeName, input.ProviderPrefix, input.ResourceLabel)
+	return &output, nil
+}
+
+// frameworkResourceSupportsUpdate returns whether an Update method is generated for this Framework Resource - when it's
+// not every argument requires the Resource to be replaced, since the Update method can't be used.
+func frameworkResourceSupportsUpdate(input models.ResourceInput) bool {
+	return input.Details.UpdateMethod != nil && input.Details.UpdateMethod.Generate
+}
+
+// unsupportedMethodForFrameworkResource returns a stub for the `methodName` method (e.g. `Update`), which is required by
+// the `resource.Resource` interface, for a method which isn't generated for this Framework Resource.
+func unsupportedMethodForFrameworkResource(input models.ResourceInput, methodName, action, detail string) *string {
+	output := fmt.Sprintf(`
+func (r *%[1]sResource) %[2]s(_ context.Context, _ resource.%[2]sRequest, resp *resource.%[2]sResponse) {
+	resp.Diagnostics.AddError(%[3]q, %[4]q)
+}
+`, input.ResourceTypeName, methodName, fmt.Sprintf("%s %s is not supported", action, input.Details.DisplayName), detail)
+	return &output
+}
+
+func schemaFunctionForFrameworkResource(input models.ResourceInput) (*string, error) {
+	helper := pluginframeworkattributes.PluginFrameworkAttributesHelpers{
+		SchemaModels:               input.SchemaModels,
+		AllArgumentsRequireReplace: !frameworkResourceSupportsUpdate(input),
+	}
+	schemaModel := input.SchemaModels[input.SchemaModelName]
+	attributesCode, err := helper.CodeForModel(schemaModel, true)
+	if err != nil {
+		return nil, fmt.Errorf("building code for top level schema model %q: %+v", input.SchemaModelName, err)
+	}
+
+	output := fmt.Sprintf(`
+func (r *%[1]sResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: %[2]q,
+		Attributes: %[3]s,
+	}
+}
+`, input.ResourceTypeName, input.Details.Documentation.Description, *attributesCode)
+	return &output, nil
+}
+
+func createFunctionForFrameworkResource(input models.ResourceInput) (*string, error) {
+	if !input.Details.CreateMethod.Generate {
+		return unsupportedMethodForFrameworkResource(input, "Create", "Creating", "the Create method isn't generated for this resource"), nil
+	}
+
+	createOperation, ok := input.Operations[input.Details.CreateMethod.SDKOperationName]
+	if !ok {
+		return nil, fmt.Errorf("couldn't find create operation named %q", input.Details.CreateMethod.SDKOperationName)
+	}
+
+	readOperation, ok := input.Operations[input.Details.ReadMethod.SDKOperationName]
+	if !ok {
+		return nil, fmt.Errorf("couldn't find Read operation for create operation named %q", input.Details.ReadMethod.SDKOperationName)
+	}
+
+	resourceId, ok := input.ResourceIds[input.Details.ResourceIDName]
+	if !ok {
+		return nil, fmt.Errorf("couldn't find Resource ID %q for Create Method", input.Details.ResourceIDName)
+	}
+
+	newResourceIdFuncName, err := input.NewResourceIdFuncName()
+	if err != nil {
+		return nil, fmt.Errorf("obtaining New Resource ID Function for Create Method: %+v", err)
+	}
+
+	helper := createFunctionComponents{
+		createMethod:           createOperation,
+		createMethodName:       input.Details.CreateMethod.SDKOperationName,
+		readMethod:             readOperation,
+		readMethodName:         input.Details.ReadMethod.SDKOperationName,
+		resourceTypeName:       input.ResourceTypeName,
+		sdkResourceName:        input.SdkResourceName,
+		sdkResourceNameLowered: strings.ToLower(input.SdkResourceName),
+		subscriptionIdSource:   "r.client.Account.SubscriptionId",
+		mappings:               input.Details.Mappings,
+		models:                 input.Models,
+		newResourceIdFuncName:  *newResourceIdFuncName,
+		resourceId:             resourceId,
+		terraformModelName:     input.SchemaModelName,
+	}
+	components := []func() (*string, error){
+		helper.idDefinitionAndMapping,
+		helper.payloadDefinition,
+		helper.create,
+	}
+	lines := make([]string, 0)
+	for i, component := range components {
+		result, err := component()
+		if err != nil {
+			return nil, fmt.Errorf("running component %d: %+v", i, err)
+		}
+
+		lines = append(lines, *result)
+	}
+
+	readMethodArguments := argumentsForApiOperationMethod(readOperation, helper.sdkResourceNameLowered, helper.readMethodName, false)
+	output := fmt.Sprintf(`
+func (r *%[1]sResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := context.WithTimeout(ctx, %[2]d*time.Minute)
+	defer cancel()
+
+	var plan %[3]s
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.create(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError(%[4]q, err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *%[1]sResource) create(ctx context.Context, plan *%[3]s) error {
+	client := r.client.%[5]s.%[6]s.%[7]s
+
+	var config %[8]s
+	if err := plan.expand(ctx, &config); err != nil {
+		return fmt.Errorf("expanding the framework model: %%+v", err)
+	}
+
+	%[9]s
+
+	existing, err := client.%[10]s(%[11]s)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for the presence of an existing %%s: %%+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return fmt.Errorf("a resource with the ID %%q already exists - to be managed via Terraform this resource needs to be imported into the State", id.ID())
+	}
+
+	%[12]s
+
+	found, err := r.read(ctx, id, plan)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return fmt.Errorf("%%s was not found after creation", id)
+	}
+
+	return nil
+}
+`, input.ResourceTypeName, input.Details.CreateMethod.TimeoutInMinutes, frameworkModelName(input.SchemaModelName), fmt.Sprintf("creating %s", input.Details.DisplayName), input.ServiceName, strings.Title(helpers.NamespaceForApiVersion(input.SdkApiVersion)), input.SdkResourceName, input.SchemaModelName, lines[0], input.Details.ReadMethod.SDKOperationName, readMethodArguments, strings.Join(lines[1:], "\n"))
+	return &output, nil
+}
+
+func readFunctionForFrameworkResource(input models.ResourceInput) (*string, error) {
+	readOperation, ok := input.Operations[input.Details.ReadMethod.SDKOperationName]
+	if !ok {
+		return nil, fmt.Errorf("couldn't find read operation named %q", input.Details.ReadMethod.SDKOperationName)
+	}
+
+	idParseLine, err := input.ParseResourceIdFuncName()
+	if err != nil {
+		return nil, fmt.Errorf("determining Parse function name for Resource ID: %+v", err)
+	}
+
+	idTypeName, err := input.ResourceIdTypeName()
+	if err != nil {
+		return nil, fmt.Errorf("determining the type name for the Resource ID: %+v", err)
+	}
+
+	resourceId, ok := input.ResourceIds[input.Details.ResourceIDName]
+	if !ok {
+		return nil, fmt.Errorf("the Resource ID named %q was not found", input.Details.ResourceIDName)
+	}
+
+	parentResource := ""
+	parentSegment := ""
+	for _, m := range input.Details.Mappings.ResourceID {
+		if m.ParsedFromParentID {
+			parentResource = m.TerraformSchemaFieldName
+			parentSegment = m.SegmentName
+			break
+		}
+	}
+
+	helper := readFunctionComponents{
+		constants:      input.Constants,
+		mappings:       input.Details.Mappings,
+		parentResource: parentResource,
+		parentSegment:  parentSegment,
+		resourceId:     resourceId,
+	}
+	resourceIdMappings, err := helper.codeForResourceIdMappings()
+	if err != nil {
+		return nil, fmt.Errorf("building code for resource id mappings: %+v", err)
+	}
+	parentIdDefinition := ""
+	if parentResource != "" && parentSegment != "" {
+		parentIdDefinition = fmt.Sprintf("%s := commonids.New%s(id.SubscriptionId, id.ResourceGroupName, id.%s)", helpers.CamelCasedName(parentResource), strings.Replace(parentResource, "Id", "ID", -1), strings.Title(parentSegment))
+	}
+
+	writeOnlyFields, err := writeOnlySchemaFieldsForResource(input)
+	if err != nil {
+		return nil, fmt.Errorf("determining the write-only fields: %+v", err)
+	}
+	writeOnlyAssignments := ""
+	if len(*writeOnlyFields) > 0 {
+		lines := codeForRetainingWriteOnlyFields(*writeOnlyFields, "schema", "existing", 0)
+		writeOnlyAssignments = fmt.Sprintf(`
+	// the API doesn't return the values for write-only fields, so these are retained from the existing state
+	var existing %[1]s
+	if err := state.expand(ctx, &existing); err != nil {
+		return false, fmt.Errorf("expanding the existing state: %%+v", err)
+	}
+	%[2]s
+`, input.SchemaModelName, strings.Join(lines, "\n"))
+	}
+
+	methodArguments := argumentsForApiOperationMethod(readOperation, input.SdkResourceName, input.Details.ReadMethod.SDKOperationName, false)
+	output := fmt.Sprintf(`
+func (r *%[1]sResource) read(ctx context.Context, id %[2]s, state *%[3]s) (bool, error) {
+	client := r.client.%[4]s.%[5]s.%[6]s
+
+	resp, err := client.%[7]s(%[8]s)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return false, nil
+		}
+		return false, fmt.Errorf("retrieving %%s: %%+v", id, err)
+	}
+
+	schema := %[9]s{}
+	%[10]s
+	if model := resp.Model; model != nil {
+		%[11]s
+		if err := r.map%[12]sTo%[9]s(*model, &schema); err != nil {
+			return false, fmt.Errorf("flattening model: %%+v", err)
+		}
+	}
+
+	%[13]s
+	if err := state.flatten(ctx, schema); err != nil {
+		return false, fmt.Errorf("flattening the framework model: %%+v", err)
+	}
+	state.Id = types.StringValue(id.ID())
+
+	return true, nil
+}
+`, input.ResourceTypeName, *idTypeName, frameworkModelName(input.SchemaModelName), input.ServiceName, strings.Title(helpers.NamespaceForApiVersion(input.SdkApiVersion)), input.SdkResourceName, input.Details.ReadMethod.SDKOperationName, methodArguments, input.SchemaModelName, parentIdDefinition, *resourceIdMappings, *readOperation.ResponseObject.ReferenceName, writeOnlyAssignments)
+
+	if input.Details.ReadMethod.Generate {
+		output += fmt.Sprintf(`
+func (r *%[1]sResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := context.WithTimeout(ctx, %[2]d*time.Minute)
+	defer cancel()
+
+	var state %[3]s
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := %[4]s(state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("parsing the Resource ID", err.Error())
+		return
+	}
+
+	found, err := r.read(ctx, *id, &state)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("retrieving %%s", *id), err.Error())
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+`, input.ResourceTypeName, input.Details.ReadMethod.TimeoutInMinutes, frameworkModelName(input.SchemaModelName), *idParseLine)
+	} else {
+		output += *unsupportedMethodForFrameworkResource(input, "Read", "Reading", "the Read method isn't generated for this resource")
+	}
+
+	return &output, nil
+}
+
+func updateFunctionForFrameworkResource(input models.ResourceInput) (*string, error) {
+	if !frameworkResourceSupportsUpdate(input) {
+		// every argument requires the Resource to be replaced, so this should never be called
+		return unsupportedMethodForFrameworkResource(input, "Update", "Updating", "all of the arguments for this resource require it to be replaced"), nil
+	}
+
+	idParseLine, err := input.ParseResourceIdFuncName()
+	if err != nil {
+		return nil, fmt.Errorf("determining Parse function name for Resource ID: %+v", err)
+	}
+
+	idTypeName, err := input.ResourceIdTypeName()
+	if err != nil {
+		return nil, fmt.Errorf("determining the type name for the Resource ID: %+v", err)
+	}
+
+	updateOperation, ok := input.Operations[input.Details.UpdateMethod.SDKOperationName]
+	if !ok {
+		return nil, fmt.Errorf("couldn't find update operation named %q", input.Details.UpdateMethod.SDKOperationName)
+	}
+
+	createOperation, ok := input.Operations[input.Details.CreateMethod.SDKOperationName]
+	if !ok {
+		return nil, fmt.Errorf("couldn't find create operation named %q for create operation", input.Details.CreateMethod.SDKOperationName)
+	}
+
+	readOperation, ok := input.Operations[input.Details.ReadMethod.SDKOperationName]
+	if !ok {
+		return nil, fmt.Errorf("couldn't find read operation named %q for read operation", input.Details.ReadMethod.SDKOperationName)
+	}
+
+	// NOTE: only sending the changed fields for a PATCH (`onlyChangedFields`) isn't supported for the Plugin Framework,
+	// since the `mapChanged{Schema}To{Sdk}` functions use `metadata.ResourceData` - as such the full payload is sent
+	updateHelpers := updateFuncHelpers{
+		onlyChangedFields:      false,
+		mappings:               input.Details.Mappings,
+		schemaModelName:        input.SchemaModelName,
+		sdkResourceNameLowered: strings.ToLower(input.SdkResourceName),
+		createMethod:           createOperation,
+		createMethodName:       input.Details.CreateMethod.SDKOperationName,
+		updateMethod:           updateOperation,
+		updateMethodName:       input.Details.UpdateMethod.SDKOperationName,
+		readMethod:             readOperation,
+		readMethodName:         input.Details.ReadMethod.SDKOperationName,
+		resourceTypeName:       input.ResourceTypeName,
+		models:                 input.Models,
+	}
+	components := []func() (*string, error){
+		updateHelpers.payloadDefinition,
+		updateHelpers.update,
+	}
+	lines := make([]string, 0)
+	for i, component := range components {
+		result, err := component()
+		if err != nil {
+			return nil, fmt.Errorf("running component %d: %+v", i, err)
+		}
+		lines = append(lines, *result)
+	}
+
+	output := fmt.Sprintf(`
+func (r *%[1]sResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := context.WithTimeout(ctx, %[2]d*time.Minute)
+	defer cancel()
+
+	var plan, state %[3]s
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := %[4]s(state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("parsing the Resource ID", err.Error())
+		return
+	}
+
+	if err := r.update(ctx, id, &plan); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("updating %%s", *id), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *%[1]sResource) update(ctx context.Context, id *%[5]s, plan *%[3]s) error {
+	client := r.client.%[6]s.%[7]s.%[8]s
+
+	var config %[9]s
+	if err := plan.expand(ctx, &config); err != nil {
+		return fmt.Errorf("expanding the framework model: %%+v", err)
+	}
+
+	%[10]s
+
+	found, err := r.read(ctx, *id, plan)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return fmt.Errorf("%%s was not found after updating", *id)
+	}
+
+	return nil
+}
+`, input.ResourceTypeName, input.Details.UpdateMethod.TimeoutInMinutes, frameworkModelName(input.SchemaModelName), *idParseLine, *idTypeName, input.ServiceName, strings.Title(helpers.NamespaceForApiVersion(input.SdkApiVersion)), input.SdkResourceName, input.SchemaModelName, strings.Join(lines, "\n"))
+	return &output, nil
+}
+
+func deleteFunctionForFrameworkResource(input models.ResourceInput) (*string, error) {
+	if !input.Details.DeleteMethod.Generate {
+		return unsupportedMethodForFrameworkResource(input, "Delete", "Deleting", "the Delete method isn't generated for this resource"), nil
+	}
+
+	idParseLine, err := input.ParseResourceIdFuncName()
+	if err != nil {
+		return nil, fmt.Errorf("determining Parse function name for Resource ID: %+v", err)
+	}
+
+	deleteOperation, ok := input.Operations[input.Details.DeleteMethod.SDKOperationName]
+	if !ok {
+		return nil, fmt.Errorf("couldn't find delete operation named %q", input.Details.DeleteMethod.SDKOperationName)
+	}
+
+	methodArguments := argumentsForApiOperationMethod(deleteOperation, input.SdkResourceName, input.Details.DeleteMethod.SDKOperationName, true)
+	deleteMethodName := methodNameToCallForOperation(deleteOperation, input.Details.DeleteMethod.SDKOperationName)
+	variablesForMethod := "err"
+	if !deleteOperation.LongRunning {
+		variablesForMethod = "_, err"
+	}
+
+	output := fmt.Sprintf(`
+func (r *%[1]sResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := context.WithTimeout(ctx, %[2]d*time.Minute)
+	defer cancel()
+
+	client := r.client.%[3]s.%[4]s.%[5]s
+
+	var state %[6]s
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := %[7]s(state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("parsing the Resource ID", err.Error())
+		return
+	}
+
+	if %[8]s := client.%[9]s(%[10]s); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("deleting %%s", *id), err.Error())
+		return
+	}
+}
+`, input.ResourceTypeName, input.Details.DeleteMethod.TimeoutInMinutes, input.ServiceName, strings.Title(helpers.NamespaceForApiVersion(input.SdkApiVersion)), input.SdkResourceName, frameworkModelName(input.SchemaModelName), *idParseLine, variablesForMethod, deleteMethodName, methodArguments)
+	return &output, nil
+}
+
+func importStateFunctionForFrameworkResource(input models.ResourceInput) (*string, error) {
+	idParseLine, err := input.ParseResourceIdFuncName()
+	if err != nil {
+		return nil, fmt.Errorf("determining Parse function name for Resource ID: %+v", err)
+	}
+
+	output := fmt.Sprintf(`
+func (r *%[1]sResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if _, err := %[2]s(req.ID); err != nil {
+		resp.Diagnostics.AddError("parsing the Resource ID", err.Error())
+		return
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+`, input.ResourceTypeName, *idParseLine)
+	return &output, nil
+}
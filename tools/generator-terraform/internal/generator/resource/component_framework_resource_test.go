@@ -0,0 +1,436 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"fmt"
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	generatorModels "github.com/hashicorp/pandora/tools/generator-terraform/internal/generator/models"
+	"github.com/hashicorp/pandora/tools/sdk/testhelpers"
+)
+
+func frameworkResourceInputForTesting() generatorModels.ResourceInput {
+	return generatorModels.ResourceInput{
+		Details: models.TerraformResourceDefinition{
+			APIResource: "SdkResource",
+			CreateMethod: models.TerraformMethodDefinition{
+				Generate:         true,
+				SDKOperationName: "CreateOrUpdate",
+				TimeoutInMinutes: 30,
+			},
+			DeleteMethod: models.TerraformMethodDefinition{
+				Generate:         true,
+				SDKOperationName: "Delete",
+				TimeoutInMinutes: 30,
+			},
+			DisplayName: "Example Resource",
+			Documentation: models.TerraformDocumentationDefinition{
+				Description: "Manages an Example Resource.",
+			},
+			Generate:      true,
+			GenerateModel: true,
+			Mappings: models.TerraformMappingDefinition{
+				ResourceID: []models.TerraformResourceIDMappingDefinition{
+					{
+						SegmentName:              "resourceGroupName",
+						TerraformSchemaFieldName: "ResourceGroupName",
+					},
+					{
+						SegmentName:              "exampleName",
+						TerraformSchemaFieldName: "Name",
+					},
+				},
+			},
+			ReadMethod: models.TerraformMethodDefinition{
+				Generate:         true,
+				SDKOperationName: "Get",
+				TimeoutInMinutes: 5,
+			},
+			ResourceIDName: "ExampleId",
+			ResourceName:   "Example",
+			UpdateMethod: &models.TerraformMethodDefinition{
+				Generate:         true,
+				SDKOperationName: "Update",
+				TimeoutInMinutes: 30,
+			},
+		},
+		Framework: models.PluginFrameworkTerraformFrameworkType,
+		Models: map[string]models.SDKModel{
+			"Example": {
+				Fields: map[string]models.SDKField{
+					"Name": {
+						JsonName: "name",
+						ObjectDefinition: models.SDKObjectDefinition{
+							Type: models.StringSDKObjectDefinitionType,
+						},
+					},
+				},
+			},
+			"ExampleUpdate": {
+				Fields: map[string]models.SDKField{
+					"Tags": {
+						JsonName: "tags",
+						ObjectDefinition: models.SDKObjectDefinition{
+							Type: models.TagsSDKObjectDefinitionType,
+						},
+					},
+				},
+			},
+		},
+		Operations: map[string]models.SDKOperation{
+			"CreateOrUpdate": {
+				LongRunning: true,
+				RequestObject: &models.SDKObjectDefinition{
+					Type:          models.ReferenceSDKObjectDefinitionType,
+					ReferenceName: pointer.To("Example"),
+				},
+				ResourceIDName: pointer.To("ExampleId"),
+			},
+			"Delete": {
+				LongRunning:    true,
+				ResourceIDName: pointer.To("ExampleId"),
+			},
+			"Get": {
+				ResponseObject: &models.SDKObjectDefinition{
+					Type:          models.ReferenceSDKObjectDefinitionType,
+					ReferenceName: pointer.To("Example"),
+				},
+				ResourceIDName: pointer.To("ExampleId"),
+			},
+			"Update": {
+				LongRunning: true,
+				RequestObject: &models.SDKObjectDefinition{
+					Type:          models.ReferenceSDKObjectDefinitionType,
+					ReferenceName: pointer.To("ExampleUpdate"),
+				},
+				ResourceIDName: pointer.To("ExampleId"),
+			},
+		},
+		ProviderPrefix: "azurerm",
+		ResourceIds: map[string]models.ResourceID{
+			"ExampleId": {
+				ExampleValue: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Example/examples/{exampleName}",
+				Segments: []models.ResourceIDSegment{
+					models.NewStaticValueResourceIDSegment("subscriptions", "subscriptions"),
+					models.NewSubscriptionIDResourceIDSegment("subscriptionId"),
+					models.NewStaticValueResourceIDSegment("resourceGroups", "resourceGroups"),
+					models.NewResourceGroupNameResourceIDSegment("resourceGroupName"),
+					models.NewStaticValueResourceIDSegment("providers", "providers"),
+					models.NewResourceProviderResourceIDSegment("resourceProvider", "Microsoft.Example"),
+					models.NewStaticValueResourceIDSegment("examples", "examples"),
+					models.NewUserSpecifiedResourceIDSegment("exampleName", "exampleValue"),
+				},
+			},
+		},
+		ResourceLabel:      "example",
+		ResourceTypeName:   "Example",
+		SchemaModelName:    "ExampleResource",
+		SdkApiVersion:      "2020-01-01",
+		SdkResourceName:    "SdkResource",
+		SdkServiceName:     "SdkService",
+		ServiceName:        "ExampleService",
+		ServicePackageName: "svcpkg",
+		SchemaModels: map[string]models.TerraformSchemaModel{
+			"ExampleResource": {
+				Fields: map[string]models.TerraformSchemaField{
+					"Name": {
+						ForceNew: true,
+						HCLName:  "name",
+						ObjectDefinition: models.TerraformSchemaObjectDefinition{
+							Type: models.StringTerraformSchemaObjectDefinitionType,
+						},
+						Required: true,
+					},
+					"ResourceGroupName": {
+						ForceNew: true,
+						HCLName:  "resource_group_name",
+						ObjectDefinition: models.TerraformSchemaObjectDefinition{
+							Type: models.ResourceGroupTerraformSchemaObjectDefinitionType,
+						},
+						Required: true,
+					},
+					"Settings": {
+						HCLName: "settings",
+						ObjectDefinition: models.TerraformSchemaObjectDefinition{
+							Type:          models.ReferenceTerraformSchemaObjectDefinitionType,
+							ReferenceName: pointer.To("ExampleResourceSettings"),
+						},
+						Optional: true,
+					},
+					"Tags": {
+						HCLName: "tags",
+						ObjectDefinition: models.TerraformSchemaObjectDefinition{
+							Type: models.TagsTerraformSchemaObjectDefinitionType,
+						},
+						Optional: true,
+					},
+				},
+			},
+			"ExampleResourceSettings": {
+				Fields: map[string]models.TerraformSchemaField{
+					"Enabled": {
+						HCLName: "enabled",
+						ObjectDefinition: models.TerraformSchemaObjectDefinition{
+							Type: models.BooleanTerraformSchemaObjectDefinitionType,
+						},
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestComponentFrameworkResource_IsValidGo(t *testing.T) {
+	input := frameworkResourceInputForTesting()
+	actual, err := codeForFrameworkResource(input)
+	if err != nil {
+		t.Fatalf("error: %+v", err)
+	}
+
+	if _, err := parser.ParseFile(token.NewFileSet(), "example_resource_gen.go", *actual, parser.AllErrors); err != nil {
+		t.Fatalf("parsing the generated code: %+v\n\n%s", err, *actual)
+	}
+
+	expectedFunctions := []string{
+		"func NewExampleResource() resource.Resource {",
+		"func (r *ExampleResource) Metadata(",
+		"func (r *ExampleResource) Configure(",
+		"func (r *ExampleResource) Schema(",
+		"func (r *ExampleResource) Create(",
+		"func (r *ExampleResource) Read(",
+		"func (r *ExampleResource) Update(",
+		"func (r *ExampleResource) Delete(",
+		"func (r *ExampleResource) ImportState(",
+		"type ExampleResourceFrameworkModel struct {",
+		"type ExampleResourceSettingsFrameworkModel struct {",
+	}
+	for _, v := range expectedFunctions {
+		if !strings.Contains(*actual, v) {
+			t.Fatalf("expected the generated code to contain %q but it didn't:\n\n%s", v, *actual)
+		}
+	}
+}
+
+func TestComponentFrameworkResource_UpdateDisabled(t *testing.T) {
+	input := frameworkResourceInputForTesting()
+	input.Details.UpdateMethod.Generate = false
+	actual, err := updateFunctionForFrameworkResource(input)
+	if err != nil {
+		t.Fatalf("error: %+v", err)
+	}
+	expected := `
+func (r *ExampleResource) Update(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError("Updating Example Resource is not supported", "all of the arguments for this resource require it to be replaced")
+}
+`
+	testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
+}
+
+func TestComponentFrameworkResource_WithoutAnUpdateMethodIsValidGo(t *testing.T) {
+	input := frameworkResourceInputForTesting()
+	input.Details.UpdateMethod = nil
+	actual, err := codeForFrameworkResource(input)
+	if err != nil {
+		t.Fatalf("error: %+v", err)
+	}
+
+	if _, err := parser.ParseFile(token.NewFileSet(), "example_resource_gen.go", *actual, parser.AllErrors); err != nil {
+		t.Fatalf("parsing the generated code: %+v\n\n%s", err, *actual)
+	}
+
+	// the `resource.Resource` interface requires each of the CRUD methods, so these are always output
+	for _, v := range []string{"Create", "Read", "Update", "Delete"} {
+		if !strings.Contains(*actual, fmt.Sprintf("func (r *ExampleResource) %s(", v)) {
+			t.Fatalf("expected the generated code to contain the %s method but it didn't:\n\n%s", v, *actual)
+		}
+	}
+
+	// since the Resource can't be updated, every argument (including `tags`, which isn't ForceNew) requires replacement
+	expected := `
+"tags": schema.MapAttribute{
+	ElementType: types.StringType,
+	Optional: true,
+	PlanModifiers: []planmodifier.Map{
+		mapplanmodifier.RequiresReplace(),
+	},
+}`
+	if !strings.Contains(strings.Join(strings.Fields(*actual), " "), strings.Join(strings.Fields(expected), " ")) {
+		t.Fatalf("expected the `tags` argument to require replacement but it didn't:\n\n%s", *actual)
+	}
+}
+
+func TestComponentFrameworkResource_MethodsNotGenerated(t *testing.T) {
+	input := frameworkResourceInputForTesting()
+	input.Details.CreateMethod.Generate = false
+	input.Details.ReadMethod.Generate = false
+	input.Details.DeleteMethod.Generate = false
+	actual, err := codeForFrameworkResource(input)
+	if err != nil {
+		t.Fatalf("error: %+v", err)
+	}
+
+	expected := []string{
+		`resp.Diagnostics.AddError("Creating Example Resource is not supported", "the Create method isn't generated for this resource")`,
+		`resp.Diagnostics.AddError("Reading Example Resource is not supported", "the Read method isn't generated for this resource")`,
+		`resp.Diagnostics.AddError("Deleting Example Resource is not supported", "the Delete method isn't generated for this resource")`,
+	}
+	for _, v := range expected {
+		if !strings.Contains(*actual, v) {
+			t.Fatalf("expected the generated code to contain %q but it didn't:\n\n%s", v, *actual)
+		}
+	}
+}
+
+func TestComponentFrameworkResource_Delete(t *testing.T) {
+	input := frameworkResourceInputForTesting()
+	actual, err := deleteFunctionForFrameworkResource(input)
+	if err != nil {
+		t.Fatalf("error: %+v", err)
+	}
+	expected := `
+func (r *ExampleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Minute)
+	defer cancel()
+
+	client := r.client.ExampleService.V20200101.SdkResource
+
+	var state ExampleResourceFrameworkModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := sdkresource.ParseExampleID(state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("parsing the Resource ID", err.Error())
+		return
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("deleting %s", *id), err.Error())
+		return
+	}
+}
+`
+	testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
+}
+
+func TestComponentFrameworkResource_ReadRetainsWriteOnlyFields(t *testing.T) {
+	input := frameworkResourceInputForTesting()
+	input.Models["Example"].Fields["Password"] = models.SDKField{
+		JsonName: "password",
+		ObjectDefinition: models.SDKObjectDefinition{
+			Type: models.StringSDKObjectDefinitionType,
+		},
+		Optional:  true,
+		Sensitive: true,
+	}
+	input.SchemaModels["ExampleResource"].Fields["Password"] = models.TerraformSchemaField{
+		HCLName: "password",
+		ObjectDefinition: models.TerraformSchemaObjectDefinition{
+			Type: models.StringTerraformSchemaObjectDefinitionType,
+		},
+		Optional:  true,
+		Sensitive: true,
+	}
+	input.Details.Mappings.Fields = []models.TerraformFieldMappingDefinition{
+		models.TerraformDirectAssignmentFieldMappingDefinition{
+			DirectAssignment: models.TerraformDirectAssignmentFieldMappingDefinitionImpl{
+				SDKFieldName:             "Password",
+				SDKModelName:             "Example",
+				TerraformSchemaFieldName: "Password",
+				TerraformSchemaModelName: "ExampleResource",
+			},
+		},
+	}
+	actual, err := readFunctionForFrameworkResource(input)
+	if err != nil {
+		t.Fatalf("error: %+v", err)
+	}
+
+	expectedLines := []string{
+		"var existing ExampleResource",
+		"if err := state.expand(ctx, &existing); err != nil {",
+		"schema.Password = existing.Password",
+	}
+	for _, v := range expectedLines {
+		if !strings.Contains(*actual, v) {
+			t.Fatalf("expected the generated code to contain %q but it didn't:\n\n%s", v, *actual)
+		}
+	}
+}
+
+func TestComponentFrameworkTypedModel(t *testing.T) {
+	input := frameworkResourceInputForTesting()
+	actual, err := codeForFrameworkModel("ExampleResourceSettings", input.SchemaModels["ExampleResourceSettings"], false)
+	if err != nil {
+		t.Fatalf("error: %+v", err)
+	}
+	expected := `
+type ExampleResourceSettingsFrameworkModel struct {
+	Enabled types.Bool ` + "`tfsdk:\"enabled\"`" + `
+}
+
+func (ExampleResourceSettingsFrameworkModel) attributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"enabled": types.BoolType,
+	}
+}
+
+func (m ExampleResourceSettingsFrameworkModel) expand(ctx context.Context, output *ExampleResourceSettings) error {
+	output.Enabled = m.Enabled.ValueBool()
+
+	return nil
+}
+
+func (m *ExampleResourceSettingsFrameworkModel) flatten(ctx context.Context, input ExampleResourceSettings) error {
+	if input.Enabled || !m.Enabled.IsNull() {
+		m.Enabled = types.BoolValue(input.Enabled)
+	}
+
+	return nil
+}
+`
+	testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
+}
+
+func TestComponentFrameworkTypedModel_TopLevelIncludesId(t *testing.T) {
+	input := frameworkResourceInputForTesting()
+	actual, err := codeForFrameworkModel("ExampleResource", input.SchemaModels["ExampleResource"], true)
+	if err != nil {
+		t.Fatalf("error: %+v", err)
+	}
+
+	if !strings.Contains(*actual, "Id types.String `tfsdk:\"id\"`") {
+		t.Fatalf("expected the top-level framework model to contain the `id` field:\n\n%s", *actual)
+	}
+	if !strings.Contains(*actual, `"settings": types.ListType{ElemType: types.ObjectType{AttrTypes: ExampleResourceSettingsFrameworkModel{}.attributeTypes()}},`) {
+		t.Fatalf("expected the top-level framework model to reference the nested framework model:\n\n%s", *actual)
+	}
+}
+
+func TestComponentFrameworkTypedModel_IdentityIsNotSupported(t *testing.T) {
+	input := models.TerraformSchemaModel{
+		Fields: map[string]models.TerraformSchemaField{
+			"Identity": {
+				HCLName: "identity",
+				ObjectDefinition: models.TerraformSchemaObjectDefinition{
+					Type: models.SystemAssignedIdentityTerraformSchemaObjectDefinitionType,
+				},
+				Optional: true,
+			},
+		},
+	}
+	actual, err := codeForFrameworkModel("ExampleResource", input, true)
+	if err == nil {
+		t.Fatalf("expected an error but got %q", *actual)
+	}
+}
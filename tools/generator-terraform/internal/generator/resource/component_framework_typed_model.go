@@ -0,0 +1,322 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	"github.com/hashicorp/pandora/tools/generator-terraform/internal/generator/helpers"
+	generatorModels "github.com/hashicorp/pandora/tools/generator-terraform/internal/generator/models"
+	"github.com/hashicorp/pandora/tools/generator-terraform/internal/generator/pluginframeworkattributes"
+)
+
+// frameworkScalarType describes how a basic Schema Field is represented within the Plugin Framework
+type frameworkScalarType struct {
+	// frameworkType is the Plugin Framework type used within the Framework Model (e.g. `types.String`)
+	frameworkType string
+
+	// attributeType is the Plugin Framework `attr.Type` for this type (e.g. `types.StringType`)
+	attributeType string
+
+	// valueFunc is the function used to construct a value of this type (e.g. `types.StringValue`)
+	valueFunc string
+
+	// valueMethod is the method used to retrieve the Go value from this type (e.g. `ValueString`)
+	valueMethod string
+
+	// hasValueFormat is a format string used to check whether the native value is set (e.g. `%s != ""`)
+	hasValueFormat string
+}
+
+var (
+	frameworkBoolType    = frameworkScalarType{frameworkType: "types.Bool", attributeType: "types.BoolType", valueFunc: "types.BoolValue", valueMethod: "ValueBool", hasValueFormat: "%s"}
+	frameworkFloat64Type = frameworkScalarType{frameworkType: "types.Float64", attributeType: "types.Float64Type", valueFunc: "types.Float64Value", valueMethod: "ValueFloat64", hasValueFormat: "%s != 0"}
+	frameworkInt64Type   = frameworkScalarType{frameworkType: "types.Int64", attributeType: "types.Int64Type", valueFunc: "types.Int64Value", valueMethod: "ValueInt64", hasValueFormat: "%s != 0"}
+	frameworkStringType  = frameworkScalarType{frameworkType: "types.String", attributeType: "types.StringType", valueFunc: "types.StringValue", valueMethod: "ValueString", hasValueFormat: `%s != ""`}
+)
+
+var frameworkScalarTypes = map[models.TerraformSchemaObjectDefinitionType]frameworkScalarType{
+	models.BooleanTerraformSchemaObjectDefinitionType:       frameworkBoolType,
+	models.DateTimeTerraformSchemaObjectDefinitionType:      frameworkStringType,
+	models.FloatTerraformSchemaObjectDefinitionType:         frameworkFloat64Type,
+	models.IntegerTerraformSchemaObjectDefinitionType:       frameworkInt64Type,
+	models.StringTerraformSchemaObjectDefinitionType:        frameworkStringType,
+	models.EdgeZoneTerraformSchemaObjectDefinitionType:      frameworkStringType,
+	models.LocationTerraformSchemaObjectDefinitionType:      frameworkStringType,
+	models.ResourceGroupTerraformSchemaObjectDefinitionType: frameworkStringType,
+	models.ZoneTerraformSchemaObjectDefinitionType:          frameworkStringType,
+}
+
+// frameworkCollectionField describes a Schema Field which is represented as a List, Map or Set within the Plugin Framework
+type frameworkCollectionField struct {
+	// collectionType is the name of the collection type (e.g. `List`)
+	collectionType string
+
+	// elementType is the `attr.Type` for the elements within this collection
+	elementType string
+
+	// nestedModelName is the name of the Schema Model used for each element, when this is a nested object
+	nestedModelName *string
+
+	// isTags specifies whether this is a Tags field, which is output as a `map[string]interface{}` in the Schema Model
+	isTags bool
+}
+
+func frameworkModelName(schemaModelName string) string {
+	return fmt.Sprintf("%sFrameworkModel", schemaModelName)
+}
+
+func frameworkCollectionFieldFor(input models.TerraformSchemaObjectDefinition) (*frameworkCollectionField, error) {
+	switch input.Type {
+	case models.TagsTerraformSchemaObjectDefinitionType:
+		return &frameworkCollectionField{
+			collectionType: "Map",
+			elementType:    "types.StringType",
+			isTags:         true,
+		}, nil
+
+	case models.ZonesTerraformSchemaObjectDefinitionType:
+		return &frameworkCollectionField{
+			collectionType: "List",
+			elementType:    "types.StringType",
+		}, nil
+
+	case models.ReferenceTerraformSchemaObjectDefinitionType:
+		if input.ReferenceName == nil {
+			return nil, fmt.Errorf("missing name for reference")
+		}
+		return &frameworkCollectionField{
+			collectionType:  "List",
+			elementType:     fmt.Sprintf("types.ObjectType{AttrTypes: %s{}.attributeTypes()}", frameworkModelName(*input.ReferenceName)),
+			nestedModelName: input.ReferenceName,
+		}, nil
+
+	case models.DictionaryTerraformSchemaObjectDefinitionType, models.ListTerraformSchemaObjectDefinitionType, models.SetTerraformSchemaObjectDefinitionType:
+		if input.NestedObject == nil {
+			return nil, fmt.Errorf("internal-error: %s type with no nested object", strings.ToLower(string(input.Type)))
+		}
+
+		collectionType := "List"
+		if input.Type == models.DictionaryTerraformSchemaObjectDefinitionType {
+			collectionType = "Map"
+		}
+		if input.Type == models.SetTerraformSchemaObjectDefinitionType {
+			collectionType = "Set"
+		}
+
+		if input.NestedObject.Type == models.ReferenceTerraformSchemaObjectDefinitionType {
+			if input.Type == models.DictionaryTerraformSchemaObjectDefinitionType {
+				return nil, fmt.Errorf("not-supported: dictionaries of nested objects are not yet supported when generating for the Plugin Framework")
+			}
+			if input.NestedObject.ReferenceName == nil {
+				return nil, fmt.Errorf("missing name for reference")
+			}
+			return &frameworkCollectionField{
+				collectionType:  collectionType,
+				elementType:     fmt.Sprintf("types.ObjectType{AttrTypes: %s{}.attributeTypes()}", frameworkModelName(*input.NestedObject.ReferenceName)),
+				nestedModelName: input.NestedObject.ReferenceName,
+			}, nil
+		}
+
+		elementType, err := pluginframeworkattributes.ElementTypeForObjectDefinition(*input.NestedObject)
+		if err != nil {
+			return nil, fmt.Errorf("determining the element type: %+v", err)
+		}
+		return &frameworkCollectionField{
+			collectionType: collectionType,
+			elementType:    *elementType,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("not-supported: %q fields are not yet supported when generating for the Plugin Framework", string(input.Type))
+}
+
+func codeForFrameworkModels(input generatorModels.ResourceInput) (*string, error) {
+	if !input.Details.GenerateModel {
+		return nil, nil
+	}
+
+	modelNames := make([]string, 0)
+	for k := range input.SchemaModels {
+		modelNames = append(modelNames, k)
+	}
+	sort.Strings(modelNames)
+
+	codeForModels := make([]string, 0)
+	for _, modelName := range modelNames {
+		model := input.SchemaModels[modelName]
+		code, err := codeForFrameworkModel(modelName, model, modelName == input.SchemaModelName)
+		if err != nil {
+			return nil, fmt.Errorf("generating the framework model for %q: %+v", modelName, err)
+		}
+		codeForModels = append(codeForModels, *code)
+	}
+	output := strings.Join(codeForModels, "\n")
+	return &output, nil
+}
+
+func codeForFrameworkModel(name string, input models.TerraformSchemaModel, isTopLevel bool) (*string, error) {
+	fieldNames := make([]string, 0)
+	for fieldName := range input.Fields {
+		fieldNames = append(fieldNames, fieldName)
+	}
+	sort.Strings(fieldNames)
+
+	structFields := make([]string, 0)
+	attributeTypes := make([]string, 0)
+	expandLines := make([]string, 0)
+	flattenLines := make([]string, 0)
+	if isTopLevel {
+		structFields = append(structFields, "Id types.String `tfsdk:\"id\"`")
+		attributeTypes = append(attributeTypes, `"id": types.StringType,`)
+	}
+
+	for _, fieldName := range fieldNames {
+		field := input.Fields[fieldName]
+		if scalarType, ok := frameworkScalarTypes[field.ObjectDefinition.Type]; ok {
+			structFields = append(structFields, fmt.Sprintf("%s %s `tfsdk:%q`", fieldName, scalarType.frameworkType, field.HCLName))
+			attributeTypes = append(attributeTypes, fmt.Sprintf("%q: %s,", field.HCLName, scalarType.attributeType))
+			expandLines = append(expandLines, fmt.Sprintf("output.%[1]s = m.%[1]s.%[2]s()", fieldName, scalarType.valueMethod))
+
+			// the Plugin Framework requires that Optional fields which aren't specified remain null, whereas
+			// the Schema Model uses the zero value - so the zero value is only set when the field is known
+			flattenLines = append(flattenLines, strings.TrimSpace(fmt.Sprintf(`
+	if %[2]s || !m.%[1]s.IsNull() {
+		m.%[1]s = %[3]s(input.%[1]s)
+	}
+`, fieldName, fmt.Sprintf(scalarType.hasValueFormat, "input."+fieldName), scalarType.valueFunc)))
+			continue
+		}
+
+		collection, err := frameworkCollectionFieldFor(field.ObjectDefinition)
+		if err != nil {
+			return nil, fmt.Errorf("field %q: %+v", fieldName, err)
+		}
+		structFields = append(structFields, fmt.Sprintf("%s types.%s `tfsdk:%q`", fieldName, collection.collectionType, field.HCLName))
+		attributeTypes = append(attributeTypes, fmt.Sprintf("%q: types.%sType{ElemType: %s},", field.HCLName, collection.collectionType, collection.elementType))
+		expandLines = append(expandLines, strings.TrimSpace(codeForFrameworkCollectionExpand(fieldName, field.HCLName, *collection)))
+		flattenLines = append(flattenLines, strings.TrimSpace(codeForFrameworkCollectionFlatten(fieldName, field.HCLName, *collection)))
+	}
+
+	frameworkName := frameworkModelName(name)
+	output := fmt.Sprintf(`
+type %[1]s struct {
+	%[3]s
+}
+
+func (%[1]s) attributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		%[4]s
+	}
+}
+
+func (m %[1]s) expand(ctx context.Context, output *%[2]s) error {
+	%[5]s
+
+	return nil
+}
+
+func (m *%[1]s) flatten(ctx context.Context, input %[2]s) error {
+	%[6]s
+
+	return nil
+}
+`, frameworkName, name, strings.Join(structFields, "\n"), strings.Join(attributeTypes, "\n"), strings.Join(expandLines, "\n"), strings.Join(flattenLines, "\n"))
+	return &output, nil
+}
+
+func codeForFrameworkCollectionExpand(fieldName, hclName string, collection frameworkCollectionField) string {
+	variableName := fmt.Sprintf("%sItems", helpers.CamelCasedName(fieldName))
+	if collection.nestedModelName != nil {
+		return fmt.Sprintf(`
+	%[1]s := make([]%[3]s, 0)
+	if diags := m.%[2]s.ElementsAs(ctx, &%[1]s, true); diags.HasError() {
+		return fmt.Errorf("expanding %%q: %%+v", %[5]q, diags)
+	}
+	output.%[2]s = make([]%[4]s, 0)
+	for _, item := range %[1]s {
+		var expanded %[4]s
+		if err := item.expand(ctx, &expanded); err != nil {
+			return fmt.Errorf("expanding %%q: %%+v", %[5]q, err)
+		}
+		output.%[2]s = append(output.%[2]s, expanded)
+	}
+`, variableName, fieldName, frameworkModelName(*collection.nestedModelName), *collection.nestedModelName, hclName)
+	}
+
+	if collection.isTags {
+		return fmt.Sprintf(`
+	%[1]s := make(map[string]string)
+	if diags := m.%[2]s.ElementsAs(ctx, &%[1]s, true); diags.HasError() {
+		return fmt.Errorf("expanding %%q: %%+v", %[3]q, diags)
+	}
+	output.%[2]s = make(map[string]interface{})
+	for k, v := range %[1]s {
+		output.%[2]s[k] = v
+	}
+`, variableName, fieldName, hclName)
+	}
+
+	return fmt.Sprintf(`
+	if diags := m.%[1]s.ElementsAs(ctx, &output.%[1]s, true); diags.HasError() {
+		return fmt.Errorf("expanding %%q: %%+v", %[2]q, diags)
+	}
+`, fieldName, hclName)
+}
+
+func codeForFrameworkCollectionFlatten(fieldName, hclName string, collection frameworkCollectionField) string {
+	variableName := fmt.Sprintf("%sItems", helpers.CamelCasedName(fieldName))
+	valueName := fmt.Sprintf("%sValue", helpers.CamelCasedName(fieldName))
+
+	itemsDefinition := ""
+	itemsReference := fmt.Sprintf("input.%s", fieldName)
+	if collection.nestedModelName != nil {
+		// the existing items are flattened into, so that unspecified Optional fields within each item remain null
+		itemsReference = variableName
+		itemsDefinition = fmt.Sprintf(`
+		existing%[2]s := make([]%[3]s, 0)
+		if !m.%[2]s.IsNull() && !m.%[2]s.IsUnknown() {
+			if diags := m.%[2]s.ElementsAs(ctx, &existing%[2]s, true); diags.HasError() {
+				return fmt.Errorf("flattening %%q: %%+v", %[4]q, diags)
+			}
+		}
+		%[1]s := make([]%[3]s, 0)
+		for i, v := range input.%[2]s {
+			item := %[3]s{}
+			if i < len(existing%[2]s) {
+				item = existing%[2]s[i]
+			}
+			if err := item.flatten(ctx, v); err != nil {
+				return fmt.Errorf("flattening %%q: %%+v", %[4]q, err)
+			}
+			%[1]s = append(%[1]s, item)
+		}
+`, variableName, fieldName, frameworkModelName(*collection.nestedModelName), hclName)
+	}
+	if collection.isTags {
+		itemsReference = variableName
+		itemsDefinition = fmt.Sprintf(`
+		%[1]s := make(map[string]string)
+		for k, v := range input.%[2]s {
+			%[1]s[k] = fmt.Sprintf("%%v", v)
+		}
+`, variableName, fieldName)
+	}
+
+	return fmt.Sprintf(`
+	if len(input.%[1]s) > 0 || !m.%[1]s.IsNull() {
+		%[4]s
+		%[5]s, diags := types.%[2]sValueFrom(ctx, %[3]s, %[6]s)
+		if diags.HasError() {
+			return fmt.Errorf("flattening %%q: %%+v", %[7]q, diags)
+		}
+		m.%[1]s = %[5]s
+	} else {
+		m.%[1]s = types.%[2]sNull(%[3]s)
+	}
+`, fieldName, collection.collectionType, collection.elementType, strings.TrimSpace(itemsDefinition), valueName, itemsReference, hclName)
+}
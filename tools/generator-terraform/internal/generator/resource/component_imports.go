@@ -7,10 +7,15 @@ import (
 	"fmt"
 	"strings"
 
+	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
 	"github.com/hashicorp/pandora/tools/generator-terraform/internal/generator/models"
 )
 
 func importsForResource(input models.ResourceInput) (*string, error) {
+	if input.SourceDataOrigin == sdkModels.MicrosoftGraphMetaDataSourceDataOrigin {
+		return importsForMicrosoftGraphResource(input)
+	}
+
 	output := fmt.Sprintf(`
 import (
 	"context"
@@ -26,10 +31,71 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/zones"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/%[1]s/%[2]s/%[3]s"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"%[4]s/internal/sdk"%[5]s
+	"%[4]s/internal/tf/pluginsdk"
+	"%[4]s/internal/tf/validation"
 )
-`, strings.ToLower(input.SdkServiceName), input.SdkApiVersion, strings.ToLower(input.SdkResourceName))
+`, strings.ToLower(input.SdkServiceName), input.SdkApiVersion, strings.ToLower(input.SdkResourceName), providerModulePath(input), migrationImportForResource(input))
 	return &output, nil
 }
+
+// importsForMicrosoftGraphResource returns the imports for a Resource within the AzureAD Provider, which uses the
+// Microsoft Graph packages within the Go SDK - where the Resource IDs and most Models are Common Types.
+func importsForMicrosoftGraphResource(input models.ResourceInput) (*string, error) {
+	if input.CommonTypesPackageName == nil {
+		return nil, fmt.Errorf("internal-error: the Common Types Package Name must be set for Microsoft Graph Resources")
+	}
+
+	output := fmt.Sprintf(`
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/%[1]s/%[2]s/%[3]s"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/%[4]s"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"%[5]s/internal/helpers/consistency"
+	"%[5]s/internal/sdk"%[6]s
+	"%[5]s/internal/tf/pluginsdk"
+	"%[5]s/internal/tf/validation"
+)
+`, strings.ToLower(input.SdkServiceName), input.SdkApiVersion, strings.ToLower(input.SdkResourceName), *input.CommonTypesPackageName, providerModulePath(input), migrationImportForResource(input))
+	return &output, nil
+}
+
+func importsForDataSource(input models.ResourceInput) (*string, error) {
+	output := fmt.Sprintf(`
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/%[1]s/%[2]s/%[3]s"
+	"%[4]s/internal/sdk"
+	"%[4]s/internal/tf/pluginsdk"
+	"%[4]s/internal/tf/validation"
+)
+`, strings.ToLower(input.SdkServiceName), input.SdkApiVersion, strings.ToLower(input.SdkResourceName), providerModulePath(input))
+	return &output, nil
+}
+
+// migrationImportForResource returns the import for the State Migrations package for this Resource, when the
+// Resource has a Schema Version (and therefore State Migrations) - or an empty string when it doesn't.
+func migrationImportForResource(input models.ResourceInput) string {
+	if input.SchemaVersion == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("\n\t\"%s/internal/services/%s/migration\"", providerModulePath(input), input.ServicePackageName)
+}
+
+// providerModulePath returns the Go Module path for the Terraform Provider which this Resource is generated into.
+func providerModulePath(input models.ResourceInput) string {
+	return fmt.Sprintf("github.com/hashicorp/terraform-provider-%s", input.ProviderPrefix)
+}
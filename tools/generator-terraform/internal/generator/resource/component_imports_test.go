@@ -7,6 +7,8 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
 	"github.com/hashicorp/pandora/tools/generator-terraform/internal/generator/models"
 
 	"github.com/hashicorp/pandora/tools/sdk/testhelpers"
@@ -14,6 +16,7 @@ import (
 
 func TestComponentImports(t *testing.T) {
 	input := models.ResourceInput{
+		ProviderPrefix:  "azurerm",
 		SdkApiVersion:   "2020-06-01",
 		SdkResourceName: "VirtualMachines",
 		SdkServiceName:  "Compute",
@@ -44,3 +47,72 @@ import (
 `)
 	testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
 }
+
+func TestComponentImportsMicrosoftGraph(t *testing.T) {
+	input := models.ResourceInput{
+		CommonTypesPackageName: pointer.To("stable"),
+		ProviderPrefix:         "azuread",
+		SdkApiVersion:          "stable",
+		SdkResourceName:        "Group",
+		SdkServiceName:         "Groups",
+		SourceDataOrigin:       sdkModels.MicrosoftGraphMetaDataSourceDataOrigin,
+	}
+	actual, err := importsForResource(input)
+	if err != nil {
+		t.Fatalf("error: %+v", err)
+	}
+	expected := strings.TrimSpace(`
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/groups/stable/group"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/consistency"
+	"github.com/hashicorp/terraform-provider-azuread/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/validation"
+)
+`)
+	testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
+}
+
+func TestComponentImportsMicrosoftGraphWithSchemaVersion(t *testing.T) {
+	input := models.ResourceInput{
+		CommonTypesPackageName: pointer.To("stable"),
+		ProviderPrefix:         "azuread",
+		SchemaVersion:          1,
+		SdkApiVersion:          "stable",
+		SdkResourceName:        "Group",
+		SdkServiceName:         "Groups",
+		ServicePackageName:     "groups",
+		SourceDataOrigin:       sdkModels.MicrosoftGraphMetaDataSourceDataOrigin,
+	}
+	actual, err := importsForResource(input)
+	if err != nil {
+		t.Fatalf("error: %+v", err)
+	}
+	expected := strings.TrimSpace(`
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/groups/stable/group"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/consistency"
+	"github.com/hashicorp/terraform-provider-azuread/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/groups/migration"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/tf/validation"
+)
+`)
+	testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"strings"
 
+	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
 	"github.com/hashicorp/pandora/tools/generator-terraform/internal/generator/mappings"
 	"github.com/hashicorp/pandora/tools/generator-terraform/internal/generator/models"
 )
@@ -15,6 +16,7 @@ func codeForMappings(input models.ResourceInput) (*string, error) {
 	lines := make([]string, 0)
 
 	helper := mappings.NewResourceMappings(input.Details, input.Constants, input.Models)
+	additionalArguments := schemaToSdkMappingAdditionalArguments(input.Details.Mappings)
 
 	for _, modelToModel := range input.Details.Mappings.ModelToModels {
 		mappingsForThisModel, err := mappings.FindMappingsBetween(modelToModel, input.Details.Mappings.Fields)
@@ -33,11 +35,11 @@ func codeForMappings(input models.ResourceInput) (*string, error) {
 
 		// Schema -> SDK
 		lines = append(lines, fmt.Sprintf(`
-func (r %[1]sResource) map%[2]sTo%[3]s(input %[2]s, output *%[4]s.%[3]s) error {
+func (r %[1]sResource) map%[2]sTo%[3]s(input %[2]s, output *%[4]s.%[3]s%[6]s) error {
 	%[5]s
 	return nil
 }
-`, input.Details.ResourceName, modelToModel.TerraformSchemaModelName, modelToModel.SDKModelName, strings.ToLower(input.SdkResourceName), *schemaToSdkLines))
+`, input.Details.ResourceName, modelToModel.TerraformSchemaModelName, modelToModel.SDKModelName, input.SdkPackageNameFor(modelToModel.SDKModelName), *schemaToSdkLines, additionalArguments))
 
 		// SDK -> Schema
 		lines = append(lines, fmt.Sprintf(`
@@ -45,9 +47,82 @@ func (r %[1]sResource) map%[2]sTo%[3]s(input %[4]s.%[2]s, output *%[3]s) error {
 	%[5]s
 	return nil
 }
-`, input.Details.ResourceName, modelToModel.SDKModelName, modelToModel.TerraformSchemaModelName, strings.ToLower(input.SdkResourceName), *sdkToSchemaLines))
+`, input.Details.ResourceName, modelToModel.SDKModelName, modelToModel.TerraformSchemaModelName, input.SdkPackageNameFor(modelToModel.SDKModelName), *sdkToSchemaLines))
 	}
 
 	output := strings.Join(lines, "\n")
 	return &output, nil
 }
+
+// codeForChangedMappings outputs the `mapChanged{Schema}To{Sdk}` functions used by the Update function when the Update
+// method is a PATCH - which only assign the fields which have changed, so that the PATCH payload only contains those.
+// This is only supported for Resources using the Plugin SDK - Resources using the Plugin Framework send the full payload.
+func codeForChangedMappings(input models.ResourceInput) (*string, error) {
+	if input.Details.UpdateMethod == nil || !input.Details.UpdateMethod.Generate {
+		return nil, nil
+	}
+	updateOperation, ok := input.Operations[input.Details.UpdateMethod.SDKOperationName]
+	if !ok {
+		return nil, fmt.Errorf("couldn't find update operation named %q", input.Details.UpdateMethod.SDKOperationName)
+	}
+	if !operationIsPatch(updateOperation) {
+		return nil, nil
+	}
+	if updateOperation.RequestObject == nil || updateOperation.RequestObject.ReferenceName == nil {
+		return nil, fmt.Errorf("the update operation %q must have a Request Object which is a Reference", input.Details.UpdateMethod.SDKOperationName)
+	}
+
+	helper := mappings.NewResourceMappings(input.Details, input.Constants, input.Models)
+	additionalArguments := schemaToSdkMappingAdditionalArguments(input.Details.Mappings)
+
+	lines := make([]string, 0)
+	sdkModelNames := []string{*updateOperation.RequestObject.ReferenceName}
+	processed := make(map[string]struct{})
+	for len(sdkModelNames) > 0 {
+		sdkModelName := sdkModelNames[0]
+		sdkModelNames = sdkModelNames[1:]
+		if _, ok := processed[sdkModelName]; ok {
+			continue
+		}
+		processed[sdkModelName] = struct{}{}
+
+		modelToModel := sdkModels.TerraformModelToModelMappingDefinition{
+			TerraformSchemaModelName: input.SchemaModelName,
+			SDKModelName:             sdkModelName,
+		}
+		assignmentLines, nestedSdkModelNames, err := helper.SchemaModelToSdkModelChangedAssignmentLines(modelToModel, input.Details.Mappings.Fields)
+		if err != nil {
+			return nil, fmt.Errorf("building changed mappings from Schema Model %q to Sdk Model %q: %+v", input.SchemaModelName, sdkModelName, err)
+		}
+		sdkModelNames = append(sdkModelNames, *nestedSdkModelNames...)
+
+		lines = append(lines, fmt.Sprintf(`
+func (r %[1]sResource) mapChanged%[2]sTo%[3]s(input %[2]s, output *%[4]s.%[3]s, metadata sdk.ResourceMetaData%[6]s) error {
+	%[5]s
+	return nil
+}
+`, input.Details.ResourceName, input.SchemaModelName, sdkModelName, input.SdkPackageNameFor(sdkModelName), *assignmentLines, additionalArguments))
+	}
+
+	output := strings.Join(lines, "\n")
+	return &output, nil
+}
+
+// schemaToSdkMappingAdditionalArguments returns the additional arguments for the Schema to SDK mapping functions - which
+// take the Microsoft Graph endpoint when ODataBind mappings are used, since this is configured in the Provider.
+func schemaToSdkMappingAdditionalArguments(input sdkModels.TerraformMappingDefinition) string {
+	if mappings.UsesMicrosoftGraphEndpoint(input) {
+		return fmt.Sprintf(", %s string", mappings.MicrosoftGraphEndpointArgumentName)
+	}
+	return ""
+}
+
+// schemaToSdkMappingAdditionalValues returns the values for the additional arguments of the Schema to SDK mapping
+// functions (see schemaToSdkMappingAdditionalArguments), where the Microsoft Graph endpoint is sourced from the
+// Base URI of the `client` used for this Resource.
+func schemaToSdkMappingAdditionalValues(input sdkModels.TerraformMappingDefinition) string {
+	if mappings.UsesMicrosoftGraphEndpoint(input) {
+		return ", client.Client.BaseUri"
+	}
+	return ""
+}
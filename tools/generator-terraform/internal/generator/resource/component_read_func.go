@@ -8,6 +8,7 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
 	"github.com/hashicorp/pandora/tools/generator-terraform/internal/generator/helpers"
 	generatorModels "github.com/hashicorp/pandora/tools/generator-terraform/internal/generator/models"
@@ -26,6 +27,7 @@ type readFunctionComponents struct {
 	sdkResourceName string
 	terraformModel  models.TerraformSchemaModel
 	topLevelModel   models.SDKModel
+	writeOnlyFields []writeOnlySchemaField
 }
 
 func readFunctionForResource(input generatorModels.ResourceInput) (*string, error) {
@@ -72,6 +74,11 @@ func readFunctionForResource(input generatorModels.ResourceInput) (*string, erro
 		return nil, fmt.Errorf("the top-level model %q used in the response was not found", *readOperation.ResponseObject.ReferenceName)
 	}
 
+	writeOnlyFields, err := writeOnlySchemaFieldsForResource(input)
+	if err != nil {
+		return nil, fmt.Errorf("determining the write-only fields: %+v", err)
+	}
+
 	helper := readFunctionComponents{
 		constants:       input.Constants,
 		idParseLine:     *idParseLine,
@@ -85,11 +92,13 @@ func readFunctionForResource(input generatorModels.ResourceInput) (*string, erro
 		sdkResourceName: input.SdkResourceName,
 		terraformModel:  terraformModel,
 		topLevelModel:   topLevelModel,
+		writeOnlyFields: *writeOnlyFields,
 	}
 	components := []func() (*string, error){
 		helper.codeForIDParser,
 		helper.codeForGet,
 		helper.codeForModelAssignments,
+		helper.codeForWriteOnlyFields,
 	}
 	lines := make([]string, 0)
 	for i, component := range components {
@@ -166,6 +175,23 @@ func (c readFunctionComponents) codeForModelAssignments() (*string, error) {
 	return &output, nil
 }
 
+func (c readFunctionComponents) codeForWriteOnlyFields() (*string, error) {
+	if len(c.writeOnlyFields) == 0 {
+		return pointer.To(""), nil
+	}
+
+	lines := codeForRetainingWriteOnlyFields(c.writeOnlyFields, "schema", "existing", 0)
+	output := fmt.Sprintf(`
+			// the API doesn't return the values for write-only fields, so these are retained from the existing state
+			var existing %[1]s
+			if err := metadata.Decode(&existing); err != nil {
+				return fmt.Errorf("decoding: %%+v", err)
+			}
+			%[2]s
+`, c.schemaModelName, strings.Join(lines, "\n"))
+	return &output, nil
+}
+
 func (c readFunctionComponents) codeForResourceIdMappings() (*string, error) {
 	lines := make([]string, 0)
 
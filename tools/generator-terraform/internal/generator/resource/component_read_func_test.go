@@ -478,6 +478,163 @@ func (r ExampleResource) Read() sdk.ResourceFunc {
 	testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
 }
 
+func TestComponentReadFunc_RegularResourceId_WriteOnlyFields(t *testing.T) {
+	input := generatorModels.ResourceInput{
+		ResourceTypeName: "Example",
+		SdkResourceName:  "SdkResource",
+		ServiceName:      "Resources",
+		SdkApiVersion:    "2021-01-01",
+		Details: models.TerraformResourceDefinition{
+			ReadMethod: models.TerraformMethodDefinition{
+				Generate:         true,
+				SDKOperationName: "Get",
+				TimeoutInMinutes: 10,
+			},
+			ResourceIDName: "CustomSubscriptionId",
+			Mappings: models.TerraformMappingDefinition{
+				Fields: []models.TerraformFieldMappingDefinition{
+					models.TerraformDirectAssignmentFieldMappingDefinition{
+						DirectAssignment: models.TerraformDirectAssignmentFieldMappingDefinitionImpl{
+							SDKFieldName:             "Password",
+							SDKModelName:             "GetModel",
+							TerraformSchemaFieldName: "Password",
+							TerraformSchemaModelName: "ExampleModel",
+						},
+					},
+					models.TerraformDirectAssignmentFieldMappingDefinition{
+						DirectAssignment: models.TerraformDirectAssignmentFieldMappingDefinitionImpl{
+							SDKFieldName:             "PrimaryKey",
+							SDKModelName:             "GetModel",
+							TerraformSchemaFieldName: "PrimaryKey",
+							TerraformSchemaModelName: "ExampleModel",
+						},
+					},
+				},
+				ResourceID: []models.TerraformResourceIDMappingDefinition{
+					{
+						SegmentName:              "resourceGroupName",
+						TerraformSchemaFieldName: "Name",
+					},
+				},
+			},
+		},
+		Operations: map[string]models.SDKOperation{
+			"Get": {
+				LongRunning:    false,
+				ResourceIDName: pointer.To("CustomSubscriptionId"),
+				ResponseObject: &models.SDKObjectDefinition{
+					Type:          models.ReferenceSDKObjectDefinitionType,
+					ReferenceName: pointer.To("GetModel"),
+				},
+			},
+		},
+		Models: map[string]models.SDKModel{
+			"GetModel": {
+				Fields: map[string]models.SDKField{
+					"Password": {
+						JsonName: "password",
+						ObjectDefinition: models.SDKObjectDefinition{
+							Type: models.StringSDKObjectDefinitionType,
+						},
+						Optional:  true,
+						Sensitive: true,
+					},
+					"PrimaryKey": {
+						JsonName: "primaryKey",
+						ObjectDefinition: models.SDKObjectDefinition{
+							Type: models.StringSDKObjectDefinitionType,
+						},
+						Optional: true,
+					},
+				},
+			},
+		},
+		ResourceIds: map[string]models.ResourceID{
+			"CustomSubscriptionId": {
+				ExampleValue: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}",
+				Segments: []models.ResourceIDSegment{
+					models.NewStaticValueResourceIDSegment("subscriptions", "subscriptions"),
+					models.NewSubscriptionIDResourceIDSegment("subscriptionId"),
+					models.NewStaticValueResourceIDSegment("resourceGroups", "resourceGroups"),
+					models.NewResourceGroupNameResourceIDSegment("resourceGroupName"),
+				},
+			},
+		},
+		SchemaModelName: "ExampleModel",
+		SchemaModels: map[string]models.TerraformSchemaModel{
+			"ExampleModel": {
+				Fields: map[string]models.TerraformSchemaField{
+					"Name": {
+						HCLName:  "name",
+						ForceNew: true,
+						ObjectDefinition: models.TerraformSchemaObjectDefinition{
+							Type: models.StringTerraformSchemaObjectDefinitionType,
+						},
+						Required: true,
+					},
+					"Password": {
+						HCLName: "password",
+						ObjectDefinition: models.TerraformSchemaObjectDefinition{
+							Type: models.StringTerraformSchemaObjectDefinitionType,
+						},
+						Optional:  true,
+						Sensitive: true,
+					},
+					"PrimaryKey": {
+						// Sensitive, but returned by the API so shouldn't be retained from the state
+						HCLName: "primary_key",
+						ObjectDefinition: models.TerraformSchemaObjectDefinition{
+							Type: models.StringTerraformSchemaObjectDefinitionType,
+						},
+						Optional:  true,
+						Sensitive: true,
+					},
+				},
+			},
+		},
+	}
+	actual, err := readFunctionForResource(input)
+	if err != nil {
+		t.Fatalf("error: %+v", err)
+	}
+	expected := `
+func (r ExampleResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+        Timeout: 10 * time.Minute,
+        Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resources.V20210101.SdkResource
+			schema := ExampleModel{}
+			id, err := sdkresource.ParseCustomSubscriptionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if model := resp.Model; model != nil {
+				schema.Name = id.ResourceGroupName
+				if err := r.mapGetModelToExampleModel(*model, &schema); err != nil {
+					return fmt.Errorf("flattening model: %+v", err)
+				}
+			}
+			// the API doesn't return the values for write-only fields, so these are retained from the existing state
+			var existing ExampleModel
+			if err := metadata.Decode(&existing); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+			schema.Password = existing.Password
+			return metadata.Encode(&schema)
+        },
+	}
+}
+`
+	testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
+}
+
 func TestComponentReadFunc_RegularResourceId_Constant_Enabled(t *testing.T) {
 	input := generatorModels.ResourceInput{
 		ResourceTypeName: "Example",
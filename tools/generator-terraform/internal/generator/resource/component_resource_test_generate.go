@@ -9,6 +9,7 @@ import (
 	"strings"
 	"unicode"
 
+	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
 	"github.com/hashicorp/pandora/tools/generator-terraform/internal/generator/models"
 )
 
@@ -17,9 +18,45 @@ func codeForResourceTestFunctions(input models.ResourceInput) (*string, error) {
 		return nil, nil
 	}
 
+	resourceType := fmt.Sprintf("%s_%s", input.ProviderPrefix, input.ResourceLabel)
+	basicValues, err := valuesForTestConfiguration(input.Details.Tests.BasicConfiguration, resourceType, input.SchemaModels, input.SchemaModelName)
+	if err != nil {
+		return nil, fmt.Errorf("determining the values within the Basic Test Configuration: %+v", err)
+	}
+	basicChecks := codeForTestChecks(basicValues)
+
+	ignoredFields, err := importStepIgnoredFieldsForResource(input)
+	if err != nil {
+		return nil, fmt.Errorf("determining the fields to ignore during the Import Step: %+v", err)
+	}
+	importStep := codeForTestImportStep(ignoredFields)
+
 	functions := make([]string, 0)
 
 	if input.Details.Tests.CompleteConfiguration != nil {
+		completeValues, err := valuesForTestConfiguration(*input.Details.Tests.CompleteConfiguration, resourceType, input.SchemaModels, input.SchemaModelName)
+		if err != nil {
+			return nil, fmt.Errorf("determining the values within the Complete Test Configuration: %+v", err)
+		}
+		completeChecks := codeForTestChecks(completeValues)
+
+		// the Update test should update the Resource in-place, so when the Complete Test Configuration changes
+		// any ForceNew fields, a separate Test Configuration is used which only changes the updatable fields
+		updateConfigName := "complete"
+		updateChecks := completeChecks
+		updateConfig, err := updateTestConfigurationForResource(input)
+		if err != nil {
+			return nil, fmt.Errorf("building the Update Test Configuration: %+v", err)
+		}
+		if updateConfig != nil {
+			updateValues, err := valuesForTestConfiguration(*updateConfig, resourceType, input.SchemaModels, input.SchemaModelName)
+			if err != nil {
+				return nil, fmt.Errorf("determining the values within the Update Test Configuration: %+v", err)
+			}
+			updateConfigName = "update"
+			updateChecks = codeForTestChecks(updateValues)
+		}
+
 		functions = append(functions, fmt.Sprintf(`
 func TestAcc%[1]s_complete(t *testing.T) {
 	data := acceptance.BuildTestData(t, "%[2]s_%[3]s", "test")
@@ -29,10 +66,10 @@ func TestAcc%[1]s_complete(t *testing.T) {
 		{
 			Config: r.complete(data),
 			Check: acceptance.ComposeTestCheckFunc(
-				check.That(data.ResourceName).ExistsInAzure(r),
+				%[5]s
 			),
 		},
-		data.ImportStep(),
+		%[4]s
 	})
 }
 
@@ -44,27 +81,27 @@ func TestAcc%[1]s_update(t *testing.T) {
 		{
 			Config: r.basic(data),
 			Check: acceptance.ComposeTestCheckFunc(
-				check.That(data.ResourceName).ExistsInAzure(r),
+				%[6]s
 			),
 		},
-		data.ImportStep(),
+		%[4]s
 		{
-			Config: r.complete(data),
+			Config: r.%[7]s(data),
 			Check: acceptance.ComposeTestCheckFunc(
-				check.That(data.ResourceName).ExistsInAzure(r),
+				%[8]s
 			),
 		},
-		data.ImportStep(),
+		%[4]s
 		{
 			Config: r.basic(data),
 			Check: acceptance.ComposeTestCheckFunc(
-				check.That(data.ResourceName).ExistsInAzure(r),
+				%[6]s
 			),
 		},
-		data.ImportStep(),
+		%[4]s
 	})
 }
-`, input.ResourceTypeName, input.ProviderPrefix, input.ResourceLabel))
+`, input.ResourceTypeName, input.ProviderPrefix, input.ResourceLabel, importStep, completeChecks, basicChecks, updateConfigName, updateChecks))
 	}
 
 	otherTestNames := make([]string, 0)
@@ -77,14 +114,20 @@ func TestAcc%[1]s_update(t *testing.T) {
 
 	for _, testName := range otherTestNames {
 		testConfigs := (*input.Details.Tests.OtherTests)[testName]
-		testFunction := testForDynamicTestConfiguration(dynamicTestInput{
+		testFunction, err := testForDynamicTestConfiguration(dynamicTestInput{
 			providerPrefix:            input.ProviderPrefix,
 			resourceLabel:             input.ResourceLabel,
 			resourceName:              input.ResourceTypeName,
 			testName:                  testName,
 			dynamicTestConfigurations: testConfigs,
+			importStep:                importStep,
+			schemaModels:              input.SchemaModels,
+			schemaModelName:           input.SchemaModelName,
 		})
-		functions = append(functions, testFunction)
+		if err != nil {
+			return nil, fmt.Errorf("building the Test %q: %+v", testName, err)
+		}
+		functions = append(functions, *testFunction)
 	}
 
 	output := fmt.Sprintf(`
@@ -96,10 +139,10 @@ func TestAcc%[1]s_basic(t *testing.T) {
 		{
 			Config: r.basic(data),
 			Check: acceptance.ComposeTestCheckFunc(
-				check.That(data.ResourceName).ExistsInAzure(r),
+				%[5]s
 			),
 		},
-		data.ImportStep(),
+		%[6]s
 	})
 }
 
@@ -119,7 +162,7 @@ func TestAcc%[1]s_requiresImport(t *testing.T) {
 }
 
 %[4]s
-`, input.ResourceTypeName, input.ProviderPrefix, input.ResourceLabel, strings.Join(functions, "\n"))
+`, input.ResourceTypeName, input.ProviderPrefix, input.ResourceLabel, strings.Join(functions, "\n"), basicChecks, importStep)
 	return &output, nil
 }
 
@@ -156,6 +199,22 @@ func (r %[1]sTestResource) complete(data acceptance.TestData) string {
 ', r.template(data))
 }
 `, input.ResourceTypeName, input.ProviderPrefix, input.ResourceLabel, completeConfig))
+
+		updateConfig, err := updateTestConfigurationForResource(input)
+		if err != nil {
+			return nil, fmt.Errorf("building the Update Test Configuration: %+v", err)
+		}
+		if updateConfig != nil {
+			functions = append(functions, fmt.Sprintf(`
+func (r %[1]sTestResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf('
+%%s
+
+%[2]s
+', r.template(data))
+}
+`, input.ResourceTypeName, *updateConfig))
+		}
 	}
 
 	otherTestNames := make([]string, 0)
@@ -216,29 +275,41 @@ type dynamicTestInput struct {
 	resourceName              string
 	testName                  string
 	dynamicTestConfigurations []string
+
+	// schemaModels and schemaModelName describe the Schema for this Resource, used to determine which values can be checked.
+	schemaModels    map[string]sdkModels.TerraformSchemaModel
+	schemaModelName string
+
+	// importStep is the Import Step used after each Test Configuration, which is only used for the Test itself.
+	importStep string
 }
 
-func testForDynamicTestConfiguration(input dynamicTestInput) string {
+func testForDynamicTestConfiguration(input dynamicTestInput) (*string, error) {
 	stages := make([]string, 0)
 
-	for i := range input.dynamicTestConfigurations {
+	resourceType := fmt.Sprintf("%s_%s", input.providerPrefix, input.resourceLabel)
+	for i, config := range input.dynamicTestConfigurations {
 		nameForStage := input.testName
 		if i > 0 {
 			nameForStage = fmt.Sprintf("%s%d", nameForStage, i)
 		}
+		values, err := valuesForTestConfiguration(config, resourceType, input.schemaModels, input.schemaModelName)
+		if err != nil {
+			return nil, fmt.Errorf("determining the values within the Test Configuration %q: %+v", nameForStage, err)
+		}
 		stage := fmt.Sprintf(`
 		{
 			Config: r.%[1]s(data),
 			Check: acceptance.ComposeTestCheckFunc(
-				check.That(data.ResourceName).ExistsInAzure(r),
+				%[2]s
 			),
 		},
-		data.ImportStep(),
-`, nameForStage)
+		%[3]s
+`, nameForStage, codeForTestChecks(values), input.importStep)
 		stages = append(stages, stage)
 	}
 
-	return fmt.Sprintf(`
+	output := fmt.Sprintf(`
 func TestAcc%[1]s_%[2]s(t *testing.T) {
 	data := acceptance.BuildTestData(t, "%[3]s_%[4]s", "test")
 	r := %[1]sTestResource{}
@@ -248,6 +319,7 @@ func TestAcc%[1]s_%[2]s(t *testing.T) {
 	})
 }
 `, input.resourceName, input.testName, input.providerPrefix, input.resourceLabel, strings.Join(stages, "\n"))
+	return &output, nil
 }
 
 func functionsForDynamicTestConfiguration(input dynamicTestInput) string {
@@ -0,0 +1,284 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	generatorModels "github.com/hashicorp/pandora/tools/generator-terraform/internal/generator/models"
+)
+
+func stateMigrationTypeName(resourceTypeName string, fromVersion int) string {
+	return fmt.Sprintf("%sV%dToV%d", resourceTypeName, fromVersion, fromVersion+1)
+}
+
+func stateMigrationFilePath(input generatorModels.ResourceInput, fromVersion int) string {
+	return fmt.Sprintf("%s/internal/services/%s/migration/%s_v%d_to_v%d.go", input.RootDirectory, input.ServicePackageName, input.ResourceLabel, fromVersion, fromVersion+1)
+}
+
+func stateUpgradersFunctionForResource(input generatorModels.ResourceInput) (*string, error) {
+	if input.SchemaVersion == 0 {
+		return nil, nil
+	}
+
+	upgraders := make([]string, 0)
+	for i := 0; i < input.SchemaVersion; i++ {
+		upgraders = append(upgraders, fmt.Sprintf("%d: migration.%s{},", i, stateMigrationTypeName(input.ResourceTypeName, i)))
+	}
+
+	output := fmt.Sprintf(`
+func (r %[1]sResource) StateUpgraders() sdk.StateUpgradeData {
+	return sdk.StateUpgradeData{
+		SchemaVersion: %[2]d,
+		Upgraders: map[int]pluginsdk.StateUpgrade{
+			%[3]s
+		},
+	}
+}
+`, input.ResourceTypeName, input.SchemaVersion, strings.Join(upgraders, "\n"))
+	return &output, nil
+}
+
+// codeForStateMigration returns the code for a State Upgrader migrating from the Schema described in `previous`
+// to the next Schema Version. Field renames are handled automatically, other changes are left for a human to implement.
+func codeForStateMigration(input generatorModels.ResourceInput, previous schemaFingerprint, changes schemaChanges) (*string, error) {
+	typeName := stateMigrationTypeName(input.ResourceTypeName, previous.SchemaVersion)
+
+	previousSchema, err := codeForStateMigrationSchema(previous.Fields)
+	if err != nil {
+		return nil, fmt.Errorf("building the Schema for Schema Version %d: %+v", previous.SchemaVersion, err)
+	}
+
+	previousNames := make([]string, 0)
+	for name := range changes.renamedFields {
+		previousNames = append(previousNames, name)
+	}
+	sort.Strings(previousNames)
+	renames := make([]string, 0)
+	for _, previousName := range previousNames {
+		renames = append(renames, fmt.Sprintf(`
+		if v, ok := rawState[%[1]q]; ok {
+			rawState[%[2]q] = v
+			delete(rawState, %[1]q)
+		}
+`, previousName, changes.renamedFields[previousName]))
+	}
+
+	changeComments := make([]string, 0)
+	for _, change := range changes.incompatibleChanges {
+		changeComments = append(changeComments, fmt.Sprintf("// - %s", change))
+	}
+
+	output := fmt.Sprintf(`
+package migration
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-provider-%[1]s/internal/tf/pluginsdk"
+)
+
+var _ pluginsdk.StateUpgrade = %[2]s{}
+
+// %[2]s migrates the State for the %[3]s Resource from Schema Version %[4]d to %[5]d.
+//
+// This migration was generated since the following changes were made to the Schema:
+%[6]s
+//
+// Renamed fields are migrated automatically, any other changes need to be migrated manually.
+type %[2]s struct{}
+
+func (%[2]s) Schema() map[string]*pluginsdk.Schema {
+	return %[7]s
+}
+
+func (%[2]s) UpgradeFunc() pluginsdk.StateUpgraderFunc {
+	return func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+		%[8]s
+		return rawState, nil
+	}
+}
+`, input.ProviderPrefix, typeName, input.Details.DisplayName, previous.SchemaVersion, previous.SchemaVersion+1, strings.Join(changeComments, "\n"), *previousSchema, strings.Join(renames, "\n"))
+	return &output, nil
+}
+
+func codeForStateMigrationSchema(fields map[string]fieldFingerprint) (*string, error) {
+	fieldNames := make([]string, 0)
+	for name := range fields {
+		fieldNames = append(fieldNames, name)
+	}
+	sort.Strings(fieldNames)
+
+	lines := make([]string, 0)
+	for _, name := range fieldNames {
+		field := fields[name]
+		attributes := make([]string, 0)
+		if field.Required {
+			attributes = append(attributes, "Required: true,")
+		}
+		if field.Optional {
+			attributes = append(attributes, "Optional: true,")
+		}
+		if field.Computed {
+			attributes = append(attributes, "Computed: true,")
+		}
+
+		typeAttributes, err := codeForStateMigrationObjectDefinition(field.ObjectDefinition)
+		if err != nil {
+			return nil, fmt.Errorf("building the Schema for the field %q: %+v", name, err)
+		}
+		attributes = append(attributes, *typeAttributes...)
+
+		lines = append(lines, fmt.Sprintf(`%q: {
+	%s
+},`, name, strings.Join(attributes, "\n")))
+	}
+
+	output := fmt.Sprintf(`map[string]*pluginsdk.Schema{
+	%s
+}`, strings.Join(lines, "\n"))
+	return &output, nil
+}
+
+func codeForStateMigrationObjectDefinition(input objectDefinitionFingerprint) (*[]string, error) {
+	basicTypes := map[models.TerraformSchemaObjectDefinitionType]string{
+		models.BooleanTerraformSchemaObjectDefinitionType:       "pluginsdk.TypeBool",
+		models.DateTimeTerraformSchemaObjectDefinitionType:      "pluginsdk.TypeString",
+		models.EdgeZoneTerraformSchemaObjectDefinitionType:      "pluginsdk.TypeString",
+		models.FloatTerraformSchemaObjectDefinitionType:         "pluginsdk.TypeFloat",
+		models.IntegerTerraformSchemaObjectDefinitionType:       "pluginsdk.TypeInt",
+		models.LocationTerraformSchemaObjectDefinitionType:      "pluginsdk.TypeString",
+		models.ResourceGroupTerraformSchemaObjectDefinitionType: "pluginsdk.TypeString",
+		models.StringTerraformSchemaObjectDefinitionType:        "pluginsdk.TypeString",
+		models.ZoneTerraformSchemaObjectDefinitionType:          "pluginsdk.TypeString",
+	}
+	if v, ok := basicTypes[input.Type]; ok {
+		return &[]string{fmt.Sprintf("Type: %s,", v)}, nil
+	}
+
+	switch input.Type {
+	case models.TagsTerraformSchemaObjectDefinitionType:
+		return &[]string{
+			"Type: pluginsdk.TypeMap,",
+			"Elem: &pluginsdk.Schema{\nType: pluginsdk.TypeString,\n},",
+		}, nil
+
+	case models.ZonesTerraformSchemaObjectDefinitionType:
+		return &[]string{
+			"Type: pluginsdk.TypeSet,",
+			"Elem: &pluginsdk.Schema{\nType: pluginsdk.TypeString,\n},",
+		}, nil
+
+	case models.DictionaryTerraformSchemaObjectDefinitionType, models.ListTerraformSchemaObjectDefinitionType, models.SetTerraformSchemaObjectDefinitionType:
+		collectionTypes := map[models.TerraformSchemaObjectDefinitionType]string{
+			models.DictionaryTerraformSchemaObjectDefinitionType: "pluginsdk.TypeMap",
+			models.ListTerraformSchemaObjectDefinitionType:       "pluginsdk.TypeList",
+			models.SetTerraformSchemaObjectDefinitionType:        "pluginsdk.TypeSet",
+		}
+		if input.NestedObject == nil {
+			return nil, fmt.Errorf("a %q must have a Nested Object", string(input.Type))
+		}
+		elem, err := codeForStateMigrationElem(*input.NestedObject)
+		if err != nil {
+			return nil, err
+		}
+		return &[]string{
+			fmt.Sprintf("Type: %s,", collectionTypes[input.Type]),
+			fmt.Sprintf("Elem: %s,", *elem),
+		}, nil
+
+	case models.ReferenceTerraformSchemaObjectDefinitionType:
+		elem, err := codeForStateMigrationElem(input)
+		if err != nil {
+			return nil, err
+		}
+		return &[]string{
+			"Type: pluginsdk.TypeList,",
+			"MaxItems: 1,",
+			fmt.Sprintf("Elem: %s,", *elem),
+		}, nil
+	}
+
+	// the remaining types (e.g. Identity) are output using Common Schema functions, whose Schema isn't known here
+	return nil, fmt.Errorf("generating the State Upgrader Schema for a %q field isn't supported at this time", string(input.Type))
+}
+
+func codeForStateMigrationElem(input objectDefinitionFingerprint) (*string, error) {
+	if input.Type == models.ReferenceTerraformSchemaObjectDefinitionType {
+		nestedSchema, err := codeForStateMigrationSchema(input.Fields)
+		if err != nil {
+			return nil, err
+		}
+		output := fmt.Sprintf(`&pluginsdk.Resource{
+	Schema: %s,
+}`, *nestedSchema)
+		return &output, nil
+	}
+
+	attributes, err := codeForStateMigrationObjectDefinition(input)
+	if err != nil {
+		return nil, err
+	}
+	output := fmt.Sprintf(`&pluginsdk.Schema{
+	%s
+}`, strings.Join(*attributes, "\n"))
+	return &output, nil
+}
+
+// schemaVersionForResource determines the Schema Version for this Resource by comparing the Schema against the
+// fingerprint recorded when the Resource was last generated. When the Schema has changed in a manner which is
+// incompatible with existing State, the Schema Version is incremented and a State Upgrader is generated.
+func schemaVersionForResource(input generatorModels.ResourceInput) (*int, error) {
+	fingerprintFilePath := schemaFingerprintFilePath(input)
+	previous, err := loadSchemaFingerprint(fingerprintFilePath)
+	if err != nil {
+		return nil, fmt.Errorf("loading the previous schema fingerprint: %+v", err)
+	}
+
+	schemaVersion := 0
+	if previous != nil {
+		schemaVersion = previous.SchemaVersion
+	}
+	current, err := buildSchemaFingerprint(input, schemaVersion)
+	if err != nil {
+		return nil, fmt.Errorf("building the schema fingerprint: %+v", err)
+	}
+
+	if previous != nil && previous.Hash != current.Hash {
+		changes := compareSchemaFingerprints(*previous, *current)
+		if len(changes.incompatibleChanges) > 0 {
+			if input.Framework == models.PluginFrameworkTerraformFrameworkType {
+				return nil, fmt.Errorf("the Schema has changed in an incompatible manner (%s) but State Upgraders aren't supported for the Plugin Framework at this time", strings.Join(changes.incompatibleChanges, " / "))
+			}
+
+			// the State Upgrader is completed by hand, so an existing one mustn't be overwritten
+			migrationFilePath := stateMigrationFilePath(input, previous.SchemaVersion)
+			if _, err := os.Stat(migrationFilePath); os.IsNotExist(err) {
+				code, err := codeForStateMigration(input, *previous, changes)
+				if err != nil {
+					return nil, fmt.Errorf("building the State Upgrader: %+v", err)
+				}
+				if err := os.MkdirAll(filepath.Dir(migrationFilePath), 0755); err != nil {
+					return nil, fmt.Errorf("creating the directory for the State Upgrader %q: %+v", migrationFilePath, err)
+				}
+				if err := writeToPath(migrationFilePath, *code); err != nil {
+					return nil, fmt.Errorf("writing the State Upgrader to %q: %+v", migrationFilePath, err)
+				}
+			}
+
+			current.SchemaVersion++
+		}
+	}
+
+	if err := saveSchemaFingerprint(fingerprintFilePath, *current); err != nil {
+		return nil, fmt.Errorf("saving the schema fingerprint: %+v", err)
+	}
+
+	return &current.SchemaVersion, nil
+}
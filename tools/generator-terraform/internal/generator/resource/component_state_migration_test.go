@@ -0,0 +1,223 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	"github.com/hashicorp/pandora/tools/sdk/testhelpers"
+)
+
+func TestComponentStateUpgraders_Disabled(t *testing.T) {
+	input := schemaFingerprintInputForTesting()
+	actual, err := stateUpgradersFunctionForResource(input)
+	if err != nil {
+		t.Fatalf("error: %+v", err)
+	}
+	if actual != nil {
+		t.Fatalf("expected `actual` to be nil but got %q", *actual)
+	}
+}
+
+func TestComponentStateUpgraders_Enabled(t *testing.T) {
+	input := schemaFingerprintInputForTesting()
+	input.SchemaVersion = 2
+	actual, err := stateUpgradersFunctionForResource(input)
+	if err != nil {
+		t.Fatalf("error: %+v", err)
+	}
+	expected := `
+func (r ExampleResource) StateUpgraders() sdk.StateUpgradeData {
+	return sdk.StateUpgradeData{
+		SchemaVersion: 2,
+		Upgraders: map[int]pluginsdk.StateUpgrade{
+			0: migration.ExampleV0ToV1{},
+			1: migration.ExampleV1ToV2{},
+		},
+	}
+}
+`
+	testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
+}
+
+func TestComponentStateMigration_RenamedField(t *testing.T) {
+	previous, err := buildSchemaFingerprint(schemaFingerprintInputForTesting(), 0)
+	if err != nil {
+		t.Fatalf("building fingerprint: %+v", err)
+	}
+	changes := schemaChanges{
+		incompatibleChanges: []string{
+			"the field `capacity` has been renamed to `instance_count`",
+		},
+		renamedFields: map[string]string{
+			"capacity": "instance_count",
+		},
+	}
+	actual, err := codeForStateMigration(schemaFingerprintInputForTesting(), *previous, changes)
+	if err != nil {
+		t.Fatalf("error: %+v", err)
+	}
+	expected := `
+package migration
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var _ pluginsdk.StateUpgrade = ExampleV0ToV1{}
+
+// ExampleV0ToV1 migrates the State for the Example Resource Resource from Schema Version 0 to 1.
+//
+// This migration was generated since the following changes were made to the Schema:
+// - the field ` + "`capacity`" + ` has been renamed to ` + "`instance_count`" + `
+//
+// Renamed fields are migrated automatically, any other changes need to be migrated manually.
+type ExampleV0ToV1 struct{}
+
+func (ExampleV0ToV1) Schema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"capacity": {
+			Optional: true,
+			Type: pluginsdk.TypeInt,
+		},
+		"name": {
+			Required: true,
+			Type: pluginsdk.TypeString,
+		},
+		"settings": {
+			Optional: true,
+			Type: pluginsdk.TypeList,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"enabled": {
+						Optional: true,
+						Type: pluginsdk.TypeBool,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (ExampleV0ToV1) UpgradeFunc() pluginsdk.StateUpgraderFunc {
+	return func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+		if v, ok := rawState["capacity"]; ok {
+			rawState["instance_count"] = v
+			delete(rawState, "capacity")
+		}
+		return rawState, nil
+	}
+}
+`
+	testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
+}
+
+func TestComponentStateMigration_CommonSchemaFieldUnsupported(t *testing.T) {
+	previous, err := buildSchemaFingerprint(schemaFingerprintInputForTesting(), 0)
+	if err != nil {
+		t.Fatalf("building fingerprint: %+v", err)
+	}
+	previous.Fields["identity"] = fieldFingerprint{
+		ObjectDefinition: objectDefinitionFingerprint{
+			Type: models.SystemAssignedIdentityTerraformSchemaObjectDefinitionType,
+		},
+		Optional: true,
+	}
+	changes := schemaChanges{
+		incompatibleChanges: []string{
+			"the field `identity` has been removed",
+		},
+	}
+	if _, err := codeForStateMigration(schemaFingerprintInputForTesting(), *previous, changes); err == nil {
+		t.Fatalf("expected an error for a Common Schema field but didn't get one")
+	}
+}
+
+func TestSchemaVersionForResource(t *testing.T) {
+	input := schemaFingerprintInputForTesting()
+	input.RootDirectory = t.TempDir()
+	if err := os.MkdirAll(input.RootDirectory+"/internal/services/example", 0755); err != nil {
+		t.Fatalf("creating the service directory: %+v", err)
+	}
+
+	// the first generation records the fingerprint
+	version, err := schemaVersionForResource(input)
+	if err != nil {
+		t.Fatalf("determining the schema version: %+v", err)
+	}
+	if *version != 0 {
+		t.Fatalf("expected the initial schema version to be 0 but got %d", *version)
+	}
+
+	// then a compatible change shouldn't change the version
+	model := input.SchemaModels["ExampleResource"]
+	model.Fields["Tags"] = models.TerraformSchemaField{
+		HCLName: "tags",
+		ObjectDefinition: models.TerraformSchemaObjectDefinition{
+			Type: models.TagsTerraformSchemaObjectDefinitionType,
+		},
+		Optional: true,
+	}
+	version, err = schemaVersionForResource(input)
+	if err != nil {
+		t.Fatalf("determining the schema version: %+v", err)
+	}
+	if *version != 0 {
+		t.Fatalf("expected the schema version to remain 0 but got %d", *version)
+	}
+
+	// whereas an incompatible change should bump the version and output a State Upgrader
+	capacity := model.Fields["Capacity"]
+	capacity.HCLName = "instance_count"
+	model.Fields["Capacity"] = capacity
+	version, err = schemaVersionForResource(input)
+	if err != nil {
+		t.Fatalf("determining the schema version: %+v", err)
+	}
+	if *version != 1 {
+		t.Fatalf("expected the schema version to be 1 but got %d", *version)
+	}
+	migration, err := os.ReadFile(stateMigrationFilePath(input, 0))
+	if err != nil {
+		t.Fatalf("reading the State Upgrader: %+v", err)
+	}
+	if !strings.Contains(string(migration), `rawState["instance_count"] = v`) {
+		t.Fatalf("expected the State Upgrader to migrate the renamed field but got:\n\n%s", string(migration))
+	}
+
+	// and regenerating should retain the new version
+	version, err = schemaVersionForResource(input)
+	if err != nil {
+		t.Fatalf("determining the schema version: %+v", err)
+	}
+	if *version != 1 {
+		t.Fatalf("expected the schema version to remain 1 but got %d", *version)
+	}
+}
+
+func TestSchemaVersionForResource_PluginFramework(t *testing.T) {
+	input := schemaFingerprintInputForTesting()
+	input.Framework = models.PluginFrameworkTerraformFrameworkType
+	input.RootDirectory = t.TempDir()
+	if err := os.MkdirAll(input.RootDirectory+"/internal/services/example", 0755); err != nil {
+		t.Fatalf("creating the service directory: %+v", err)
+	}
+	if _, err := schemaVersionForResource(input); err != nil {
+		t.Fatalf("determining the schema version: %+v", err)
+	}
+
+	model := input.SchemaModels["ExampleResource"]
+	capacity := model.Fields["Capacity"]
+	capacity.HCLName = "instance_count"
+	model.Fields["Capacity"] = capacity
+	if _, err := schemaVersionForResource(input); err == nil {
+		t.Fatalf("expected an error for an incompatible change to a Plugin Framework resource but didn't get one")
+	}
+}
@@ -29,3 +29,16 @@ import (
 `, strings.ToLower(input.SdkServiceName), input.SdkApiVersion, strings.ToLower(input.SdkResourceName))
 	return &output, nil
 }
+
+func importsForDataSourceTest(_ models.ResourceInput) (*string, error) {
+	output := `
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+`
+	return &output, nil
+}
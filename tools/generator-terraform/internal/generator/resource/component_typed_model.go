@@ -67,6 +67,31 @@ func codeForNonTopLevelModels(input generatorModels.ResourceInput) (*string, err
 	return &output, nil
 }
 
+// codeForSchemaModels outputs each of the Schema Models, including the top-level Schema Model, without
+// the `ModelObject` function - which is used when generating for the Plugin Framework.
+func codeForSchemaModels(input generatorModels.ResourceInput) (*string, error) {
+	if !input.Details.GenerateModel {
+		return nil, nil
+	}
+
+	modelNames := make([]string, 0)
+	for k := range input.SchemaModels {
+		modelNames = append(modelNames, k)
+	}
+	sort.Strings(modelNames)
+
+	codeForModels := make([]string, 0)
+	for _, modelName := range modelNames {
+		code, err := codeForModel(modelName, input.SchemaModels[modelName])
+		if err != nil {
+			return nil, fmt.Errorf("generating code for model %q: %+v", modelName, err)
+		}
+		codeForModels = append(codeForModels, *code)
+	}
+	output := strings.Join(codeForModels, "\n")
+	return &output, nil
+}
+
 func codeForModel(name string, input models.TerraformSchemaModel) (*string, error) {
 	schemaFields := make([]string, 0)
 	for fieldName, fieldDetails := range input.Fields {
@@ -51,19 +51,22 @@ func updateFuncForResource(input generatorModels.ResourceInput) (*string, error)
 	}
 
 	updateHelpers := updateFuncHelpers{
-		schemaModelName:         input.SchemaModelName,
-		sdkResourceNameLowered:  strings.ToLower(input.SdkResourceName),
-		createMethod:            createOperation,
-		createMethodName:        input.Details.CreateMethod.SDKOperationName,
-		updateMethod:            updateOperation,
-		updateMethodName:        input.Details.UpdateMethod.SDKOperationName,
-		readMethod:              readOperation,
-		readMethodName:          input.Details.ReadMethod.SDKOperationName,
-		resourceIdParseFuncName: *idParseLine,
-		resourceTypeName:        input.ResourceTypeName,
-		models:                  input.Models,
-		topLevelModel:           topLevelModel,
-		terraformModel:          terraformModel,
+		schemaModelName:               input.SchemaModelName,
+		sdkResourceNameLowered:        strings.ToLower(input.SdkResourceName),
+		payloadCommonTypesPackageName: input.CommonTypesPackageNameFor(*updateOperation.RequestObject.ReferenceName),
+		createMethod:                  createOperation,
+		createMethodName:              input.Details.CreateMethod.SDKOperationName,
+		updateMethod:                  updateOperation,
+		updateMethodName:              input.Details.UpdateMethod.SDKOperationName,
+		readMethod:                    readOperation,
+		readMethodName:                input.Details.ReadMethod.SDKOperationName,
+		resourceIdParseFuncName:       *idParseLine,
+		resourceTypeName:              input.ResourceTypeName,
+		models:                        input.Models,
+		topLevelModel:                 topLevelModel,
+		terraformModel:                terraformModel,
+		onlyChangedFields:             operationIsPatch(updateOperation),
+		mappings:                      input.Details.Mappings,
 	}
 	components := []func() (*string, error){
 		updateHelpers.resourceIdParser,
@@ -102,6 +105,10 @@ type updateFuncHelpers struct {
 	schemaModelName        string
 	sdkResourceNameLowered string
 
+	// payloadCommonTypesPackageName is the name of the package containing the Common Types, when the SDK Model used
+	// as the payload is a Common Type - otherwise the SDK Model is within the package for the SDK Resource.
+	payloadCommonTypesPackageName *string
+
 	createMethod     models.SDKOperation
 	createMethodName string
 
@@ -111,6 +118,12 @@ type updateFuncHelpers struct {
 	readMethod     models.SDKOperation
 	readMethodName string
 
+	// onlyChangedFields specifies that only the fields which have changed should be mapped into the
+	// payload, which is the case when the update method is a PATCH.
+	onlyChangedFields bool
+
+	mappings models.TerraformMappingDefinition
+
 	resourceIdParseFuncName string
 	resourceTypeName        string
 
@@ -130,11 +143,27 @@ func (h updateFuncHelpers) modelDecode() (*string, error) {
 }
 
 func (h updateFuncHelpers) payloadDefinition() (*string, error) {
-	updateObjectName, err := helpers.GolangTypeForSDKObjectDefinition(*h.updateMethod.RequestObject, &h.sdkResourceNameLowered, nil)
+	payloadPackageName := h.sdkResourceNameLowered
+	if h.payloadCommonTypesPackageName != nil {
+		payloadPackageName = *h.payloadCommonTypesPackageName
+	}
+	updateObjectName, err := helpers.GolangTypeForSDKObjectDefinition(*h.updateMethod.RequestObject, &payloadPackageName, nil)
 	if err != nil {
 		return nil, fmt.Errorf("determining Golang Type name for Update Request Object: %+v", err)
 	}
 
+	// when the update method is a PATCH, only the fields which have changed should be sent - to avoid clobbering any
+	// values defaulted by the API or requiring permissions for fields which haven't been changed
+	if h.onlyChangedFields {
+		output := fmt.Sprintf(`
+			var payload %[1]s
+			if err := r.mapChanged%[2]sTo%[3]s(config, &payload, metadata%[4]s); err != nil {
+				return fmt.Errorf("mapping changed fields from schema model to sdk model: %%+v", err)
+			}
+`, *updateObjectName, h.schemaModelName, *h.updateMethod.RequestObject.ReferenceName, schemaToSdkMappingAdditionalValues(h.mappings))
+		return &output, nil
+	}
+
 	// if the same method is used for CreateOrUpdate - and Read - then we need to load and patch the existing resource
 	hasMatchingPayloads := false
 	if h.updateMethod.RequestObject != nil && h.createMethod.RequestObject != nil && h.readMethod.ResponseObject != nil {
@@ -158,19 +187,19 @@ func (h updateFuncHelpers) payloadDefinition() (*string, error) {
 			}
 			payload := *existing.Model
 
-			if err := r.map%[3]sTo%[4]s(config, &payload); err != nil {
+			if err := r.map%[3]sTo%[4]s(config, &payload%[5]s); err != nil {
 				return fmt.Errorf("mapping schema model to sdk model: %%+v", err)
 			}
-`, methodName, methodArguments, h.schemaModelName, *h.updateMethod.RequestObject.ReferenceName)
+`, methodName, methodArguments, h.schemaModelName, *h.updateMethod.RequestObject.ReferenceName, schemaToSdkMappingAdditionalValues(h.mappings))
 		return &output, nil
 	}
 
 	output := fmt.Sprintf(`
 			var payload %[1]s
-			if err := r.map%[2]sTo%[3]s(config, &payload); err != nil {
+			if err := r.map%[2]sTo%[3]s(config, &payload%[4]s); err != nil {
 				return fmt.Errorf("mapping schema model to sdk model: %%+v", err)
 			}
-`, *updateObjectName, h.schemaModelName, *h.updateMethod.RequestObject.ReferenceName)
+`, *updateObjectName, h.schemaModelName, *h.updateMethod.RequestObject.ReferenceName, schemaToSdkMappingAdditionalValues(h.mappings))
 	return &output, nil
 }
 
@@ -963,3 +963,178 @@ func TestComponentUpdate_UpdateFunc_LongRunning_PayloadResourceIdOptions(t *test
 `
 	testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
 }
+
+func TestComponentUpdate_PayloadDefinition_PatchOnlyChangedFields(t *testing.T) {
+	actual, err := updateFuncHelpers{
+		createMethod: models.SDKOperation{
+			LongRunning: false,
+			RequestObject: &models.SDKObjectDefinition{
+				Type:          models.ReferenceSDKObjectDefinitionType,
+				ReferenceName: pointer.To("SharedPayload"),
+			},
+			ResourceIDName: pointer.To("SomeId"),
+		},
+		createMethodName: "Create",
+		readMethod: models.SDKOperation{
+			LongRunning: false,
+			ResponseObject: &models.SDKObjectDefinition{
+				Type:          models.ReferenceSDKObjectDefinitionType,
+				ReferenceName: pointer.To("SharedPayload"),
+			},
+			ResourceIDName: pointer.To("SomeId"),
+		},
+		readMethodName: "Get",
+		updateMethod: models.SDKOperation{
+			LongRunning: false,
+			Method:      "PATCH",
+			RequestObject: &models.SDKObjectDefinition{
+				Type:          models.ReferenceSDKObjectDefinitionType,
+				ReferenceName: pointer.To("SharedPayload"),
+			},
+			ResourceIDName: pointer.To("SomeId"),
+		},
+		updateMethodName:       "Update",
+		onlyChangedFields:      true,
+		schemaModelName:        "MyTypedModel",
+		sdkResourceNameLowered: "sdkresource",
+	}.payloadDefinition()
+	if err != nil {
+		t.Fatalf("error: %+v", err)
+	}
+	expected := `
+	var payload sdkresource.SharedPayload
+	if err := r.mapChangedMyTypedModelToSharedPayload(config, &payload, metadata); err != nil {
+		return fmt.Errorf("mapping changed fields from schema model to sdk model: %+v", err)
+	}
+`
+	testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
+}
+
+func TestComponentUpdate_ChangedMappings(t *testing.T) {
+	input := generatorModels.ResourceInput{
+		Details: models.TerraformResourceDefinition{
+			APIResource: "SdkResource",
+			Mappings: models.TerraformMappingDefinition{
+				Fields: []models.TerraformFieldMappingDefinition{
+					models.TerraformModelToModelFieldMappingDefinition{
+						ModelToModel: models.TerraformModelToModelFieldMappingDefinitionImpl{
+							SDKFieldName:             "Properties",
+							SDKModelName:             "UpdatePayload",
+							TerraformSchemaModelName: "MyTypedModel",
+						},
+					},
+					models.TerraformDirectAssignmentFieldMappingDefinition{
+						DirectAssignment: models.TerraformDirectAssignmentFieldMappingDefinitionImpl{
+							SDKFieldName:             "Example",
+							SDKModelName:             "UpdatePayloadProperties",
+							TerraformSchemaFieldName: "Example",
+							TerraformSchemaModelName: "MyTypedModel",
+						},
+					},
+				},
+			},
+			ResourceName:    "MyResource",
+			SchemaModelName: "MyTypedModel",
+			SchemaModels: map[string]models.TerraformSchemaModel{
+				"MyTypedModel": {
+					Fields: map[string]models.TerraformSchemaField{
+						"Example": {
+							HCLName: "example",
+							ObjectDefinition: models.TerraformSchemaObjectDefinition{
+								Type: models.StringTerraformSchemaObjectDefinitionType,
+							},
+							Optional: true,
+						},
+					},
+				},
+			},
+			UpdateMethod: &models.TerraformMethodDefinition{
+				Generate:         true,
+				SDKOperationName: "Update",
+			},
+		},
+		Models: map[string]models.SDKModel{
+			"UpdatePayload": {
+				Fields: map[string]models.SDKField{
+					"Properties": {
+						JsonName: "properties",
+						ObjectDefinition: models.SDKObjectDefinition{
+							Type:          models.ReferenceSDKObjectDefinitionType,
+							ReferenceName: pointer.To("UpdatePayloadProperties"),
+						},
+						Optional: true,
+					},
+				},
+			},
+			"UpdatePayloadProperties": {
+				Fields: map[string]models.SDKField{
+					"Example": {
+						JsonName: "example",
+						ObjectDefinition: models.SDKObjectDefinition{
+							Type: models.StringSDKObjectDefinitionType,
+						},
+						Optional: true,
+					},
+				},
+			},
+		},
+		Operations: map[string]models.SDKOperation{
+			"Update": {
+				Method: "PATCH",
+				RequestObject: &models.SDKObjectDefinition{
+					Type:          models.ReferenceSDKObjectDefinitionType,
+					ReferenceName: pointer.To("UpdatePayload"),
+				},
+				ResourceIDName: pointer.To("SomeResourceId"),
+			},
+		},
+		SchemaModelName: "MyTypedModel",
+		SdkResourceName: "SdkResource",
+	}
+
+	t.Run("Patch", func(t *testing.T) {
+		actual, err := codeForChangedMappings(input)
+		if err != nil {
+			t.Fatalf("error: %+v", err)
+		}
+		expected := `
+func (r MyResourceResource) mapChangedMyTypedModelToUpdatePayload(input MyTypedModel, output *sdkresource.UpdatePayload, metadata sdk.ResourceMetaData) error {
+	if metadata.ResourceData.HasChanges("example") {
+		if output.Properties == nil {
+			output.Properties = &sdkresource.UpdatePayloadProperties{}
+		}
+		if err := r.mapChangedMyTypedModelToUpdatePayloadProperties(input, output.Properties, metadata); err != nil {
+			return fmt.Errorf("mapping changed Schema to SDK Field %q / Model %q: %+v", "UpdatePayloadProperties", "Properties", err)
+		}
+	}
+	return nil
+}
+
+func (r MyResourceResource) mapChangedMyTypedModelToUpdatePayloadProperties(input MyTypedModel, output *sdkresource.UpdatePayloadProperties, metadata sdk.ResourceMetaData) error {
+	if metadata.ResourceData.HasChange("example") {
+		output.Example = &input.Example
+	}
+	return nil
+}
+`
+		testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
+	})
+
+	t.Run("Put", func(t *testing.T) {
+		putInput := input
+		putInput.Operations = map[string]models.SDKOperation{
+			"Update": {
+				Method:         "PUT",
+				RequestObject:  input.Operations["Update"].RequestObject,
+				ResourceIDName: pointer.To("SomeResourceId"),
+			},
+		}
+		actual, err := codeForChangedMappings(putInput)
+		if err != nil {
+			t.Fatalf("error: %+v", err)
+		}
+		if actual != nil {
+			t.Fatalf("expected `actual` to be nil but got %q", *actual)
+		}
+	})
+}
@@ -58,9 +58,111 @@ func codeForResource(input models.ResourceInput) (*string, error) {
 		readFunctionForResource,
 		deleteFunctionForResource,
 		updateFuncForResource,
+		stateUpgradersFunctionForResource,
 
 		codeForNonTopLevelModels,
 		codeForMappings,
+		codeForChangedMappings,
+	}
+
+	lines := make([]string, 0)
+	for _, component := range components {
+		line, err := component(input)
+		if err != nil {
+			return nil, err
+		}
+
+		// components can opt-out of generation so if it's not generating anything
+		// do nothing
+		if line != nil {
+			lines = append(lines, strings.TrimSpace(*line))
+		}
+	}
+	output := strings.Join(lines, "\n")
+	return &output, nil
+}
+
+func codeForFrameworkResource(input models.ResourceInput) (*string, error) {
+	components := []func(input models.ResourceInput) (*string, error){
+		// NOTE: the ordering is important, components can opt in/out of generation
+		packageDefinitionForResource,
+		generationNoteForResource,
+		copyrightLinesForResource,
+		importsForFrameworkResource,
+		definitionForFrameworkResource,
+
+		// then the Plugin Framework functions
+		schemaFunctionForFrameworkResource,
+		createFunctionForFrameworkResource,
+		readFunctionForFrameworkResource,
+		updateFunctionForFrameworkResource,
+		deleteFunctionForFrameworkResource,
+		importStateFunctionForFrameworkResource,
+
+		// the Framework Models are converted to/from the Schema Models, so that the mappings can be reused
+		codeForFrameworkModels,
+		codeForSchemaModels,
+		codeForMappings,
+	}
+
+	lines := make([]string, 0)
+	for _, component := range components {
+		line, err := component(input)
+		if err != nil {
+			return nil, err
+		}
+
+		// components can opt-out of generation so if it's not generating anything
+		// do nothing
+		if line != nil {
+			lines = append(lines, strings.TrimSpace(*line))
+		}
+	}
+	output := strings.Join(lines, "\n")
+	return &output, nil
+}
+
+func componentsForDataSourceTest(input models.ResourceInput) (*string, error) {
+	components := []func(input models.ResourceInput) (*string, error){
+		packageTestDefinitionForResource,
+		generationNoteForResource,
+		copyrightLinesForResource,
+		importsForDataSourceTest,
+
+		testDataSourceStruct,
+		codeForDataSourceTestFunctions,
+		codeForDataSourceTestConfigurationFunctions,
+	}
+
+	lines := make([]string, 0)
+	for _, component := range components {
+		line, err := component(input)
+		if err != nil {
+			return nil, err
+		}
+
+		// components can opt-out of generation so if it's not generating anything
+		// do nothing
+		if line != nil {
+			lines = append(lines, strings.TrimSpace(*line))
+		}
+	}
+	output := strings.Join(lines, "\n")
+	return &output, nil
+}
+
+func codeForDataSource(input models.ResourceInput) (*string, error) {
+	components := []func(input models.ResourceInput) (*string, error){
+		// NOTE: the ordering is important, components can opt in/out of generation
+		packageDefinitionForResource,
+		generationNoteForResource,
+		copyrightLinesForResource,
+		importsForDataSource,
+		definitionForDataSource,
+
+		argumentsCodeFunctionForDataSource,
+		attributesCodeFunctionForDataSource,
+		readFunctionForDataSource,
 	}
 
 	lines := make([]string, 0)
@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"fmt"
+	"os"
+
+	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	"github.com/hashicorp/pandora/tools/generator-terraform/internal/generator/models"
+	"github.com/hashicorp/pandora/tools/generator-terraform/internal/generator/resource/docs"
+)
+
+// DataSource generates the Data Source for the Resource defined in input, which looks up an existing
+// instance of the Resource - this is a no-op when no Data Source should be generated.
+func DataSource(input models.ResourceInput) error {
+	if input.Details.DataSource == nil || !input.Details.DataSource.Generate {
+		return nil
+	}
+
+	// TODO: support generating Data Sources for the Plugin Framework
+	if input.Framework == sdkModels.PluginFrameworkTerraformFrameworkType {
+		return nil
+	}
+
+	// the Data Source uses a read-only version of the Resource's Schema
+	input = dataSourceInputFromResourceInput(input)
+
+	// ensure the service directory exists
+	serviceDirectory := fmt.Sprintf("%s/internal/services/%s", input.RootDirectory, input.ServicePackageName)
+	os.MkdirAll(serviceDirectory, 0755)
+
+	// Generate the Data Source
+	dataSourceFilePath := fmt.Sprintf("%s/%s_data_source_gen.go", serviceDirectory, input.ResourceLabel)
+	os.Remove(dataSourceFilePath)
+	dataSourceCode, err := codeForDataSource(input)
+	if err != nil {
+		return fmt.Errorf("building code for data source: %+v", err)
+	}
+	if err := writeToPath(dataSourceFilePath, *dataSourceCode); err != nil {
+		return fmt.Errorf("writing to %q: %+v", dataSourceFilePath, err)
+	}
+
+	// then generate the Tests
+	testFilePath := fmt.Sprintf("%s/%s_data_source_gen_test.go", serviceDirectory, input.ResourceLabel)
+	os.Remove(testFilePath)
+	testFileContents, err := componentsForDataSourceTest(input)
+	if err != nil {
+		return fmt.Errorf("building tests for data source: %+v", err)
+	}
+	if err := writeToPath(testFilePath, *testFileContents); err != nil {
+		return fmt.Errorf("writing to %q: %+v", testFilePath, err)
+	}
+
+	// then generate the documentation
+	websiteDataSourcesDirectory := fmt.Sprintf("%s/website/docs/d/", input.RootDirectory)
+	os.MkdirAll(websiteDataSourcesDirectory, 0755)
+	documentationFilePath := fmt.Sprintf("%s/%s.html.markdown", websiteDataSourcesDirectory, input.ResourceLabel)
+	os.Remove(documentationFilePath)
+	documentationForDataSource, err := docs.ComponentsForDataSource(input)
+	if err != nil {
+		return fmt.Errorf("building documentation for data source: %+v", err)
+	}
+	if err := writeToPath(documentationFilePath, *documentationForDataSource); err != nil {
+		return fmt.Errorf("writing to %q: %+v", documentationFilePath, err)
+	}
+
+	return nil
+}
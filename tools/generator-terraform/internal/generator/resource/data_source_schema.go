@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	generatorModels "github.com/hashicorp/pandora/tools/generator-terraform/internal/generator/models"
+)
+
+// dataSourceInputFromResourceInput returns a copy of the ResourceInput for use in the Data Source, where
+// the Schema Models have been replaced with a read-only version of the Resource's Schema.
+//
+// The top-level fields which make up the Resource ID are Required (since these are used to look up the
+// existing Resource) and all other fields (including those within nested Schema Models) are Computed.
+func dataSourceInputFromResourceInput(input generatorModels.ResourceInput) generatorModels.ResourceInput {
+	output := input
+
+	resourceIdFields := make(map[string]struct{})
+	for _, v := range input.Details.Mappings.ResourceID {
+		resourceIdFields[v.TerraformSchemaFieldName] = struct{}{}
+	}
+
+	schemaModels := make(map[string]models.TerraformSchemaModel, len(input.SchemaModels))
+	for modelName, model := range input.SchemaModels {
+		fields := make(map[string]models.TerraformSchemaField, len(model.Fields))
+		for fieldName, field := range model.Fields {
+			_, isResourceIdField := resourceIdFields[fieldName]
+			if modelName == input.SchemaModelName && isResourceIdField {
+				field.Required = true
+				field.Optional = false
+				field.Computed = false
+				field.ForceNew = false
+			} else {
+				field.Required = false
+				field.Optional = false
+				field.Computed = true
+				field.ForceNew = false
+				field.Default = nil
+				field.Validation = nil
+			}
+			fields[fieldName] = field
+		}
+		model.Fields = fields
+		schemaModels[modelName] = model
+	}
+	output.SchemaModels = schemaModels
+
+	return output
+}
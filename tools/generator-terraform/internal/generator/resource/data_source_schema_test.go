@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	generatorModels "github.com/hashicorp/pandora/tools/generator-terraform/internal/generator/models"
+)
+
+func TestDataSourceInputFromResourceInput(t *testing.T) {
+	input := generatorModels.ResourceInput{
+		Details: models.TerraformResourceDefinition{
+			Mappings: models.TerraformMappingDefinition{
+				ResourceID: []models.TerraformResourceIDMappingDefinition{
+					{
+						SegmentName:              "resourceGroupName",
+						TerraformSchemaFieldName: "ResourceGroupName",
+					},
+					{
+						SegmentName:              "exampleName",
+						TerraformSchemaFieldName: "Name",
+					},
+				},
+			},
+		},
+		SchemaModelName: "ExampleModel",
+		SchemaModels: map[string]models.TerraformSchemaModel{
+			"ExampleModel": {
+				Fields: map[string]models.TerraformSchemaField{
+					"Name": {
+						HCLName:  "name",
+						ForceNew: true,
+						Required: true,
+						ObjectDefinition: models.TerraformSchemaObjectDefinition{
+							Type: models.StringTerraformSchemaObjectDefinitionType,
+						},
+					},
+					"ResourceGroupName": {
+						HCLName:  "resource_group_name",
+						ForceNew: true,
+						Required: true,
+						ObjectDefinition: models.TerraformSchemaObjectDefinition{
+							Type: models.ResourceGroupTerraformSchemaObjectDefinitionType,
+						},
+					},
+					"Sku": {
+						HCLName:  "sku",
+						Optional: true,
+						ObjectDefinition: models.TerraformSchemaObjectDefinition{
+							Type: models.StringTerraformSchemaObjectDefinitionType,
+						},
+						Validation: models.TerraformSchemaFieldValidationPossibleValuesDefinition{
+							PossibleValues: &models.TerraformSchemaFieldValidationPossibleValuesDefinitionImpl{
+								Type:   models.StringTerraformSchemaFieldValidationPossibleValuesType,
+								Values: []interface{}{"Basic", "Standard"},
+							},
+						},
+					},
+					"Properties": {
+						HCLName:  "properties",
+						Required: true,
+						ObjectDefinition: models.TerraformSchemaObjectDefinition{
+							Type:          models.ReferenceTerraformSchemaObjectDefinitionType,
+							ReferenceName: pointer.To("NestedModel"),
+						},
+					},
+				},
+			},
+			"NestedModel": {
+				Fields: map[string]models.TerraformSchemaField{
+					// a nested field with the same name as a Resource ID field should be Computed
+					"Name": {
+						HCLName:  "name",
+						Required: true,
+						ObjectDefinition: models.TerraformSchemaObjectDefinition{
+							Type: models.StringTerraformSchemaObjectDefinitionType,
+						},
+					},
+				},
+			},
+		},
+	}
+	actual := dataSourceInputFromResourceInput(input)
+
+	topLevel := actual.SchemaModels["ExampleModel"]
+	for _, fieldName := range []string{"Name", "ResourceGroupName"} {
+		field := topLevel.Fields[fieldName]
+		if !field.Required || field.Optional || field.Computed || field.ForceNew {
+			t.Fatalf("expected the Resource ID field %q to be Required only but got %+v", fieldName, field)
+		}
+	}
+	for _, fieldName := range []string{"Properties", "Sku"} {
+		field := topLevel.Fields[fieldName]
+		if field.Required || field.Optional || !field.Computed || field.ForceNew {
+			t.Fatalf("expected the field %q to be Computed only but got %+v", fieldName, field)
+		}
+		if field.Validation != nil {
+			t.Fatalf("expected the field %q to have no Validation but got %+v", fieldName, field.Validation)
+		}
+	}
+	nestedField := actual.SchemaModels["NestedModel"].Fields["Name"]
+	if nestedField.Required || !nestedField.Computed {
+		t.Fatalf("expected the nested field `Name` to be Computed only but got %+v", nestedField)
+	}
+
+	// the Resource's Schema Models must not be modified
+	if !input.SchemaModels["ExampleModel"].Fields["Sku"].Optional {
+		t.Fatalf("expected the Resource's Schema Model to be unchanged")
+	}
+}
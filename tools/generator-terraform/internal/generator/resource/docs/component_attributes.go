@@ -46,7 +46,7 @@ func getAttributes(model models.TerraformSchemaModel) (*string, error) {
 			lines = append(lines, *line)
 		}
 	}
-	out := strings.Join(append(lines), "\n\n")
+	out := strings.Join(lines, "\n\n")
 	return &out, nil
 }
 
@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package docs
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/pandora/tools/generator-terraform/internal/generator/models"
+)
+
+// NOTE: the Data Source documentation reuses the Arguments/Attributes/Blocks components from the Resource
+// documentation, since the Schema Models passed in for a Data Source are the read-only versions.
+
+func codeForDataSourceYAMLFrontMatter(input models.ResourceInput) (*string, error) {
+	frontMatterDescription := fmt.Sprintf("Gets information about an existing %s.", input.Details.DisplayName)
+	output := strings.TrimSpace(fmt.Sprintf(`
+---
+subcategory: "%[1]s"
+layout: "%[2]s"
+page_title: "Azure Resource Manager: Data Source: %[2]s_%[3]s"
+description: |-
+  %[4]s
+---
+`, input.Details.Documentation.Category, input.ProviderPrefix, input.ResourceLabel, frontMatterDescription))
+	return &output, nil
+}
+
+func codeForDataSourceSummary(input models.ResourceInput) (*string, error) {
+	if input.Details.DataSource == nil {
+		return nil, fmt.Errorf("internal-error: the Data Source definition was nil")
+	}
+
+	output := strings.TrimSpace(fmt.Sprintf(`
+# Data Source: %[1]s_%[2]s
+
+%[3]s.
+`, input.ProviderPrefix, input.ResourceLabel, strings.TrimSuffix(input.Details.DataSource.Description, ".")))
+	return &output, nil
+}
+
+func codeForDataSourceExampleUsage(input models.ResourceInput) (*string, error) {
+	model, ok := input.SchemaModels[input.SchemaModelName]
+	if !ok {
+		return nil, fmt.Errorf("the schema model %q was not found", input.SchemaModelName)
+	}
+
+	lines := make([]string, 0)
+	for _, fieldName := range sortFieldNamesAlphabetically(model) {
+		field := model.Fields[fieldName]
+		if !field.Required {
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("  %s = \"existing\"", field.HCLName))
+	}
+
+	code := strings.TrimSpace(fmt.Sprintf(`
+## Example Usage
+
+'''hcl
+data "%[1]s_%[2]s" "example" {
+%[3]s
+}
+
+output "id" {
+  value = data.%[1]s_%[2]s.example.id
+}
+'''
+`, input.ProviderPrefix, input.ResourceLabel, strings.Join(lines, "\n")))
+	output := strings.ReplaceAll(code, "'", "`")
+	return &output, nil
+}
+
+func codeForDataSourceTimeouts(input models.ResourceInput) (*string, error) {
+	if input.Details.DataSource == nil {
+		return nil, fmt.Errorf("internal-error: the Data Source definition was nil")
+	}
+
+	readTimeout := wordifyTimeout(input.Details.DataSource.TimeoutInMinutes)
+	output := fmt.Sprintf(`
+## Timeouts
+
+The 'timeouts' block allows you to specify [timeouts](https://www.terraform.io/docs/configuration/resources.html#timeouts) for certain actions:
+
+* 'read' - (Defaults to %[2]s) Used when retrieving the %[1]s.
+`, input.Details.DisplayName, readTimeout)
+	output = strings.ReplaceAll(output, "'", "`")
+	return &output, nil
+}
@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package docs
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	generatorModels "github.com/hashicorp/pandora/tools/generator-terraform/internal/generator/models"
+	"github.com/hashicorp/pandora/tools/sdk/testhelpers"
+)
+
+func TestDataSourceExampleUsage(t *testing.T) {
+	input := generatorModels.ResourceInput{
+		ProviderPrefix:  "azurerm",
+		ResourceLabel:   "example",
+		SchemaModelName: "ExampleModel",
+		SchemaModels: map[string]models.TerraformSchemaModel{
+			"ExampleModel": {
+				Fields: map[string]models.TerraformSchemaField{
+					"Name": {
+						HCLName:  "name",
+						Required: true,
+					},
+					"ResourceGroupName": {
+						HCLName:  "resource_group_name",
+						Required: true,
+					},
+					"Sku": {
+						HCLName:  "sku",
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+	actual, err := codeForDataSourceExampleUsage(input)
+	if err != nil {
+		t.Fatalf("expected no error but got one: %+v", err)
+	}
+	expected := strings.ReplaceAll(`
+## Example Usage
+
+'''hcl
+data "azurerm_example" "example" {
+  name = "existing"
+  resource_group_name = "existing"
+}
+
+output "id" {
+  value = data.azurerm_example.example.id
+}
+'''
+`, "'", "`")
+	testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
+}
+
+func TestDataSourceSummary(t *testing.T) {
+	input := generatorModels.ResourceInput{
+		ProviderPrefix: "azurerm",
+		ResourceLabel:  "example",
+		Details: models.TerraformResourceDefinition{
+			DataSource: &models.TerraformDataSourceDefinition{
+				Description: "Gets information about an existing Example",
+			},
+		},
+	}
+	actual, err := codeForDataSourceSummary(input)
+	if err != nil {
+		t.Fatalf("expected no error but got one: %+v", err)
+	}
+	expected := `
+# Data Source: azurerm_example
+
+Gets information about an existing Example.
+`
+	testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
+}
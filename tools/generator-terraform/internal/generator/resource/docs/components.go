@@ -37,3 +37,29 @@ func ComponentsForResource(input models.ResourceInput) (*string, error) {
 	output := strings.Join(lines, "\n\n")
 	return &output, nil
 }
+
+func ComponentsForDataSource(input models.ResourceInput) (*string, error) {
+	components := []func(input models.ResourceInput) (*string, error){
+		codeForDataSourceYAMLFrontMatter,
+		codeForGeneratedNote,
+		codeForDataSourceSummary,
+		codeForDataSourceExampleUsage,
+		codeForArgumentsReference,
+		codeForAttributesReference,
+		codeForBlocksReference,
+		codeForDataSourceTimeouts,
+	}
+	lines := make([]string, 0)
+	for i, component := range components {
+		result, err := component(input)
+		if err != nil {
+			return nil, fmt.Errorf("templating component %d: %+v", i, err)
+		}
+		if result != nil {
+			lines = append(lines, strings.TrimSpace(*result))
+		}
+	}
+
+	output := strings.Join(lines, "\n\n")
+	return &output, nil
+}
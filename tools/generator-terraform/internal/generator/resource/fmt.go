@@ -8,10 +8,13 @@ import (
 	"os/exec"
 )
 
-func writeToPath(filePath string, fileContents string) {
-	os.WriteFile(filePath, []byte(fileContents), 0644)
+func writeToPath(filePath string, fileContents string) error {
+	if err := os.WriteFile(filePath, []byte(fileContents), 0644); err != nil {
+		return err
+	}
 	runGoFmt(filePath)
 	runGoImports(filePath)
+	return nil
 }
 
 func runGoFmt(path string) {
@@ -5,10 +5,12 @@ package resource
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	generatorModels "github.com/hashicorp/pandora/tools/generator-terraform/internal/generator/models"
 )
 
 func argumentsForApiOperationMethod(operation models.SDKOperation, sdkResourceName, methodName string, idIsAPointer bool) string {
@@ -37,6 +39,38 @@ func argumentsForApiOperationMethod(operation models.SDKOperation, sdkResourceNa
 	return strings.Join(methodArguments, ", ")
 }
 
+// operationIsPatch returns whether the specified operation is a PATCH request - in which case only
+// the fields which have changed should be sent in the payload.
+func operationIsPatch(operation models.SDKOperation) bool {
+	return strings.EqualFold(operation.Method, "PATCH")
+}
+
+// codeForWaitingForConsistency returns the code used to poll the Read method of an Eventually Consistent Resource until
+// the change has propagated - where `waitFunctionName` is the function within the `consistency` package to call (e.g.
+// `WaitForUpdate` or `WaitForDeletion`) and `description` describes the change being waited for (e.g. `creation`).
+func codeForWaitingForConsistency(readOperation models.SDKOperation, sdkResourceName, readMethodName string, idIsAPointer bool, waitFunctionName, description string) string {
+	methodArguments := argumentsForApiOperationMethod(readOperation, sdkResourceName, readMethodName, idIsAPointer)
+	idVariable := "id"
+	if idIsAPointer {
+		idVariable = "*id"
+	}
+
+	return fmt.Sprintf(`
+			if err := consistency.%[1]s(ctx, func(ctx context.Context) (*bool, error) {
+				resp, err := client.%[2]s(%[3]s)
+				if err != nil {
+					if response.WasNotFound(resp.HttpResponse) {
+						return pointer.To(false), nil
+					}
+					return nil, err
+				}
+				return pointer.To(resp.Model != nil), nil
+			}); err != nil {
+				return fmt.Errorf("waiting for the %[4]s of %%s: %%+v", %[5]s, err)
+			}
+`, waitFunctionName, readMethodName, methodArguments, description, idVariable)
+}
+
 func methodNameToCallForOperation(operation models.SDKOperation, methodName string) string {
 	if operation.LongRunning {
 		return fmt.Sprintf("%sThenPoll", methodName)
@@ -81,3 +115,153 @@ func updateTemplateWithVariableNames(input string) (template *string, variableNa
 	variableNames = pointer.To(strings.Join(variables, ", "))
 	return
 }
+
+// writeOnlySchemaField is a field within a Schema Model which is write-only - or a nested block containing one or
+// more write-only fields.
+type writeOnlySchemaField struct {
+	// fieldName is the name of this field within the Schema Model.
+	fieldName string
+
+	// hclName is the name of this field within the Terraform Schema.
+	hclName string
+
+	// nestedFields are the write-only fields within the nested Schema Model, when this field is a nested block.
+	nestedFields []writeOnlySchemaField
+}
+
+// writeOnlySchemaFieldsForResource returns the (sorted) fields within the top-level Schema Model (and any nested
+// Schema Models) which are write-only - that is, Sensitive fields mapped from an SDK Field marked as Sensitive
+// (`x-ms-secret`) and fields mapped to an `@odata.bind` field, the value for which isn't returned by the API,
+// so must be retained from the users configuration during a Read.
+func writeOnlySchemaFieldsForResource(input generatorModels.ResourceInput) (*[]writeOnlySchemaField, error) {
+	if _, ok := input.SchemaModels[input.SchemaModelName]; !ok {
+		return nil, fmt.Errorf("the Schema Model named %q was not found", input.SchemaModelName)
+	}
+
+	// Schema Model Name -> Field Names
+	writeOnlyFieldNames := make(map[string]map[string]struct{})
+	for _, mapping := range input.Details.Mappings.Fields {
+		schemaModelName := ""
+		schemaFieldName := ""
+		if v, ok := mapping.(models.TerraformODataBindFieldMappingDefinition); ok {
+			schemaModelName = v.ODataBind.TerraformSchemaModelName
+			schemaFieldName = v.ODataBind.TerraformSchemaFieldName
+		}
+
+		if v, ok := mapping.(models.TerraformDirectAssignmentFieldMappingDefinition); ok {
+			schemaModel, ok := input.SchemaModels[v.DirectAssignment.TerraformSchemaModelName]
+			if !ok {
+				continue
+			}
+			schemaField, ok := schemaModel.Fields[v.DirectAssignment.TerraformSchemaFieldName]
+			if !ok || !schemaField.Sensitive {
+				continue
+			}
+			sdkModel, ok := input.Models[v.DirectAssignment.SDKModelName]
+			if !ok {
+				return nil, fmt.Errorf("the SDK Model %q referenced in mapping was not found", v.DirectAssignment.SDKModelName)
+			}
+			sdkField, ok := sdkModel.Fields[v.DirectAssignment.SDKFieldName]
+			if !ok || !sdkField.Sensitive {
+				continue
+			}
+
+			schemaModelName = v.DirectAssignment.TerraformSchemaModelName
+			schemaFieldName = v.DirectAssignment.TerraformSchemaFieldName
+		}
+
+		if schemaModelName == "" {
+			continue
+		}
+		if _, ok := writeOnlyFieldNames[schemaModelName]; !ok {
+			writeOnlyFieldNames[schemaModelName] = make(map[string]struct{})
+		}
+		writeOnlyFieldNames[schemaModelName][schemaFieldName] = struct{}{}
+	}
+
+	output, err := writeOnlyFieldsWithinSchemaModel(input.SchemaModels, input.SchemaModelName, writeOnlyFieldNames, map[string]struct{}{})
+	if err != nil {
+		return nil, err
+	}
+	return &output, nil
+}
+
+func writeOnlyFieldsWithinSchemaModel(schemaModels map[string]models.TerraformSchemaModel, schemaModelName string, writeOnlyFieldNames map[string]map[string]struct{}, seen map[string]struct{}) ([]writeOnlySchemaField, error) {
+	output := make([]writeOnlySchemaField, 0)
+	if _, ok := seen[schemaModelName]; ok {
+		return output, nil
+	}
+	seen[schemaModelName] = struct{}{}
+	defer delete(seen, schemaModelName)
+
+	schemaModel, ok := schemaModels[schemaModelName]
+	if !ok {
+		return nil, fmt.Errorf("the Schema Model %q was not found", schemaModelName)
+	}
+
+	fieldNames := make([]string, 0)
+	for fieldName := range schemaModel.Fields {
+		fieldNames = append(fieldNames, fieldName)
+	}
+	sort.Strings(fieldNames)
+
+	for _, fieldName := range fieldNames {
+		field := schemaModel.Fields[fieldName]
+		if _, ok := writeOnlyFieldNames[schemaModelName][fieldName]; ok {
+			output = append(output, writeOnlySchemaField{
+				fieldName: fieldName,
+				hclName:   field.HCLName,
+			})
+			continue
+		}
+
+		// the items within a Set are unordered, so the existing items can't be matched up - as such only
+		// nested blocks and Lists of nested blocks are retained
+		objectDefinition := field.ObjectDefinition
+		if objectDefinition.Type == models.ListTerraformSchemaObjectDefinitionType && objectDefinition.NestedObject != nil {
+			objectDefinition = *objectDefinition.NestedObject
+		}
+		if objectDefinition.Type != models.ReferenceTerraformSchemaObjectDefinitionType || objectDefinition.ReferenceName == nil {
+			continue
+		}
+
+		nestedFields, err := writeOnlyFieldsWithinSchemaModel(schemaModels, *objectDefinition.ReferenceName, writeOnlyFieldNames, seen)
+		if err != nil {
+			return nil, err
+		}
+		if len(nestedFields) == 0 {
+			continue
+		}
+		output = append(output, writeOnlySchemaField{
+			fieldName:    fieldName,
+			hclName:      field.HCLName,
+			nestedFields: nestedFields,
+		})
+	}
+
+	return output, nil
+}
+
+// codeForRetainingWriteOnlyFields returns the lines assigning each of the write-only fields within `target`
+// from `source` - matching up the items within nested blocks by their index.
+func codeForRetainingWriteOnlyFields(fields []writeOnlySchemaField, target, source string, depth int) []string {
+	lines := make([]string, 0)
+	for _, field := range fields {
+		if len(field.nestedFields) == 0 {
+			lines = append(lines, fmt.Sprintf("%[1]s.%[3]s = %[2]s.%[3]s", target, source, field.fieldName))
+			continue
+		}
+
+		index := string(rune('i' + depth))
+		nestedTarget := fmt.Sprintf("%s.%s[%s]", target, field.fieldName, index)
+		nestedSource := fmt.Sprintf("%s.%s[%s]", source, field.fieldName, index)
+		nestedLines := codeForRetainingWriteOnlyFields(field.nestedFields, nestedTarget, nestedSource, depth+1)
+		lines = append(lines, fmt.Sprintf(`for %[4]s := range %[1]s.%[3]s {
+	if %[4]s >= len(%[2]s.%[3]s) {
+		break
+	}
+	%[5]s
+}`, target, source, field.fieldName, index, strings.Join(nestedLines, "\n")))
+	}
+	return lines
+}
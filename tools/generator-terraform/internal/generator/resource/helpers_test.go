@@ -4,8 +4,12 @@
 package resource
 
 import (
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	generatorModels "github.com/hashicorp/pandora/tools/generator-terraform/internal/generator/models"
 	"github.com/hashicorp/pandora/tools/sdk/testhelpers"
 )
 
@@ -172,3 +176,120 @@ resource "example_test" "test" {
 	testhelpers.AssertTemplatedCodeMatches(t, expectedConfig, *actualConfig)
 	testhelpers.AssertTemplatedCodeMatches(t, expectedVariables, *actualVariables)
 }
+
+func resourceInputWithNestedWriteOnlyFields() generatorModels.ResourceInput {
+	return generatorModels.ResourceInput{
+		Details: models.TerraformResourceDefinition{
+			Mappings: models.TerraformMappingDefinition{
+				Fields: []models.TerraformFieldMappingDefinition{
+					models.TerraformDirectAssignmentFieldMappingDefinition{
+						DirectAssignment: models.TerraformDirectAssignmentFieldMappingDefinitionImpl{
+							SDKFieldName:             "Password",
+							SDKModelName:             "SdkSettings",
+							TerraformSchemaFieldName: "Password",
+							TerraformSchemaModelName: "ExampleSettings",
+						},
+					},
+					models.TerraformODataBindFieldMappingDefinition{
+						ODataBind: models.TerraformODataBindFieldMappingDefinitionImpl{
+							SDKFieldName:             "OwnersODataBind",
+							SDKModelName:             "SdkMember",
+							TerraformSchemaFieldName: "Owners",
+							TerraformSchemaModelName: "ExampleMember",
+						},
+					},
+				},
+			},
+		},
+		Models: map[string]models.SDKModel{
+			"SdkSettings": {
+				Fields: map[string]models.SDKField{
+					"Password": {
+						JsonName: "password",
+						ObjectDefinition: models.SDKObjectDefinition{
+							Type: models.StringSDKObjectDefinitionType,
+						},
+						Sensitive: true,
+					},
+				},
+			},
+		},
+		SchemaModelName: "ExampleModel",
+		SchemaModels: map[string]models.TerraformSchemaModel{
+			"ExampleModel": {
+				Fields: map[string]models.TerraformSchemaField{
+					"Name": {
+						HCLName: "name",
+						ObjectDefinition: models.TerraformSchemaObjectDefinition{
+							Type: models.StringTerraformSchemaObjectDefinitionType,
+						},
+					},
+					"Settings": {
+						HCLName: "settings",
+						ObjectDefinition: models.TerraformSchemaObjectDefinition{
+							Type:          models.ReferenceTerraformSchemaObjectDefinitionType,
+							ReferenceName: pointer.To("ExampleSettings"),
+						},
+					},
+				},
+			},
+			"ExampleSettings": {
+				Fields: map[string]models.TerraformSchemaField{
+					"Members": {
+						HCLName: "members",
+						ObjectDefinition: models.TerraformSchemaObjectDefinition{
+							Type: models.ListTerraformSchemaObjectDefinitionType,
+							NestedObject: &models.TerraformSchemaObjectDefinition{
+								Type:          models.ReferenceTerraformSchemaObjectDefinitionType,
+								ReferenceName: pointer.To("ExampleMember"),
+							},
+						},
+					},
+					"Password": {
+						HCLName: "password",
+						ObjectDefinition: models.TerraformSchemaObjectDefinition{
+							Type: models.StringTerraformSchemaObjectDefinitionType,
+						},
+						Sensitive: true,
+					},
+				},
+			},
+			"ExampleMember": {
+				Fields: map[string]models.TerraformSchemaField{
+					"Owners": {
+						HCLName: "owners",
+						ObjectDefinition: models.TerraformSchemaObjectDefinition{
+							Type: models.ListTerraformSchemaObjectDefinitionType,
+							NestedObject: &models.TerraformSchemaObjectDefinition{
+								Type: models.StringTerraformSchemaObjectDefinitionType,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestCodeForRetainingWriteOnlyFields_NestedModels(t *testing.T) {
+	fields, err := writeOnlySchemaFieldsForResource(resourceInputWithNestedWriteOnlyFields())
+	if err != nil {
+		t.Fatalf("error: %+v", err)
+	}
+	actual := strings.Join(codeForRetainingWriteOnlyFields(*fields, "schema", "existing", 0), "\n")
+	expected := `
+for i := range schema.Settings {
+	if i >= len(existing.Settings) {
+		break
+	}
+	for j := range schema.Settings[i].Members {
+		if j >= len(existing.Settings[i].Members) {
+			break
+		}
+		schema.Settings[i].Members[j].Owners = existing.Settings[i].Members[j].Owners
+	}
+	schema.Settings[i].Password = existing.Settings[i].Password
+}
+`
+	testhelpers.AssertTemplatedCodeMatches(t, expected, actual)
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"os"
 
+	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
 	"github.com/hashicorp/pandora/tools/generator-terraform/internal/generator/models"
 	"github.com/hashicorp/pandora/tools/generator-terraform/internal/generator/resource/docs"
 )
@@ -16,21 +17,43 @@ func Resource(input models.ResourceInput) error {
 	serviceDirectory := fmt.Sprintf("%s/internal/services/%s", input.RootDirectory, input.ServicePackageName)
 	os.MkdirAll(serviceDirectory, 0755)
 
+	// determine the Schema Version, generating a State Upgrader when the Schema has changed incompatibly
+	schemaVersion, err := schemaVersionForResource(input)
+	if err != nil {
+		return fmt.Errorf("determining the Schema Version: %+v", err)
+	}
+	input.SchemaVersion = *schemaVersion
+
 	// Generate the Resource
 	resourceFilePath := fmt.Sprintf("%s/%s_resource_gen.go", serviceDirectory, input.ResourceLabel)
 	os.Remove(resourceFilePath)
-	resourceCode, err := codeForResource(input)
+	codeForResourceFunc := codeForResource
+	if input.Framework == sdkModels.PluginFrameworkTerraformFrameworkType {
+		codeForResourceFunc = codeForFrameworkResource
+	}
+	resourceCode, err := codeForResourceFunc(input)
 	if err != nil {
 		return fmt.Errorf("building code for resource: %+v", err)
 	}
-	writeToPath(resourceFilePath, *resourceCode)
+	if err := writeToPath(resourceFilePath, *resourceCode); err != nil {
+		return fmt.Errorf("writing to %q: %+v", resourceFilePath, err)
+	}
 
 	// then generate the Tests
 	testFilePath := fmt.Sprintf("%s/%s_resource_gen_test.go", serviceDirectory, input.ResourceLabel)
 	// remove the file if it already exists
 	os.Remove(testFilePath)
-	testFileContents, err := componentsForResourceTest(input)
-	writeToPath(testFilePath, *testFileContents)
+	// the acceptance test helpers only exist for Resource Manager at this time, so the test file is omitted entirely
+	// for Microsoft Graph Resources when Tests aren't being generated
+	if input.Details.Tests.Generate || input.SourceDataOrigin != sdkModels.MicrosoftGraphMetaDataSourceDataOrigin {
+		testFileContents, err := componentsForResourceTest(input)
+		if err != nil {
+			return fmt.Errorf("building code for resource tests: %+v", err)
+		}
+		if err := writeToPath(testFilePath, *testFileContents); err != nil {
+			return fmt.Errorf("writing to %q: %+v", testFilePath, err)
+		}
+	}
 
 	// then generate the documentation
 	websiteResourcesDirectory := fmt.Sprintf("%s/website/docs/r/", input.RootDirectory)
@@ -41,7 +64,9 @@ func Resource(input models.ResourceInput) error {
 	if err != nil {
 		return fmt.Errorf("building documentation for resource: %+v", err)
 	}
-	writeToPath(documentationFilePath, *documentationForResource)
+	if err := writeToPath(documentationFilePath, *documentationForResource); err != nil {
+		return fmt.Errorf("writing to %q: %+v", documentationFilePath, err)
+	}
 
 	return nil
 }
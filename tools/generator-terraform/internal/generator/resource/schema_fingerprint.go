@@ -0,0 +1,275 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	generatorModels "github.com/hashicorp/pandora/tools/generator-terraform/internal/generator/models"
+)
+
+// schemaFingerprint is a summary of the Schema for a generated Resource, which is output alongside the Resource
+// so that changes to the Schema can be detected (and State Upgraders generated) when the Resource is regenerated.
+type schemaFingerprint struct {
+	// SchemaVersion is the Schema Version of the Resource when this fingerprint was recorded.
+	SchemaVersion int `json:"schemaVersion"`
+
+	// Hash is a SHA256 hash of the Fields within this fingerprint.
+	Hash string `json:"hash"`
+
+	// Fields is a map of HCL Name (key) to the fingerprint for the top-level Schema Field (value).
+	Fields map[string]fieldFingerprint `json:"fields"`
+}
+
+type fieldFingerprint struct {
+	// ObjectDefinition describes the type of this Schema Field.
+	ObjectDefinition objectDefinitionFingerprint `json:"objectDefinition"`
+
+	Computed bool `json:"computed,omitempty"`
+	Optional bool `json:"optional,omitempty"`
+	Required bool `json:"required,omitempty"`
+
+	// MappedFrom is the source for the value of this Schema Field - either `{SdkModelName}.{SdkFieldName}`
+	// or `ResourceId.{segmentName}` - which allows renamed fields to be identified.
+	MappedFrom *string `json:"mappedFrom,omitempty"`
+}
+
+type objectDefinitionFingerprint struct {
+	Type models.TerraformSchemaObjectDefinitionType `json:"type"`
+
+	// NestedObject is the fingerprint for the nested item, when this is a collection (e.g. a List).
+	NestedObject *objectDefinitionFingerprint `json:"nestedObject,omitempty"`
+
+	// Fields is a map of HCL Name (key) to the fingerprint for the Schema Field (value) when this is a Reference.
+	Fields map[string]fieldFingerprint `json:"fields,omitempty"`
+}
+
+// schemaChanges describes the changes between two schemaFingerprints.
+type schemaChanges struct {
+	// incompatibleChanges is a list of human-readable descriptions of the changes which require a State Upgrader.
+	incompatibleChanges []string
+
+	// renamedFields is a map of the previous HCL Name (key) to the new HCL Name (value) for top-level fields
+	// which have been renamed, for example via an `override` block within the Resource Definition.
+	renamedFields map[string]string
+}
+
+func schemaFingerprintFilePath(input generatorModels.ResourceInput) string {
+	return fmt.Sprintf("%s/internal/services/%s/%s_resource_gen_schema.json", input.RootDirectory, input.ServicePackageName, input.ResourceLabel)
+}
+
+// buildSchemaFingerprint builds the schemaFingerprint for the top-level Schema Model for this Resource.
+func buildSchemaFingerprint(input generatorModels.ResourceInput, schemaVersion int) (*schemaFingerprint, error) {
+	fields, err := fingerprintForSchemaModel(input, input.SchemaModelName, map[string]struct{}{})
+	if err != nil {
+		return nil, fmt.Errorf("building fingerprint for Schema Model %q: %+v", input.SchemaModelName, err)
+	}
+
+	hash, err := hashForFieldFingerprints(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	return &schemaFingerprint{
+		SchemaVersion: schemaVersion,
+		Hash:          *hash,
+		Fields:        fields,
+	}, nil
+}
+
+func fingerprintForSchemaModel(input generatorModels.ResourceInput, schemaModelName string, seen map[string]struct{}) (map[string]fieldFingerprint, error) {
+	schemaModel, ok := input.SchemaModels[schemaModelName]
+	if !ok {
+		return nil, fmt.Errorf("the Schema Model %q was not found", schemaModelName)
+	}
+	seen[schemaModelName] = struct{}{}
+	defer delete(seen, schemaModelName)
+
+	output := make(map[string]fieldFingerprint)
+	for fieldName, field := range schemaModel.Fields {
+		objectDefinition, err := fingerprintForObjectDefinition(input, field.ObjectDefinition, seen)
+		if err != nil {
+			return nil, fmt.Errorf("building fingerprint for Field %q: %+v", fieldName, err)
+		}
+
+		output[field.HCLName] = fieldFingerprint{
+			ObjectDefinition: *objectDefinition,
+			Computed:         field.Computed,
+			Optional:         field.Optional,
+			Required:         field.Required,
+			MappedFrom:       mappedFromForSchemaField(input.Details.Mappings, schemaModelName, fieldName),
+		}
+	}
+	return output, nil
+}
+
+func fingerprintForObjectDefinition(input generatorModels.ResourceInput, objectDefinition models.TerraformSchemaObjectDefinition, seen map[string]struct{}) (*objectDefinitionFingerprint, error) {
+	output := objectDefinitionFingerprint{
+		Type: objectDefinition.Type,
+	}
+
+	if objectDefinition.NestedObject != nil {
+		nested, err := fingerprintForObjectDefinition(input, *objectDefinition.NestedObject, seen)
+		if err != nil {
+			return nil, fmt.Errorf("building fingerprint for Nested Object: %+v", err)
+		}
+		output.NestedObject = nested
+	}
+
+	if objectDefinition.Type == models.ReferenceTerraformSchemaObjectDefinitionType && objectDefinition.ReferenceName != nil {
+		// self-referential models can't be output into the Schema, so there's nothing further to record
+		if _, ok := seen[*objectDefinition.ReferenceName]; ok {
+			return &output, nil
+		}
+
+		fields, err := fingerprintForSchemaModel(input, *objectDefinition.ReferenceName, seen)
+		if err != nil {
+			return nil, err
+		}
+		output.Fields = fields
+	}
+
+	return &output, nil
+}
+
+// mappedFromForSchemaField returns the source of the value for the specified Schema Field, using the
+// first (sorted) DirectAssignment/Resource ID mapping for this field.
+func mappedFromForSchemaField(mappings models.TerraformMappingDefinition, schemaModelName, schemaFieldName string) *string {
+	sources := make([]string, 0)
+	for _, item := range mappings.Fields {
+		v, ok := item.(models.TerraformDirectAssignmentFieldMappingDefinition)
+		if !ok || v.DirectAssignment.TerraformSchemaModelName != schemaModelName || v.DirectAssignment.TerraformSchemaFieldName != schemaFieldName {
+			continue
+		}
+		sources = append(sources, fmt.Sprintf("%s.%s", v.DirectAssignment.SDKModelName, v.DirectAssignment.SDKFieldName))
+	}
+	for _, item := range mappings.ResourceID {
+		if item.TerraformSchemaFieldName == schemaFieldName {
+			sources = append(sources, fmt.Sprintf("ResourceId.%s", item.SegmentName))
+		}
+	}
+
+	if len(sources) == 0 {
+		return nil
+	}
+	sort.Strings(sources)
+	return &sources[0]
+}
+
+func hashForFieldFingerprints(input map[string]fieldFingerprint) (*string, error) {
+	// NOTE: maps are marshalled with sorted keys, so this is stable
+	data, err := json.Marshal(input)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling the field fingerprints: %+v", err)
+	}
+	hash := fmt.Sprintf("%x", sha256.Sum256(data))
+	return &hash, nil
+}
+
+// loadSchemaFingerprint loads the schemaFingerprint from the specified file path, returning nil if it doesn't exist.
+func loadSchemaFingerprint(filePath string) (*schemaFingerprint, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("reading %q: %+v", filePath, err)
+	}
+
+	var output schemaFingerprint
+	if err := json.Unmarshal(data, &output); err != nil {
+		return nil, fmt.Errorf("unmarshalling %q: %+v", filePath, err)
+	}
+	return &output, nil
+}
+
+func saveSchemaFingerprint(filePath string, input schemaFingerprint) error {
+	data, err := json.MarshalIndent(input, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshalling the schema fingerprint: %+v", err)
+	}
+	if err := os.WriteFile(filePath, append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("writing %q: %+v", filePath, err)
+	}
+	return nil
+}
+
+// compareSchemaFingerprints returns the changes between the previous and current Schema Fingerprints.
+// Fields which are added, removed or whose Required/Optional/Computed values change can be handled by Terraform
+// without a State Upgrader - however renamed fields and fields whose type changes can't be.
+func compareSchemaFingerprints(previous, current schemaFingerprint) schemaChanges {
+	output := schemaChanges{
+		incompatibleChanges: make([]string, 0),
+		renamedFields:       make(map[string]string),
+	}
+
+	// renamed fields are those which have been removed, where a new field is mapped from the same source
+	newFieldsBySource := make(map[string]string)
+	for hclName, field := range current.Fields {
+		if _, existed := previous.Fields[hclName]; existed || field.MappedFrom == nil {
+			continue
+		}
+		newFieldsBySource[*field.MappedFrom] = hclName
+	}
+
+	previousNames := make([]string, 0)
+	for hclName := range previous.Fields {
+		previousNames = append(previousNames, hclName)
+	}
+	sort.Strings(previousNames)
+
+	for _, hclName := range previousNames {
+		previousField := previous.Fields[hclName]
+		currentField, ok := current.Fields[hclName]
+		if !ok {
+			if previousField.MappedFrom == nil {
+				continue
+			}
+			newName, renamed := newFieldsBySource[*previousField.MappedFrom]
+			if !renamed {
+				continue
+			}
+			output.renamedFields[hclName] = newName
+			output.incompatibleChanges = append(output.incompatibleChanges, fmt.Sprintf("the field `%s` has been renamed to `%s`", hclName, newName))
+			currentField = current.Fields[newName]
+		}
+
+		output.incompatibleChanges = append(output.incompatibleChanges, incompatibleChangesForObjectDefinition(hclName, previousField.ObjectDefinition, currentField.ObjectDefinition)...)
+	}
+
+	return output
+}
+
+func incompatibleChangesForObjectDefinition(path string, previous, current objectDefinitionFingerprint) []string {
+	if previous.Type != current.Type {
+		return []string{
+			fmt.Sprintf("the type of the field `%s` has changed from %q to %q", path, string(previous.Type), string(current.Type)),
+		}
+	}
+
+	output := make([]string, 0)
+	if previous.NestedObject != nil && current.NestedObject != nil {
+		output = append(output, incompatibleChangesForObjectDefinition(path, *previous.NestedObject, *current.NestedObject)...)
+	}
+
+	nestedNames := make([]string, 0)
+	for hclName := range previous.Fields {
+		nestedNames = append(nestedNames, hclName)
+	}
+	sort.Strings(nestedNames)
+	for _, hclName := range nestedNames {
+		currentField, ok := current.Fields[hclName]
+		if !ok {
+			continue
+		}
+		nestedPath := fmt.Sprintf("%s.%s", path, hclName)
+		output = append(output, incompatibleChangesForObjectDefinition(nestedPath, previous.Fields[hclName].ObjectDefinition, currentField.ObjectDefinition)...)
+	}
+	return output
+}
@@ -0,0 +1,231 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	generatorModels "github.com/hashicorp/pandora/tools/generator-terraform/internal/generator/models"
+)
+
+func schemaFingerprintInputForTesting() generatorModels.ResourceInput {
+	return generatorModels.ResourceInput{
+		Details: models.TerraformResourceDefinition{
+			DisplayName: "Example Resource",
+			Mappings: models.TerraformMappingDefinition{
+				Fields: []models.TerraformFieldMappingDefinition{
+					models.TerraformDirectAssignmentFieldMappingDefinition{
+						DirectAssignment: models.TerraformDirectAssignmentFieldMappingDefinitionImpl{
+							SDKFieldName:             "Capacity",
+							SDKModelName:             "ExampleProperties",
+							TerraformSchemaFieldName: "Capacity",
+							TerraformSchemaModelName: "ExampleResource",
+						},
+					},
+					models.TerraformDirectAssignmentFieldMappingDefinition{
+						DirectAssignment: models.TerraformDirectAssignmentFieldMappingDefinitionImpl{
+							SDKFieldName:             "Enabled",
+							SDKModelName:             "ExampleSettings",
+							TerraformSchemaFieldName: "Enabled",
+							TerraformSchemaModelName: "ExampleResourceSettings",
+						},
+					},
+				},
+				ResourceID: []models.TerraformResourceIDMappingDefinition{
+					{
+						SegmentName:              "exampleName",
+						TerraformSchemaFieldName: "Name",
+					},
+				},
+			},
+		},
+		ProviderPrefix:  "azurerm",
+		ResourceLabel:   "example",
+		SchemaModelName: "ExampleResource",
+		SchemaModels: map[string]models.TerraformSchemaModel{
+			"ExampleResource": {
+				Fields: map[string]models.TerraformSchemaField{
+					"Capacity": {
+						HCLName: "capacity",
+						ObjectDefinition: models.TerraformSchemaObjectDefinition{
+							Type: models.IntegerTerraformSchemaObjectDefinitionType,
+						},
+						Optional: true,
+					},
+					"Name": {
+						ForceNew: true,
+						HCLName:  "name",
+						ObjectDefinition: models.TerraformSchemaObjectDefinition{
+							Type: models.StringTerraformSchemaObjectDefinitionType,
+						},
+						Required: true,
+					},
+					"Settings": {
+						HCLName: "settings",
+						ObjectDefinition: models.TerraformSchemaObjectDefinition{
+							Type:          models.ReferenceTerraformSchemaObjectDefinitionType,
+							ReferenceName: pointer.To("ExampleResourceSettings"),
+						},
+						Optional: true,
+					},
+				},
+			},
+			"ExampleResourceSettings": {
+				Fields: map[string]models.TerraformSchemaField{
+					"Enabled": {
+						HCLName: "enabled",
+						ObjectDefinition: models.TerraformSchemaObjectDefinition{
+							Type: models.BooleanTerraformSchemaObjectDefinitionType,
+						},
+						Optional: true,
+					},
+				},
+			},
+		},
+		ServicePackageName: "example",
+		ResourceTypeName:   "Example",
+	}
+}
+
+func TestBuildSchemaFingerprint(t *testing.T) {
+	actual, err := buildSchemaFingerprint(schemaFingerprintInputForTesting(), 2)
+	if err != nil {
+		t.Fatalf("building fingerprint: %+v", err)
+	}
+
+	expected := map[string]fieldFingerprint{
+		"capacity": {
+			ObjectDefinition: objectDefinitionFingerprint{
+				Type: models.IntegerTerraformSchemaObjectDefinitionType,
+			},
+			Optional:   true,
+			MappedFrom: pointer.To("ExampleProperties.Capacity"),
+		},
+		"name": {
+			ObjectDefinition: objectDefinitionFingerprint{
+				Type: models.StringTerraformSchemaObjectDefinitionType,
+			},
+			Required:   true,
+			MappedFrom: pointer.To("ResourceId.exampleName"),
+		},
+		"settings": {
+			ObjectDefinition: objectDefinitionFingerprint{
+				Type: models.ReferenceTerraformSchemaObjectDefinitionType,
+				Fields: map[string]fieldFingerprint{
+					"enabled": {
+						ObjectDefinition: objectDefinitionFingerprint{
+							Type: models.BooleanTerraformSchemaObjectDefinitionType,
+						},
+						Optional:   true,
+						MappedFrom: pointer.To("ExampleSettings.Enabled"),
+					},
+				},
+			},
+			Optional: true,
+		},
+	}
+	if actual.SchemaVersion != 2 {
+		t.Fatalf("expected the SchemaVersion to be 2 but got %d", actual.SchemaVersion)
+	}
+	if !reflect.DeepEqual(expected, actual.Fields) {
+		t.Fatalf("expected %+v but got %+v", expected, actual.Fields)
+	}
+
+	// the hash should be stable
+	second, err := buildSchemaFingerprint(schemaFingerprintInputForTesting(), 2)
+	if err != nil {
+		t.Fatalf("building fingerprint: %+v", err)
+	}
+	if actual.Hash != second.Hash {
+		t.Fatalf("expected the hash to be stable but got %q and %q", actual.Hash, second.Hash)
+	}
+}
+
+func TestCompareSchemaFingerprints(t *testing.T) {
+	testData := []struct {
+		name                  string
+		update                func(input *generatorModels.ResourceInput)
+		expectedChanges       []string
+		expectedRenamedFields map[string]string
+	}{
+		{
+			name:                  "No Changes",
+			update:                func(input *generatorModels.ResourceInput) {},
+			expectedChanges:       []string{},
+			expectedRenamedFields: map[string]string{},
+		},
+		{
+			name: "Field Added and Required changed",
+			update: func(input *generatorModels.ResourceInput) {
+				model := input.SchemaModels["ExampleResource"]
+				model.Fields["Tags"] = models.TerraformSchemaField{
+					HCLName: "tags",
+					ObjectDefinition: models.TerraformSchemaObjectDefinition{
+						Type: models.TagsTerraformSchemaObjectDefinitionType,
+					},
+					Optional: true,
+				}
+				capacity := model.Fields["Capacity"]
+				capacity.Optional = false
+				capacity.Required = true
+				model.Fields["Capacity"] = capacity
+			},
+			expectedChanges:       []string{},
+			expectedRenamedFields: map[string]string{},
+		},
+		{
+			name: "Field Renamed",
+			update: func(input *generatorModels.ResourceInput) {
+				model := input.SchemaModels["ExampleResource"]
+				capacity := model.Fields["Capacity"]
+				capacity.HCLName = "instance_count"
+				model.Fields["Capacity"] = capacity
+			},
+			expectedChanges: []string{
+				"the field `capacity` has been renamed to `instance_count`",
+			},
+			expectedRenamedFields: map[string]string{
+				"capacity": "instance_count",
+			},
+		},
+		{
+			name: "Nested Field Type Changed",
+			update: func(input *generatorModels.ResourceInput) {
+				model := input.SchemaModels["ExampleResourceSettings"]
+				enabled := model.Fields["Enabled"]
+				enabled.ObjectDefinition.Type = models.StringTerraformSchemaObjectDefinitionType
+				model.Fields["Enabled"] = enabled
+			},
+			expectedChanges: []string{
+				"the type of the field `settings.enabled` has changed from \"Boolean\" to \"String\"",
+			},
+			expectedRenamedFields: map[string]string{},
+		},
+	}
+	for _, v := range testData {
+		t.Logf("Test %q", v.name)
+
+		previous, err := buildSchemaFingerprint(schemaFingerprintInputForTesting(), 0)
+		if err != nil {
+			t.Fatalf("building previous fingerprint: %+v", err)
+		}
+		input := schemaFingerprintInputForTesting()
+		v.update(&input)
+		current, err := buildSchemaFingerprint(input, 0)
+		if err != nil {
+			t.Fatalf("building current fingerprint: %+v", err)
+		}
+
+		actual := compareSchemaFingerprints(*previous, *current)
+		if !reflect.DeepEqual(v.expectedChanges, actual.incompatibleChanges) {
+			t.Fatalf("expected the incompatible changes to be %+v but got %+v", v.expectedChanges, actual.incompatibleChanges)
+		}
+		if !reflect.DeepEqual(v.expectedRenamedFields, actual.renamedFields) {
+			t.Fatalf("expected the renamed fields to be %+v but got %+v", v.expectedRenamedFields, actual.renamedFields)
+		}
+	}
+}
@@ -0,0 +1,379 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	generatorModels "github.com/hashicorp/pandora/tools/generator-terraform/internal/generator/models"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// testConfigurationValues is a map of State Key (e.g. `sku.0.name`) to the literal value for that key within
+// the Resource being tested in a Test Configuration.
+type testConfigurationValues map[string]string
+
+// valuesForTestConfiguration returns the literal values set for the Resource `resourceType` within the Test
+// Configuration `config`. Values which reference other Resources or Variables aren't known until apply-time
+// and are therefore not included - nor are values within blocks which are Sets, since the State Key for an
+// item within a Set isn't its index.
+func valuesForTestConfiguration(config string, resourceType string, schemaModels map[string]models.TerraformSchemaModel, schemaModelName string) (testConfigurationValues, error) {
+	file, diags := hclsyntax.ParseConfig([]byte(config), "config.hcl", hcl.InitialPos)
+	if diags.HasErrors() {
+		return nil, fmt.Errorf("parsing the Test Configuration: %+v", diags.Error())
+	}
+	body, ok := file.Body.(*hclsyntax.Body)
+	if !ok {
+		return nil, fmt.Errorf("internal-error: expected a hclsyntax.Body but got %T", file.Body)
+	}
+
+	output := testConfigurationValues{}
+	for _, block := range body.Blocks {
+		if block.Type != "resource" || len(block.Labels) != 2 || block.Labels[0] != resourceType || block.Labels[1] != "test" {
+			continue
+		}
+		valuesForTestConfigurationBody(block.Body, "", schemaModels, schemaModelName, output)
+	}
+	return output, nil
+}
+
+func valuesForTestConfigurationBody(body *hclsyntax.Body, prefix string, schemaModels map[string]models.TerraformSchemaModel, schemaModelName string, output testConfigurationValues) {
+	for name, attribute := range body.Attributes {
+		value, diags := attribute.Expr.Value(nil)
+		if diags.HasErrors() || !value.IsWhollyKnown() || value.IsNull() {
+			continue
+		}
+		valuesForTestConfigurationValue(fmt.Sprintf("%s%s", prefix, name), value, output)
+	}
+
+	blockIndexes := make(map[string]int)
+	for _, block := range body.Blocks {
+		index := blockIndexes[block.Type]
+		blockIndexes[block.Type] = index + 1
+		nestedSchemaModelName := nestedSchemaModelNameForTestConfigurationBlock(schemaModels, schemaModelName, block.Type)
+		if nestedSchemaModelName == nil {
+			// only the number of items can be checked
+			continue
+		}
+		valuesForTestConfigurationBody(block.Body, fmt.Sprintf("%s%s.%d.", prefix, block.Type, index), schemaModels, *nestedSchemaModelName, output)
+	}
+	for blockType, count := range blockIndexes {
+		output[fmt.Sprintf("%s%s.#", prefix, blockType)] = fmt.Sprintf("%d", count)
+	}
+}
+
+// nestedSchemaModelNameForTestConfigurationBlock returns the name of the Schema Model for the block `hclName` within
+// the Schema Model `schemaModelName` - or nil when the items within this block can't be referenced by their index,
+// either since the block is a Set (where the State Key for an item is a hash of its value) or isn't in the Schema.
+func nestedSchemaModelNameForTestConfigurationBlock(schemaModels map[string]models.TerraformSchemaModel, schemaModelName string, hclName string) *string {
+	schemaModel, ok := schemaModels[schemaModelName]
+	if !ok {
+		return nil
+	}
+	for _, field := range schemaModel.Fields {
+		if field.HCLName != hclName {
+			continue
+		}
+
+		objectDefinition := field.ObjectDefinition
+		if objectDefinition.Type == models.ListTerraformSchemaObjectDefinitionType && objectDefinition.NestedObject != nil {
+			objectDefinition = *objectDefinition.NestedObject
+		}
+		if objectDefinition.Type == models.ReferenceTerraformSchemaObjectDefinitionType {
+			return objectDefinition.ReferenceName
+		}
+		return nil
+	}
+	return nil
+}
+
+func valuesForTestConfigurationValue(key string, value cty.Value, output testConfigurationValues) {
+	valueType := value.Type()
+	switch {
+	case valueType == cty.String:
+		output[key] = value.AsString()
+
+	case valueType == cty.Bool:
+		output[key] = fmt.Sprintf("%t", value.True())
+
+	case valueType == cty.Number:
+		output[key] = value.AsBigFloat().Text('f', -1)
+
+	case valueType.IsObjectType() || valueType.IsMapType():
+		// e.g. `tags = { ... }` - only maps of primitive values can be represented in a flat-map
+		count := 0
+		for it := value.ElementIterator(); it.Next(); {
+			k, v := it.Element()
+			count++
+			if !v.Type().IsPrimitiveType() {
+				continue
+			}
+			valuesForTestConfigurationValue(fmt.Sprintf("%s.%s", key, k.AsString()), v, output)
+		}
+		output[fmt.Sprintf("%s.%%", key)] = fmt.Sprintf("%d", count)
+
+	case valueType.IsTupleType() || valueType.IsListType() || valueType.IsSetType():
+		// the ordering of Sets isn't guaranteed, so only the number of items is checked
+		output[fmt.Sprintf("%s.#", key)] = fmt.Sprintf("%d", value.LengthInt())
+	}
+}
+
+// codeForTestChecks returns the Check functions used to assert the values for the Resource within a Test Step.
+func codeForTestChecks(values testConfigurationValues) string {
+	keys := make([]string, 0)
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	lines := []string{
+		"check.That(data.ResourceName).ExistsInAzure(r),",
+	}
+	for _, key := range keys {
+		lines = append(lines, fmt.Sprintf("check.That(data.ResourceName).Key(%q).HasValue(%q),", key, values[key]))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// codeForTestImportStep returns the Import Step used within an Acceptance Test, ignoring any fields which
+// aren't returned from the API (and thus can't be imported).
+func codeForTestImportStep(ignoredFields []string) string {
+	quoted := make([]string, 0)
+	for _, field := range ignoredFields {
+		quoted = append(quoted, fmt.Sprintf("%q", field))
+	}
+	return fmt.Sprintf("data.ImportStep(%s),", strings.Join(quoted, ", "))
+}
+
+// importStepIgnoredFieldsForResource returns the (sorted) State Keys for the Sensitive and write-only fields within
+// this Resource, which can't be verified during an import since these aren't returned from the API.
+func importStepIgnoredFieldsForResource(input generatorModels.ResourceInput) ([]string, error) {
+	paths := make([]string, 0)
+	if err := sensitiveFieldPathsWithinSchemaModel(input.SchemaModels, input.SchemaModelName, "", map[string]struct{}{}, &paths); err != nil {
+		return nil, err
+	}
+	writeOnlyFields, err := writeOnlySchemaFieldsForResource(input)
+	if err != nil {
+		return nil, fmt.Errorf("determining the write-only fields: %+v", err)
+	}
+	writeOnlyFieldPaths(*writeOnlyFields, "", &paths)
+
+	// a Sensitive field can also be write-only, so these are de-duplicated
+	uniquePaths := make(map[string]struct{})
+	output := make([]string, 0)
+	for _, path := range paths {
+		if _, ok := uniquePaths[path]; ok {
+			continue
+		}
+		uniquePaths[path] = struct{}{}
+		output = append(output, path)
+	}
+	sort.Strings(output)
+	return output, nil
+}
+
+func writeOnlyFieldPaths(fields []writeOnlySchemaField, prefix string, output *[]string) {
+	for _, field := range fields {
+		if len(field.nestedFields) == 0 {
+			*output = append(*output, fmt.Sprintf("%s%s", prefix, field.hclName))
+			continue
+		}
+
+		writeOnlyFieldPaths(field.nestedFields, fmt.Sprintf("%s%s.0.", prefix, field.hclName), output)
+	}
+}
+
+func sensitiveFieldPathsWithinSchemaModel(schemaModels map[string]models.TerraformSchemaModel, schemaModelName, prefix string, seen map[string]struct{}, output *[]string) error {
+	if _, ok := seen[schemaModelName]; ok {
+		return nil
+	}
+	seen[schemaModelName] = struct{}{}
+	defer delete(seen, schemaModelName)
+
+	schemaModel, ok := schemaModels[schemaModelName]
+	if !ok {
+		return fmt.Errorf("the Schema Model %q was not found", schemaModelName)
+	}
+
+	for _, field := range schemaModel.Fields {
+		if field.Sensitive {
+			*output = append(*output, fmt.Sprintf("%s%s", prefix, field.HCLName))
+			continue
+		}
+
+		nestedSchemaModelName := nestedSchemaModelNameForField(field)
+		if nestedSchemaModelName == nil {
+			continue
+		}
+		nestedPrefix := fmt.Sprintf("%s%s.0.", prefix, field.HCLName)
+		if err := sensitiveFieldPathsWithinSchemaModel(schemaModels, *nestedSchemaModelName, nestedPrefix, seen, output); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateTestConfigurationForResource returns a Test Configuration based on the Complete Test Configuration, where the
+// ForceNew fields (including those within nested blocks) use the values from the Basic Test Configuration - meaning
+// that moving between the Basic and this Test Configuration updates the Resource in-place, rather than recreating it.
+//
+// nil is returned when the Complete Test Configuration already only differs from the Basic Test Configuration
+// in fields which can be updated in-place.
+func updateTestConfigurationForResource(input generatorModels.ResourceInput) (*string, error) {
+	if input.Details.Tests.CompleteConfiguration == nil {
+		return nil, nil
+	}
+	resourceType := fmt.Sprintf("%s_%s", input.ProviderPrefix, input.ResourceLabel)
+
+	basic, diags := hclwrite.ParseConfig([]byte(input.Details.Tests.BasicConfiguration), "basic.hcl", hcl.InitialPos)
+	if diags.HasErrors() {
+		return nil, fmt.Errorf("parsing the Basic Test Configuration: %+v", diags.Error())
+	}
+	complete, diags := hclwrite.ParseConfig([]byte(*input.Details.Tests.CompleteConfiguration), "complete.hcl", hcl.InitialPos)
+	if diags.HasErrors() {
+		return nil, fmt.Errorf("parsing the Complete Test Configuration: %+v", diags.Error())
+	}
+	basicBlock := basic.Body().FirstMatchingBlock("resource", []string{resourceType, "test"})
+	completeBlock := complete.Body().FirstMatchingBlock("resource", []string{resourceType, "test"})
+	if basicBlock == nil || completeBlock == nil {
+		return nil, fmt.Errorf("the Resource %q was not found in both the Basic and Complete Test Configurations", resourceType)
+	}
+
+	changed, err := useBasicValuesForForceNewFields(input.SchemaModels, input.SchemaModelName, basicBlock.Body(), completeBlock.Body())
+	if err != nil {
+		return nil, err
+	}
+	if !changed {
+		return nil, nil
+	}
+
+	output := strings.TrimSpace(string(hclwrite.Format(complete.Bytes())))
+	return &output, nil
+}
+
+// useBasicValuesForForceNewFields replaces the value for each ForceNew field within `completeBody` with the value from
+// `basicBody`, recursing into nested blocks (which are matched up by their index) - returning whether any changes
+// were made. Nested blocks which only exist within `completeBody` and contain ForceNew fields are removed, since
+// adding these would recreate the Resource.
+func useBasicValuesForForceNewFields(schemaModels map[string]models.TerraformSchemaModel, schemaModelName string, basicBody, completeBody *hclwrite.Body) (bool, error) {
+	schemaModel, ok := schemaModels[schemaModelName]
+	if !ok {
+		return false, fmt.Errorf("the Schema Model %q was not found", schemaModelName)
+	}
+
+	fieldNames := make([]string, 0)
+	for fieldName := range schemaModel.Fields {
+		fieldNames = append(fieldNames, fieldName)
+	}
+	sort.Strings(fieldNames)
+
+	changed := false
+	for _, fieldName := range fieldNames {
+		field := schemaModel.Fields[fieldName]
+		hclName := field.HCLName
+		if field.ForceNew {
+			basicTokens := tokensForTestConfigurationField(basicBody, hclName)
+			completeTokens := tokensForTestConfigurationField(completeBody, hclName)
+			// formatting the tokens means that whitespace differences aren't considered
+			if string(hclwrite.Format(basicTokens.Bytes())) == string(hclwrite.Format(completeTokens.Bytes())) {
+				continue
+			}
+			changed = true
+
+			completeBody.RemoveAttribute(hclName)
+			for _, block := range blocksForTestConfigurationField(completeBody, hclName) {
+				completeBody.RemoveBlock(block)
+			}
+			if attribute := basicBody.GetAttribute(hclName); attribute != nil {
+				completeBody.SetAttributeRaw(hclName, attribute.Expr().BuildTokens(nil))
+			}
+			for _, block := range blocksForTestConfigurationField(basicBody, hclName) {
+				completeBody.AppendBlock(block)
+			}
+			continue
+		}
+
+		nestedSchemaModelName := nestedSchemaModelNameForField(field)
+		if nestedSchemaModelName == nil {
+			continue
+		}
+		basicBlocks := blocksForTestConfigurationField(basicBody, hclName)
+		for i, completeBlock := range blocksForTestConfigurationField(completeBody, hclName) {
+			if i >= len(basicBlocks) {
+				if schemaModelContainsForceNewFields(schemaModels, *nestedSchemaModelName, map[string]struct{}{}) {
+					completeBody.RemoveBlock(completeBlock)
+					changed = true
+				}
+				continue
+			}
+
+			nestedChanged, err := useBasicValuesForForceNewFields(schemaModels, *nestedSchemaModelName, basicBlocks[i].Body(), completeBlock.Body())
+			if err != nil {
+				return false, err
+			}
+			changed = changed || nestedChanged
+		}
+	}
+	return changed, nil
+}
+
+// nestedSchemaModelNameForField returns the name of the Schema Model used for this field when it's a nested block.
+func nestedSchemaModelNameForField(field models.TerraformSchemaField) *string {
+	objectDefinition := field.ObjectDefinition
+	if objectDefinition.NestedObject != nil {
+		objectDefinition = *objectDefinition.NestedObject
+	}
+	if objectDefinition.Type != models.ReferenceTerraformSchemaObjectDefinitionType {
+		return nil
+	}
+	return objectDefinition.ReferenceName
+}
+
+func schemaModelContainsForceNewFields(schemaModels map[string]models.TerraformSchemaModel, schemaModelName string, seen map[string]struct{}) bool {
+	if _, ok := seen[schemaModelName]; ok {
+		return false
+	}
+	seen[schemaModelName] = struct{}{}
+
+	for _, field := range schemaModels[schemaModelName].Fields {
+		if field.ForceNew {
+			return true
+		}
+		if nestedSchemaModelName := nestedSchemaModelNameForField(field); nestedSchemaModelName != nil {
+			if schemaModelContainsForceNewFields(schemaModels, *nestedSchemaModelName, seen) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// blocksForTestConfigurationField returns the Block(s) named `hclName` within `body`.
+func blocksForTestConfigurationField(body *hclwrite.Body, hclName string) []*hclwrite.Block {
+	output := make([]*hclwrite.Block, 0)
+	for _, block := range body.Blocks() {
+		if block.Type() == hclName {
+			output = append(output, block)
+		}
+	}
+	return output
+}
+
+// tokensForTestConfigurationField returns the tokens for the Attribute or Block(s) named `hclName` within `body`.
+func tokensForTestConfigurationField(body *hclwrite.Body, hclName string) hclwrite.Tokens {
+	output := hclwrite.Tokens{}
+	if attribute := body.GetAttribute(hclName); attribute != nil {
+		output = append(output, attribute.Expr().BuildTokens(nil)...)
+	}
+	for _, block := range blocksForTestConfigurationField(body, hclName) {
+		output = append(output, block.Body().BuildTokens(nil)...)
+	}
+	return output
+}
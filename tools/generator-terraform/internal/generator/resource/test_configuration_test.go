@@ -0,0 +1,339 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	generatorModels "github.com/hashicorp/pandora/tools/generator-terraform/internal/generator/models"
+)
+
+func TestValuesForTestConfiguration(t *testing.T) {
+	config := `
+resource "azurerm_resource_group" "test" {
+  name     = "not-the-resource-being-tested"
+  location = var.primary_location
+}
+
+resource "azurerm_example" "test" {
+  name                = "acctest-example"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = var.primary_location
+  capacity            = 2
+  enabled             = true
+  zones               = ["1", "2"]
+
+  settings {
+    tier = "Standard"
+  }
+
+  settings {
+    tier = "Premium"
+  }
+
+  rule {
+    priority = 100
+  }
+
+  tags = {
+    environment = "Production"
+  }
+}
+`
+	input := testConfigurationInputForTesting()
+	actual, err := valuesForTestConfiguration(config, "azurerm_example", input.SchemaModels, input.SchemaModelName)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	expected := testConfigurationValues{
+		"name":            "acctest-example",
+		"capacity":        "2",
+		"enabled":         "true",
+		"zones.#":         "2",
+		"settings.#":      "2",
+		"settings.0.tier": "Standard",
+		"settings.1.tier": "Premium",
+		// `rule` is a Set, so only the number of items can be checked
+		"rule.#":           "1",
+		"tags.%":           "1",
+		"tags.environment": "Production",
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestCodeForTestChecks(t *testing.T) {
+	actual := codeForTestChecks(testConfigurationValues{
+		"name":     "acctest-example",
+		"capacity": "2",
+	})
+	expected := `
+check.That(data.ResourceName).ExistsInAzure(r),
+check.That(data.ResourceName).Key("capacity").HasValue("2"),
+check.That(data.ResourceName).Key("name").HasValue("acctest-example"),
+`
+	if strings.TrimSpace(expected) != actual {
+		t.Fatalf("expected %q but got %q", strings.TrimSpace(expected), actual)
+	}
+}
+
+func TestCodeForTestImportStep(t *testing.T) {
+	if actual := codeForTestImportStep([]string{}); actual != "data.ImportStep()," {
+		t.Fatalf("expected no ignored fields but got %q", actual)
+	}
+	if actual := codeForTestImportStep([]string{"password", "settings.0.secret"}); actual != `data.ImportStep("password", "settings.0.secret"),` {
+		t.Fatalf("expected two ignored fields but got %q", actual)
+	}
+}
+
+func TestImportStepIgnoredFieldsForResource(t *testing.T) {
+	actual, err := importStepIgnoredFieldsForResource(testConfigurationInputForTesting())
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	// `owners` is write-only since it's mapped to an `@odata.bind` field which isn't returned from the API
+	expected := []string{"owners", "password", "settings.0.secret"}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestUpdateTestConfigurationForResource_ForceNewFieldsDiffer(t *testing.T) {
+	input := testConfigurationInputForTesting()
+	input.Details.Tests.BasicConfiguration = `
+resource "azurerm_example" "test" {
+  name = "acctest-example"
+  kind = "Basic"
+}
+`
+	input.Details.Tests.CompleteConfiguration = pointer.To(`
+resource "azurerm_example" "test" {
+  name     = "acctest-example"
+  kind     = "Advanced"
+  capacity = 2
+}
+`)
+	actual, err := updateTestConfigurationForResource(input)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if actual == nil {
+		t.Fatalf("expected an Update Test Configuration but didn't get one")
+	}
+	values, err := valuesForTestConfiguration(*actual, "azurerm_example", input.SchemaModels, input.SchemaModelName)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	expected := testConfigurationValues{
+		"name":     "acctest-example",
+		"kind":     "Basic",
+		"capacity": "2",
+	}
+	if !reflect.DeepEqual(expected, values) {
+		t.Fatalf("expected %+v but got %+v", expected, values)
+	}
+}
+
+func TestUpdateTestConfigurationForResource_NestedForceNewFieldsDiffer(t *testing.T) {
+	input := testConfigurationInputForTesting()
+	input.Details.Tests.BasicConfiguration = `
+resource "azurerm_example" "test" {
+  name = "acctest-example"
+  kind = "Basic"
+
+  settings {
+    tier = "Standard"
+  }
+}
+`
+	input.Details.Tests.CompleteConfiguration = pointer.To(`
+resource "azurerm_example" "test" {
+  name = "acctest-example"
+  kind = "Basic"
+
+  settings {
+    secret = "s3cr3t"
+    tier   = "Premium"
+  }
+
+  settings {
+    tier = "Premium"
+  }
+}
+`)
+	actual, err := updateTestConfigurationForResource(input)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if actual == nil {
+		t.Fatalf("expected an Update Test Configuration but didn't get one")
+	}
+	values, err := valuesForTestConfiguration(*actual, "azurerm_example", input.SchemaModels, input.SchemaModelName)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	// the nested ForceNew field uses the value from the Basic Test Configuration and the additional block
+	// (containing a ForceNew field) is removed, since adding it would recreate the Resource
+	expected := testConfigurationValues{
+		"name":              "acctest-example",
+		"kind":              "Basic",
+		"settings.#":        "1",
+		"settings.0.secret": "s3cr3t",
+		"settings.0.tier":   "Standard",
+	}
+	if !reflect.DeepEqual(expected, values) {
+		t.Fatalf("expected %+v but got %+v", expected, values)
+	}
+}
+
+func TestUpdateTestConfigurationForResource_OnlyUpdatableFieldsDiffer(t *testing.T) {
+	input := testConfigurationInputForTesting()
+	input.Details.Tests.BasicConfiguration = `
+resource "azurerm_example" "test" {
+  name = "acctest-example"
+  kind = "Basic"
+}
+`
+	input.Details.Tests.CompleteConfiguration = pointer.To(`
+resource "azurerm_example" "test" {
+  name     = "acctest-example"
+  kind     =   "Basic"
+  capacity = 2
+}
+`)
+	actual, err := updateTestConfigurationForResource(input)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if actual != nil {
+		t.Fatalf("expected no Update Test Configuration but got %q", *actual)
+	}
+}
+
+func testConfigurationInputForTesting() generatorModels.ResourceInput {
+	return generatorModels.ResourceInput{
+		Details: models.TerraformResourceDefinition{
+			Mappings: models.TerraformMappingDefinition{
+				Fields: []models.TerraformFieldMappingDefinition{
+					models.TerraformODataBindFieldMappingDefinition{
+						ODataBind: models.TerraformODataBindFieldMappingDefinitionImpl{
+							SDKFieldName:             "OwnersODataBind",
+							SDKModelName:             "Example",
+							TerraformSchemaFieldName: "Owners",
+							TerraformSchemaModelName: "ExampleResource",
+						},
+					},
+				},
+			},
+		},
+		ProviderPrefix:  "azurerm",
+		ResourceLabel:   "example",
+		SchemaModelName: "ExampleResource",
+		SchemaModels: map[string]models.TerraformSchemaModel{
+			"ExampleResource": {
+				Fields: map[string]models.TerraformSchemaField{
+					"Capacity": {
+						HCLName: "capacity",
+						ObjectDefinition: models.TerraformSchemaObjectDefinition{
+							Type: models.IntegerTerraformSchemaObjectDefinitionType,
+						},
+						Optional: true,
+					},
+					"Kind": {
+						ForceNew: true,
+						HCLName:  "kind",
+						ObjectDefinition: models.TerraformSchemaObjectDefinition{
+							Type: models.StringTerraformSchemaObjectDefinitionType,
+						},
+						Required: true,
+					},
+					"Name": {
+						ForceNew: true,
+						HCLName:  "name",
+						ObjectDefinition: models.TerraformSchemaObjectDefinition{
+							Type: models.StringTerraformSchemaObjectDefinitionType,
+						},
+						Required: true,
+					},
+					"Owners": {
+						HCLName: "owners",
+						ObjectDefinition: models.TerraformSchemaObjectDefinition{
+							Type: models.ListTerraformSchemaObjectDefinitionType,
+							NestedObject: &models.TerraformSchemaObjectDefinition{
+								Type: models.StringTerraformSchemaObjectDefinitionType,
+							},
+						},
+						Optional: true,
+					},
+					"Password": {
+						HCLName: "password",
+						ObjectDefinition: models.TerraformSchemaObjectDefinition{
+							Type: models.StringTerraformSchemaObjectDefinitionType,
+						},
+						Optional:  true,
+						Sensitive: true,
+					},
+					"Rules": {
+						HCLName: "rule",
+						ObjectDefinition: models.TerraformSchemaObjectDefinition{
+							Type: models.SetTerraformSchemaObjectDefinitionType,
+							NestedObject: &models.TerraformSchemaObjectDefinition{
+								Type:          models.ReferenceTerraformSchemaObjectDefinitionType,
+								ReferenceName: pointer.To("ExampleResourceRule"),
+							},
+						},
+						Optional: true,
+					},
+					"Settings": {
+						HCLName: "settings",
+						ObjectDefinition: models.TerraformSchemaObjectDefinition{
+							Type: models.ListTerraformSchemaObjectDefinitionType,
+							NestedObject: &models.TerraformSchemaObjectDefinition{
+								Type:          models.ReferenceTerraformSchemaObjectDefinitionType,
+								ReferenceName: pointer.To("ExampleResourceSettings"),
+							},
+						},
+						Optional: true,
+					},
+				},
+			},
+			"ExampleResourceRule": {
+				Fields: map[string]models.TerraformSchemaField{
+					"Priority": {
+						HCLName: "priority",
+						ObjectDefinition: models.TerraformSchemaObjectDefinition{
+							Type: models.IntegerTerraformSchemaObjectDefinitionType,
+						},
+						Required: true,
+					},
+				},
+			},
+			"ExampleResourceSettings": {
+				Fields: map[string]models.TerraformSchemaField{
+					"Secret": {
+						HCLName: "secret",
+						ObjectDefinition: models.TerraformSchemaObjectDefinition{
+							Type: models.StringTerraformSchemaObjectDefinitionType,
+						},
+						Optional:  true,
+						Sensitive: true,
+					},
+					"Tier": {
+						ForceNew: true,
+						HCLName:  "tier",
+						ObjectDefinition: models.TerraformSchemaObjectDefinition{
+							Type: models.StringTerraformSchemaObjectDefinitionType,
+						},
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"sort"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	v1 "github.com/hashicorp/pandora/tools/data-api-sdk/v1"
 	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
 	"github.com/hashicorp/pandora/tools/generator-terraform/internal/generator/definitions"
@@ -35,25 +36,43 @@ func RunLegacy(input v1.LoadAllDataResult, providerPrefix, outputDirectory strin
 		}
 
 		// Build the intermediate models used by the Terraform Generator
-		terraformResources, err := buildTerraformResourcesForService(serviceDetails.TerraformDefinition.Resources, serviceDetails, serviceName, providerPrefix, outputDirectory)
+		terraformResources, err := buildTerraformResourcesForService(serviceDetails.TerraformDefinition.Resources, serviceDetails, input.CommonTypes, serviceName, providerPrefix, outputDirectory)
 		if err != nil {
 			return fmt.Errorf("building intermediate models: %+v", err)
 		}
 
+		framework := serviceDetails.TerraformDefinition.Framework
+		if framework == "" {
+			framework = models.PluginSdkTerraformFrameworkType
+		}
+
 		// Then build each of the Terraform Resources
 		for resourceLabel, resourceDefinition := range *terraformResources {
+			// Data Sources are only generated for the Plugin SDK at this time
+			if dataSource := resourceDefinition.Details.DataSource; dataSource != nil && dataSource.Generate && framework != models.PluginSdkTerraformFrameworkType {
+				return fmt.Errorf("the Resource %q (Service %q) defines a Data Source, which isn't supported for the Terraform Framework %q", resourceLabel, serviceName, string(framework))
+			}
+
 			if err := resourceGenerator.Resource(resourceDefinition); err != nil {
 				return fmt.Errorf("generating definitions for Resource %q (Service %q / API Version %q): %+v", resourceLabel, serviceName, resourceDefinition.SdkApiVersion, err)
 			}
+
+			if err := resourceGenerator.DataSource(resourceDefinition); err != nil {
+				return fmt.Errorf("generating definitions for the Data Source for Resource %q (Service %q / API Version %q): %+v", resourceLabel, serviceName, resourceDefinition.SdkApiVersion, err)
+			}
 		}
 
 		resourceToApiVersion := make(map[string]string)
 		categories := make(map[string]struct{})
 		resourceNames := make([]string, 0)
+		dataSourceNames := make([]string, 0)
 		for _, resource := range serviceDetails.TerraformDefinition.Resources {
 			categories[resource.Documentation.Category] = struct{}{}
 			resourceNames = append(resourceNames, resource.ResourceName)
 			resourceToApiVersion[resource.ResourceName] = resource.APIVersion
+			if resource.DataSource != nil && resource.DataSource.Generate {
+				dataSourceNames = append(dataSourceNames, resource.ResourceName)
+			}
 		}
 
 		categoryNames := make([]string, 0)
@@ -62,6 +81,7 @@ func RunLegacy(input v1.LoadAllDataResult, providerPrefix, outputDirectory strin
 		}
 		sort.Strings(categoryNames)
 		sort.Strings(resourceNames)
+		sort.Strings(dataSourceNames)
 
 		resourceToApiVersionSorted := make(map[string]string, 0)
 		for _, resource := range resourceNames {
@@ -70,6 +90,8 @@ func RunLegacy(input v1.LoadAllDataResult, providerPrefix, outputDirectory strin
 
 		serviceInput := generatorModels.ServiceInput{
 			CategoryNames:        categoryNames,
+			DataSourceNames:      dataSourceNames,
+			Framework:            framework,
 			ProviderPrefix:       providerPrefix,
 			ResourceToApiVersion: resourceToApiVersionSorted,
 			RootDirectory:        outputDirectory,
@@ -95,9 +117,14 @@ func RunLegacy(input v1.LoadAllDataResult, providerPrefix, outputDirectory strin
 	return nil
 }
 
-func buildTerraformResourcesForService(input map[string]models.TerraformResourceDefinition, service models.Service, serviceName, providerPrefix, outputDirectory string) (*map[string]generatorModels.ResourceInput, error) {
+func buildTerraformResourcesForService(input map[string]models.TerraformResourceDefinition, service models.Service, commonTypes map[string]models.CommonTypes, serviceName, providerPrefix, outputDirectory string) (*map[string]generatorModels.ResourceInput, error) {
 	output := make(map[string]generatorModels.ResourceInput)
 
+	framework := service.TerraformDefinition.Framework
+	if framework == "" {
+		framework = models.PluginSdkTerraformFrameworkType
+	}
+
 	for resourceLabel, resourceDefinition := range input {
 		if !resourceDefinition.Generate {
 			logging.Log.Debug(fmt.Sprintf("Resource %q has generation disabled - skipping", resourceLabel))
@@ -115,12 +142,13 @@ func buildTerraformResourcesForService(input map[string]models.TerraformResource
 		}
 
 		logging.Log.Debug(fmt.Sprintf("Processing Resource %q..", resourceLabel))
-		output[resourceLabel] = generatorModels.ResourceInput{
+		resourceInput := generatorModels.ResourceInput{
 			// Provider related
 			ProviderPrefix:     providerPrefix,
 			RootDirectory:      outputDirectory,
 			ServicePackageName: service.TerraformDefinition.TerraformPackageName,
 			ServiceName:        serviceName,
+			Framework:          framework,
 
 			// Resource Related
 			Details:          resourceDefinition,
@@ -139,8 +167,68 @@ func buildTerraformResourcesForService(input map[string]models.TerraformResource
 			ResourceIds:     resource.ResourceIDs,
 			SchemaModelName: resourceDefinition.SchemaModelName,
 			SchemaModels:    resourceDefinition.SchemaModels,
+
+			SourceDataOrigin: versionDetails.Source,
+		}
+
+		// Microsoft Graph outputs the Resource IDs and the majority of Models as Common Types, which need to be
+		// available alongside those defined within the APIResource
+		if versionDetails.Source == models.MicrosoftGraphMetaDataSourceDataOrigin {
+			commonTypesForVersion, ok := commonTypes[resourceDefinition.APIVersion]
+			if !ok {
+				return nil, fmt.Errorf("couldn't find the Common Types for API Version %q for Terraform Resource %q (Service %q)", resourceDefinition.APIVersion, resourceLabel, serviceName)
+			}
+			resourceInput = withCommonTypes(resourceInput, commonTypesForVersion)
 		}
+
+		output[resourceLabel] = resourceInput
 	}
 
 	return &output, nil
 }
+
+// withCommonTypes returns a copy of the ResourceInput which also contains the Common Types for this API Version.
+// Constants, Models and Resource IDs defined within the APIResource take precedence over any Common Types of the same name.
+func withCommonTypes(input generatorModels.ResourceInput, commonTypes models.CommonTypes) generatorModels.ResourceInput {
+	used := models.CommonTypes{
+		Constants:   make(map[string]models.SDKConstant),
+		Models:      make(map[string]models.SDKModel),
+		ResourceIDs: make(map[string]models.ResourceID),
+	}
+	constants := make(map[string]models.SDKConstant)
+	for k, v := range commonTypes.Constants {
+		constants[k] = v
+		used.Constants[k] = v
+	}
+	for k, v := range input.Constants {
+		constants[k] = v
+		delete(used.Constants, k)
+	}
+
+	sdkModels := make(map[string]models.SDKModel)
+	for k, v := range commonTypes.Models {
+		sdkModels[k] = v
+		used.Models[k] = v
+	}
+	for k, v := range input.Models {
+		sdkModels[k] = v
+		delete(used.Models, k)
+	}
+
+	resourceIds := make(map[string]models.ResourceID)
+	for k, v := range commonTypes.ResourceIDs {
+		resourceIds[k] = v
+		used.ResourceIDs[k] = v
+	}
+	for k, v := range input.ResourceIds {
+		resourceIds[k] = v
+		delete(used.ResourceIDs, k)
+	}
+
+	input.CommonTypes = used
+	input.CommonTypesPackageName = pointer.To(input.SdkApiVersion)
+	input.Constants = constants
+	input.Models = sdkModels
+	input.ResourceIds = resourceIds
+	return input
+}
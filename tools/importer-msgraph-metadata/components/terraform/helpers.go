@@ -0,0 +1,27 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package terraform
+
+import (
+	"regexp"
+	"strings"
+)
+
+var snakeCaseBoundary = regexp.MustCompile("([a-z0-9])([A-Z])")
+var snakeCaseAcronymBoundary = regexp.MustCompile("([A-Z]+)([A-Z][a-z])")
+
+// convertToSnakeCase converts the specified field name into the snake_case form used for Schema Fields, for
+// example `MailNickname` becomes `mail_nickname` and `OnPremisesNetBiosName` becomes `on_premises_net_bios_name`.
+func convertToSnakeCase(input string) string {
+	output := strings.ReplaceAll(strings.TrimSpace(input), "_", "")
+	output = snakeCaseAcronymBoundary.ReplaceAllString(output, "${1}_${2}")
+	output = snakeCaseBoundary.ReplaceAllString(output, "${1}_${2}")
+	return strings.ToLower(output)
+}
+
+// resourceNameFromDisplayName returns the Resource Name (an Identifier) for the specified Display Name, for
+// example `Administrative Unit` becomes `AdministrativeUnit`.
+func resourceNameFromDisplayName(input string) string {
+	return strings.ReplaceAll(input, " ", "")
+}
@@ -0,0 +1,189 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package terraform
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	"github.com/hashicorp/pandora/tools/importer-msgraph-metadata/internal/logging"
+	"github.com/hashicorp/pandora/tools/sdk/config/definitions"
+)
+
+type methodsForResource struct {
+	createMethod sdkModels.TerraformMethodDefinition
+	deleteMethod sdkModels.TerraformMethodDefinition
+	readMethod   sdkModels.TerraformMethodDefinition
+	updateMethod *sdkModels.TerraformMethodDefinition
+
+	// payloadModelName is the name of the SDK Model sent as the payload for the Create (and Update) methods
+	payloadModelName string
+}
+
+// findResourceIDName returns the name of the Resource ID matching the `id` specified in the Terraform Definition.
+// Resource IDs are output as Common Types for Microsoft Graph, however any defined in the APIResource are also checked.
+func findResourceIDName(id string, apiResource sdkModels.APIResource, commonTypes sdkModels.CommonTypes) (*string, error) {
+	for _, resourceIDs := range []map[string]sdkModels.ResourceID{apiResource.ResourceIDs, commonTypes.ResourceIDs} {
+		names := make([]string, 0)
+		for name := range resourceIDs {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			if resourceIDs[name].ExampleValue == id {
+				return &name, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("unable to identify the Resource ID associated with %q", id)
+}
+
+// identifyMethodsForAPIResource identifies the Create/Read/Update/Delete methods for this Resource. Unlike Resource
+// Manager, Microsoft Graph Resources are created by POSTing to the collection (e.g. `POST /groups`), which is
+// parented by the Resource ID of the parent Resource (or no Resource ID for top-level Resources) - updated using
+// PATCH and deleted using DELETE, both against the Resource ID for the Resource.
+func identifyMethodsForAPIResource(apiResource sdkModels.APIResource, commonTypes sdkModels.CommonTypes, resourceMetaData definitions.ResourceDefinition, resourceIDName string) (*methodsForResource, error) {
+	collectionUri, parentResourceID := collectionUriForResourceID(resourceMetaData.ID)
+
+	var parentResourceIDName *string
+	if parentResourceID != nil {
+		name, err := findResourceIDName(*parentResourceID, apiResource, commonTypes)
+		if err != nil {
+			return nil, fmt.Errorf("identifying the parent Resource ID: %+v", err)
+		}
+		parentResourceIDName = name
+	}
+
+	operationNames := make([]string, 0)
+	for operationName := range apiResource.Operations {
+		operationNames = append(operationNames, operationName)
+	}
+	sort.Strings(operationNames)
+
+	var createMethod, deleteMethod, readMethod, updateMethod *string
+	payloadModelName := ""
+	for _, operationName := range operationNames {
+		operation := apiResource.Operations[operationName]
+		method := strings.ToUpper(operation.Method)
+
+		if method == http.MethodPost && operation.URISuffix != nil && strings.EqualFold(*operation.URISuffix, collectionUri) {
+			if !pointersEqual(operation.ResourceIDName, parentResourceIDName) || operation.RequestObject == nil {
+				continue
+			}
+			if operation.RequestObject.Type != sdkModels.ReferenceSDKObjectDefinitionType || operation.RequestObject.ReferenceName == nil {
+				continue
+			}
+
+			if createMethod != nil {
+				return nil, fmt.Errorf("multiple Create operations were identified (%q and %q)", *createMethod, operationName)
+			}
+			createMethod = &operationName
+			payloadModelName = *operation.RequestObject.ReferenceName
+			continue
+		}
+
+		// the remaining operations should be against the Resource ID itself
+		if operation.URISuffix != nil || operation.ResourceIDName == nil || *operation.ResourceIDName != resourceIDName {
+			continue
+		}
+
+		switch method {
+		case http.MethodDelete:
+			if deleteMethod != nil {
+				return nil, fmt.Errorf("multiple Delete operations were identified (%q and %q)", *deleteMethod, operationName)
+			}
+			deleteMethod = &operationName
+
+		case http.MethodGet:
+			if operation.ResponseObject == nil {
+				continue
+			}
+			if readMethod != nil {
+				return nil, fmt.Errorf("multiple Read operations were identified (%q and %q)", *readMethod, operationName)
+			}
+			readMethod = &operationName
+
+		case http.MethodPatch:
+			if operation.RequestObject == nil {
+				continue
+			}
+			if updateMethod != nil {
+				return nil, fmt.Errorf("multiple Update operations were identified (%q and %q)", *updateMethod, operationName)
+			}
+			updateMethod = &operationName
+		}
+	}
+
+	if createMethod == nil {
+		logging.Tracef("Missing a method for POST %q - skipping", collectionUri)
+		return nil, nil
+	}
+	if deleteMethod == nil {
+		logging.Tracef("Missing a method for DELETE - skipping")
+		return nil, nil
+	}
+	if readMethod == nil {
+		logging.Tracef("Missing a method for GET - skipping")
+		return nil, nil
+	}
+
+	output := methodsForResource{
+		createMethod: sdkModels.TerraformMethodDefinition{
+			Generate:         resourceMetaData.GenerateCreate,
+			SDKOperationName: *createMethod,
+			TimeoutInMinutes: 10,
+		},
+		deleteMethod: sdkModels.TerraformMethodDefinition{
+			Generate:         resourceMetaData.GenerateDelete,
+			SDKOperationName: *deleteMethod,
+			TimeoutInMinutes: 10,
+		},
+		readMethod: sdkModels.TerraformMethodDefinition{
+			Generate:         resourceMetaData.GenerateRead,
+			SDKOperationName: *readMethod,
+			TimeoutInMinutes: 5,
+		},
+		payloadModelName: payloadModelName,
+	}
+	if updateMethod != nil {
+		output.updateMethod = &sdkModels.TerraformMethodDefinition{
+			Generate:         resourceMetaData.GenerateUpdate,
+			SDKOperationName: *updateMethod,
+			TimeoutInMinutes: 10,
+		}
+	}
+
+	return &output, nil
+}
+
+// collectionUriForResourceID returns the URI of the collection containing the Resource with the specified ID, relative
+// to the parent Resource - and the ID of the parent Resource, if this isn't a top-level Resource.
+// For example `/groups/{groupId}` returns `/groups` with no parent, and `/applications/{applicationId}/owners/{directoryObjectId}`
+// returns `/owners` with the parent `/applications/{applicationId}`.
+func collectionUriForResourceID(id string) (string, *string) {
+	segments := strings.Split(strings.Trim(id, "/"), "/")
+	if len(segments) < 2 {
+		return id, nil
+	}
+
+	collectionUri := fmt.Sprintf("/%s", segments[len(segments)-2])
+	if len(segments) == 2 {
+		return collectionUri, nil
+	}
+
+	parentID := fmt.Sprintf("/%s", strings.Join(segments[:len(segments)-2], "/"))
+	return collectionUri, &parentID
+}
+
+func pointersEqual(first *string, second *string) bool {
+	if first == nil || second == nil {
+		return first == nil && second == nil
+	}
+	return *first == *second
+}
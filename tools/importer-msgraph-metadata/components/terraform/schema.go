@@ -0,0 +1,263 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package terraform
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	"github.com/hashicorp/pandora/tools/importer-msgraph-metadata/internal/logging"
+	"github.com/hashicorp/pandora/tools/sdk/config/definitions"
+)
+
+// directoryObjectsEntitySetName is the Entity Set containing the Directory Objects which can be referenced using the
+// `@odata.bind` fields added by the `workaroundODataBind` data workaround.
+const directoryObjectsEntitySetName = "directoryObjects"
+
+// oDataBindFieldSuffix is the suffix for the SDK Fields added by the `workaroundODataBind` data workaround
+const oDataBindFieldSuffix = "_ODataBind"
+
+// fieldsWhichShouldBeIgnored are present in (almost) every Microsoft Graph Model and are either populated by the API
+// or used to determine the type of the entity, so shouldn't be exposed in the Schema.
+var fieldsWhichShouldBeIgnored = map[string]struct{}{
+	"Id":        {},
+	"ODataId":   {},
+	"ODataType": {},
+}
+
+var scalarTypes = map[sdkModels.SDKObjectDefinitionType]sdkModels.TerraformSchemaObjectDefinitionType{
+	sdkModels.BooleanSDKObjectDefinitionType: sdkModels.BooleanTerraformSchemaObjectDefinitionType,
+	sdkModels.FloatSDKObjectDefinitionType:   sdkModels.FloatTerraformSchemaObjectDefinitionType,
+	sdkModels.IntegerSDKObjectDefinitionType: sdkModels.IntegerTerraformSchemaObjectDefinitionType,
+	sdkModels.StringSDKObjectDefinitionType:  sdkModels.StringTerraformSchemaObjectDefinitionType,
+}
+
+// buildSchemaAndMappings builds the top-level Schema Model for this Resource from the SDK Model used as the payload
+// for the Create method, along with the Mappings between the two.
+//
+// NOTE: at this time only scalar fields, lists of strings and `@odata.bind` fields are supported - nested models are
+// skipped, since these require further design work (e.g. discriminated implementations within Microsoft Graph).
+func buildSchemaAndMappings(resource sdkModels.TerraformResourceDefinition, resourceMetaData definitions.ResourceDefinition, apiResource sdkModels.APIResource, commonTypes sdkModels.CommonTypes, sdkModelName string) (map[string]sdkModels.TerraformSchemaModel, *sdkModels.TerraformMappingDefinition, error) {
+	sdkModel, ok := apiResource.Models[sdkModelName]
+	if !ok {
+		sdkModel, ok = commonTypes.Models[sdkModelName]
+		if !ok {
+			return nil, nil, fmt.Errorf("the SDK Model %q was not found", sdkModelName)
+		}
+	}
+
+	schemaModel := sdkModels.TerraformSchemaModel{
+		Fields: make(map[string]sdkModels.TerraformSchemaField),
+	}
+	mappings := sdkModels.TerraformMappingDefinition{
+		Fields: make([]sdkModels.TerraformFieldMappingDefinition, 0),
+		ModelToModels: []sdkModels.TerraformModelToModelMappingDefinition{
+			{
+				SDKModelName:             sdkModelName,
+				TerraformSchemaModelName: resource.SchemaModelName,
+			},
+		},
+		ResourceID: make([]sdkModels.TerraformResourceIDMappingDefinition, 0),
+	}
+
+	sdkFieldNames := make([]string, 0)
+	for sdkFieldName := range sdkModel.Fields {
+		sdkFieldNames = append(sdkFieldNames, sdkFieldName)
+	}
+	sort.Strings(sdkFieldNames)
+
+	for _, sdkFieldName := range sdkFieldNames {
+		sdkField := sdkModel.Fields[sdkFieldName]
+		if _, shouldIgnore := fieldsWhichShouldBeIgnored[sdkFieldName]; shouldIgnore || sdkField.ContainsDiscriminatedValue {
+			continue
+		}
+		if sdkField.ReadOnly {
+			logging.Tracef("Skipping the Read-Only Field %q within the SDK Model %q", sdkFieldName, sdkModelName)
+			continue
+		}
+
+		if strings.HasSuffix(sdkFieldName, oDataBindFieldSuffix) {
+			// the relationship these IDs are bound to must itself be writable
+			relationshipFieldName := strings.TrimSuffix(sdkFieldName, oDataBindFieldSuffix)
+			if relationship, ok := sdkModel.Fields[relationshipFieldName]; ok && relationship.ReadOnly {
+				logging.Tracef("Skipping the `@odata.bind` Field %q since the relationship %q is Read-Only", sdkFieldName, relationshipFieldName)
+				continue
+			}
+
+			schemaModel.Fields[relationshipFieldName] = sdkModels.TerraformSchemaField{
+				Documentation: sdkModels.TerraformSchemaFieldDocumentationDefinition{
+					Markdown: documentationForField(sdkField),
+				},
+				// when updating, `@odata.bind` only adds to a relationship (rather than replacing it) - as such
+				// changing these requires recreating the Resource until relationships can be managed separately
+				ForceNew:         true,
+				HCLName:          convertToSnakeCase(relationshipFieldName),
+				ObjectDefinition: schemaObjectDefinitionForODataBindField(sdkField.ObjectDefinition),
+				Optional:         !sdkField.Required,
+				Required:         sdkField.Required,
+			}
+			mappings.Fields = append(mappings.Fields, sdkModels.TerraformODataBindFieldMappingDefinition{
+				ODataBind: sdkModels.TerraformODataBindFieldMappingDefinitionImpl{
+					TerraformSchemaModelName: resource.SchemaModelName,
+					TerraformSchemaFieldName: relationshipFieldName,
+					SDKModelName:             sdkModelName,
+					SDKFieldName:             sdkFieldName,
+					EntitySetName:            directoryObjectsEntitySetName,
+				},
+			})
+			continue
+		}
+
+		objectDefinition := schemaObjectDefinitionForField(sdkField.ObjectDefinition)
+		if objectDefinition == nil {
+			logging.Debugf("Skipping the Field %q within the SDK Model %q since the type %q isn't supported at this time", sdkFieldName, sdkModelName, string(sdkField.ObjectDefinition.Type))
+			continue
+		}
+
+		schemaModel.Fields[sdkFieldName] = sdkModels.TerraformSchemaField{
+			Documentation: sdkModels.TerraformSchemaFieldDocumentationDefinition{
+				Markdown: documentationForField(sdkField),
+			},
+			HCLName:          convertToSnakeCase(sdkFieldName),
+			ObjectDefinition: *objectDefinition,
+			Optional:         !sdkField.Required,
+			Required:         sdkField.Required,
+			Sensitive:        sdkField.Sensitive,
+		}
+		mappings.Fields = append(mappings.Fields, sdkModels.TerraformDirectAssignmentFieldMappingDefinition{
+			DirectAssignment: sdkModels.TerraformDirectAssignmentFieldMappingDefinitionImpl{
+				TerraformSchemaModelName: resource.SchemaModelName,
+				TerraformSchemaFieldName: sdkFieldName,
+				SDKModelName:             sdkModelName,
+				SDKFieldName:             sdkFieldName,
+			},
+		})
+	}
+
+	if resourceMetaData.Overrides != nil {
+		if err := applyFieldOverrides(resource.SchemaModelName, &schemaModel, &mappings, *resourceMetaData.Overrides); err != nil {
+			return nil, nil, fmt.Errorf("applying overrides: %+v", err)
+		}
+	}
+
+	schemaModels := map[string]sdkModels.TerraformSchemaModel{
+		resource.SchemaModelName: schemaModel,
+	}
+	return schemaModels, &mappings, nil
+}
+
+func schemaObjectDefinitionForField(input sdkModels.SDKObjectDefinition) *sdkModels.TerraformSchemaObjectDefinition {
+	if v, ok := scalarTypes[input.Type]; ok {
+		return &sdkModels.TerraformSchemaObjectDefinition{
+			Type: v,
+		}
+	}
+
+	// Lists of Strings are supported, providing these aren't nullable
+	if input.Type == sdkModels.ListSDKObjectDefinitionType && !input.Nullable && input.NestedItem != nil && input.NestedItem.Type == sdkModels.StringSDKObjectDefinitionType {
+		return &sdkModels.TerraformSchemaObjectDefinition{
+			NestedObject: &sdkModels.TerraformSchemaObjectDefinition{
+				Type: sdkModels.StringTerraformSchemaObjectDefinitionType,
+			},
+			Type: sdkModels.ListTerraformSchemaObjectDefinitionType,
+		}
+	}
+
+	return nil
+}
+
+func schemaObjectDefinitionForODataBindField(input sdkModels.SDKObjectDefinition) sdkModels.TerraformSchemaObjectDefinition {
+	if input.Type == sdkModels.ListSDKObjectDefinitionType {
+		// the order of related entities isn't meaningful, so these are exposed as a Set
+		return sdkModels.TerraformSchemaObjectDefinition{
+			NestedObject: &sdkModels.TerraformSchemaObjectDefinition{
+				Type: sdkModels.StringTerraformSchemaObjectDefinitionType,
+			},
+			Type: sdkModels.SetTerraformSchemaObjectDefinitionType,
+		}
+	}
+
+	return sdkModels.TerraformSchemaObjectDefinition{
+		Type: sdkModels.StringTerraformSchemaObjectDefinitionType,
+	}
+}
+
+func documentationForField(input sdkModels.SDKField) string {
+	if input.Description != "" {
+		return input.Description
+	}
+	return fmt.Sprintf("Specifies the %s.", strings.ReplaceAll(convertToSnakeCase(input.JsonName), "_", " "))
+}
+
+// applyFieldOverrides applies the changes to the Schema defined within the `overrides` blocks for this Resource,
+// returning an error if an override doesn't match any field (or isn't supported for Microsoft Graph Resources).
+func applyFieldOverrides(schemaModelName string, schemaModel *sdkModels.TerraformSchemaModel, mappings *sdkModels.TerraformMappingDefinition, overrides []definitions.Override) error {
+	for _, override := range overrides {
+		if override.Default != nil || override.CollectionType != nil || override.Validation != nil || override.Mapping != nil {
+			return fmt.Errorf("the override for %q specifies `default`, `collection_type`, `validation` or `mapping` which aren't supported for Microsoft Graph Resources at this time", override.Name)
+		}
+
+		matched := false
+		for fieldName, field := range schemaModel.Fields {
+			if field.HCLName != override.Name {
+				continue
+			}
+			matched = true
+
+			if override.Exclude {
+				logging.Tracef("Excluding the field %q from Schema Model %q", fieldName, schemaModelName)
+				delete(schemaModel.Fields, fieldName)
+				mappings.Fields = removeMappingsForSchemaField(fieldName, mappings.Fields)
+				continue
+			}
+
+			if override.UpdatedName != nil {
+				field.HCLName = *override.UpdatedName
+			}
+			if override.Description != nil {
+				field.Documentation.Markdown = *override.Description
+			}
+			if override.ForceNew != nil {
+				field.ForceNew = *override.ForceNew
+			}
+			if override.Sensitive != nil {
+				field.Sensitive = *override.Sensitive
+			}
+			if override.Computed != nil {
+				field.Computed = *override.Computed
+			}
+			if override.Optional != nil {
+				field.Optional = *override.Optional
+				field.Required = !*override.Optional
+			}
+			if override.Required != nil {
+				field.Required = *override.Required
+				field.Optional = !*override.Required
+			}
+			schemaModel.Fields[fieldName] = field
+		}
+
+		if !matched {
+			return fmt.Errorf("the override for %q didn't match any field within the Schema Model %q", override.Name, schemaModelName)
+		}
+	}
+
+	return nil
+}
+
+func removeMappingsForSchemaField(schemaFieldName string, input []sdkModels.TerraformFieldMappingDefinition) []sdkModels.TerraformFieldMappingDefinition {
+	output := make([]sdkModels.TerraformFieldMappingDefinition, 0)
+	for _, item := range input {
+		if v, ok := item.(sdkModels.TerraformDirectAssignmentFieldMappingDefinition); ok && v.DirectAssignment.TerraformSchemaFieldName == schemaFieldName {
+			continue
+		}
+		if v, ok := item.(sdkModels.TerraformODataBindFieldMappingDefinition); ok && v.ODataBind.TerraformSchemaFieldName == schemaFieldName {
+			continue
+		}
+		output = append(output, item)
+	}
+	return output
+}
@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package terraform
+
+import (
+	"fmt"
+	"sort"
+
+	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	"github.com/hashicorp/pandora/tools/importer-msgraph-metadata/internal/logging"
+	"github.com/hashicorp/pandora/tools/sdk/config/definitions"
+)
+
+// BuildForService builds the Terraform Definition for the Service, using the Terraform Resources defined in the
+// terraformConfig for this Service. Since the Microsoft Graph Resource IDs and most Models are Common Types, the
+// Common Types for the API Version are required to resolve these.
+func BuildForService(input sdkModels.Service, commonTypes map[string]sdkModels.CommonTypes, terraformConfig map[string]definitions.ResourceDefinition, providerPrefix string, terraformPackageName *string) (*sdkModels.Service, error) {
+	if len(terraformConfig) == 0 || terraformPackageName == nil {
+		logging.Debugf("No Terraform Definition exists for the Service %q - skipping", input.Name)
+		return &input, nil
+	}
+
+	resourceLabels := make([]string, 0)
+	for resourceLabel := range terraformConfig {
+		resourceLabels = append(resourceLabels, resourceLabel)
+	}
+	sort.Strings(resourceLabels)
+
+	resources := make(map[string]sdkModels.TerraformResourceDefinition)
+	for _, resourceLabel := range resourceLabels {
+		resourceMetaData := terraformConfig[resourceLabel]
+		if resourceMetaData.ServiceName != input.Name {
+			logging.Tracef("The Resource %q is for the Service %q but this is %q - skipping for now..", resourceLabel, resourceMetaData.ServiceName, input.Name)
+			continue
+		}
+
+		apiVersion, ok := input.APIVersions[resourceMetaData.APIVersion]
+		if !ok {
+			// Services are imported one API Version at a time, so this Resource will be handled with its API Version
+			logging.Tracef("The Resource %q is for the API Version %q which isn't present - skipping for now..", resourceLabel, resourceMetaData.APIVersion)
+			continue
+		}
+		apiResource, ok := apiVersion.Resources[resourceMetaData.APIResource]
+		if !ok {
+			return nil, fmt.Errorf("the Resource %q referenced APIResource %q which was not found", resourceLabel, resourceMetaData.APIResource)
+		}
+		commonTypesForVersion, ok := commonTypes[resourceMetaData.APIVersion]
+		if !ok {
+			return nil, fmt.Errorf("the Common Types for the API Version %q were not found", resourceMetaData.APIVersion)
+		}
+
+		logging.Infof("Processing the Terraform Resource %q..", resourceLabel)
+		resource, err := buildResource(providerPrefix, resourceMetaData, apiResource, commonTypesForVersion)
+		if err != nil {
+			return nil, fmt.Errorf("building the Terraform Resource %q: %+v", resourceLabel, err)
+		}
+		if resource == nil {
+			logging.Debugf("The Resource %q could not be identified within the APIResource %q - skipping", resourceLabel, resourceMetaData.APIResource)
+			continue
+		}
+
+		resources[resourceLabel] = *resource
+	}
+
+	logging.Tracef("%q has %d Resources", input.Name, len(resources))
+	if len(resources) == 0 {
+		return &input, nil
+	}
+
+	input.TerraformDefinition = &sdkModels.TerraformDefinition{
+		Framework:            sdkModels.PluginSdkTerraformFrameworkType,
+		Resources:            resources,
+		TerraformPackageName: *terraformPackageName,
+	}
+
+	return &input, nil
+}
+
+func buildResource(providerPrefix string, resourceMetaData definitions.ResourceDefinition, apiResource sdkModels.APIResource, commonTypes sdkModels.CommonTypes) (*sdkModels.TerraformResourceDefinition, error) {
+	resourceIDName, err := findResourceIDName(resourceMetaData.ID, apiResource, commonTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	methods, err := identifyMethodsForAPIResource(apiResource, commonTypes, resourceMetaData, *resourceIDName)
+	if err != nil {
+		return nil, fmt.Errorf("identifying the methods for the Resource %q: %+v", resourceMetaData.Name, err)
+	}
+	if methods == nil {
+		return nil, nil
+	}
+
+	resource := sdkModels.TerraformResourceDefinition{
+		APIResource:  resourceMetaData.APIResource,
+		APIVersion:   resourceMetaData.APIVersion,
+		CreateMethod: methods.createMethod,
+		DeleteMethod: methods.deleteMethod,
+		Documentation: sdkModels.TerraformDocumentationDefinition{
+			Category:    resourceMetaData.WebsiteSubcategory,
+			Description: resourceMetaData.Description,
+		},
+		DisplayName: resourceMetaData.Name,
+
+		// Microsoft Graph is eventually consistent, so changes need to be polled for after Create/Delete
+		EventuallyConsistent: true,
+
+		Generate:             true,
+		GenerateModel:        true,
+		GenerateIDValidation: true,
+		GenerateSchema:       true,
+		ReadMethod:           methods.readMethod,
+		ResourceIDName:       *resourceIDName,
+		ResourceLabel:        resourceMetaData.ResourceLabel,
+		ResourceName:         resourceNameFromDisplayName(resourceMetaData.Name),
+		UpdateMethod: methods.updateMethod,
+	}
+	resource.SchemaModelName = fmt.Sprintf("%sResource", resource.ResourceName)
+
+	logging.Infof("Building the Schema for Terraform Resource %q..", fmt.Sprintf("%s_%s", providerPrefix, resourceMetaData.ResourceLabel))
+	schemaModels, mappings, err := buildSchemaAndMappings(resource, resourceMetaData, apiResource, commonTypes, methods.payloadModelName)
+	if err != nil {
+		return nil, fmt.Errorf("building the Terraform Schema: %+v", err)
+	}
+	resource.SchemaModels = schemaModels
+	resource.Mappings = *mappings
+
+	tests, err := buildTestsForResource(providerPrefix, resource)
+	if err != nil {
+		return nil, fmt.Errorf("building the Tests: %+v", err)
+	}
+	resource.Tests = *tests
+
+	return &resource, nil
+}
@@ -0,0 +1,336 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package terraform
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	"github.com/hashicorp/pandora/tools/sdk/config/definitions"
+)
+
+func TestBuildForServiceGroup(t *testing.T) {
+	commonTypes := map[string]sdkModels.CommonTypes{
+		"stable": {
+			Models: map[string]sdkModels.SDKModel{
+				"Group": {
+					Fields: map[string]sdkModels.SDKField{
+						"Id": {
+							JsonName:         "id",
+							ObjectDefinition: sdkModels.SDKObjectDefinition{Type: sdkModels.StringSDKObjectDefinitionType},
+							ReadOnly:         true,
+						},
+						"DisplayName": {
+							JsonName:         "displayName",
+							ObjectDefinition: sdkModels.SDKObjectDefinition{Type: sdkModels.StringSDKObjectDefinitionType, Nullable: true},
+							Required:         true,
+						},
+						"GroupTypes": {
+							JsonName: "groupTypes",
+							ObjectDefinition: sdkModels.SDKObjectDefinition{
+								NestedItem: &sdkModels.SDKObjectDefinition{Type: sdkModels.StringSDKObjectDefinitionType},
+								Type:       sdkModels.ListSDKObjectDefinitionType,
+							},
+							Optional: true,
+						},
+						"Mail": {
+							JsonName:         "mail",
+							ObjectDefinition: sdkModels.SDKObjectDefinition{Type: sdkModels.StringSDKObjectDefinitionType, Nullable: true},
+							ReadOnly:         true,
+						},
+						"Owners": {
+							JsonName: "owners",
+							ObjectDefinition: sdkModels.SDKObjectDefinition{
+								NestedItem: &sdkModels.SDKObjectDefinition{ReferenceName: pointer.To("DirectoryObject"), Type: sdkModels.ReferenceSDKObjectDefinitionType},
+								Type:       sdkModels.ListSDKObjectDefinitionType,
+							},
+							Optional: true,
+						},
+						"Owners_ODataBind": {
+							JsonName: "owners@odata.bind",
+							ObjectDefinition: sdkModels.SDKObjectDefinition{
+								NestedItem: &sdkModels.SDKObjectDefinition{Type: sdkModels.StringSDKObjectDefinitionType},
+								Type:       sdkModels.ListSDKObjectDefinitionType,
+							},
+							Optional: true,
+						},
+						"SecurityEnabled": {
+							JsonName:         "securityEnabled",
+							ObjectDefinition: sdkModels.SDKObjectDefinition{Type: sdkModels.BooleanSDKObjectDefinitionType, Nullable: true},
+							Required:         true,
+						},
+						"Visibility": {
+							JsonName:         "visibility",
+							ObjectDefinition: sdkModels.SDKObjectDefinition{Type: sdkModels.StringSDKObjectDefinitionType, Nullable: true},
+							Optional:         true,
+						},
+					},
+				},
+			},
+			ResourceIDs: map[string]sdkModels.ResourceID{
+				"GroupId": {
+					ExampleValue: "/groups/{groupId}",
+					Segments: []sdkModels.ResourceIDSegment{
+						sdkModels.NewStaticValueResourceIDSegment("groups", "groups"),
+						sdkModels.NewUserSpecifiedResourceIDSegment("groupId", "groupId"),
+					},
+				},
+			},
+		},
+	}
+	groupReference := &sdkModels.SDKObjectDefinition{
+		ReferenceName:             pointer.To("Group"),
+		ReferenceNameIsCommonType: pointer.To(true),
+		Type:                      sdkModels.ReferenceSDKObjectDefinitionType,
+	}
+	service := sdkModels.Service{
+		Name: "Groups",
+		APIVersions: map[string]sdkModels.APIVersion{
+			"stable": {
+				APIVersion: "stable",
+				Resources: map[string]sdkModels.APIResource{
+					"Group": {
+						Operations: map[string]sdkModels.SDKOperation{
+							"CreateGroup": {
+								Method:         "POST",
+								RequestObject:  groupReference,
+								ResponseObject: groupReference,
+								URISuffix:      pointer.To("/groups"),
+							},
+							"CreateValidatesProperty": {
+								Method:    "POST",
+								URISuffix: pointer.To("/groups/validateProperties"),
+							},
+							"DeleteGroup": {
+								Method:         "DELETE",
+								ResourceIDName: pointer.To("GroupId"),
+							},
+							"GetGroup": {
+								Method:         "GET",
+								ResourceIDName: pointer.To("GroupId"),
+								ResponseObject: groupReference,
+							},
+							"ListGroups": {
+								Method:         "GET",
+								ResponseObject: groupReference,
+								URISuffix:      pointer.To("/groups"),
+							},
+							"UpdateGroup": {
+								Method:         "PATCH",
+								RequestObject:  groupReference,
+								ResourceIDName: pointer.To("GroupId"),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	terraformConfig := map[string]definitions.ResourceDefinition{
+		"group": {
+			ServiceName:    "Groups",
+			APIVersion:     "stable",
+			APIResource:    "Group",
+			ResourceLabel:  "group",
+			ID:             "/groups/{groupId}",
+			Name:           "Group",
+			GenerateCreate: true,
+			GenerateDelete: true,
+			GenerateRead:   true,
+			GenerateUpdate: true,
+			Overrides: &[]definitions.Override{
+				{
+					Name:    "visibility",
+					Exclude: true,
+				},
+				{
+					Name:     "group_types",
+					ForceNew: pointer.To(true),
+				},
+			},
+		},
+	}
+
+	actual, err := BuildForService(service, commonTypes, terraformConfig, "azuread", pointer.To("groups"))
+	if err != nil {
+		t.Fatalf("building the Terraform Definition: %+v", err)
+	}
+	if actual.TerraformDefinition == nil {
+		t.Fatalf("expected a Terraform Definition but got nil")
+	}
+	resource, ok := actual.TerraformDefinition.Resources["group"]
+	if !ok {
+		t.Fatalf("expected the Resource `group` but it wasn't found")
+	}
+
+	if resource.CreateMethod.SDKOperationName != "CreateGroup" {
+		t.Fatalf("expected the Create method to be `CreateGroup` but got %q", resource.CreateMethod.SDKOperationName)
+	}
+	if resource.ReadMethod.SDKOperationName != "GetGroup" {
+		t.Fatalf("expected the Read method to be `GetGroup` but got %q", resource.ReadMethod.SDKOperationName)
+	}
+	if resource.UpdateMethod == nil || resource.UpdateMethod.SDKOperationName != "UpdateGroup" {
+		t.Fatalf("expected the Update method to be `UpdateGroup` but got %+v", resource.UpdateMethod)
+	}
+	if resource.DeleteMethod.SDKOperationName != "DeleteGroup" {
+		t.Fatalf("expected the Delete method to be `DeleteGroup` but got %q", resource.DeleteMethod.SDKOperationName)
+	}
+	if resource.ResourceIDName != "GroupId" {
+		t.Fatalf("expected the Resource ID Name to be `GroupId` but got %q", resource.ResourceIDName)
+	}
+	if !resource.EventuallyConsistent {
+		t.Fatalf("expected the Resource to be Eventually Consistent")
+	}
+
+	schemaModel, ok := resource.SchemaModels["GroupResource"]
+	if !ok {
+		t.Fatalf("expected the Schema Model `GroupResource` but it wasn't found")
+	}
+	expectedFields := map[string]string{
+		"DisplayName":     "display_name",
+		"GroupTypes":      "group_types",
+		"Owners":          "owners",
+		"SecurityEnabled": "security_enabled",
+	}
+	if len(schemaModel.Fields) != len(expectedFields) {
+		t.Fatalf("expected %d fields but got %d: %+v", len(expectedFields), len(schemaModel.Fields), schemaModel.Fields)
+	}
+	for fieldName, hclName := range expectedFields {
+		field, ok := schemaModel.Fields[fieldName]
+		if !ok {
+			t.Fatalf("expected the field %q but it wasn't found", fieldName)
+		}
+		if field.HCLName != hclName {
+			t.Fatalf("expected the field %q to have the HCL Name %q but got %q", fieldName, hclName, field.HCLName)
+		}
+	}
+	if !schemaModel.Fields["DisplayName"].Required {
+		t.Fatalf("expected `display_name` to be Required")
+	}
+	if !schemaModel.Fields["GroupTypes"].ForceNew {
+		t.Fatalf("expected `group_types` to be ForceNew")
+	}
+	if schemaModel.Fields["Owners"].ObjectDefinition.Type != sdkModels.SetTerraformSchemaObjectDefinitionType {
+		t.Fatalf("expected `owners` to be a Set but got %q", string(schemaModel.Fields["Owners"].ObjectDefinition.Type))
+	}
+	if !schemaModel.Fields["Owners"].ForceNew {
+		t.Fatalf("expected `owners` to be ForceNew")
+	}
+
+	oDataBindMappings := 0
+	for _, item := range resource.Mappings.Fields {
+		if v, ok := item.(sdkModels.TerraformODataBindFieldMappingDefinition); ok {
+			oDataBindMappings++
+			if v.ODataBind.SDKFieldName != "Owners_ODataBind" || v.ODataBind.TerraformSchemaFieldName != "Owners" || v.ODataBind.EntitySetName != "directoryObjects" {
+				t.Fatalf("unexpected ODataBind mapping: %+v", v)
+			}
+		}
+		if v, ok := item.(sdkModels.TerraformDirectAssignmentFieldMappingDefinition); ok && v.DirectAssignment.TerraformSchemaFieldName == "Visibility" {
+			t.Fatalf("expected the mapping for the excluded field `visibility` to be removed")
+		}
+	}
+	if oDataBindMappings != 1 {
+		t.Fatalf("expected 1 ODataBind mapping but got %d", oDataBindMappings)
+	}
+	if len(resource.Mappings.Fields) != 4 {
+		t.Fatalf("expected 4 field mappings but got %d", len(resource.Mappings.Fields))
+	}
+
+	if !resource.Tests.Generate {
+		t.Fatalf("expected the Tests to be generated")
+	}
+	expectedBasicConfig := `
+provider "azuread" {}
+
+resource "azuread_group" "test" {
+  display_name     = "acctest-${var.random_string}"
+  security_enabled = true
+}
+`
+	if resource.Tests.BasicConfiguration != expectedBasicConfig {
+		t.Fatalf("expected the Basic Configuration to be %q but got %q", expectedBasicConfig, resource.Tests.BasicConfiguration)
+	}
+	expectedRequiresImportConfig := `resource "azuread_group" "import" {
+  display_name     = azuread_group.test.display_name
+  security_enabled = azuread_group.test.security_enabled
+}
+`
+	if resource.Tests.RequiresImportConfiguration != expectedRequiresImportConfig {
+		t.Fatalf("expected the RequiresImport Configuration to be %q but got %q", expectedRequiresImportConfig, resource.Tests.RequiresImportConfiguration)
+	}
+	expectedCompleteConfig := `
+provider "azuread" {}
+
+resource "azuread_group" "test" {
+  display_name     = "acctest-${var.random_string}"
+  group_types      = ["acctest-${var.random_string}"]
+  owners           = [data.azuread_client_config.current.object_id]
+  security_enabled = true
+}
+`
+	if resource.Tests.CompleteConfiguration == nil || *resource.Tests.CompleteConfiguration != expectedCompleteConfig {
+		t.Fatalf("expected the Complete Configuration to be %q but got %v", expectedCompleteConfig, resource.Tests.CompleteConfiguration)
+	}
+	expectedTemplateConfig := `variable "random_string" {}
+data "azuread_client_config" "current" {}`
+	if resource.Tests.TemplateConfiguration == nil || *resource.Tests.TemplateConfiguration != expectedTemplateConfig {
+		t.Fatalf("expected the Template Configuration to be %q but got %v", expectedTemplateConfig, resource.Tests.TemplateConfiguration)
+	}
+}
+
+func TestBuildForServiceWithoutTerraformConfig(t *testing.T) {
+	service := sdkModels.Service{
+		Name: "Groups",
+	}
+	actual, err := BuildForService(service, nil, nil, "azuread", nil)
+	if err != nil {
+		t.Fatalf("building the Terraform Definition: %+v", err)
+	}
+	if actual.TerraformDefinition != nil {
+		t.Fatalf("expected no Terraform Definition but got %+v", *actual.TerraformDefinition)
+	}
+}
+
+func TestCollectionUriForResourceID(t *testing.T) {
+	testCases := []struct {
+		input              string
+		expectedUri        string
+		expectedParentPath *string
+	}{
+		{
+			input:       "/groups/{groupId}",
+			expectedUri: "/groups",
+		},
+		{
+			input:              "/applications/{applicationId}/owners/{directoryObjectId}",
+			expectedUri:        "/owners",
+			expectedParentPath: pointer.To("/applications/{applicationId}"),
+		},
+	}
+	for _, testCase := range testCases {
+		uri, parent := collectionUriForResourceID(testCase.input)
+		if uri != testCase.expectedUri {
+			t.Fatalf("expected the URI for %q to be %q but got %q", testCase.input, testCase.expectedUri, uri)
+		}
+		if !pointersEqual(parent, testCase.expectedParentPath) {
+			t.Fatalf("expected the parent for %q to be %v but got %v", testCase.input, testCase.expectedParentPath, parent)
+		}
+	}
+}
+
+func TestConvertToSnakeCase(t *testing.T) {
+	testCases := map[string]string{
+		"DisplayName":           "display_name",
+		"IsAssignableToRole":    "is_assignable_to_role",
+		"OnPremisesNetBiosName": "on_premises_net_bios_name",
+		"ODataType":             "o_data_type",
+		"Owners":                "owners",
+	}
+	for input, expected := range testCases {
+		if actual := convertToSnakeCase(input); actual != expected {
+			t.Fatalf("expected %q to become %q but got %q", input, expected, actual)
+		}
+	}
+}
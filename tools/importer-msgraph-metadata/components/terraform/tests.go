@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package terraform
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+// clientConfigDataSourceReference references the Object ID of the principal running the Tests, which is used as the
+// value for `@odata.bind` fields since these must reference an existing Directory Object.
+const clientConfigDataSourceReference = "data.%s_client_config.current.object_id"
+
+// buildTestsForResource builds the Basic, RequiresImport and (where there are Optional fields) Complete Test
+// configurations for this Resource from the top-level Schema Model.
+func buildTestsForResource(providerPrefix string, resource sdkModels.TerraformResourceDefinition) (*sdkModels.TerraformResourceTestsDefinition, error) {
+	schemaModel, ok := resource.SchemaModels[resource.SchemaModelName]
+	if !ok {
+		return nil, fmt.Errorf("the Schema Model %q was not found", resource.SchemaModelName)
+	}
+
+	oDataBindFields := make(map[string]struct{})
+	for _, item := range resource.Mappings.Fields {
+		if v, ok := item.(sdkModels.TerraformODataBindFieldMappingDefinition); ok {
+			oDataBindFields[v.ODataBind.TerraformSchemaFieldName] = struct{}{}
+		}
+	}
+
+	resourceType := fmt.Sprintf("%s_%s", providerPrefix, resource.ResourceLabel)
+	fieldNames := make([]string, 0)
+	requiredFieldNames := make([]string, 0)
+	for fieldName, field := range schemaModel.Fields {
+		if !field.Required && !field.Optional {
+			// Computed-only fields can't be specified
+			continue
+		}
+		fieldNames = append(fieldNames, fieldName)
+		if field.Required {
+			requiredFieldNames = append(requiredFieldNames, fieldName)
+		}
+	}
+	sort.Strings(fieldNames)
+	sort.Strings(requiredFieldNames)
+
+	usesClientConfig := false
+	buildConfig := func(fields []string) (*string, error) {
+		f := hclwrite.NewEmptyFile()
+		body := f.Body().AppendNewBlock("resource", []string{resourceType, "test"}).Body()
+		for _, fieldName := range fields {
+			field := schemaModel.Fields[fieldName]
+			_, isODataBind := oDataBindFields[fieldName]
+			value, err := testValueForField(providerPrefix, field, isODataBind)
+			if err != nil {
+				return nil, fmt.Errorf("building the test value for the field %q: %+v", fieldName, err)
+			}
+			if isODataBind {
+				usesClientConfig = true
+			}
+			body.SetAttributeRaw(field.HCLName, hclwrite.Tokens{
+				{Type: hclsyntax.TokenIdent, Bytes: []byte(*value)},
+			})
+		}
+
+		out := fmt.Sprintf(`
+provider %q {}
+
+%s`, providerPrefix, hclwrite.Format(f.Bytes()))
+		return &out, nil
+	}
+
+	basicConfig, err := buildConfig(requiredFieldNames)
+	if err != nil {
+		return nil, fmt.Errorf("generating the basic test: %+v", err)
+	}
+
+	f := hclwrite.NewEmptyFile()
+	importBody := f.Body().AppendNewBlock("resource", []string{resourceType, "import"}).Body()
+	for _, fieldName := range requiredFieldNames {
+		field := schemaModel.Fields[fieldName]
+		importBody.SetAttributeTraversal(field.HCLName, hcl.Traversal{
+			hcl.TraverseRoot{
+				Name: fmt.Sprintf("%s.test.%s", resourceType, field.HCLName),
+			},
+		})
+	}
+	requiresImportConfig := string(hclwrite.Format(f.Bytes()))
+
+	out := sdkModels.TerraformResourceTestsDefinition{
+		BasicConfiguration:          *basicConfig,
+		RequiresImportConfiguration: requiresImportConfig,
+		Generate:                    true,
+		OtherTests:                  &map[string][]sdkModels.TerraformTestDefinition{},
+	}
+
+	// Complete is an Optional test, therefore only output it if there are Optional fields
+	if len(fieldNames) > len(requiredFieldNames) {
+		completeConfig, err := buildConfig(fieldNames)
+		if err != nil {
+			return nil, fmt.Errorf("generating the complete test: %+v", err)
+		}
+		out.CompleteConfiguration = completeConfig
+	}
+
+	template := []string{
+		`variable "random_string" {}`,
+	}
+	if usesClientConfig {
+		template = append(template, fmt.Sprintf(`data "%s_client_config" "current" {}`, providerPrefix))
+	}
+	out.TemplateConfiguration = pointer.To(strings.Join(template, "\n"))
+
+	return &out, nil
+}
+
+// testValueForField returns the HCL expression used as the value for this Schema Field within the Tests.
+func testValueForField(providerPrefix string, field sdkModels.TerraformSchemaField, isODataBind bool) (*string, error) {
+	var value string
+	switch field.ObjectDefinition.Type {
+	case sdkModels.BooleanTerraformSchemaObjectDefinitionType:
+		value = "true"
+
+	case sdkModels.FloatTerraformSchemaObjectDefinitionType:
+		value = "1.5"
+
+	case sdkModels.IntegerTerraformSchemaObjectDefinitionType:
+		value = "1"
+
+	case sdkModels.StringTerraformSchemaObjectDefinitionType:
+		value = `"acctest-${var.random_string}"`
+		if isODataBind {
+			value = fmt.Sprintf(clientConfigDataSourceReference, providerPrefix)
+		}
+
+	case sdkModels.ListTerraformSchemaObjectDefinitionType, sdkModels.SetTerraformSchemaObjectDefinitionType:
+		if field.ObjectDefinition.NestedObject == nil || field.ObjectDefinition.NestedObject.Type != sdkModels.StringTerraformSchemaObjectDefinitionType {
+			return nil, fmt.Errorf("only Lists/Sets of Strings are supported at this time")
+		}
+		value = `["acctest-${var.random_string}"]`
+		if isODataBind {
+			value = fmt.Sprintf("[%s]", fmt.Sprintf(clientConfigDataSourceReference, providerPrefix))
+		}
+
+	default:
+		return nil, fmt.Errorf("the Schema Field type %q isn't supported at this time", string(field.ObjectDefinition.Type))
+	}
+
+	return &value, nil
+}
@@ -17,22 +17,24 @@ import (
 
 var _ cli.Command = ImportCommand{}
 
-func NewImportCommand(metadataDirectory, microsoftGraphConfigPath, openApiFilePattern, outputDirectory string) func() (cli.Command, error) {
+func NewImportCommand(metadataDirectory, microsoftGraphConfigPath, openApiFilePattern, outputDirectory, terraformDefinitionsDirectory string) func() (cli.Command, error) {
 	return func() (cli.Command, error) {
 		return ImportCommand{
-			metadataDirectory:        metadataDirectory,
-			microsoftGraphConfigPath: microsoftGraphConfigPath,
-			openApiFilePattern:       openApiFilePattern,
-			outputDirectory:          outputDirectory,
+			metadataDirectory:             metadataDirectory,
+			microsoftGraphConfigPath:      microsoftGraphConfigPath,
+			openApiFilePattern:            openApiFilePattern,
+			outputDirectory:               outputDirectory,
+			terraformDefinitionsDirectory: terraformDefinitionsDirectory,
 		}, nil
 	}
 }
 
 type ImportCommand struct {
-	metadataDirectory        string
-	microsoftGraphConfigPath string
-	openApiFilePattern       string
-	outputDirectory          string
+	metadataDirectory             string
+	microsoftGraphConfigPath      string
+	openApiFilePattern            string
+	outputDirectory               string
+	terraformDefinitionsDirectory string
 }
 
 func (ImportCommand) Synopsis() string {
@@ -44,10 +46,11 @@ func (ImportCommand) Help() string {
 }
 
 func (c ImportCommand) Run(args []string) int {
-	var serviceNamesRaw string
+	var serviceNamesRaw, terraformDefinitionsDirectory string
 
 	f := flag.NewFlagSet("importer-msgraph", flag.ExitOnError)
 	f.StringVar(&serviceNamesRaw, "services", "", "A list of comma separated Service named from the Data API to import")
+	f.StringVar(&terraformDefinitionsDirectory, "terraform-definitions-directory", c.terraformDefinitionsDirectory, "The directory containing the Terraform Resource Definitions for Microsoft Graph")
 
 	if err := f.Parse(args); err != nil {
 		log.Fatalf("Error: %+v", err)
@@ -66,12 +69,13 @@ func (c ImportCommand) Run(args []string) int {
 	input := pipeline.RunInput{
 		ProviderPrefix: "azuread",
 
-		ConfigFilePath:     c.microsoftGraphConfigPath,
-		MetadataDirectory:  c.metadataDirectory,
-		OpenApiFilePattern: c.openApiFilePattern,
-		OutputDirectory:    c.outputDirectory,
-		Repo:               repo,
-		Services:           serviceNames,
+		ConfigFilePath:                c.microsoftGraphConfigPath,
+		MetadataDirectory:             c.metadataDirectory,
+		OpenApiFilePattern:            c.openApiFilePattern,
+		OutputDirectory:               c.outputDirectory,
+		Repo:                          repo,
+		Services:                      serviceNames,
+		TerraformDefinitionsDirectory: terraformDefinitionsDirectory,
 	}
 	if err := pipeline.Run(input); err != nil {
 		log.Fatalf("Error: %+v", err)
@@ -23,6 +23,12 @@ func runImporter(input RunInput, metadataGitSha string) error {
 		return fmt.Errorf("loading config: %+v", err)
 	}
 
+	servicesToTerraformDetails, err := loadTerraformDefinitions(input.TerraformDefinitionsDirectory)
+	if err != nil {
+		return err
+	}
+	terraformResources := make(map[string]map[string]sdkModels.TerraformResourceDefinition)
+
 	logging.Debugf("Removing any existing API Definitions")
 	if err = input.Repo.PurgeExistingData(sdkModels.MicrosoftGraphMetaDataSourceDataOrigin); err != nil {
 		return fmt.Errorf("removing existing API Definitions: %+v", err)
@@ -30,7 +36,7 @@ func runImporter(input RunInput, metadataGitSha string) error {
 
 	for _, apiVersion := range versions.Supported {
 		openApiFile := fmt.Sprintf(input.OpenApiFilePattern, versions.Upstream(apiVersion))
-		if err := runImportForVersion(input, apiVersion, openApiFile, metadataGitSha, config); err != nil {
+		if err := runImportForVersion(input, apiVersion, openApiFile, metadataGitSha, config, servicesToTerraformDetails, terraformResources); err != nil {
 			return err
 		}
 	}
@@ -39,15 +45,18 @@ func runImporter(input RunInput, metadataGitSha string) error {
 	return nil
 }
 
-func runImportForVersion(input RunInput, apiVersion, openApiFile, metadataGitSha string, config *services.Config) error {
+func runImportForVersion(input RunInput, apiVersion, openApiFile, metadataGitSha string, config *services.Config, servicesToTerraformDetails map[string]terraformDetailsForService, terraformResources map[string]map[string]sdkModels.TerraformResourceDefinition) error {
 	var err error
 
 	p := &pipeline{
-		apiVersion:      apiVersion,
-		metadataGitSha:  metadataGitSha,
-		outputDirectory: input.OutputDirectory,
-		resources:       make(map[string]parser.Resources),
-		repo:            input.Repo,
+		apiVersion:                 apiVersion,
+		metadataGitSha:             metadataGitSha,
+		outputDirectory:            input.OutputDirectory,
+		providerPrefix:             input.ProviderPrefix,
+		resources:                  make(map[string]parser.Resources),
+		repo:                       input.Repo,
+		servicesToTerraformDetails: servicesToTerraformDetails,
+		terraformResources:         terraformResources,
 	}
 
 	logging.Infof("Loading OpenAPI3 definitions for API version %q...", apiVersion)
@@ -198,6 +207,11 @@ func (p pipelineForService) PersistDefinitions(commonTypesForVersion sdkModels.C
 		p.apiVersion: commonTypesForVersion,
 	}
 
+	sdkService, err = p.buildTerraformDefinition(*sdkService, commonTypes)
+	if err != nil {
+		return err
+	}
+
 	if err = p.persistApiDefinitions(*sdkService, commonTypes); err != nil {
 		return err
 	}
@@ -8,8 +8,10 @@ import (
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/hashicorp/pandora/tools/data-api-repository/repository"
+	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
 	"github.com/hashicorp/pandora/tools/importer-msgraph-metadata/components/parser"
 	"github.com/hashicorp/pandora/tools/importer-msgraph-metadata/internal/logging"
+	"github.com/hashicorp/pandora/tools/sdk/config/definitions"
 )
 
 type RunInput struct {
@@ -22,6 +24,10 @@ type RunInput struct {
 	OutputDirectory          string
 	Repo                     repository.Repository
 	Services                 []string
+
+	// TerraformDefinitionsDirectory is the directory containing the Terraform Resource Definitions
+	// for Microsoft Graph (in the same format as those for Resource Manager).
+	TerraformDefinitionsDirectory string
 }
 
 func Run(input RunInput) error {
@@ -40,9 +46,23 @@ type pipeline struct {
 	metadataGitSha  string
 	models          parser.Models
 	outputDirectory string
+	providerPrefix  string
 	resources       map[string]parser.Resources
 	resourceIds     parser.ResourceIds
 	spec            *openapi3.T
+
+	// servicesToTerraformDetails is a map of Service Name (key) to the Terraform Details for that Service (value)
+	servicesToTerraformDetails map[string]terraformDetailsForService
+
+	// terraformResources is a map of Service Name (key) to the Terraform Resources built for that Service so far (value).
+	// Since each API Version is imported separately, this is shared across API Versions so that the Terraform Resources
+	// for earlier API Versions are retained when the Service is persisted for subsequent API Versions.
+	terraformResources map[string]map[string]sdkModels.TerraformResourceDefinition
+}
+
+type terraformDetailsForService struct {
+	resourceLabelToResourceDefinitions map[string]definitions.ResourceDefinition
+	terraformPackageName               *string
 }
 
 type pipelineForService struct {
@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pipeline
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	"github.com/hashicorp/pandora/tools/importer-msgraph-metadata/components/terraform"
+	"github.com/hashicorp/pandora/tools/importer-msgraph-metadata/internal/logging"
+	"github.com/hashicorp/pandora/tools/sdk/config/definitions"
+)
+
+func loadTerraformDefinitions(directory string) (map[string]terraformDetailsForService, error) {
+	servicesToTerraformDetails := make(map[string]terraformDetailsForService)
+	if directory == "" {
+		return servicesToTerraformDetails, nil
+	}
+
+	logging.Debugf("Parsing the Terraform Resource Definitions..")
+	terraformResourceDefinitions, err := definitions.LoadFromDirectory(directory)
+	if err != nil {
+		return nil, fmt.Errorf("parsing the Terraform Definitions from %q: %+v", directory, err)
+	}
+
+	for serviceName, serviceData := range terraformResourceDefinitions.Services {
+		if serviceData.TerraformFramework != definitions.PluginSdkTerraformFramework {
+			return nil, fmt.Errorf("the Service %q specifies the Terraform Framework %q but only %q is supported for Microsoft Graph at this time", serviceName, string(serviceData.TerraformFramework), string(definitions.PluginSdkTerraformFramework))
+		}
+
+		terraformResourceDefinition := make(map[string]definitions.ResourceDefinition)
+		for _, apiVersionData := range serviceData.ApiVersions {
+			for _, apiResourceData := range apiVersionData.Packages {
+				for resourceLabel, resourceData := range apiResourceData.Definitions {
+					terraformResourceDefinition[resourceLabel] = resourceData
+				}
+			}
+		}
+		servicesToTerraformDetails[serviceName] = terraformDetailsForService{
+			resourceLabelToResourceDefinitions: terraformResourceDefinition,
+			terraformPackageName:               pointer.To(serviceData.TerraformPackageName),
+		}
+	}
+	logging.Debugf("Completed - Parsing the Terraform Resource Definitions.")
+
+	return servicesToTerraformDetails, nil
+}
+
+func (p pipelineForService) buildTerraformDefinition(sdkService sdkModels.Service, commonTypes map[string]sdkModels.CommonTypes) (*sdkModels.Service, error) {
+	terraformDetails, ok := p.servicesToTerraformDetails[sdkService.Name]
+	if !ok {
+		return &sdkService, nil
+	}
+
+	logging.Infof("Building the Terraform Definition for Service %q..", sdkService.Name)
+	output, err := terraform.BuildForService(sdkService, commonTypes, terraformDetails.resourceLabelToResourceDefinitions, p.providerPrefix, terraformDetails.terraformPackageName)
+	if err != nil {
+		return nil, fmt.Errorf("building the Terraform Definition for Service %q: %+v", sdkService.Name, err)
+	}
+
+	// retain the Terraform Resources built for earlier API Versions of this Service, since the Service Definition
+	// is re-written when persisting each API Version
+	if _, ok := p.terraformResources[sdkService.Name]; !ok {
+		p.terraformResources[sdkService.Name] = make(map[string]sdkModels.TerraformResourceDefinition)
+	}
+	if output.TerraformDefinition != nil {
+		for resourceLabel, resource := range output.TerraformDefinition.Resources {
+			p.terraformResources[sdkService.Name][resourceLabel] = resource
+		}
+	}
+
+	if resources := p.terraformResources[sdkService.Name]; len(resources) > 0 {
+		output.TerraformDefinition = &sdkModels.TerraformDefinition{
+			Framework:            sdkModels.PluginSdkTerraformFrameworkType,
+			Resources:            resources,
+			TerraformPackageName: *terraformDetails.terraformPackageName,
+		}
+	}
+
+	return output, nil
+}
@@ -18,6 +18,8 @@ const (
 	microsoftGraphConfig = "../../config/microsoft-graph.hcl"
 	openApiFilePattern   = "openapi/%s/default.yaml"
 	outputDirectory      = "../../api-definitions"
+
+	terraformDefinitionsDirectory = "../../config/resources-microsoft-graph"
 )
 
 func main() {
@@ -30,7 +32,7 @@ func main() {
 	c := cli.NewCLI("importer-msgraph-metadata", "0.3.0")
 	c.Args = os.Args[1:]
 	c.Commands = map[string]cli.CommandFactory{
-		"import":    cmd.NewImportCommand(metadataDirectory, microsoftGraphConfig, openApiFilePattern, outputDirectory),
+		"import":    cmd.NewImportCommand(metadataDirectory, microsoftGraphConfig, openApiFilePattern, outputDirectory, terraformDefinitionsDirectory),
 		"list-tags": cmd.NewListTagsCommand(metadataDirectory, openApiFilePattern),
 	}
 
@@ -6,8 +6,10 @@ package cmd
 import (
 	"flag"
 	"log"
+	"runtime"
 	"strings"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
 	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/pipeline"
 	"github.com/mitchellh/cli"
@@ -15,9 +17,11 @@ import (
 
 var _ cli.Command = ImportCommand{}
 
-func NewImportCommand(restAPISpecsRepositoryDirectoryPath, resourceManagerConfigPath, terraformDefinitionsPath, outputDirectory string) func() (cli.Command, error) {
+func NewImportCommand(restAPISpecsRepositoryDirectoryPath, resourceManagerConfigPath, dataPlaneConfigPath, terraformDefinitionsPath, dataWorkaroundsDirectory, outputDirectory string) func() (cli.Command, error) {
 	return func() (cli.Command, error) {
 		return ImportCommand{
+			dataPlaneConfigPath:                 dataPlaneConfigPath,
+			dataWorkaroundsDirectory:            dataWorkaroundsDirectory,
 			outputDirectory:                     outputDirectory,
 			resourceManagerConfigPath:           resourceManagerConfigPath,
 			restAPISpecsRepositoryDirectoryPath: restAPISpecsRepositoryDirectoryPath,
@@ -27,6 +31,8 @@ func NewImportCommand(restAPISpecsRepositoryDirectoryPath, resourceManagerConfig
 }
 
 type ImportCommand struct {
+	dataPlaneConfigPath                 string
+	dataWorkaroundsDirectory            string
 	outputDirectory                     string
 	resourceManagerConfigPath           string
 	restAPISpecsRepositoryDirectoryPath string
@@ -39,16 +45,54 @@ which Terraform Data Sources & Resources can be generated - and then finally
 outputs this Data in the format used by the Data API.
 
 Specify -services=Compute,Resource to limit to just that or don't for everything, you do you.
+
+Services (and their API Versions) are processed concurrently, bounded by -parallelism. By default the
+import stops at the first Service which fails to be imported - specify -continue-on-error to instead
+retain the existing API Definitions for any Service which fails and continue with the remaining Services.
+
+Specify -report-file to write a JSON report detailing the outcome, duration, any error and the Data Workarounds
+applied for each Service to that path once completed.
+
+Specify -source-data-type=data-plane to import the Azure Data Plane Services defined in './config/data-plane.hcl'
+rather than the Resource Manager Services - Terraform Data isn't generated for Data Plane Services.
+
+Specify -detect-stale-workarounds to check whether each Data Workaround changed the API Version it was applied
+to - any which made no changes (and so have likely been fixed upstream) are summarised once the import has completed
+(and included in the report, when -report-file is specified).
+
+A summary of the Diagnostics raised whilst parsing each Service (for example Operations which were ignored, or
+Constants which are missing an 'x-ms-enum') is output once the import has completed - specify -diagnostics-directory
+to also write these to a JSON file per Service within that directory.
 `
 }
 
 func (c ImportCommand) Run(args []string) int {
 	var serviceNamesRaw string
+	var sourceDataTypeRaw string
+	var testDependencyCatalogDirectory string
+	var continueOnError bool
+	var parallelism int
+	var reportFilePath string
+	var detectStaleWorkarounds bool
+	var diagnosticsDirectory string
 
 	f := flag.NewFlagSet("importer-rest-api-specs", flag.ExitOnError)
 	f.StringVar(&serviceNamesRaw, "services", "", "A list of comma separated Service named from the Data API to import")
+	f.StringVar(&testDependencyCatalogDirectory, "test-dependencies-directory", "", "An optional path to a directory containing additional Test Dependencies used when generating the Terraform Acceptance Tests")
+	f.BoolVar(&continueOnError, "continue-on-error", false, "Continue importing the remaining Services when a Service fails to be imported, retaining the existing API Definitions for the failed Service")
+	f.IntVar(&parallelism, "parallelism", runtime.NumCPU(), "The maximum number of Services/API Versions to process concurrently")
+	f.BoolVar(&detectStaleWorkarounds, "detect-stale-workarounds", false, "Report the Data Workarounds which made no changes to the API Version they were applied to")
+	f.StringVar(&diagnosticsDirectory, "diagnostics-directory", "", "An optional path to a directory to write a JSON file containing the Diagnostics raised whilst parsing each Service to")
+	f.StringVar(&reportFilePath, "report-file", "", "An optional path to write a JSON report of the outcome of importing each Service to")
+	f.StringVar(&sourceDataTypeRaw, "source-data-type", string(sdkModels.ResourceManagerSourceDataType), "The Source Data Type to import - either resource-manager (default) or data-plane")
 	f.Parse(args)
 
+	sourceDataType, configFilePath, err := configForSourceDataType(sourceDataTypeRaw, c.resourceManagerConfigPath, c.dataPlaneConfigPath)
+	if err != nil {
+		log.Printf("Error: %+v", err)
+		return 1
+	}
+
 	var serviceNames []string
 	if serviceNamesRaw != "" {
 		serviceNames = strings.Split(serviceNamesRaw, ",")
@@ -56,14 +100,27 @@ func (c ImportCommand) Run(args []string) int {
 
 	opts := pipeline.Options{
 		APIDefinitionsDirectory:       c.outputDirectory,
-		ConfigFilePath:                c.resourceManagerConfigPath,
+		ConfigFilePath:                configFilePath,
+		DataWorkaroundsDirectory:      c.dataWorkaroundsDirectory,
+		ContinueOnError:               continueOnError,
+		DetectStaleWorkarounds:        detectStaleWorkarounds,
+		Parallelism:                   parallelism,
 		ProviderPrefix:                "azurerm",
 		RestAPISpecsDirectory:         c.restAPISpecsRepositoryDirectoryPath,
 		ServiceNamesToLimitTo:         serviceNames,
 		SourceDataOrigin:              sdkModels.AzureRestAPISpecsSourceDataOrigin,
-		SourceDataType:                sdkModels.ResourceManagerSourceDataType,
+		SourceDataType:                sourceDataType,
 		TerraformDefinitionsDirectory: c.terraformDefinitionsPath,
 	}
+	if diagnosticsDirectory != "" {
+		opts.DiagnosticsDirectory = pointer.To(diagnosticsDirectory)
+	}
+	if reportFilePath != "" {
+		opts.ReportFilePath = pointer.To(reportFilePath)
+	}
+	if testDependencyCatalogDirectory != "" {
+		opts.TestDependencyCatalogDirectory = pointer.To(testDependencyCatalogDirectory)
+	}
 	if err := pipeline.RunImporter(opts); err != nil {
 		log.Printf("Error: %+v", err)
 		return 1
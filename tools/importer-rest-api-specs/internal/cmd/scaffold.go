@@ -0,0 +1,215 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/apidefinitions"
+	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/discovery"
+	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/scaffold"
+	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/terraform/identification"
+	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/logging"
+	"github.com/hashicorp/pandora/tools/sdk/config/services"
+	"github.com/mitchellh/cli"
+)
+
+var _ cli.Command = ScaffoldCommand{}
+
+func NewScaffoldCommand(restAPISpecsRepositoryDirectoryPath string) func() (cli.Command, error) {
+	return func() (cli.Command, error) {
+		return ScaffoldCommand{
+			restAPISpecsRepositoryDirectoryPath: restAPISpecsRepositoryDirectoryPath,
+		}, nil
+	}
+}
+
+type ScaffoldCommand struct {
+	restAPISpecsRepositoryDirectoryPath string
+}
+
+func (ScaffoldCommand) Help() string {
+	return `Scaffold bootstraps the configuration required to import a new Resource Manager Service in two steps.
+
+1. List the API Versions available within the directory specified in -directory (e.g. 'appconfiguration') of the
+   './submodules/rest-api-specs' submodule:
+
+     scaffold -directory=appconfiguration
+
+2. List the Resource IDs within an API Version which support the Create, Read and Delete Operations required for a
+   Terraform Resource, by also specifying the normalized name of the Service in -service and the API Version in
+   -api-version (defaulting to the latest Stable API Version when omitted):
+
+     scaffold -directory=appconfiguration -service=AppConfiguration -api-version=2023-03-01
+
+   The Service Configuration for this Service is written to 'resource-manager.hcl' within -output-directory, and a
+   Terraform Resource Definition for each of the Resource IDs is written to '{terraform-package}.hcl' within
+   -output-directory.
+
+These files are intended to be reviewed before being merged into './config/resource-manager.hcl' and
+'./config/resources/' respectively.
+`
+}
+
+func (c ScaffoldCommand) Run(args []string) int {
+	var apiVersion string
+	var directory string
+	var outputDirectory string
+	var serviceName string
+	var terraformPackageName string
+
+	f := flag.NewFlagSet("importer-rest-api-specs", flag.ExitOnError)
+	f.StringVar(&apiVersion, "api-version", "", "The API Version to scaffold the Service for - defaults to the latest Stable API Version")
+	f.StringVar(&directory, "directory", "", "The name of the directory within the Rest API Specs repository containing the Service (e.g. appconfiguration)")
+	f.StringVar(&outputDirectory, "output-directory", "scaffold", "The path to the directory to write the generated configuration files to")
+	f.StringVar(&serviceName, "service", "", "The normalized name for this Service (e.g. AppConfiguration)")
+	f.StringVar(&terraformPackageName, "terraform-package", "", "The name of the Terraform Package for this Service - defaults to the lower-cased Service name")
+	f.Parse(args)
+
+	if directory == "" {
+		log.Printf("Error: -directory must be specified")
+		return 1
+	}
+	if serviceName == "" {
+		// when no Service is specified, only the API Versions available for the directory are listed
+		if err := c.listAPIVersions(directory); err != nil {
+			log.Printf("Error: %+v", err)
+			return 1
+		}
+		return 0
+	}
+	if terraformPackageName == "" {
+		terraformPackageName = strings.ToLower(serviceName)
+	}
+
+	if err := c.run(directory, serviceName, apiVersion, terraformPackageName, outputDirectory); err != nil {
+		log.Printf("Error: %+v", err)
+		return 1
+	}
+
+	return 0
+}
+
+// listAPIVersions lists the API Versions available within the specified directory, which is the first step
+// in scaffolding a new Service.
+func (c ScaffoldCommand) listAPIVersions(directory string) error {
+	apiVersions, err := c.discoverAPIVersions(directory)
+	if err != nil {
+		return err
+	}
+
+	logging.Infof("Found %d API Versions:", len(apiVersions))
+	for _, item := range apiVersions {
+		logging.Infof("* %s", item)
+	}
+	logging.Infof("When -api-version is omitted the API Version %q will be used.", *discovery.LatestAPIVersion(apiVersions))
+	logging.Infof("Re-run this command specifying -service (and optionally -api-version) to list the Resource IDs within an API Version.")
+	return nil
+}
+
+func (c ScaffoldCommand) discoverAPIVersions(directory string) ([]string, error) {
+	logging.Infof("Discovering the API Versions available for %q..", directory)
+	apiVersions, err := discovery.DiscoverAPIVersionsForService(directory, c.restAPISpecsRepositoryDirectoryPath, sdkModels.ResourceManagerSourceDataType)
+	if err != nil {
+		return nil, fmt.Errorf("discovering the API Versions for %q: %+v", directory, err)
+	}
+	if len(apiVersions) == 0 {
+		return nil, fmt.Errorf("no API Versions were found for %q", directory)
+	}
+	return apiVersions, nil
+}
+
+// run lists the Resource IDs within the API Version for this Service, which is the second step in scaffolding
+// a new Service - and writes out the Service Configuration and Terraform Resource Definitions for review.
+func (c ScaffoldCommand) run(directory, serviceName, apiVersion, terraformPackageName, outputDirectory string) error {
+	apiVersions, err := c.discoverAPIVersions(directory)
+	if err != nil {
+		return err
+	}
+
+	if apiVersion == "" {
+		apiVersion = *discovery.LatestAPIVersion(apiVersions)
+		logging.Infof("No API Version was specified - using %q", apiVersion)
+	} else if !containsAPIVersion(apiVersions, apiVersion) {
+		return fmt.Errorf("the API Version %q was not found for %q", apiVersion, directory)
+	}
+
+	service := services.Service{
+		Directory: directory,
+		Name:      serviceName,
+		Available: []string{apiVersion},
+	}
+
+	if err := os.MkdirAll(outputDirectory, 0755); err != nil {
+		return fmt.Errorf("creating the output directory %q: %+v", outputDirectory, err)
+	}
+
+	serviceConfigurationFilePath := filepath.Join(outputDirectory, "resource-manager.hcl")
+	if err := os.WriteFile(serviceConfigurationFilePath, scaffold.ServiceConfiguration(service), 0644); err != nil {
+		return fmt.Errorf("writing the Service Configuration to %q: %+v", serviceConfigurationFilePath, err)
+	}
+	logging.Infof("The Service Configuration has been written to %q for review.", serviceConfigurationFilePath)
+
+	logging.Infof("Parsing the API Version %q..", apiVersion)
+	dataSet, err := discovery.DiscoverForService(service, c.restAPISpecsRepositoryDirectoryPath, sdkModels.ResourceManagerSourceDataType)
+	if err != nil {
+		return fmt.Errorf("discovering the Data for Service %q: %+v", serviceName, err)
+	}
+	dataSetForAPIVersion, ok := dataSet.DataSetsForAPIVersions[apiVersion]
+	if !ok {
+		return fmt.Errorf("no Data was discovered for the API Version %q", apiVersion)
+	}
+	parsedAPIVersion, _, err := apidefinitions.ParseAPIVersion(serviceName, dataSetForAPIVersion, dataSet.ResourceProvider, apidefinitions.ParseAPIVersionOptions{
+		SourceDataType: dataSet.SourceDataType,
+	})
+	if err != nil {
+		return fmt.Errorf("parsing the API Version %q: %+v", apiVersion, err)
+	}
+
+	candidates, err := identification.CandidatesWithinAPIVersion(*parsedAPIVersion)
+	if err != nil {
+		return fmt.Errorf("identifying the Terraform Resource candidates within the API Version %q: %+v", apiVersion, err)
+	}
+	if len(candidates) == 0 {
+		logging.Infof("No Resource IDs within the API Version %q support the Operations required for a Terraform Resource.", apiVersion)
+		return nil
+	}
+
+	logging.Infof("Found %d Resource IDs which could be Terraform Resources:", len(candidates))
+	for _, candidate := range candidates {
+		supportsUpdate := "no"
+		if candidate.SupportsUpdate {
+			supportsUpdate = "yes"
+		}
+		logging.Infof("* %s / %s (Update: %s) - %s", candidate.APIResource, candidate.ResourceIDName, supportsUpdate, candidate.ResourceID.ExampleValue)
+	}
+
+	resources := scaffold.ResourceDefinitionsFromCandidates(serviceName, candidates)
+	resourceDefinitionsFilePath := filepath.Join(outputDirectory, fmt.Sprintf("%s.hcl", terraformPackageName))
+	if err := os.WriteFile(resourceDefinitionsFilePath, scaffold.ResourceDefinitionsConfiguration(serviceName, terraformPackageName, apiVersion, resources), 0644); err != nil {
+		return fmt.Errorf("writing the Terraform Resource Definitions to %q: %+v", resourceDefinitionsFilePath, err)
+	}
+	logging.Infof("The Terraform Resource Definitions have been written to %q for review.", resourceDefinitionsFilePath)
+
+	return nil
+}
+
+func containsAPIVersion(apiVersions []string, apiVersion string) bool {
+	for _, item := range apiVersions {
+		if item == apiVersion {
+			return true
+		}
+	}
+	return false
+}
+
+func (ScaffoldCommand) Synopsis() string {
+	return "Scaffolds the Service Configuration and Terraform Resource Definitions for a new Service"
+}
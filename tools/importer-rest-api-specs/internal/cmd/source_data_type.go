@@ -0,0 +1,24 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"fmt"
+
+	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+// configForSourceDataType returns the Source Data Type and the path to the Configuration File containing
+// the Services which should be imported for it.
+func configForSourceDataType(input, resourceManagerConfigPath, dataPlaneConfigPath string) (sdkModels.SourceDataType, string, error) {
+	switch sdkModels.SourceDataType(input) {
+	case sdkModels.DataPlaneSourceDataType:
+		return sdkModels.DataPlaneSourceDataType, dataPlaneConfigPath, nil
+
+	case sdkModels.ResourceManagerSourceDataType:
+		return sdkModels.ResourceManagerSourceDataType, resourceManagerConfigPath, nil
+	}
+
+	return "", "", fmt.Errorf("unsupported Source Data Type %q - expected either %q or %q", input, string(sdkModels.ResourceManagerSourceDataType), string(sdkModels.DataPlaneSourceDataType))
+}
@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/hashicorp/pandora/tools/data-api-repository/repository"
+	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/commonidcandidates"
+	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/logging"
+	"github.com/mitchellh/cli"
+)
+
+var _ cli.Command = SuggestCommonIDsCommand{}
+
+func NewSuggestCommonIDsCommand(apiDefinitionsDirectory string) func() (cli.Command, error) {
+	return func() (cli.Command, error) {
+		return SuggestCommonIDsCommand{
+			apiDefinitionsDirectory: apiDefinitionsDirectory,
+		}, nil
+	}
+}
+
+type SuggestCommonIDsCommand struct {
+	apiDefinitionsDirectory string
+}
+
+func (SuggestCommonIDsCommand) Help() string {
+	return `Suggest Common IDs loads the existing Resource Manager API Definitions and groups the Resource IDs used
+across each of the Services - outputting those used in at least -min-services Services which aren't already
+a Common ID, along with a suggested name for each.
+
+A Go file containing a skeleton for each of the suggested Common IDs is written to the path specified in -output-file,
+which is intended to be reviewed before moving each Common ID into the 'commonids' package.
+`
+}
+
+func (c SuggestCommonIDsCommand) Run(args []string) int {
+	var minimumNumberOfServices int
+	var outputFilePath string
+
+	f := flag.NewFlagSet("importer-rest-api-specs", flag.ExitOnError)
+	f.IntVar(&minimumNumberOfServices, "min-services", 3, "The minimum number of Services which must use a Resource ID for it to be suggested as a Common ID")
+	f.StringVar(&outputFilePath, "output-file", "common_id_candidates.go", "The path to write the Go file containing a skeleton for each of the suggested Common IDs to")
+	f.Parse(args)
+
+	if err := c.run(minimumNumberOfServices, outputFilePath); err != nil {
+		log.Printf("Error: %+v", err)
+		return 1
+	}
+
+	return 0
+}
+
+func (c SuggestCommonIDsCommand) run(minimumNumberOfServices int, outputFilePath string) error {
+	logging.Infof("Loading the Resource Manager API Definitions from %q..", c.apiDefinitionsDirectory)
+	repo, err := repository.NewRepository(c.apiDefinitionsDirectory, sdkModels.ResourceManagerSourceDataType, nil, logging.Log)
+	if err != nil {
+		return fmt.Errorf("building repository: %+v", err)
+	}
+	services, err := repo.GetAllServices()
+	if err != nil {
+		return fmt.Errorf("loading the Services: %+v", err)
+	}
+	logging.Infof("Loaded %d Services.", len(*services))
+
+	candidates, err := commonidcandidates.FindCandidates(*services, minimumNumberOfServices)
+	if err != nil {
+		return fmt.Errorf("finding the Common ID Candidates: %+v", err)
+	}
+	if len(candidates) == 0 {
+		logging.Infof("No Resource IDs are used in %d or more Services which aren't already a Common ID.", minimumNumberOfServices)
+		return nil
+	}
+
+	logging.Infof("Found %d Resource IDs used in %d or more Services which aren't already a Common ID:", len(candidates), minimumNumberOfServices)
+	for _, candidate := range candidates {
+		logging.Infof("* %s (%d Services) - %s", candidate.SuggestedName, len(candidate.ServiceNames), candidate.NormalizedResourceID)
+	}
+
+	skeleton, err := commonidcandidates.GenerateSkeleton(candidates)
+	if err != nil {
+		return fmt.Errorf("generating the Common ID skeleton: %+v", err)
+	}
+	if err := os.WriteFile(outputFilePath, []byte(*skeleton), 0644); err != nil {
+		return fmt.Errorf("writing the Common ID skeleton to %q: %+v", outputFilePath, err)
+	}
+	logging.Infof("The Common ID skeleton has been written to %q for review.", outputFilePath)
+
+	return nil
+}
+
+func (SuggestCommonIDsCommand) Synopsis() string {
+	return "Suggests Resource IDs used across multiple Services which could become Common IDs"
+}
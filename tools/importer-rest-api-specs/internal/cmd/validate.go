@@ -6,16 +6,20 @@ package cmd
 import (
 	"flag"
 	"log"
+	"runtime"
 	"strings"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
 	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/pipeline"
 	"github.com/mitchellh/cli"
 )
 
-func NewValidateCommand(restAPISpecsRepositoryDirectoryPath, resourceManagerConfigPath, terraformDefinitionsPath string) func() (cli.Command, error) {
+func NewValidateCommand(restAPISpecsRepositoryDirectoryPath, resourceManagerConfigPath, dataPlaneConfigPath, terraformDefinitionsPath, dataWorkaroundsDirectory string) func() (cli.Command, error) {
 	return func() (cli.Command, error) {
 		return ValidateCommand{
+			dataPlaneConfigPath:                 dataPlaneConfigPath,
+			dataWorkaroundsDirectory:            dataWorkaroundsDirectory,
 			resourceManagerConfigPath:           resourceManagerConfigPath,
 			restAPISpecsRepositoryDirectoryPath: restAPISpecsRepositoryDirectoryPath,
 			terraformDefinitionsPath:            terraformDefinitionsPath,
@@ -26,22 +30,40 @@ func NewValidateCommand(restAPISpecsRepositoryDirectoryPath, resourceManagerConf
 var _ cli.Command = ValidateCommand{}
 
 type ValidateCommand struct {
+	dataPlaneConfigPath                 string
+	dataWorkaroundsDirectory            string
 	resourceManagerConfigPath           string
 	restAPISpecsRepositoryDirectoryPath string
 	terraformDefinitionsPath            string
 }
 
 func (ValidateCommand) Help() string {
-	return "Validates that the data within the './submodules/rest-api-specs' submodule can be parsed"
+	return `Validates that the data within the './submodules/rest-api-specs' submodule can be parsed.
+
+A summary of the Diagnostics raised whilst parsing each Service is output once the validation has completed - specify
+-diagnostics-directory to also write these to a JSON file per Service within that directory.
+`
 }
 
 func (c ValidateCommand) Run(args []string) int {
 	var serviceNamesRaw string
+	var sourceDataTypeRaw string
+	var detectStaleWorkarounds bool
+	var diagnosticsDirectory string
 
 	f := flag.NewFlagSet("importer-rest-api-specs", flag.ExitOnError)
 	f.StringVar(&serviceNamesRaw, "services", "", "A list of comma separated Service named from the Data API to validate")
+	f.BoolVar(&detectStaleWorkarounds, "detect-stale-workarounds", false, "Report the Data Workarounds which made no changes to the API Version they were applied to")
+	f.StringVar(&diagnosticsDirectory, "diagnostics-directory", "", "An optional path to a directory to write a JSON file containing the Diagnostics raised whilst parsing each Service to")
+	f.StringVar(&sourceDataTypeRaw, "source-data-type", string(sdkModels.ResourceManagerSourceDataType), "The Source Data Type to validate - either resource-manager (default) or data-plane")
 	f.Parse(args)
 
+	sourceDataType, configFilePath, err := configForSourceDataType(sourceDataTypeRaw, c.resourceManagerConfigPath, c.dataPlaneConfigPath)
+	if err != nil {
+		log.Printf("Error: %+v", err)
+		return 1
+	}
+
 	var serviceNames []string
 	if serviceNamesRaw != "" {
 		serviceNames = strings.Split(serviceNamesRaw, ",")
@@ -49,14 +71,20 @@ func (c ValidateCommand) Run(args []string) int {
 
 	opts := pipeline.Options{
 		APIDefinitionsDirectory:       "", // not used for this
-		ConfigFilePath:                c.resourceManagerConfigPath,
+		ConfigFilePath:                configFilePath,
+		DataWorkaroundsDirectory:      c.dataWorkaroundsDirectory,
+		DetectStaleWorkarounds:        detectStaleWorkarounds,
+		Parallelism:                   runtime.NumCPU(),
 		ProviderPrefix:                "azurerm",
 		RestAPISpecsDirectory:         c.restAPISpecsRepositoryDirectoryPath,
 		ServiceNamesToLimitTo:         serviceNames,
 		SourceDataOrigin:              sdkModels.AzureRestAPISpecsSourceDataOrigin,
-		SourceDataType:                sdkModels.ResourceManagerSourceDataType,
+		SourceDataType:                sourceDataType,
 		TerraformDefinitionsDirectory: c.terraformDefinitionsPath,
 	}
+	if diagnosticsDirectory != "" {
+		opts.DiagnosticsDirectory = pointer.To(diagnosticsDirectory)
+	}
 	if err := pipeline.RunValidate(opts); err != nil {
 		log.Printf("Error: %+v", err)
 		return 1
@@ -19,7 +19,7 @@ func TestDebugSingleSwaggerFile(t *testing.T) {
 			"/path/to/submodules/rest-api-specs/specification/storagecache/resource-manager/Microsoft.StorageCache/stable/2023-05-01/amlfilesystem.json",
 		},
 	}
-	result, err := parseAPIVersion("StorageCache", input, pointer.To("Microsoft.StorageCache"))
+	result, _, err := ParseAPIVersion("StorageCache", input, pointer.To("Microsoft.StorageCache"), ParseAPIVersionOptions{})
 	if err != nil {
 		t.Fatalf(err.Error())
 	}
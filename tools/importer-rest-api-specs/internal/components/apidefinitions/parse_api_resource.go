@@ -10,13 +10,14 @@ import (
 	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/apidefinitions/parser"
 	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/apidefinitions/parser/cleanup"
 	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/apidefinitions/parser/combine"
+	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/apidefinitions/parser/diagnostics"
 	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/apidefinitions/parser/ignore"
 	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/apidefinitions/parser/resourceids"
 	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/logging"
 )
 
-func parseAPIResourcesFromFile(filePath, serviceName string, resourceProvider *string, parsedAPIResources map[string]sdkModels.APIResource, resourceIds resourceids.ParseResult) (map[string]sdkModels.APIResource, error) {
-	parser, err := parser.NewAPIDefinitionsParser(filePath)
+func parseAPIResourcesFromFile(filePath, serviceName string, resourceProvider *string, parsedAPIResources map[string]sdkModels.APIResource, resourceIds resourceids.ParseResult, collector *diagnostics.Collector) (map[string]sdkModels.APIResource, error) {
+	parser, err := parser.NewAPIDefinitionsParser(filePath, collector)
 	if err != nil {
 		return nil, fmt.Errorf("parsing the API Definitions within %q: %+v", filePath, err)
 	}
@@ -10,13 +10,30 @@ import (
 	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/apidefinitions/parser"
 	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/apidefinitions/parser/cleanup"
 	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/apidefinitions/parser/dataworkarounds"
+	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/apidefinitions/parser/diagnostics"
 	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/apidefinitions/parser/resourceids"
 	discoveryModels "github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/discovery/models"
 	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/logging"
 )
 
-// parseAPIVersion parses the information for this APIVersion from the AvailableDataSetForAPIVersion.
-func parseAPIVersion(serviceName string, input discoveryModels.AvailableDataSetForAPIVersion, resourceProvider *string) (*sdkModels.APIVersion, error) {
+// ParseAPIVersionOptions specifies the optional behaviours used when parsing an APIVersion.
+type ParseAPIVersionOptions struct {
+	// DetectStaleWorkarounds specifies whether each applied Data Workaround should also record whether it made
+	// any changes to the APIVersion.
+	DetectStaleWorkarounds bool
+
+	// Diagnostics is an optional Collector which any Diagnostics raised whilst parsing are recorded in.
+	Diagnostics *diagnostics.Collector
+
+	// SourceDataType specifies the Source Data Type of the API Definitions being parsed. Data Plane APIs are
+	// addressed by paths relative to the (Parameterized) Host rather than by Resource IDs, so Resource IDs
+	// are only parsed when this isn't the DataPlaneSourceDataType.
+	SourceDataType sdkModels.SourceDataType
+}
+
+// ParseAPIVersion parses the information for this APIVersion from the AvailableDataSetForAPIVersion - returning
+// the parsed APIVersion and any Data Workarounds which were applied to it.
+func ParseAPIVersion(serviceName string, input discoveryModels.AvailableDataSetForAPIVersion, resourceProvider *string, opts ParseAPIVersionOptions) (*sdkModels.APIVersion, []dataworkarounds.AppliedWorkaround, error) {
 	// First we need to pull out a list of each of the Resource IDs within this API Version
 	// This is required to ensure we have consistent naming of these across the API Version which
 	// makes for a better user experience
@@ -25,20 +42,43 @@ func parseAPIVersion(serviceName string, input discoveryModels.AvailableDataSetF
 		NamesToResourceIDs:              make(map[string]sdkModels.ResourceID),
 		Constants:                       make(map[string]sdkModels.SDKConstant),
 	}
+	var parameterizedHost *sdkModels.ParameterizedHost
 	for _, filePath := range input.FilePathsContainingAPIDefinitions {
 		logging.Tracef("Loading the Resource IDs from %q..", filePath)
-		parser, err := parser.NewAPIDefinitionsParser(filePath)
+		parser, err := parser.NewAPIDefinitionsParser(filePath, opts.Diagnostics)
 		if err != nil {
-			return nil, fmt.Errorf("parsing the API Definitions within %q: %+v", filePath, err)
+			return nil, nil, fmt.Errorf("parsing the API Definitions within %q: %+v", filePath, err)
 		}
-		parsedResourceIds, err := parser.ParseResourceIds()
-		if err != nil {
-			return nil, fmt.Errorf("parsing the Resource IDs from %q: %+v", filePath, err)
+		var parsedResourceIds *resourceids.ParseResult
+		if opts.SourceDataType == sdkModels.DataPlaneSourceDataType {
+			parsedResourceIds, err = parser.ParseRelativeURIs()
+			if err != nil {
+				return nil, nil, fmt.Errorf("parsing the relative URIs from %q: %+v", filePath, err)
+			}
+		} else {
+			parsedResourceIds, err = parser.ParseResourceIds()
+			if err != nil {
+				return nil, nil, fmt.Errorf("parsing the Resource IDs from %q: %+v", filePath, err)
+			}
 		}
 		if err := foundResourceIDs.Append(*parsedResourceIds); err != nil {
-			return nil, fmt.Errorf("appending the Resource IDs from %q: %+v", filePath, err)
+			return nil, nil, fmt.Errorf("appending the Resource IDs from %q: %+v", filePath, err)
 		}
 		logging.Tracef("Load the Resource IDs from %q - Completed.", filePath)
+
+		// Data Plane APIs define the Host which each Operation is relative to via `x-ms-parameterized-host`
+		// which is expected to be consistent across the API Version, so we use the first one defined.
+		host, err := parser.ParseParameterizedHost()
+		if err != nil {
+			return nil, nil, fmt.Errorf("parsing the Parameterized Host from %q: %+v", filePath, err)
+		}
+		if host != nil {
+			if parameterizedHost == nil {
+				parameterizedHost = host
+			} else if host.HostTemplate != parameterizedHost.HostTemplate {
+				logging.Warnf("The Parameterized Host %q within %q differs from %q used for API Version %q - using the latter", host.HostTemplate, filePath, parameterizedHost.HostTemplate, input.APIVersion)
+			}
+		}
 	}
 	logging.Tracef("Loaded a total of %d Resource IDs (with %d Constants)", len(foundResourceIDs.NamesToResourceIDs), len(foundResourceIDs.Constants))
 
@@ -47,8 +87,8 @@ func parseAPIVersion(serviceName string, input discoveryModels.AvailableDataSetF
 	for _, filePath := range input.FilePathsContainingAPIDefinitions {
 		logging.Tracef("Processing API Definitions from file %q..", filePath)
 		var err error
-		if apiResources, err = parseAPIResourcesFromFile(filePath, serviceName, resourceProvider, apiResources, foundResourceIDs); err != nil {
-			return nil, fmt.Errorf("parsing the APIResources from the API Definitions within %q: %+v", filePath, err)
+		if apiResources, err = parseAPIResourcesFromFile(filePath, serviceName, resourceProvider, apiResources, foundResourceIDs, opts.Diagnostics); err != nil {
+			return nil, nil, fmt.Errorf("parsing the APIResources from the API Definitions within %q: %+v", filePath, err)
 		}
 
 		logging.Tracef("There are now %d APIResources", len(apiResources))
@@ -71,18 +111,19 @@ func parseAPIVersion(serviceName string, input discoveryModels.AvailableDataSetF
 	}
 
 	apiVersion := sdkModels.APIVersion{
-		APIVersion: input.APIVersion,
-		Generate:   true,
-		Preview:    !input.ContainsStableAPIVersion,
-		Resources:  apiResources,
-		Source:     sdkModels.AzureRestAPISpecsSourceDataOrigin,
+		APIVersion:        input.APIVersion,
+		Generate:          true,
+		ParameterizedHost: parameterizedHost,
+		Preview:           !input.ContainsStableAPIVersion,
+		Resources:         apiResources,
+		Source:            sdkModels.AzureRestAPISpecsSourceDataOrigin,
 	}
 
 	// Next let's apply any data workarounds
 	logging.Debugf("Applying Data Workarounds..")
-	withFixesApplied, err := dataworkarounds.Apply(serviceName, apiVersion)
+	withFixesApplied, workaroundsApplied, err := dataworkarounds.Apply(serviceName, apiVersion, opts.DetectStaleWorkarounds)
 	if err != nil {
-		return nil, fmt.Errorf("applying Data Workarounds for Service %q / API Version %q: %+v", serviceName, input.APIVersion, err)
+		return nil, nil, fmt.Errorf("applying Data Workarounds for Service %q / API Version %q: %+v", serviceName, input.APIVersion, err)
 	}
 	logging.Debugf("Applying Data Workarounds - Complete.")
 
@@ -91,5 +132,5 @@ func parseAPIVersion(serviceName string, input discoveryModels.AvailableDataSetF
 	output := cleanup.RemoveUnusedItems(*withFixesApplied)
 	logging.Debugf("Removing unused items - Complete.")
 
-	return &output, nil
+	return &output, workaroundsApplied, nil
 }
@@ -24,7 +24,9 @@ func ParseService(input discoveryModels.AvailableDataSet) (*sdkModels.Service, e
 
 	for apiVersionName, dataSet := range input.DataSetsForAPIVersions {
 		logging.Infof("Parsing Data for API Version %q..", apiVersionName)
-		parsed, err := parseAPIVersion(input.ServiceName, dataSet, input.ResourceProvider)
+		parsed, _, err := ParseAPIVersion(input.ServiceName, dataSet, input.ResourceProvider, ParseAPIVersionOptions{
+			SourceDataType: input.SourceDataType,
+		})
 		if err != nil {
 			return nil, fmt.Errorf("parsing API Version %q: %+v", apiVersionName, err)
 		}
@@ -19,6 +19,10 @@ import (
 type ParsedConstant struct {
 	Name    string
 	Details sdkModels.SDKConstant
+
+	// WithoutXMSEnum specifies that this Constant has no (valid) `x-ms-enum` extension, and as such
+	// the name of this Constant has been inferred from the field name.
+	WithoutXMSEnum bool
 }
 
 func Parse(typeVal spec.StringOrArray, fieldName string, modelName *string, values []interface{}, extensions spec.Extensions) (*ParsedConstant, error) {
@@ -73,6 +77,7 @@ func Parse(typeVal spec.StringOrArray, fieldName string, modelName *string, valu
 			Values: keysAndValues,
 			Type:   constantType,
 		},
+		WithoutXMSEnum: constExtension == nil,
 	}, nil
 }
 
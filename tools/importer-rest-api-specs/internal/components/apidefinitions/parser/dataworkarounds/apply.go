@@ -4,35 +4,90 @@
 package dataworkarounds
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
 	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/logging"
 )
 
+// AppliedWorkaround describes a Data Workaround which was applied to an API Version.
+type AppliedWorkaround struct {
+	// Name is the name of the Data Workaround.
+	Name string
+
+	// PullRequest is a link to the upstream Pull Request referenced by this Data Workaround, where known.
+	PullRequest *string
+
+	// MadeChanges specifies whether this Data Workaround changed the API Version - this is only determined
+	// when detecting stale Data Workarounds, and is otherwise nil.
+	MadeChanges *bool
+}
+
+// IsStale returns whether this Data Workaround was found to make no changes to the API Version, meaning that
+// it's likely been fixed upstream and can be removed.
+func (w AppliedWorkaround) IsStale() bool {
+	return w.MadeChanges != nil && !*w.MadeChanges
+}
+
 // Apply goes through and determines if any workarounds are required for the Service/API Version
 // and applies those - which allows for patching API Definitions to workaround issues; for example a correctness
 // issue (a field is defined as an Integer rather than a String), or adding/removing Fields/Models/Constants etc.
 //
 // These workarounds are intended as a short-term workaround only - so we'll want to ensure there's an accompanying
 // pull request to fix the issues in question - else we'll end up diverging overtime/this could become problematic.
-func Apply(serviceName string, input sdkModels.APIVersion) (*sdkModels.APIVersion, error) {
+//
+// The Data Workarounds which have been applied are returned alongside the updated API Version. When
+// detectStaleWorkarounds is true, the API Version is compared before and after each Data Workaround is applied,
+// to determine whether the Data Workaround made any changes.
+func Apply(serviceName string, input sdkModels.APIVersion, detectStaleWorkarounds bool) (*sdkModels.APIVersion, []AppliedWorkaround, error) {
 	logging.Debugf("Applying Data Workarounds to the API Version %q..", input.APIVersion)
 	output := input
-	for _, fix := range workarounds {
+	applied := make([]AppliedWorkaround, 0)
+	// the Declarative Workarounds are applied after those defined in Go
+	allWorkarounds := append(append([]workaround{}, workarounds...), declarativeWorkarounds...)
+	for _, fix := range allWorkarounds {
 		if !fix.IsApplicable(serviceName, output) {
 			logging.Tracef("Data Workaround %q is not applicable - skipping..", fix.Name())
 			continue
 		}
 
+		// NOTE: the workarounds update the maps within the API Version in-place, so this needs to be
+		// serialized prior to the workaround being applied
+		var before []byte
+		if detectStaleWorkarounds {
+			var err error
+			if before, err = json.Marshal(output); err != nil {
+				return nil, nil, fmt.Errorf("serializing the API Version %q prior to applying the Data Workaround %q: %+v", input.APIVersion, fix.Name(), err)
+			}
+		}
+
 		logging.Tracef("Applying Data Workaround %q..", fix.Name())
 		updated, err := fix.Process(output)
 		if err != nil {
-			return nil, fmt.Errorf("applying Swagger Data Workaround %q to Service %q / API Version %q: %+v", fix.Name(), serviceName, input.APIVersion, err)
+			return nil, nil, fmt.Errorf("applying Swagger Data Workaround %q to Service %q / API Version %q: %+v", fix.Name(), serviceName, input.APIVersion, err)
 		}
 		output = *updated
+
+		item := AppliedWorkaround{
+			Name:        fix.Name(),
+			PullRequest: pullRequestForWorkaround(fix),
+		}
+		if detectStaleWorkarounds {
+			after, err := json.Marshal(output)
+			if err != nil {
+				return nil, nil, fmt.Errorf("serializing the API Version %q after applying the Data Workaround %q: %+v", input.APIVersion, fix.Name(), err)
+			}
+			item.MadeChanges = pointer.To(!bytes.Equal(before, after))
+			if item.IsStale() {
+				logging.Warnf("The Data Workaround %q made no changes to Service %q / API Version %q and may be stale", fix.Name(), serviceName, input.APIVersion)
+			}
+		}
+		applied = append(applied, item)
 		logging.Tracef("Applying Data Workaround %q - Completed", fix.Name())
 	}
 	logging.Debugf("Applying Data Workarounds to the API Version %q - Completed", input.APIVersion)
-	return &output, nil
+	return &output, applied, nil
 }
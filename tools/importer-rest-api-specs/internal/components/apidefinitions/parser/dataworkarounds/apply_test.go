@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dataworkarounds
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+func TestApplyDetectsStaleWorkarounds(t *testing.T) {
+	existing := declarativeWorkarounds
+	defer func() {
+		declarativeWorkarounds = existing
+	}()
+	declarativeWorkarounds = []workaround{
+		declarativeWorkaround{
+			definition: declarativeWorkaroundDefinition{
+				Name:        "applies",
+				Service:     "Example",
+				PullRequest: "https://github.com/Azure/azure-rest-api-specs/pull/1",
+				SetFields: []setFieldDefinition{
+					{
+						Model:    "Example",
+						Field:    "Name",
+						Required: pointer.To(true),
+					},
+				},
+			},
+		},
+		declarativeWorkaround{
+			definition: declarativeWorkaroundDefinition{
+				Name:        "stale",
+				Service:     "Example",
+				PullRequest: "https://github.com/Azure/azure-rest-api-specs/pull/2",
+				AddConstantValues: []addConstantValuesDefinition{
+					{
+						Constant: "ExampleType",
+						Values: map[string]string{
+							"First": "first",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	input := sdkModels.APIVersion{
+		APIVersion: "2020-01-01",
+		Resources: map[string]sdkModels.APIResource{
+			"Example": {
+				Constants: map[string]sdkModels.SDKConstant{
+					"ExampleType": {
+						Type: sdkModels.StringSDKConstantType,
+						Values: map[string]string{
+							"First": "first",
+						},
+					},
+				},
+				Models: map[string]sdkModels.SDKModel{
+					"Example": {
+						Fields: map[string]sdkModels.SDKField{
+							"Name": {
+								JsonName:         "name",
+								ObjectDefinition: sdkModels.SDKObjectDefinition{Type: sdkModels.StringSDKObjectDefinitionType},
+								Optional:         true,
+							},
+						},
+					},
+				},
+				Operations:  map[string]sdkModels.SDKOperation{},
+				ResourceIDs: map[string]sdkModels.ResourceID{},
+			},
+		},
+	}
+
+	_, applied, err := Apply("Example", input, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	appliedWorkarounds := make(map[string]AppliedWorkaround)
+	for _, item := range applied {
+		if item.MadeChanges == nil {
+			t.Fatalf("expected MadeChanges to be determined for %q but it was nil", item.Name)
+		}
+		appliedWorkarounds[item.Name] = item
+	}
+
+	actual, ok := appliedWorkarounds["Example / applies"]
+	if !ok {
+		t.Fatalf("expected the workaround `Example / applies` to be applied but it wasn't")
+	}
+	if actual.IsStale() {
+		t.Fatalf("expected the workaround `Example / applies` not to be stale")
+	}
+
+	actual, ok = appliedWorkarounds["Example / stale"]
+	if !ok {
+		t.Fatalf("expected the workaround `Example / stale` to be applied but it wasn't")
+	}
+	if !actual.IsStale() {
+		t.Fatalf("expected the workaround `Example / stale` to be stale")
+	}
+	if pointer.From(actual.PullRequest) != "https://github.com/Azure/azure-rest-api-specs/pull/2" {
+		t.Fatalf("expected the Pull Request to be `https://github.com/Azure/azure-rest-api-specs/pull/2` but got %q", pointer.From(actual.PullRequest))
+	}
+}
+
+func TestApplyWithoutDetectingStaleWorkarounds(t *testing.T) {
+	input := sdkModels.APIVersion{
+		APIVersion: "2020-01-01",
+		Resources:  map[string]sdkModels.APIResource{},
+	}
+	_, applied, err := Apply("Example", input, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	for _, item := range applied {
+		if item.MadeChanges != nil {
+			t.Fatalf("expected MadeChanges to be nil for %q when not detecting stale workarounds", item.Name)
+		}
+	}
+}
+
+func TestPullRequestForWorkaround(t *testing.T) {
+	if actual := pointer.From(pullRequestForWorkaround(workaroundRedis22407{})); actual != "https://github.com/Azure/azure-rest-api-specs/pull/22407" {
+		t.Fatalf("expected the Pull Request for `Redis / 22407` to be `https://github.com/Azure/azure-rest-api-specs/pull/22407` but got %q", actual)
+	}
+	if actual := pullRequestForWorkaround(workaroundInvalidGoPackageNames{}); actual != nil {
+		t.Fatalf("expected no Pull Request for %q but got %q", workaroundInvalidGoPackageNames{}.Name(), *actual)
+	}
+}
@@ -0,0 +1,248 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dataworkarounds
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/gohcl"
+	"github.com/hashicorp/hcl/v2/hclparse"
+	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+// declarativeWorkaroundsFile defines the contents of a file containing one or more Declarative Workarounds.
+type declarativeWorkaroundsFile struct {
+	Workarounds []declarativeWorkaroundDefinition `hcl:"workaround,block"`
+}
+
+// declarativeWorkaroundDefinition defines a Data Workaround which is applied to the parsed API Definitions for a
+// Service (and optionally specific API Versions/API Resource), rather than being implemented as Go code.
+type declarativeWorkaroundDefinition struct {
+	// Name is a unique name for this Workaround (e.g. `batch-21291`).
+	Name string `hcl:"name,label"`
+
+	// Service is the name of the Service which this Workaround applies to (e.g. `Batch`).
+	Service string `hcl:"service"`
+
+	// APIVersions is an optional list of API Versions which this Workaround applies to - when
+	// unspecified this Workaround applies to all API Versions for this Service.
+	APIVersions *[]string `hcl:"api_versions"`
+
+	// Resource is the optional name of the API Resource which this Workaround applies to - when
+	// unspecified this Workaround applies to each API Resource containing the items being patched.
+	Resource *string `hcl:"resource"`
+
+	// PullRequest is a link to the upstream Pull Request fixing the issue being worked around.
+	PullRequest string `hcl:"pull_request"`
+
+	// Description is an optional description of the issue being worked around.
+	Description *string `hcl:"description"`
+
+	// NOTE: the operations are applied in the order below, rather than the order they're defined in the file.
+
+	ChangeFieldTypes                []changeFieldTypeDefinition                `hcl:"change_field_type,block"`
+	SetFields                       []setFieldDefinition                       `hcl:"set_field,block"`
+	AddConstantValues               []addConstantValuesDefinition              `hcl:"add_constant_values,block"`
+	RemoveConstantValues            []removeConstantValuesDefinition           `hcl:"remove_constant_values,block"`
+	RenameResourceIDSegments        []renameResourceIDSegmentDefinition        `hcl:"rename_resource_id_segment,block"`
+	AddDiscriminatedImplementations []addDiscriminatedImplementationDefinition `hcl:"add_discriminated_implementation,block"`
+}
+
+// changeFieldTypeDefinition changes the Type of the specified Field within a Model.
+type changeFieldTypeDefinition struct {
+	Model string `hcl:"model"`
+	Field string `hcl:"field"`
+
+	// Type is the SDKObjectDefinitionType which this Field should become (e.g. `String` or `Reference`).
+	Type string `hcl:"type"`
+
+	// Reference is the name of the Constant/Model which is referenced when Type is `Reference`.
+	Reference *string `hcl:"reference"`
+}
+
+// setFieldDefinition updates whether the specified Field within a Model is Required, Optional and/or ReadOnly.
+type setFieldDefinition struct {
+	Model string `hcl:"model"`
+	Field string `hcl:"field"`
+
+	// Required specifies whether this Field should be Required - when Optional is unspecified, this
+	// Field becomes Optional when it's no longer Required (and vice versa).
+	Required *bool `hcl:"required"`
+	Optional *bool `hcl:"optional"`
+	ReadOnly *bool `hcl:"read_only"`
+}
+
+// addConstantValuesDefinition adds the specified Values to an existing Constant.
+type addConstantValuesDefinition struct {
+	Constant string `hcl:"constant"`
+
+	// Values is a map of Key (e.g. `Hadoop`) to Value (e.g. `HADOOP`).
+	Values map[string]string `hcl:"values"`
+}
+
+// removeConstantValuesDefinition removes the Values with the specified Keys from an existing Constant.
+type removeConstantValuesDefinition struct {
+	Constant string   `hcl:"constant"`
+	Keys     []string `hcl:"keys"`
+}
+
+// renameResourceIDSegmentDefinition renames a (User Specified) Segment within the specified Resource ID.
+type renameResourceIDSegmentDefinition struct {
+	ResourceID string `hcl:"resource_id"`
+	From       string `hcl:"from"`
+	To         string `hcl:"to"`
+}
+
+// addDiscriminatedImplementationDefinition marks an existing Model as a Discriminated Implementation
+// of the specified Parent Model, which must be a Discriminated Parent Type.
+type addDiscriminatedImplementationDefinition struct {
+	ParentModel        string `hcl:"parent_model"`
+	Model              string `hcl:"model"`
+	DiscriminatedValue string `hcl:"discriminated_value"`
+}
+
+// LoadDeclarativeWorkarounds loads the Declarative Workarounds defined in the `*.hcl` and `*.json` files within
+// the specified directory, which are then applied alongside the Workarounds defined in Go.
+//
+// NOTE: this must be called prior to parsing any API Definitions, since the Workarounds are registered globally.
+func LoadDeclarativeWorkarounds(directory string) error {
+	if _, err := os.Stat(directory); os.IsNotExist(err) {
+		return nil
+	}
+
+	loaded, err := loadDeclarativeWorkaroundsFromFileSystem(os.DirFS(directory))
+	if err != nil {
+		return fmt.Errorf("loading the Declarative Workarounds from %q: %+v", directory, err)
+	}
+	declarativeWorkarounds = loaded
+	return nil
+}
+
+// declarativeWorkarounds contains the Declarative Workarounds which have been loaded.
+var declarativeWorkarounds = make([]workaround, 0)
+
+func loadDeclarativeWorkaroundsFromFileSystem(fileSystem fs.FS) ([]workaround, error) {
+	fileNames := make([]string, 0)
+	for _, pattern := range []string{"*.hcl", "*.json"} {
+		matches, err := fs.Glob(fileSystem, pattern)
+		if err != nil {
+			return nil, fmt.Errorf("finding the Declarative Workaround files: %+v", err)
+		}
+		fileNames = append(fileNames, matches...)
+	}
+	sort.Strings(fileNames)
+
+	output := make([]workaround, 0)
+	definedIn := make(map[string]string)
+	parser := hclparse.NewParser()
+	for _, fileName := range fileNames {
+		contents, err := fs.ReadFile(fileSystem, fileName)
+		if err != nil {
+			return nil, fmt.Errorf("reading %q: %+v", fileName, err)
+		}
+
+		var file *hcl.File
+		var diags hcl.Diagnostics
+		if strings.EqualFold(path.Ext(fileName), ".json") {
+			file, diags = parser.ParseJSON(contents, filepath.Base(fileName))
+		} else {
+			file, diags = parser.ParseHCL(contents, filepath.Base(fileName))
+		}
+		if diags.HasErrors() {
+			return nil, fmt.Errorf("parsing %q: %+v", fileName, diags.Error())
+		}
+
+		var decoded declarativeWorkaroundsFile
+		if diags := gohcl.DecodeBody(file.Body, nil, &decoded); diags.HasErrors() {
+			return nil, fmt.Errorf("decoding %q: %+v", fileName, diags.Error())
+		}
+
+		for _, item := range decoded.Workarounds {
+			if existing, ok := definedIn[item.Name]; ok {
+				return nil, fmt.Errorf("the Declarative Workaround %q is defined in both %q and %q", item.Name, existing, fileName)
+			}
+			definedIn[item.Name] = fileName
+
+			if err := item.validate(); err != nil {
+				return nil, fmt.Errorf("validating the Declarative Workaround %q defined in %q: %+v", item.Name, fileName, err)
+			}
+			output = append(output, declarativeWorkaround{
+				definition: item,
+			})
+		}
+	}
+
+	return output, nil
+}
+
+func (d declarativeWorkaroundDefinition) validate() error {
+	if d.Service == "" {
+		return fmt.Errorf("`service` must be specified")
+	}
+	if !strings.HasPrefix(d.PullRequest, "https://") {
+		return fmt.Errorf("`pull_request` must be a link to the upstream Pull Request but got %q", d.PullRequest)
+	}
+
+	operations := len(d.ChangeFieldTypes) + len(d.SetFields) + len(d.AddConstantValues) + len(d.RemoveConstantValues) + len(d.RenameResourceIDSegments) + len(d.AddDiscriminatedImplementations)
+	if operations == 0 {
+		return fmt.Errorf("at least one operation must be specified")
+	}
+
+	for _, item := range d.ChangeFieldTypes {
+		if !isValidSDKObjectDefinitionType(item.Type) {
+			return fmt.Errorf("`change_field_type` for the Field %q in Model %q: %q is not a supported type", item.Field, item.Model, item.Type)
+		}
+		isReference := item.Type == string(sdkModels.ReferenceSDKObjectDefinitionType)
+		if isReference != (item.Reference != nil) {
+			return fmt.Errorf("`change_field_type` for the Field %q in Model %q: `reference` must be specified only when `type` is %q", item.Field, item.Model, sdkModels.ReferenceSDKObjectDefinitionType)
+		}
+	}
+	for _, item := range d.SetFields {
+		if item.Required == nil && item.Optional == nil && item.ReadOnly == nil {
+			return fmt.Errorf("`set_field` for the Field %q in Model %q: at least one of `required`, `optional` or `read_only` must be specified", item.Field, item.Model)
+		}
+		if item.Required != nil && item.Optional != nil && *item.Required && *item.Optional {
+			return fmt.Errorf("`set_field` for the Field %q in Model %q: a Field cannot be both Required and Optional", item.Field, item.Model)
+		}
+	}
+	for _, item := range d.AddConstantValues {
+		if len(item.Values) == 0 {
+			return fmt.Errorf("`add_constant_values` for the Constant %q: at least one value must be specified", item.Constant)
+		}
+	}
+	for _, item := range d.RemoveConstantValues {
+		if len(item.Keys) == 0 {
+			return fmt.Errorf("`remove_constant_values` for the Constant %q: at least one key must be specified", item.Constant)
+		}
+	}
+
+	return nil
+}
+
+// supportedFieldTypes are the SDKObjectDefinitionTypes which a Field can be changed to using `change_field_type`.
+var supportedFieldTypes = []sdkModels.SDKObjectDefinitionType{
+	sdkModels.BooleanSDKObjectDefinitionType,
+	sdkModels.DateTimeSDKObjectDefinitionType,
+	sdkModels.FloatSDKObjectDefinitionType,
+	sdkModels.IntegerSDKObjectDefinitionType,
+	sdkModels.RawObjectSDKObjectDefinitionType,
+	sdkModels.ReferenceSDKObjectDefinitionType,
+	sdkModels.StringSDKObjectDefinitionType,
+}
+
+func isValidSDKObjectDefinitionType(input string) bool {
+	for _, v := range supportedFieldTypes {
+		if string(v) == input {
+			return true
+		}
+	}
+	return false
+}
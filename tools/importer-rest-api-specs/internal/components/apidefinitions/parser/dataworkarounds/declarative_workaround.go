@@ -0,0 +1,331 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dataworkarounds
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	sdkHelpers "github.com/hashicorp/pandora/tools/data-api-sdk/v1/helpers"
+	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/logging"
+)
+
+var _ workaround = declarativeWorkaround{}
+
+// declarativeWorkaround is a workaround which applies the operations defined in a Declarative Workaround file.
+type declarativeWorkaround struct {
+	definition declarativeWorkaroundDefinition
+}
+
+// declarativeOperation is a single operation within a Declarative Workaround, which is applied to an APIResource.
+type declarativeOperation interface {
+	// applyTo applies this operation to the APIResource, returning whether any changes were made.
+	applyTo(resource *sdkModels.APIResource) (bool, error)
+
+	// String returns a description of this operation, used when this operation no longer applies.
+	String() string
+}
+
+func (w declarativeWorkaround) IsApplicable(serviceName string, apiVersion sdkModels.APIVersion) bool {
+	if w.definition.Service != serviceName {
+		return false
+	}
+	if w.definition.APIVersions == nil {
+		return true
+	}
+	for _, v := range *w.definition.APIVersions {
+		if v == apiVersion.APIVersion {
+			return true
+		}
+	}
+	return false
+}
+
+func (w declarativeWorkaround) Name() string {
+	return fmt.Sprintf("%s / %s", w.definition.Service, w.definition.Name)
+}
+
+func (w declarativeWorkaround) Process(input sdkModels.APIVersion) (*sdkModels.APIVersion, error) {
+	resourceNames := make([]string, 0)
+	for resourceName := range input.Resources {
+		if w.definition.Resource == nil || *w.definition.Resource == resourceName {
+			resourceNames = append(resourceNames, resourceName)
+		}
+	}
+	sort.Strings(resourceNames)
+
+	appliedCount := 0
+	for _, operation := range w.definition.operations() {
+		applied := false
+		for _, resourceName := range resourceNames {
+			resource := input.Resources[resourceName]
+			changed, err := operation.applyTo(&resource)
+			if err != nil {
+				return nil, fmt.Errorf("applying %s to the API Resource %q: %+v", operation, resourceName, err)
+			}
+			if changed {
+				input.Resources[resourceName] = resource
+				applied = true
+			}
+		}
+
+		if !applied {
+			logging.Warnf("The Data Workaround %q no longer applies to API Version %q: %s - this may have been fixed by %s", w.Name(), input.APIVersion, operation, w.definition.PullRequest)
+			continue
+		}
+		appliedCount++
+	}
+
+	if appliedCount == 0 {
+		logging.Warnf("The Data Workaround %q no longer applies to API Version %q and can likely be removed (see %s)", w.Name(), input.APIVersion, w.definition.PullRequest)
+	}
+
+	return &input, nil
+}
+
+// operations returns the operations defined within this Declarative Workaround, in the order they should be applied.
+func (d declarativeWorkaroundDefinition) operations() []declarativeOperation {
+	output := make([]declarativeOperation, 0)
+	for _, item := range d.ChangeFieldTypes {
+		output = append(output, item)
+	}
+	for _, item := range d.SetFields {
+		output = append(output, item)
+	}
+	for _, item := range d.AddConstantValues {
+		output = append(output, item)
+	}
+	for _, item := range d.RemoveConstantValues {
+		output = append(output, item)
+	}
+	for _, item := range d.RenameResourceIDSegments {
+		output = append(output, item)
+	}
+	for _, item := range d.AddDiscriminatedImplementations {
+		output = append(output, item)
+	}
+	return output
+}
+
+func (d changeFieldTypeDefinition) applyTo(resource *sdkModels.APIResource) (bool, error) {
+	model, ok := resource.Models[d.Model]
+	if !ok {
+		return false, nil
+	}
+	field, ok := model.Fields[d.Field]
+	if !ok {
+		return false, nil
+	}
+
+	objectDefinition := sdkModels.SDKObjectDefinition{
+		Type:          sdkModels.SDKObjectDefinitionType(d.Type),
+		ReferenceName: d.Reference,
+	}
+	if field.ObjectDefinition.Type == objectDefinition.Type && pointer.From(field.ObjectDefinition.ReferenceName) == pointer.From(objectDefinition.ReferenceName) && field.ObjectDefinition.NestedItem == nil {
+		return false, nil
+	}
+	if objectDefinition.ReferenceName != nil {
+		_, isConstant := resource.Constants[*objectDefinition.ReferenceName]
+		_, isModel := resource.Models[*objectDefinition.ReferenceName]
+		if !isConstant && !isModel {
+			return false, fmt.Errorf("the Constant/Model %q referenced by the Field %q in Model %q was not found", *objectDefinition.ReferenceName, d.Field, d.Model)
+		}
+	}
+
+	field.ObjectDefinition = objectDefinition
+	if objectDefinition.Type == sdkModels.DateTimeSDKObjectDefinitionType {
+		field.DateFormat = pointer.To(sdkModels.RFC3339SDKDateFormat)
+	} else {
+		field.DateFormat = nil
+	}
+	model.Fields[d.Field] = field
+	resource.Models[d.Model] = model
+	return true, nil
+}
+
+func (d changeFieldTypeDefinition) String() string {
+	return fmt.Sprintf("`change_field_type` for the Field %q in Model %q", d.Field, d.Model)
+}
+
+func (d setFieldDefinition) applyTo(resource *sdkModels.APIResource) (bool, error) {
+	model, ok := resource.Models[d.Model]
+	if !ok {
+		return false, nil
+	}
+	field, ok := model.Fields[d.Field]
+	if !ok {
+		return false, nil
+	}
+
+	updated := field
+	if d.Required != nil {
+		updated.Required = *d.Required
+		updated.Optional = !*d.Required
+	}
+	if d.Optional != nil {
+		updated.Optional = *d.Optional
+		if d.Required == nil {
+			updated.Required = !*d.Optional
+		}
+	}
+	if d.ReadOnly != nil {
+		updated.ReadOnly = *d.ReadOnly
+	}
+	if updated.Required == field.Required && updated.Optional == field.Optional && updated.ReadOnly == field.ReadOnly {
+		return false, nil
+	}
+
+	model.Fields[d.Field] = updated
+	resource.Models[d.Model] = model
+	return true, nil
+}
+
+func (d setFieldDefinition) String() string {
+	return fmt.Sprintf("`set_field` for the Field %q in Model %q", d.Field, d.Model)
+}
+
+func (d addConstantValuesDefinition) applyTo(resource *sdkModels.APIResource) (bool, error) {
+	constant, ok := resource.Constants[d.Constant]
+	if !ok {
+		return false, nil
+	}
+
+	changed := false
+	values := make(map[string]string)
+	for k, v := range constant.Values {
+		values[k] = v
+	}
+	for k, v := range d.Values {
+		if existing, ok := values[k]; ok && existing == v {
+			continue
+		}
+		values[k] = v
+		changed = true
+	}
+	if !changed {
+		return false, nil
+	}
+
+	constant.Values = values
+	resource.Constants[d.Constant] = constant
+	return true, nil
+}
+
+func (d addConstantValuesDefinition) String() string {
+	return fmt.Sprintf("`add_constant_values` for the Constant %q", d.Constant)
+}
+
+func (d removeConstantValuesDefinition) applyTo(resource *sdkModels.APIResource) (bool, error) {
+	constant, ok := resource.Constants[d.Constant]
+	if !ok {
+		return false, nil
+	}
+
+	values := make(map[string]string)
+	for k, v := range constant.Values {
+		values[k] = v
+	}
+	changed := false
+	for _, key := range d.Keys {
+		if _, ok := values[key]; ok {
+			delete(values, key)
+			changed = true
+		}
+	}
+	if !changed {
+		return false, nil
+	}
+	if len(values) == 0 {
+		return false, fmt.Errorf("removing the values from the Constant %q would leave it without any values", d.Constant)
+	}
+
+	constant.Values = values
+	resource.Constants[d.Constant] = constant
+	return true, nil
+}
+
+func (d removeConstantValuesDefinition) String() string {
+	return fmt.Sprintf("`remove_constant_values` for the Constant %q", d.Constant)
+}
+
+func (d renameResourceIDSegmentDefinition) applyTo(resource *sdkModels.APIResource) (bool, error) {
+	id, ok := resource.ResourceIDs[d.ResourceID]
+	if !ok {
+		return false, nil
+	}
+
+	hasFrom := false
+	for _, segment := range id.Segments {
+		if segment.Name == d.From {
+			hasFrom = true
+		}
+	}
+	if !hasFrom {
+		return false, nil
+	}
+
+	changed := false
+	segments := make([]sdkModels.ResourceIDSegment, 0)
+	for _, segment := range id.Segments {
+		if segment.Name == d.To {
+			return false, fmt.Errorf("the Resource ID %q already contains a Segment named %q", d.ResourceID, d.To)
+		}
+		if segment.Name == d.From {
+			// the parser uses the Segment Name as the Example Value for User Specified Segments
+			if segment.ExampleValue == segment.Name {
+				segment.ExampleValue = d.To
+			}
+			segment.Name = d.To
+			changed = true
+		}
+		segments = append(segments, segment)
+	}
+	if !changed {
+		return false, nil
+	}
+
+	id.Segments = segments
+	id.ExampleValue = sdkHelpers.DisplayValueForResourceID(id)
+	resource.ResourceIDs[d.ResourceID] = id
+	return true, nil
+}
+
+func (d renameResourceIDSegmentDefinition) String() string {
+	return fmt.Sprintf("`rename_resource_id_segment` for the Segment %q in Resource ID %q", d.From, d.ResourceID)
+}
+
+func (d addDiscriminatedImplementationDefinition) applyTo(resource *sdkModels.APIResource) (bool, error) {
+	parent, ok := resource.Models[d.ParentModel]
+	if !ok {
+		return false, nil
+	}
+	model, ok := resource.Models[d.Model]
+	if !ok {
+		return false, nil
+	}
+	if !parent.IsDiscriminatedParentType() || parent.FieldNameContainingDiscriminatedValue == nil {
+		return false, fmt.Errorf("the Model %q is not a Discriminated Parent Type", d.ParentModel)
+	}
+
+	if pointer.From(model.ParentTypeName) == d.ParentModel && pointer.From(model.DiscriminatedValue) == d.DiscriminatedValue {
+		return false, nil
+	}
+	for name, other := range resource.Models {
+		if name != d.Model && pointer.From(other.ParentTypeName) == d.ParentModel && pointer.From(other.DiscriminatedValue) == d.DiscriminatedValue {
+			return false, fmt.Errorf("the Discriminated Value %q is already used by the Model %q", d.DiscriminatedValue, name)
+		}
+	}
+
+	model.ParentTypeName = pointer.To(d.ParentModel)
+	model.FieldNameContainingDiscriminatedValue = pointer.To(*parent.FieldNameContainingDiscriminatedValue)
+	model.DiscriminatedValue = pointer.To(d.DiscriminatedValue)
+	resource.Models[d.Model] = model
+	return true, nil
+}
+
+func (d addDiscriminatedImplementationDefinition) String() string {
+	return fmt.Sprintf("`add_discriminated_implementation` for the Model %q (of %q)", d.Model, d.ParentModel)
+}
@@ -0,0 +1,322 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dataworkarounds
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+func TestLoadDeclarativeWorkarounds(t *testing.T) {
+	fileSystem := fstest.MapFS{
+		"batch.hcl": &fstest.MapFile{
+			Data: []byte(`
+workaround "batch-21291" {
+  service      = "Batch"
+  api_versions = ["2022-01-01"]
+  pull_request = "https://github.com/Azure/azure-rest-api-specs/pull/21291"
+
+  change_field_type {
+    model = "Pool"
+    field = "Count"
+    type  = "Integer"
+  }
+}
+`),
+		},
+		"redis.json": &fstest.MapFile{
+			Data: []byte(`{
+  "workaround": {
+    "redis-22407": {
+      "service": "Redis",
+      "pull_request": "https://github.com/Azure/azure-rest-api-specs/pull/22407",
+      "add_constant_values": [
+        {
+          "constant": "SkuName",
+          "values": {"Enterprise": "Enterprise"}
+        }
+      ]
+    }
+  }
+}`),
+		},
+		"README.md": &fstest.MapFile{
+			Data: []byte("ignored"),
+		},
+	}
+
+	actual, err := loadDeclarativeWorkaroundsFromFileSystem(fileSystem)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if len(actual) != 2 {
+		t.Fatalf("expected 2 workarounds but got %d", len(actual))
+	}
+	if actual[0].Name() != "Batch / batch-21291" {
+		t.Fatalf("expected the first workaround to be `Batch / batch-21291` but got %q", actual[0].Name())
+	}
+	if actual[1].Name() != "Redis / redis-22407" {
+		t.Fatalf("expected the second workaround to be `Redis / redis-22407` but got %q", actual[1].Name())
+	}
+
+	if !actual[0].IsApplicable("Batch", sdkModels.APIVersion{APIVersion: "2022-01-01"}) {
+		t.Fatalf("expected the workaround to be applicable to Batch@2022-01-01")
+	}
+	if actual[0].IsApplicable("Batch", sdkModels.APIVersion{APIVersion: "2023-01-01"}) {
+		t.Fatalf("expected the workaround not to be applicable to Batch@2023-01-01")
+	}
+	if actual[0].IsApplicable("Redis", sdkModels.APIVersion{APIVersion: "2022-01-01"}) {
+		t.Fatalf("expected the workaround not to be applicable to Redis@2022-01-01")
+	}
+	if !actual[1].IsApplicable("Redis", sdkModels.APIVersion{APIVersion: "2099-01-01"}) {
+		t.Fatalf("expected the workaround to be applicable to all API Versions of Redis")
+	}
+}
+
+func TestLoadDeclarativeWorkaroundsInvalid(t *testing.T) {
+	testData := map[string]string{
+		"duplicate names": `
+workaround "example" {
+  service      = "Example"
+  pull_request = "https://github.com/Azure/azure-rest-api-specs/pull/1"
+  set_field {
+    model    = "Example"
+    field    = "Name"
+    required = true
+  }
+}
+workaround "example" {
+  service      = "Example"
+  pull_request = "https://github.com/Azure/azure-rest-api-specs/pull/2"
+  set_field {
+    model    = "Example"
+    field    = "Name"
+    required = true
+  }
+}
+`,
+		"missing pull request link": `
+workaround "example" {
+  service      = "Example"
+  pull_request = "#1"
+  set_field {
+    model    = "Example"
+    field    = "Name"
+    required = true
+  }
+}
+`,
+		"no operations": `
+workaround "example" {
+  service      = "Example"
+  pull_request = "https://github.com/Azure/azure-rest-api-specs/pull/1"
+}
+`,
+		"unsupported type": `
+workaround "example" {
+  service      = "Example"
+  pull_request = "https://github.com/Azure/azure-rest-api-specs/pull/1"
+  change_field_type {
+    model = "Example"
+    field = "Name"
+    type  = "List"
+  }
+}
+`,
+		"reference without a reference name": `
+workaround "example" {
+  service      = "Example"
+  pull_request = "https://github.com/Azure/azure-rest-api-specs/pull/1"
+  change_field_type {
+    model = "Example"
+    field = "Name"
+    type  = "Reference"
+  }
+}
+`,
+		"set field without any values": `
+workaround "example" {
+  service      = "Example"
+  pull_request = "https://github.com/Azure/azure-rest-api-specs/pull/1"
+  set_field {
+    model = "Example"
+    field = "Name"
+  }
+}
+`,
+	}
+	for name, contents := range testData {
+		t.Logf("Testing %q", name)
+		fileSystem := fstest.MapFS{
+			"example.hcl": &fstest.MapFile{
+				Data: []byte(contents),
+			},
+		}
+		if _, err := loadDeclarativeWorkaroundsFromFileSystem(fileSystem); err == nil {
+			t.Fatalf("expected an error for %q but didn't get one", name)
+		}
+	}
+}
+
+func TestDeclarativeWorkaroundProcess(t *testing.T) {
+	input := sdkModels.APIVersion{
+		APIVersion: "2022-01-01",
+		Resources: map[string]sdkModels.APIResource{
+			"Pools": {
+				Constants: map[string]sdkModels.SDKConstant{
+					"PoolType": {
+						Type: sdkModels.StringSDKConstantType,
+						Values: map[string]string{
+							"Basic":    "Basic",
+							"Standard": "Standard",
+						},
+					},
+				},
+				Models: map[string]sdkModels.SDKModel{
+					"Pool": {
+						Fields: map[string]sdkModels.SDKField{
+							"Count": {
+								JsonName:         "count",
+								ObjectDefinition: sdkModels.SDKObjectDefinition{Type: sdkModels.StringSDKObjectDefinitionType},
+								Optional:         true,
+							},
+						},
+					},
+					"Task": {
+						Fields: map[string]sdkModels.SDKField{
+							"Type": {
+								JsonName:         "type",
+								ObjectDefinition: sdkModels.SDKObjectDefinition{Type: sdkModels.StringSDKObjectDefinitionType},
+								Required:         true,
+							},
+						},
+						FieldNameContainingDiscriminatedValue: pointer.To("Type"),
+					},
+					"ShellTask": {
+						Fields: map[string]sdkModels.SDKField{
+							"Type": {
+								JsonName:         "type",
+								ObjectDefinition: sdkModels.SDKObjectDefinition{Type: sdkModels.StringSDKObjectDefinitionType},
+								Required:         true,
+							},
+						},
+					},
+				},
+				ResourceIDs: map[string]sdkModels.ResourceID{
+					"PoolId": {
+						ConstantNames: []string{},
+						Segments: []sdkModels.ResourceIDSegment{
+							sdkModels.NewStaticValueResourceIDSegment("staticPools", "pools"),
+							sdkModels.NewUserSpecifiedResourceIDSegment("poolName", "poolName"),
+						},
+					},
+				},
+			},
+		},
+	}
+	workaround := declarativeWorkaround{
+		definition: declarativeWorkaroundDefinition{
+			Name:        "batch-21291",
+			Service:     "Batch",
+			PullRequest: "https://github.com/Azure/azure-rest-api-specs/pull/21291",
+			ChangeFieldTypes: []changeFieldTypeDefinition{
+				{
+					Model: "Pool",
+					Field: "Count",
+					Type:  "Integer",
+				},
+			},
+			SetFields: []setFieldDefinition{
+				{
+					Model:    "Pool",
+					Field:    "Count",
+					Required: pointer.To(true),
+				},
+			},
+			AddConstantValues: []addConstantValuesDefinition{
+				{
+					Constant: "PoolType",
+					Values: map[string]string{
+						"Premium": "Premium",
+					},
+				},
+			},
+			RemoveConstantValues: []removeConstantValuesDefinition{
+				{
+					Constant: "PoolType",
+					Keys:     []string{"Basic"},
+				},
+			},
+			RenameResourceIDSegments: []renameResourceIDSegmentDefinition{
+				{
+					ResourceID: "PoolId",
+					From:       "poolName",
+					To:         "batchPoolName",
+				},
+			},
+			AddDiscriminatedImplementations: []addDiscriminatedImplementationDefinition{
+				{
+					ParentModel:        "Task",
+					Model:              "ShellTask",
+					DiscriminatedValue: "Shell",
+				},
+			},
+		},
+	}
+
+	output, err := workaround.Process(input)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	resource := output.Resources["Pools"]
+
+	count := resource.Models["Pool"].Fields["Count"]
+	if count.ObjectDefinition.Type != sdkModels.IntegerSDKObjectDefinitionType {
+		t.Fatalf("expected the Field `Count` to be an Integer but got %q", count.ObjectDefinition.Type)
+	}
+	if !count.Required || count.Optional {
+		t.Fatalf("expected the Field `Count` to be Required but got Required %t / Optional %t", count.Required, count.Optional)
+	}
+
+	expectedValues := map[string]string{
+		"Premium":  "Premium",
+		"Standard": "Standard",
+	}
+	actualValues := resource.Constants["PoolType"].Values
+	if len(actualValues) != len(expectedValues) {
+		t.Fatalf("expected the Constant `PoolType` to have %d values but got %d: %+v", len(expectedValues), len(actualValues), actualValues)
+	}
+	for k, v := range expectedValues {
+		if actualValues[k] != v {
+			t.Fatalf("expected the Constant `PoolType` to have the value %q for %q but got %q", v, k, actualValues[k])
+		}
+	}
+
+	id := resource.ResourceIDs["PoolId"]
+	if id.Segments[1].Name != "batchPoolName" {
+		t.Fatalf("expected the Segment to be renamed to `batchPoolName` but got %q", id.Segments[1].Name)
+	}
+	if id.ExampleValue != "/pools/{batchPoolName}" {
+		t.Fatalf("expected the Example Value to be `/pools/{batchPoolName}` but got %q", id.ExampleValue)
+	}
+
+	shellTask := resource.Models["ShellTask"]
+	if pointer.From(shellTask.ParentTypeName) != "Task" {
+		t.Fatalf("expected the Parent Type Name to be `Task` but got %q", pointer.From(shellTask.ParentTypeName))
+	}
+	if pointer.From(shellTask.DiscriminatedValue) != "Shell" {
+		t.Fatalf("expected the Discriminated Value to be `Shell` but got %q", pointer.From(shellTask.DiscriminatedValue))
+	}
+	if pointer.From(shellTask.FieldNameContainingDiscriminatedValue) != "Type" {
+		t.Fatalf("expected the Field Name Containing the Discriminated Value to be `Type` but got %q", pointer.From(shellTask.FieldNameContainingDiscriminatedValue))
+	}
+
+	// re-applying the workaround is a no-op, since each of the operations has already been applied
+	if _, err := workaround.Process(*output); err != nil {
+		t.Fatalf("unexpected error re-applying the workaround: %+v", err)
+	}
+}
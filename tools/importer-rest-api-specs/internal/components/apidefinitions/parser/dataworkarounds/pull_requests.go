@@ -0,0 +1,27 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dataworkarounds
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// workaroundNameReferencingPullRequest matches the names of Data Workarounds defined in Go which reference an
+// upstream Pull Request, for example `Redis / 22407`.
+var workaroundNameReferencingPullRequest = regexp.MustCompile(`^[A-Za-z]+ / ([0-9]+)$`)
+
+// pullRequestForWorkaround returns a link to the upstream Pull Request referenced by this Data Workaround, if any.
+func pullRequestForWorkaround(input workaround) *string {
+	if v, ok := input.(declarativeWorkaround); ok {
+		return &v.definition.PullRequest
+	}
+
+	matches := workaroundNameReferencingPullRequest.FindStringSubmatch(input.Name())
+	if len(matches) != 2 {
+		return nil
+	}
+	link := fmt.Sprintf("https://github.com/Azure/azure-rest-api-specs/pull/%s", matches[1])
+	return &link
+}
@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package diagnostics
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Collector collects the Diagnostics raised whilst parsing the API Definitions for a Service. Since the same
+// items can be parsed multiple times (e.g. once for each Swagger Tag), duplicate Diagnostics are only recorded once.
+//
+// A Collector is safe for concurrent use, so can be shared between each of the API Versions being parsed.
+type Collector struct {
+	diagnostics map[string]Diagnostic
+	lock        sync.Mutex
+}
+
+func NewCollector() *Collector {
+	return &Collector{
+		diagnostics: make(map[string]Diagnostic),
+	}
+}
+
+// Add records the Diagnostic, unless an identical Diagnostic has already been recorded.
+func (c *Collector) Add(input Diagnostic) {
+	key := fmt.Sprintf("%s|%s|%s|%s", input.Code, input.FilePath, input.JSONPointer, input.Message)
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.diagnostics[key] = input
+}
+
+// Diagnostics returns the Diagnostics which have been recorded, ordered by File Path, JSON Pointer and then Code.
+func (c *Collector) Diagnostics() []Diagnostic {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	output := make([]Diagnostic, 0)
+	for _, item := range c.diagnostics {
+		output = append(output, item)
+	}
+	sort.Slice(output, func(i, j int) bool {
+		if output[i].FilePath != output[j].FilePath {
+			return output[i].FilePath < output[j].FilePath
+		}
+		if output[i].JSONPointer != output[j].JSONPointer {
+			return output[i].JSONPointer < output[j].JSONPointer
+		}
+		if output[i].Code != output[j].Code {
+			return output[i].Code < output[j].Code
+		}
+		return output[i].Message < output[j].Message
+	})
+	return output
+}
+
+// JSONPointer returns a JSON Pointer (RFC 6901) as a URI Fragment for the specified reference tokens,
+// for example `#/definitions/Example/properties/name`.
+func JSONPointer(tokens ...string) string {
+	output := "#"
+	for _, token := range tokens {
+		token = strings.ReplaceAll(token, "~", "~0")
+		token = strings.ReplaceAll(token, "/", "~1")
+		output += "/" + token
+	}
+	return output
+}
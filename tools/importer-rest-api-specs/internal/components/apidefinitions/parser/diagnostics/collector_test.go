@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package diagnostics
+
+import (
+	"testing"
+)
+
+func TestCollectorDeduplicatesAndSorts(t *testing.T) {
+	collector := NewCollector()
+	second := Diagnostic{
+		Code:        OperationIgnoredCode,
+		Severity:    InfoSeverity,
+		FilePath:    "second.json",
+		JSONPointer: "#/paths/~1operations/get",
+		Message:     "ignored",
+	}
+	first := Diagnostic{
+		Code:        UnknownFormatCode,
+		Severity:    WarningSeverity,
+		FilePath:    "first.json",
+		JSONPointer: "#/definitions/Example/properties/value",
+		Message:     "unknown format",
+	}
+	collector.Add(second)
+	collector.Add(first)
+	collector.Add(second)
+
+	actual := collector.Diagnostics()
+	if len(actual) != 2 {
+		t.Fatalf("expected 2 Diagnostics but got %d: %+v", len(actual), actual)
+	}
+	if actual[0] != first {
+		t.Fatalf("expected the first Diagnostic to be %+v but got %+v", first, actual[0])
+	}
+	if actual[1] != second {
+		t.Fatalf("expected the second Diagnostic to be %+v but got %+v", second, actual[1])
+	}
+}
+
+func TestJSONPointer(t *testing.T) {
+	testData := []struct {
+		tokens   []string
+		expected string
+	}{
+		{
+			tokens:   []string{},
+			expected: "#",
+		},
+		{
+			tokens:   []string{"definitions", "Example", "properties", "name"},
+			expected: "#/definitions/Example/properties/name",
+		},
+		{
+			tokens:   []string{"paths", "/subscriptions/{subscriptionId}/providers/Microsoft.Example/operations", "get"},
+			expected: "#/paths/~1subscriptions~1{subscriptionId}~1providers~1Microsoft.Example~1operations/get",
+		},
+		{
+			tokens:   []string{"definitions", "Some~Model"},
+			expected: "#/definitions/Some~0Model",
+		},
+	}
+	for _, v := range testData {
+		actual := JSONPointer(v.tokens...)
+		if actual != v.expected {
+			t.Fatalf("expected %q but got %q", v.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package diagnostics
+
+type Code string
+
+const (
+	// ConstantWithoutXMSEnumCode specifies that a Constant was parsed from an `enum` which doesn't define a
+	// (valid) `x-ms-enum` extension - and so the name of the Constant was inferred from the field name.
+	// This is only possible when `featureflags.AllowConstantsWithoutXMSEnum` is enabled.
+	ConstantWithoutXMSEnumCode Code = "ConstantWithoutXMSEnum"
+
+	// InlinedModelRenamedCode specifies that a Model defined inline within another Model (or an array) has no
+	// name of its own - and so has been named after the Model and field which contain it.
+	InlinedModelRenamedCode Code = "InlinedModelRenamed"
+
+	// OperationIgnoredCode specifies that an Operation has been intentionally ignored, for example since it's
+	// used to poll a Long Running Operation.
+	OperationIgnoredCode Code = "OperationIgnored"
+
+	// UnknownFormatCode specifies that a field has a format (or no type) which isn't supported - and so it's
+	// been parsed as a RawObject instead.
+	UnknownFormatCode Code = "UnknownFormat"
+)
+
+type Severity string
+
+const (
+	// InfoSeverity specifies that the Diagnostic is informational and is expected to occur.
+	InfoSeverity Severity = "Info"
+
+	// WarningSeverity specifies that the Diagnostic likely indicates an issue with the API Definitions, which
+	// should be fixed upstream (or via a Data Workaround).
+	WarningSeverity Severity = "Warning"
+)
+
+// Diagnostic describes something notable which happened whilst parsing the API Definitions, which whilst not
+// an error, is useful to know about.
+type Diagnostic struct {
+	// Code is a machine-readable identifier for this type of Diagnostic.
+	Code Code `json:"code"`
+
+	// Severity specifies how important this Diagnostic is.
+	Severity Severity `json:"severity"`
+
+	// FilePath is the path to the Swagger file which this Diagnostic was raised for.
+	FilePath string `json:"filePath"`
+
+	// JSONPointer is a JSON Pointer (RFC 6901) to the item within the Swagger file which this Diagnostic was raised for,
+	// which is empty when the location isn't known (for example a Model defined inline or within another file).
+	JSONPointer string `json:"jsonPointer,omitempty"`
+
+	// Message is a human-readable description of this Diagnostic.
+	Message string `json:"message"`
+}
+
+func PossibleValuesForCode() []Code {
+	return []Code{
+		ConstantWithoutXMSEnumCode,
+		InlinedModelRenamedCode,
+		OperationIgnoredCode,
+		UnknownFormatCode,
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parser_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/apidefinitions"
+	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/apidefinitions/parser/diagnostics"
+	discoveryModels "github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/discovery/models"
+)
+
+func TestParsingRaisesDiagnostics(t *testing.T) {
+	filePath := filepath.Join("testdata", "diagnostics.json")
+	input := discoveryModels.AvailableDataSetForAPIVersion{
+		APIVersion: "2020-01-01",
+		FilePathsContainingAPIDefinitions: []string{
+			filePath,
+		},
+	}
+	collector := diagnostics.NewCollector()
+	if _, _, err := apidefinitions.ParseAPIVersion("Example", input, nil, apidefinitions.ParseAPIVersionOptions{Diagnostics: collector}); err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	expected := []struct {
+		code        diagnostics.Code
+		jsonPointer string
+	}{
+		{
+			code:        diagnostics.ConstantWithoutXMSEnumCode,
+			jsonPointer: "#/definitions/Example/properties/kind",
+		},
+		{
+			// the Model defined inline within `nested` isn't defined within `definitions`, so there's no JSON Pointer
+			code:        diagnostics.ConstantWithoutXMSEnumCode,
+			jsonPointer: "",
+		},
+		{
+			code:        diagnostics.InlinedModelRenamedCode,
+			jsonPointer: "#/definitions/Example/properties/nested",
+		},
+		{
+			code:        diagnostics.OperationIgnoredCode,
+			jsonPointer: "#/paths/~1providers~1Microsoft.Example~1operations/get",
+		},
+		{
+			code:        diagnostics.UnknownFormatCode,
+			jsonPointer: "#/definitions/Example/properties/settings",
+		},
+	}
+	actual := collector.Diagnostics()
+	if len(actual) != len(expected) {
+		t.Fatalf("expected %d Diagnostics but got %d: %+v", len(expected), len(actual), actual)
+	}
+	for _, v := range expected {
+		found := false
+		for _, item := range actual {
+			if item.Code != v.code || item.JSONPointer != v.jsonPointer {
+				continue
+			}
+			if item.FilePath != filePath {
+				t.Fatalf("expected the File Path for the Diagnostic %q to be %q but got %q", string(item.Code), filePath, item.FilePath)
+			}
+			found = true
+			break
+		}
+		if !found {
+			t.Fatalf("expected a Diagnostic %q with the JSON Pointer %q but didn't get one: %+v", string(v.code), v.jsonPointer, actual)
+		}
+	}
+}
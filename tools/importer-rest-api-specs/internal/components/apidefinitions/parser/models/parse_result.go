@@ -5,6 +5,7 @@ package models
 
 import (
 	"fmt"
+	"reflect"
 	"strings"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
@@ -118,6 +119,9 @@ func compareFields(first map[string]sdkModels.SDKField, second map[string]sdkMod
 		if firstVal.Sensitive != secondVal.Sensitive {
 			return fmt.Errorf("first.Sensitive was %t but second.Sensitive was %t", firstVal.Sensitive, secondVal.Sensitive)
 		}
+		if !reflect.DeepEqual(firstVal.Mutability, secondVal.Mutability) {
+			return fmt.Errorf("first.Mutability was %+v but second.Mutability was %+v", firstVal.Mutability, secondVal.Mutability)
+		}
 		if err := objectDefinitionsMatch(firstVal.ObjectDefinition, secondVal.ObjectDefinition); err != nil {
 			return fmt.Errorf("object definitions differ: %+v.\n\nFirst %+v\n\nSecond %+v", err, firstVal.ObjectDefinition, secondVal.ObjectDefinition)
 		}
@@ -93,6 +93,172 @@ func TestParseModelTopLevel(t *testing.T) {
 	testhelpers.ValidateParsedSwaggerResultMatches(t, expected, actual)
 }
 
+func TestParseModelWithSecret(t *testing.T) {
+	actual, err := testhelpers.ParseSwaggerFileForTesting(t, "model_with_secret.json", nil)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	expected := sdkModels.APIVersion{
+		APIVersion: "2020-01-01",
+		Resources: map[string]sdkModels.APIResource{
+			"Example": {
+				Models: map[string]sdkModels.SDKModel{
+					"Model": {
+						Fields: map[string]sdkModels.SDKField{
+							"Age": {
+								JsonName: "age",
+								ObjectDefinition: sdkModels.SDKObjectDefinition{
+									Type: sdkModels.IntegerSDKObjectDefinitionType,
+								},
+								Required: false,
+							},
+							"Enabled": {
+								JsonName: "enabled",
+								ObjectDefinition: sdkModels.SDKObjectDefinition{
+									Type: sdkModels.BooleanSDKObjectDefinitionType,
+								},
+								Required: false,
+							},
+							"Height": {
+								JsonName: "height",
+								ObjectDefinition: sdkModels.SDKObjectDefinition{
+									Type: sdkModels.FloatSDKObjectDefinitionType,
+								},
+								Required: false,
+							},
+							"Name": {
+								JsonName: "name",
+								ObjectDefinition: sdkModels.SDKObjectDefinition{
+									Type: sdkModels.StringSDKObjectDefinitionType,
+								},
+								Required: true,
+							},
+							"Password": {
+								JsonName: "password",
+								ObjectDefinition: sdkModels.SDKObjectDefinition{
+									Type: sdkModels.StringSDKObjectDefinitionType,
+								},
+								Required:  false,
+								Sensitive: true,
+							},
+							"Tags": {
+								JsonName: "tags",
+								ObjectDefinition: sdkModels.SDKObjectDefinition{
+									Type: sdkModels.TagsSDKObjectDefinitionType,
+								},
+								Required: false,
+							},
+							"Value": {
+								JsonName: "value",
+								ObjectDefinition: sdkModels.SDKObjectDefinition{
+									Type: sdkModels.RawObjectSDKObjectDefinitionType,
+								},
+								Required: false,
+							},
+						},
+					},
+				},
+				Operations: map[string]sdkModels.SDKOperation{
+					"Test": {
+						ContentType:         "application/json",
+						ExpectedStatusCodes: []int{200},
+						Method:              "PUT",
+						RequestObject: &sdkModels.SDKObjectDefinition{
+							ReferenceName: pointer.To("Model"),
+							Type:          sdkModels.ReferenceSDKObjectDefinitionType,
+						},
+						ResponseObject: &sdkModels.SDKObjectDefinition{
+							ReferenceName: pointer.To("Model"),
+							Type:          sdkModels.ReferenceSDKObjectDefinitionType,
+						},
+						URISuffix: pointer.To("/example"),
+					},
+				},
+			},
+		},
+	}
+	testhelpers.ValidateParsedSwaggerResultMatches(t, expected, actual)
+}
+
+func TestParseModelWithMutability(t *testing.T) {
+	actual, err := testhelpers.ParseSwaggerFileForTesting(t, "model_with_mutability.json", nil)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	expected := sdkModels.APIVersion{
+		APIVersion: "2020-01-01",
+		Resources: map[string]sdkModels.APIResource{
+			"Example": {
+				Models: map[string]sdkModels.SDKModel{
+					"Model": {
+						Fields: map[string]sdkModels.SDKField{
+							"Mode": {
+								JsonName: "mode",
+								Mutability: []sdkModels.SDKFieldMutability{
+									sdkModels.CreateSDKFieldMutability,
+									sdkModels.ReadSDKFieldMutability,
+								},
+								ObjectDefinition: sdkModels.SDKObjectDefinition{
+									Type: sdkModels.StringSDKObjectDefinitionType,
+								},
+								Required: false,
+							},
+							"Name": {
+								JsonName: "name",
+								ObjectDefinition: sdkModels.SDKObjectDefinition{
+									Type: sdkModels.StringSDKObjectDefinitionType,
+								},
+								Required: true,
+							},
+							"Password": {
+								JsonName: "password",
+								Mutability: []sdkModels.SDKFieldMutability{
+									sdkModels.UpdateSDKFieldMutability,
+								},
+								ObjectDefinition: sdkModels.SDKObjectDefinition{
+									Type: sdkModels.StringSDKObjectDefinitionType,
+								},
+								Required: false,
+							},
+							"Size": {
+								JsonName: "size",
+								Mutability: []sdkModels.SDKFieldMutability{
+									sdkModels.CreateSDKFieldMutability,
+									sdkModels.ReadSDKFieldMutability,
+									sdkModels.UpdateSDKFieldMutability,
+								},
+								ObjectDefinition: sdkModels.SDKObjectDefinition{
+									Type: sdkModels.IntegerSDKObjectDefinitionType,
+								},
+								Required: false,
+							},
+						},
+					},
+				},
+				Operations: map[string]sdkModels.SDKOperation{
+					"Test": {
+						ContentType:         "application/json",
+						ExpectedStatusCodes: []int{200},
+						Method:              "PUT",
+						RequestObject: &sdkModels.SDKObjectDefinition{
+							ReferenceName: pointer.To("Model"),
+							Type:          sdkModels.ReferenceSDKObjectDefinitionType,
+						},
+						ResponseObject: &sdkModels.SDKObjectDefinition{
+							ReferenceName: pointer.To("Model"),
+							Type:          sdkModels.ReferenceSDKObjectDefinitionType,
+						},
+						URISuffix: pointer.To("/example"),
+					},
+				},
+			},
+		},
+	}
+	testhelpers.ValidateParsedSwaggerResultMatches(t, expected, actual)
+}
+
 func TestParseModelTopLevelWithRawFile(t *testing.T) {
 	actual, err := testhelpers.ParseSwaggerFileForTesting(t, "model_top_level_with_rawfile.json", nil)
 	if err != nil {
@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package operation
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/apidefinitions/parser/parsingcontext"
+	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/logging"
+)
+
+// exampleFile defines the structure of an Example file referenced from the `x-ms-examples` extension
+type exampleFile struct {
+	// Parameters is a map of Parameter Name (key) to the value for this Parameter - which includes
+	// Path, QueryString and HTTP Header Parameters, as well as the Request Body.
+	Parameters map[string]interface{} `json:"parameters"`
+
+	// Responses is a map of HTTP Status Code (key) to the Response returned for this Status Code
+	Responses map[string]exampleFileResponse `json:"responses"`
+}
+
+type exampleFileResponse struct {
+	Body interface{} `json:"body,omitempty"`
+}
+
+// examplesForOperation parses the Examples referenced via the `x-ms-examples` extension for this Operation,
+// which live alongside the API Definitions (e.g. `./examples/VirtualMachines_CreateOrUpdate.json`).
+func examplesForOperation(parsingContext *parsingcontext.Context, input parsedOperation) (map[string]sdkModels.SDKOperationExample, error) {
+	raw, ok := input.operation.VendorExtensible.Extensions["x-ms-examples"]
+	if !ok {
+		return nil, nil
+	}
+	references, ok := raw.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("expected `x-ms-examples` to be a map but got %+v", raw)
+	}
+
+	pathParameterNames := make(map[string]struct{})
+	bodyParameterName := ""
+	for _, param := range input.operation.Parameters {
+		if strings.EqualFold(param.In, "path") {
+			pathParameterNames[param.Name] = struct{}{}
+		}
+		if strings.EqualFold(param.In, "body") {
+			bodyParameterName = param.Name
+		}
+	}
+
+	directory := filepath.Dir(parsingContext.FilePath)
+	output := make(map[string]sdkModels.SDKOperationExample)
+	for exampleName, v := range references {
+		reference, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected the `x-ms-examples` entry %q to be a map but got %+v", exampleName, v)
+		}
+		relativePath, ok := reference["$ref"].(string)
+		if !ok || relativePath == "" {
+			return nil, fmt.Errorf("the `x-ms-examples` entry %q doesn't contain a `$ref`", exampleName)
+		}
+
+		filePath := filepath.Join(directory, filepath.FromSlash(relativePath))
+		contents, err := os.ReadFile(filePath)
+		if err != nil {
+			// the Example is supplementary information, so a missing or unreadable file shouldn't block the import
+			logging.Warnf("Skipping the Example %q for Operation %q since the file %q couldn't be read: %+v", exampleName, input.name, filePath, err)
+			continue
+		}
+		var file exampleFile
+		if err := json.Unmarshal(contents, &file); err != nil {
+			logging.Warnf("Skipping the Example %q for Operation %q since the file %q couldn't be parsed: %+v", exampleName, input.name, filePath, err)
+			continue
+		}
+
+		example := sdkModels.SDKOperationExample{}
+		for paramName, paramValue := range file.Parameters {
+			if bodyParameterName != "" && paramName == bodyParameterName {
+				example.RequestBody = paramValue
+				continue
+			}
+			if _, isPathParameter := pathParameterNames[paramName]; isPathParameter && paramValue != nil {
+				if example.PathParameters == nil {
+					example.PathParameters = make(map[string]string)
+				}
+				example.PathParameters[paramName] = fmt.Sprintf("%v", paramValue)
+			}
+		}
+		for statusCodeRaw, response := range file.Responses {
+			statusCode, err := strconv.Atoi(statusCodeRaw)
+			if err != nil {
+				logging.Tracef("Skipping the Response %q within the Example %q since it's not a Status Code", statusCodeRaw, exampleName)
+				continue
+			}
+			if example.Responses == nil {
+				example.Responses = make(map[int]interface{})
+			}
+			example.Responses[statusCode] = response.Body
+		}
+
+		output[exampleName] = example
+	}
+
+	if len(output) == 0 {
+		return nil, nil
+	}
+	return output, nil
+}
@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package operation
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/go-openapi/spec"
+	"github.com/hashicorp/go-hclog"
+	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/apidefinitions/parser/parsingcontext"
+	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/logging"
+)
+
+func TestExamplesForOperation(t *testing.T) {
+	logging.Log = hclog.New(hclog.DefaultOptions)
+	directory := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(directory, "examples"), os.ModePerm); err != nil {
+		t.Fatalf("creating the examples directory: %+v", err)
+	}
+	example := `{
+  "parameters": {
+    "api-version": "2020-01-01",
+    "subscriptionId": "00000000-0000-0000-0000-000000000000",
+    "worldName": "example",
+    "body": {
+      "name": "example"
+    }
+  },
+  "responses": {
+    "200": {
+      "body": {
+        "id": "/subscriptions/00000000-0000-0000-0000-000000000000/worlds/example"
+      }
+    },
+    "201": {},
+    "default": {
+      "body": {
+        "code": "Error"
+      }
+    }
+  }
+}`
+	if err := os.WriteFile(filepath.Join(directory, "examples", "PutWorld.json"), []byte(example), 0644); err != nil {
+		t.Fatalf("writing the example: %+v", err)
+	}
+	if err := os.WriteFile(filepath.Join(directory, "examples", "Invalid.json"), []byte("{"), 0644); err != nil {
+		t.Fatalf("writing the example: %+v", err)
+	}
+
+	operation := &spec.Operation{}
+	operation.Parameters = []spec.Parameter{
+		*spec.PathParam("subscriptionId"),
+		*spec.PathParam("worldName"),
+		*spec.QueryParam("api-version"),
+		*spec.BodyParam("body", nil),
+	}
+	operation.AddExtension("x-ms-examples", map[string]interface{}{
+		"Put World": map[string]interface{}{
+			"$ref": "./examples/PutWorld.json",
+		},
+		"Invalid File": map[string]interface{}{
+			"$ref": "./examples/Invalid.json",
+		},
+		"Missing File": map[string]interface{}{
+			"$ref": "./examples/DoesNotExist.json",
+		},
+	})
+	input := parsedOperation{
+		name:      "PutWorld",
+		operation: operation,
+	}
+	parsingContext := &parsingcontext.Context{
+		FilePath: filepath.Join(directory, "example.json"),
+	}
+
+	actual, err := examplesForOperation(parsingContext, input)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	expected := map[string]sdkModels.SDKOperationExample{
+		"Put World": {
+			PathParameters: map[string]string{
+				"subscriptionId": "00000000-0000-0000-0000-000000000000",
+				"worldName":      "example",
+			},
+			RequestBody: map[string]interface{}{
+				"name": "example",
+			},
+			Responses: map[int]interface{}{
+				200: map[string]interface{}{
+					"id": "/subscriptions/00000000-0000-0000-0000-000000000000/worlds/example",
+				},
+				201: nil,
+			},
+		},
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestExamplesForOperation_NoExamples(t *testing.T) {
+	input := parsedOperation{
+		name:      "PutWorld",
+		operation: &spec.Operation{},
+	}
+	actual, err := examplesForOperation(&parsingcontext.Context{}, input)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if actual != nil {
+		t.Fatalf("expected no examples but got %+v", actual)
+	}
+}
+
+func TestExamplesForOperation_MissingReference(t *testing.T) {
+	operation := &spec.Operation{}
+	operation.AddExtension("x-ms-examples", map[string]interface{}{
+		"Put World": map[string]interface{}{},
+	})
+	input := parsedOperation{
+		name:      "PutWorld",
+		operation: operation,
+	}
+	if _, err := examplesForOperation(&parsingcontext.Context{}, input); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+}
@@ -121,8 +121,7 @@ func isLongRunning(input parsedOperation) bool {
 	//   > "x-ms-long-running-operation-options": {
 	//   >   "final-state-via": "azure-async-operation"
 	//   > }
-	// Whilst these _could_ be useful at some point we can likely ignore them for
-	// the moment since the convention is either `Location` or `Azure-AsyncOperation`
+	// The `final-state-via` is parsed separately in finalStateViaForLongRunningOperation
 	val, exists := input.operation.Extensions.GetBool("x-ms-long-running-operation")
 	if !exists {
 		return false
@@ -131,6 +130,28 @@ func isLongRunning(input parsedOperation) bool {
 	return val
 }
 
+// finalStateViaForLongRunningOperation returns the `final-state-via` defined within the
+// `x-ms-long-running-operation-options` for this Operation, if any - which specifies where the
+// final state of the Long Running Operation is retrieved from (e.g. `azure-async-operation`).
+func finalStateViaForLongRunningOperation(input parsedOperation) *string {
+	if raw, ok := input.operation.VendorExtensible.Extensions["x-ms-long-running-operation-options"]; ok {
+		val, ok := raw.(map[string]interface{})
+		if ok {
+			for k, v := range val {
+				if !strings.EqualFold("final-state-via", k) {
+					continue
+				}
+				if str, ok := v.(string); ok && str != "" {
+					str = strings.ToLower(str)
+					return &str
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
 func matchesTag(operation *spec.Operation, tag *string) bool {
 	// if there's no tags defined, we should capture it when the tag matched
 	if tag == nil {
@@ -5,22 +5,25 @@ package operation
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/go-openapi/spec"
 	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
 	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/apidefinitions/parser/cleanup"
 	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/apidefinitions/parser/constants"
+	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/apidefinitions/parser/diagnostics"
 	parserModels "github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/apidefinitions/parser/models"
+	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/apidefinitions/parser/parsingcontext"
 )
 
-func optionsForOperation(input parsedOperation) (map[string]sdkModels.SDKOperationOption, *parserModels.ParseResult, error) {
+func optionsForOperation(parsingContext *parsingcontext.Context, input parsedOperation) (map[string]sdkModels.SDKOperationOption, *parserModels.ParseResult, error) {
 	output := make(map[string]sdkModels.SDKOperationOption)
 	result := parserModels.ParseResult{
 		Constants: map[string]sdkModels.SDKConstant{},
 	}
 
-	for _, param := range input.operation.Parameters {
+	for i, param := range input.operation.Parameters {
 		// these are (currently) handled elsewhere, so we're good for now
 		if strings.EqualFold(param.Name, "$skipToken") {
 			// NOTE: we may also need to do the odata ones, media has an example
@@ -68,6 +71,10 @@ func optionsForOperation(input parsedOperation) (map[string]sdkModels.SDKOperati
 				if err != nil {
 					return nil, nil, fmt.Errorf("mapping %q: %+v", param.Name, err)
 				}
+				if constant.WithoutXMSEnum {
+					jsonPointer := diagnostics.JSONPointer("paths", input.uri, strings.ToLower(input.httpMethod), "parameters", strconv.Itoa(i))
+					parsingContext.AddDiagnostic(diagnostics.ConstantWithoutXMSEnumCode, diagnostics.WarningSeverity, jsonPointer, fmt.Sprintf("the Constant %q has no (valid) `x-ms-enum` so its name has been inferred from the parameter %q", constant.Name, param.Name))
+				}
 				result.Constants[constant.Name] = constant.Details
 
 				option.ObjectDefinition = sdkModels.SDKOperationOptionObjectDefinition{
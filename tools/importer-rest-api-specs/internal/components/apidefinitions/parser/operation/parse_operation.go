@@ -45,8 +45,17 @@ func parseOperation(parsingContext *parsingcontext.Context, operation parsedOper
 		paginationField = responseResult.paginationFieldName
 	}
 	longRunning := isLongRunning(operation)
+	var longRunningFinalStateVia *string
+	if longRunning {
+		longRunningFinalStateVia = finalStateViaForLongRunningOperation(operation)
+	}
+
+	examples, err := examplesForOperation(parsingContext, operation)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parsing the examples for operation %q: %+v", operation.name, err)
+	}
 
-	options, nestedResult, err := optionsForOperation(operation)
+	options, nestedResult, err := optionsForOperation(parsingContext, operation)
 	if err != nil {
 		return nil, nil, fmt.Errorf("building options for operation %q: %+v", operation.name, err)
 	}
@@ -71,9 +80,11 @@ func parseOperation(parsingContext *parsingcontext.Context, operation parsedOper
 
 	operationData := sdkModels.SDKOperation{
 		ContentType:                      contentType,
+		Examples:                         examples,
 		ExpectedStatusCodes:              expectedStatusCodes,
 		FieldContainingPaginationDetails: paginationField,
 		LongRunning:                      longRunning,
+		LongRunningFinalStateVia:         longRunningFinalStateVia,
 		Method:                           strings.ToUpper(operation.httpMethod),
 		Options:                          options,
 		RequestObject:                    requestObject,
@@ -5,8 +5,10 @@ package operation
 
 import (
 	"fmt"
+	"strings"
 
 	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/apidefinitions/parser/diagnostics"
 	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/apidefinitions/parser/ignore"
 	parserModels "github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/apidefinitions/parser/models"
 	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/apidefinitions/parser/parsingcontext"
@@ -29,6 +31,8 @@ func ParseOperationsWithinTag(parsingContext *parsingcontext.Context, tag *strin
 
 		if ignore.Operation(operation.uri) {
 			logging.Debugf("Operation should be ignored - skipping..")
+			jsonPointer := diagnostics.JSONPointer("paths", operation.uri, strings.ToLower(operation.httpMethod))
+			parsingContext.AddDiagnostic(diagnostics.OperationIgnoredCode, diagnostics.InfoSeverity, jsonPointer, fmt.Sprintf("the %s Operation %q has been ignored", operation.httpMethod, operation.name))
 			continue
 		}
 
@@ -1133,6 +1133,49 @@ func TestParseOperationSingleWithLongRunningOperation(t *testing.T) {
 	testhelpers.ValidateParsedSwaggerResultMatches(t, expected, actual)
 }
 
+func TestParseOperationSingleWithLongRunningOperationFinalStateVia(t *testing.T) {
+	actual, err := testhelpers.ParseSwaggerFileForTesting(t, "operations_single_long_running_final_state_via.json", nil)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	expected := sdkModels.APIVersion{
+		APIVersion: "2020-01-01",
+		Resources: map[string]sdkModels.APIResource{
+			"Hello": {
+				Models: map[string]sdkModels.SDKModel{
+					"Example": {
+						Fields: map[string]sdkModels.SDKField{
+							"Name": {
+								JsonName: "name",
+								ObjectDefinition: sdkModels.SDKObjectDefinition{
+									Type: sdkModels.StringSDKObjectDefinitionType,
+								},
+								Required: false,
+							},
+						},
+					},
+				},
+				Operations: map[string]sdkModels.SDKOperation{
+					"PutWorld": {
+						ContentType:              "application/json",
+						ExpectedStatusCodes:      []int{200},
+						LongRunning:              true,
+						LongRunningFinalStateVia: pointer.To("azure-async-operation"),
+						Method:                   "PUT",
+						RequestObject: &sdkModels.SDKObjectDefinition{
+							ReferenceName: pointer.To("Example"),
+							Type:          sdkModels.ReferenceSDKObjectDefinitionType,
+						},
+						URISuffix: pointer.To("/things"),
+					},
+				},
+			},
+		},
+	}
+	testhelpers.ValidateParsedSwaggerResultMatches(t, expected, actual)
+}
+
 func TestParseOperationSingleWithRequestAndResponseObject(t *testing.T) {
 	actual, err := testhelpers.ParseSwaggerFileForTesting(t, "operations_single_with_request_and_response_object.json", nil)
 	if err != nil {
@@ -1178,6 +1221,91 @@ func TestParseOperationSingleWithRequestAndResponseObject(t *testing.T) {
 	testhelpers.ValidateParsedSwaggerResultMatches(t, expected, actual)
 }
 
+func TestParseOperationSingleWithExamples(t *testing.T) {
+	actual, err := testhelpers.ParseSwaggerFileForTesting(t, "operations_single_with_examples.json", nil)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	expected := sdkModels.APIVersion{
+		APIVersion: "2020-01-01",
+		Resources: map[string]sdkModels.APIResource{
+			"Hello": {
+				Models: map[string]sdkModels.SDKModel{
+					"Example": {
+						Fields: map[string]sdkModels.SDKField{
+							"Enabled": {
+								JsonName: "enabled",
+								ObjectDefinition: sdkModels.SDKObjectDefinition{
+									Type: sdkModels.BooleanSDKObjectDefinitionType,
+								},
+							},
+							"Name": {
+								JsonName: "name",
+								ObjectDefinition: sdkModels.SDKObjectDefinition{
+									Type: sdkModels.StringSDKObjectDefinitionType,
+								},
+							},
+						},
+					},
+				},
+				Operations: map[string]sdkModels.SDKOperation{
+					"PutWorld": {
+						ContentType: "application/json",
+						// the Example referencing a file which doesn't exist is skipped
+						Examples: map[string]sdkModels.SDKOperationExample{
+							"Create a Thing": {
+								PathParameters: map[string]string{
+									"resourceGroupName": "myResourceGroup",
+									"subscriptionId":    "11111111-2222-3333-4444-555555555555",
+									"thing":             "myThing",
+								},
+								RequestBody: map[string]interface{}{
+									"enabled": true,
+									"name":    "myThing",
+								},
+								// non-numeric Status Codes (e.g. `default`) are skipped
+								Responses: map[int]interface{}{
+									200: map[string]interface{}{
+										"enabled": true,
+										"name":    "myThing",
+									},
+								},
+							},
+						},
+						ExpectedStatusCodes: []int{200},
+						Method:              "PUT",
+						RequestObject: &sdkModels.SDKObjectDefinition{
+							ReferenceName: pointer.To("Example"),
+							Type:          sdkModels.ReferenceSDKObjectDefinitionType,
+						},
+						ResourceIDName: pointer.To("ThingId"),
+						ResponseObject: &sdkModels.SDKObjectDefinition{
+							ReferenceName: pointer.To("Example"),
+							Type:          sdkModels.ReferenceSDKObjectDefinitionType,
+						},
+					},
+				},
+				ResourceIDs: map[string]sdkModels.ResourceID{
+					"ThingId": {
+						Segments: []sdkModels.ResourceIDSegment{
+							sdkModels.NewStaticValueResourceIDSegment("staticSubscriptions", "subscriptions"),
+							sdkModels.NewSubscriptionIDResourceIDSegment("subscriptionId"),
+							sdkModels.NewStaticValueResourceIDSegment("staticResourceGroups", "resourceGroups"),
+							sdkModels.NewResourceGroupNameResourceIDSegment("resourceGroupName"),
+							sdkModels.NewStaticValueResourceIDSegment("staticProviders", "providers"),
+							sdkModels.NewResourceProviderResourceIDSegment("staticMicrosoftFooBar", "Microsoft.FooBar"),
+							sdkModels.NewStaticValueResourceIDSegment("staticThings", "things"),
+							sdkModels.NewUserSpecifiedResourceIDSegment("thingName", "thing"),
+						},
+					},
+				},
+			},
+		},
+	}
+	testhelpers.ValidateParsedSwaggerResultMatches(t, expected, actual)
+}
+
 func TestParseOperationSingleWithMultipleTags(t *testing.T) {
 	actual, err := testhelpers.ParseSwaggerFileForTesting(t, "operations_single_multiple_tags.json", nil)
 	if err != nil {
@@ -0,0 +1,255 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parser_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/apidefinitions/parser/testhelpers"
+)
+
+func TestParseParameterizedHost(t *testing.T) {
+	actual, err := testhelpers.ParseDataPlaneSwaggerFileForTesting(t, "parameterized_host.json")
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	// Data Plane Operations are addressed by a path relative to the Parameterized Host, rather than a Resource ID
+	expected := sdkModels.APIVersion{
+		APIVersion: "2020-01-01",
+		Resources: map[string]sdkModels.APIResource{
+			"Example": {
+				Operations: map[string]sdkModels.SDKOperation{
+					"Test": {
+						ContentType:         "application/json",
+						ExpectedStatusCodes: []int{200},
+						Method:              "HEAD",
+						URISuffix:           pointer.To("/secrets/{secret-name}"),
+					},
+				},
+			},
+		},
+	}
+	testhelpers.ValidateParsedSwaggerResultMatches(t, expected, actual)
+
+	if actual.ParameterizedHost == nil {
+		t.Fatalf("expected a Parameterized Host but didn't get one")
+	}
+	if actual.ParameterizedHost.HostTemplate != "{vaultBaseUrl}" {
+		t.Fatalf("expected the Host Template to be `{vaultBaseUrl}` but got %q", actual.ParameterizedHost.HostTemplate)
+	}
+	if actual.ParameterizedHost.UseSchemePrefix {
+		t.Fatalf("expected UseSchemePrefix to be false but got true")
+	}
+	if len(actual.ParameterizedHost.Parameters) != 1 {
+		t.Fatalf("expected 1 Parameter but got %d", len(actual.ParameterizedHost.Parameters))
+	}
+	parameter := actual.ParameterizedHost.Parameters[0]
+	if parameter.Name != "vaultBaseUrl" {
+		t.Fatalf("expected the Parameter Name to be `vaultBaseUrl` but got %q", parameter.Name)
+	}
+	if !parameter.Required {
+		t.Fatalf("expected the Parameter `vaultBaseUrl` to be Required")
+	}
+}
+
+func TestParseParameterizedHostNotDefined(t *testing.T) {
+	actual, err := testhelpers.ParseSwaggerFileForTesting(t, "resource_ids_basic.json", nil)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+	if actual.ParameterizedHost != nil {
+		t.Fatalf("expected no Parameterized Host but got %+v", *actual.ParameterizedHost)
+	}
+}
+
+func TestParseDataPlaneKeyVaultSecrets(t *testing.T) {
+	// a subset of the Key Vault Secrets Data Plane API Definition
+	actual, err := testhelpers.ParseDataPlaneSwaggerFileForTesting(t, "data_plane_keyvault_secrets.json")
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	expected := sdkModels.APIVersion{
+		APIVersion: "2020-01-01",
+		Resources: map[string]sdkModels.APIResource{
+			"Secrets": {
+				Constants: map[string]sdkModels.SDKConstant{
+					"DeletionRecoveryLevel": {
+						Type: sdkModels.StringSDKConstantType,
+						Values: map[string]string{
+							"Purgeable":   "Purgeable",
+							"Recoverable": "Recoverable",
+						},
+					},
+				},
+				Models: map[string]sdkModels.SDKModel{
+					"SecretAttributes": {
+						Fields: map[string]sdkModels.SDKField{
+							"Enabled": {
+								JsonName: "enabled",
+								ObjectDefinition: sdkModels.SDKObjectDefinition{
+									Type: sdkModels.BooleanSDKObjectDefinitionType,
+								},
+							},
+							"RecoveryLevel": {
+								JsonName: "recoveryLevel",
+								ObjectDefinition: sdkModels.SDKObjectDefinition{
+									Type:          sdkModels.ReferenceSDKObjectDefinitionType,
+									ReferenceName: pointer.To("DeletionRecoveryLevel"),
+								},
+							},
+						},
+					},
+					"SecretBundle": {
+						Fields: map[string]sdkModels.SDKField{
+							"Attributes": {
+								JsonName: "attributes",
+								ObjectDefinition: sdkModels.SDKObjectDefinition{
+									Type:          sdkModels.ReferenceSDKObjectDefinitionType,
+									ReferenceName: pointer.To("SecretAttributes"),
+								},
+							},
+							"ContentType": {
+								JsonName: "contentType",
+								ObjectDefinition: sdkModels.SDKObjectDefinition{
+									Type: sdkModels.StringSDKObjectDefinitionType,
+								},
+							},
+							"Id": {
+								JsonName: "id",
+								ObjectDefinition: sdkModels.SDKObjectDefinition{
+									Type: sdkModels.StringSDKObjectDefinitionType,
+								},
+							},
+							"Tags": {
+								JsonName: "tags",
+								ObjectDefinition: sdkModels.SDKObjectDefinition{
+									Type: sdkModels.TagsSDKObjectDefinitionType,
+								},
+							},
+							"Value": {
+								JsonName: "value",
+								ObjectDefinition: sdkModels.SDKObjectDefinition{
+									Type: sdkModels.StringSDKObjectDefinitionType,
+								},
+							},
+						},
+					},
+					"SecretItem": {
+						Fields: map[string]sdkModels.SDKField{
+							"ContentType": {
+								JsonName: "contentType",
+								ObjectDefinition: sdkModels.SDKObjectDefinition{
+									Type: sdkModels.StringSDKObjectDefinitionType,
+								},
+							},
+							"Id": {
+								JsonName: "id",
+								ObjectDefinition: sdkModels.SDKObjectDefinition{
+									Type: sdkModels.StringSDKObjectDefinitionType,
+								},
+							},
+						},
+					},
+					"SecretSetParameters": {
+						Fields: map[string]sdkModels.SDKField{
+							"Attributes": {
+								JsonName: "attributes",
+								ObjectDefinition: sdkModels.SDKObjectDefinition{
+									Type:          sdkModels.ReferenceSDKObjectDefinitionType,
+									ReferenceName: pointer.To("SecretAttributes"),
+								},
+							},
+							"ContentType": {
+								JsonName: "contentType",
+								ObjectDefinition: sdkModels.SDKObjectDefinition{
+									Type: sdkModels.StringSDKObjectDefinitionType,
+								},
+							},
+							"Value": {
+								JsonName: "value",
+								ObjectDefinition: sdkModels.SDKObjectDefinition{
+									Type: sdkModels.StringSDKObjectDefinitionType,
+								},
+								Required: true,
+							},
+						},
+					},
+				},
+				Operations: map[string]sdkModels.SDKOperation{
+					"DeleteSecret": {
+						ContentType:         "application/json",
+						ExpectedStatusCodes: []int{200},
+						Method:              "DELETE",
+						ResponseObject: &sdkModels.SDKObjectDefinition{
+							Type:          sdkModels.ReferenceSDKObjectDefinitionType,
+							ReferenceName: pointer.To("SecretBundle"),
+						},
+						URISuffix: pointer.To("/secrets/{secret-name}"),
+					},
+					"GetSecret": {
+						ContentType:         "application/json",
+						ExpectedStatusCodes: []int{200},
+						Method:              "GET",
+						ResponseObject: &sdkModels.SDKObjectDefinition{
+							Type:          sdkModels.ReferenceSDKObjectDefinitionType,
+							ReferenceName: pointer.To("SecretBundle"),
+						},
+						URISuffix: pointer.To("/secrets/{secret-name}/{secret-version}"),
+					},
+					"GetSecrets": {
+						ContentType:                      "application/json",
+						ExpectedStatusCodes:              []int{200},
+						FieldContainingPaginationDetails: pointer.To("nextLink"),
+						Method:                           "GET",
+						Options: map[string]sdkModels.SDKOperationOption{
+							"Maxresults": {
+								QueryStringName: pointer.To("maxresults"),
+								ObjectDefinition: sdkModels.SDKOperationOptionObjectDefinition{
+									Type: sdkModels.IntegerSDKOperationOptionObjectDefinitionType,
+								},
+							},
+						},
+						ResponseObject: &sdkModels.SDKObjectDefinition{
+							Type:          sdkModels.ReferenceSDKObjectDefinitionType,
+							ReferenceName: pointer.To("SecretItem"),
+						},
+						URISuffix: pointer.To("/secrets"),
+					},
+					"SetSecret": {
+						ContentType:         "application/json",
+						ExpectedStatusCodes: []int{200},
+						Method:              "PUT",
+						RequestObject: &sdkModels.SDKObjectDefinition{
+							Type:          sdkModels.ReferenceSDKObjectDefinitionType,
+							ReferenceName: pointer.To("SecretSetParameters"),
+						},
+						ResponseObject: &sdkModels.SDKObjectDefinition{
+							Type:          sdkModels.ReferenceSDKObjectDefinitionType,
+							ReferenceName: pointer.To("SecretBundle"),
+						},
+						URISuffix: pointer.To("/secrets/{secret-name}"),
+					},
+				},
+			},
+		},
+	}
+	testhelpers.ValidateParsedSwaggerResultMatches(t, expected, actual)
+
+	if actual.ParameterizedHost == nil {
+		t.Fatalf("expected a Parameterized Host but didn't get one")
+	}
+	if actual.ParameterizedHost.HostTemplate != "{vaultBaseUrl}" {
+		t.Fatalf("expected the Host Template to be `{vaultBaseUrl}` but got %q", actual.ParameterizedHost.HostTemplate)
+	}
+	if len(actual.ParameterizedHost.Parameters) != 1 {
+		t.Fatalf("expected 1 Parameter but got %d", len(actual.ParameterizedHost.Parameters))
+	}
+	if actual.ParameterizedHost.Parameters[0].Name != "vaultBaseUrl" {
+		t.Fatalf("expected the Parameter Name to be `vaultBaseUrl` but got %q", actual.ParameterizedHost.Parameters[0].Name)
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parser
+
+import (
+	"fmt"
+	"strings"
+
+	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+// ParseParameterizedHost parses the `x-ms-parameterized-host` extension from the root of the Swagger document,
+// which is used by Data Plane APIs to define the (templated) Host which each Operation is relative to.
+// When this extension isn't defined, nil is returned.
+func (p *apiDefinitionsParser) ParseParameterizedHost() (*sdkModels.ParameterizedHost, error) {
+	raw, ok := p.context.SwaggerSpecWithReferencesRaw.Extensions["x-ms-parameterized-host"]
+	if !ok {
+		return nil, nil
+	}
+	values, ok := raw.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("expected `x-ms-parameterized-host` to be a map but got %+v", raw)
+	}
+
+	hostTemplate, ok := values["hostTemplate"].(string)
+	if !ok || hostTemplate == "" {
+		return nil, fmt.Errorf("`x-ms-parameterized-host` doesn't contain a `hostTemplate`")
+	}
+	output := sdkModels.ParameterizedHost{
+		HostTemplate: hostTemplate,
+		Parameters:   make([]sdkModels.ParameterizedHostParameter, 0),
+		// when unspecified `useSchemePrefix` defaults to true, meaning that `https://` is prefixed to the Host
+		UseSchemePrefix: true,
+	}
+	if v, ok := values["useSchemePrefix"].(bool); ok {
+		output.UseSchemePrefix = v
+	}
+
+	rawParameters, ok := values["parameters"].([]interface{})
+	if !ok {
+		return &output, nil
+	}
+	for i, rawParameter := range rawParameters {
+		parameter, ok := rawParameter.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected the `x-ms-parameterized-host` parameter at index %d to be a map but got %+v", i, rawParameter)
+		}
+		parsed, err := p.parseParameterizedHostParameter(parameter)
+		if err != nil {
+			return nil, fmt.Errorf("parsing the `x-ms-parameterized-host` parameter at index %d: %+v", i, err)
+		}
+		output.Parameters = append(output.Parameters, *parsed)
+	}
+
+	return &output, nil
+}
+
+func (p *apiDefinitionsParser) parseParameterizedHostParameter(input map[string]interface{}) (*sdkModels.ParameterizedHostParameter, error) {
+	// Parameters are commonly defined at the root of the Swagger document and then referenced
+	if ref, ok := input["$ref"].(string); ok {
+		parameterName := strings.TrimPrefix(ref, "#/parameters/")
+		if parameterName == ref {
+			return nil, fmt.Errorf("only references to `#/parameters` are supported but got %q", ref)
+		}
+		parameter, ok := p.context.SwaggerSpecWithReferencesRaw.Parameters[parameterName]
+		if !ok {
+			return nil, fmt.Errorf("the referenced Parameter %q was not found", parameterName)
+		}
+		output := sdkModels.ParameterizedHostParameter{
+			Description: parameter.Description,
+			Name:        parameter.Name,
+			Required:    parameter.Required,
+		}
+		if v, ok := parameter.Default.(string); ok {
+			output.DefaultValue = &v
+		}
+		return &output, nil
+	}
+
+	name, ok := input["name"].(string)
+	if !ok || name == "" {
+		return nil, fmt.Errorf("the parameter doesn't contain a `name`")
+	}
+	output := sdkModels.ParameterizedHostParameter{
+		Name: name,
+	}
+	if v, ok := input["description"].(string); ok {
+		output.Description = v
+	}
+	if v, ok := input["required"].(bool); ok {
+		output.Required = v
+	}
+	if v, ok := input["default"].(string); ok {
+		output.DefaultValue = &v
+	}
+	return &output, nil
+}
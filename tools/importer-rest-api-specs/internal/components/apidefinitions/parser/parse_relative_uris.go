@@ -0,0 +1,37 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parser
+
+import (
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/apidefinitions/parser/ignore"
+	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/apidefinitions/parser/resourceids"
+	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/logging"
+)
+
+// ParseRelativeURIs parses the URI for each Operation as-is, for use with Data Plane APIs - where Operations
+// are addressed by a path relative to the (Parameterized) Host rather than by a Resource ID. As such the
+// ParseResult contains only a UriSuffix for each Operation (which may contain `{placeholders}`) and no Resource IDs.
+func (p *apiDefinitionsParser) ParseRelativeURIs() (*resourceids.ParseResult, error) {
+	result := resourceids.ParseResult{
+		OperationIdsToParsedResourceIds: make(map[string]resourceids.ParsedOperation),
+		NamesToResourceIDs:              make(map[string]sdkModels.ResourceID),
+		Constants:                       make(map[string]sdkModels.SDKConstant),
+	}
+	for _, operation := range p.context.SwaggerSpecExpanded.Operations() {
+		for uri, operationDetails := range operation {
+			if ignore.Operation(uri) {
+				logging.Debugf("Ignoring %q", uri)
+				continue
+			}
+
+			logging.Tracef("Using the relative URI %q for Operation %q..", uri, operationDetails.ID)
+			result.OperationIdsToParsedResourceIds[operationDetails.ID] = resourceids.ParsedOperation{
+				UriSuffix: pointer.To(uri),
+			}
+		}
+	}
+	return &result, nil
+}
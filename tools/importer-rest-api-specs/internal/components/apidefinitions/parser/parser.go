@@ -6,6 +6,7 @@ package parser
 import (
 	"fmt"
 
+	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/apidefinitions/parser/diagnostics"
 	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/apidefinitions/parser/parsingcontext"
 )
 
@@ -13,11 +14,14 @@ type apiDefinitionsParser struct {
 	context *parsingcontext.Context
 }
 
-func NewAPIDefinitionsParser(filePath string) (*apiDefinitionsParser, error) {
+// NewAPIDefinitionsParser returns a parser for the API Definitions within filePath - any Diagnostics raised
+// whilst parsing are recorded in the (optional) Diagnostics Collector.
+func NewAPIDefinitionsParser(filePath string, collector *diagnostics.Collector) (*apiDefinitionsParser, error) {
 	parsingContext, err := parsingcontext.BuildFromFile(filePath)
 	if err != nil {
 		return nil, fmt.Errorf("building the parsing context: %+v", err)
 	}
+	parsingContext.Diagnostics = collector
 
 	return &apiDefinitionsParser{
 		context: parsingContext,
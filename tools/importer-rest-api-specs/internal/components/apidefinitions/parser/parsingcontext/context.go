@@ -6,6 +6,7 @@ package parsingcontext
 import (
 	"github.com/go-openapi/analysis"
 	"github.com/go-openapi/spec"
+	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/apidefinitions/parser/diagnostics"
 )
 
 // Context contains a working set of information about the parsed API Definition.
@@ -21,4 +22,7 @@ type Context struct {
 
 	// SwaggerSpecExpanded is the parsed spec with all references resolved, and their target properties inlined
 	SwaggerSpecExpanded *analysis.Spec
+
+	// Diagnostics is an optional Collector used to record any Diagnostics raised whilst parsing this file
+	Diagnostics *diagnostics.Collector
 }
@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parsingcontext
+
+import (
+	"fmt"
+
+	"github.com/go-openapi/spec"
+	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/apidefinitions/parser/constants"
+	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/apidefinitions/parser/diagnostics"
+)
+
+// AddDiagnostic records a Diagnostic for the item at jsonPointer within this file, when a Diagnostics Collector
+// has been configured.
+func (c *Context) AddDiagnostic(code diagnostics.Code, severity diagnostics.Severity, jsonPointer, message string) {
+	if c.Diagnostics == nil {
+		return
+	}
+
+	c.Diagnostics.Add(diagnostics.Diagnostic{
+		Code:        code,
+		Severity:    severity,
+		FilePath:    c.FilePath,
+		JSONPointer: jsonPointer,
+		Message:     message,
+	})
+}
+
+// parseConstant parses the Constant defined at jsonPointer, recording a Diagnostic when it doesn't define
+// an `x-ms-enum` extension.
+func (c *Context) parseConstant(typeVal spec.StringOrArray, fieldName string, modelName *string, values []interface{}, extensions spec.Extensions, jsonPointer string) (*constants.ParsedConstant, error) {
+	constant, err := constants.Parse(typeVal, fieldName, modelName, values, extensions)
+	if err != nil {
+		return nil, err
+	}
+
+	if constant.WithoutXMSEnum {
+		c.AddDiagnostic(diagnostics.ConstantWithoutXMSEnumCode, diagnostics.WarningSeverity, jsonPointer, fmt.Sprintf("the Constant %q has no (valid) `x-ms-enum` so its name has been inferred from the field %q", constant.Name, fieldName))
+	}
+
+	return constant, nil
+}
+
+// definitionPointer returns a JSON Pointer to the property within the specified Model - or to the Model itself
+// when propertyName is empty - providing the Model is defined within the `definitions` of this file. Models which
+// are defined inline (and named by the Importer) or within another file have no location in this file, so an
+// empty JSON Pointer is returned for these rather than one which points to the wrong location.
+func (c *Context) definitionPointer(modelName, propertyName string) string {
+	if c.SwaggerSpecWithReferencesRaw == nil {
+		return ""
+	}
+	definition, ok := c.SwaggerSpecWithReferencesRaw.Definitions[modelName]
+	if !ok {
+		return ""
+	}
+
+	if propertyName == "" || propertyName == modelName {
+		return diagnostics.JSONPointer("definitions", modelName)
+	}
+	if _, ok := definition.Properties[propertyName]; !ok {
+		// e.g. the property is inherited from a parent Model (via `allOf`), so refer to the Model itself
+		return diagnostics.JSONPointer("definitions", modelName)
+	}
+	return diagnostics.JSONPointer("definitions", modelName, "properties", propertyName)
+}
@@ -11,7 +11,7 @@ import (
 )
 
 func (c *Context) ParseConstant(constantName string, spec spec.Schema) (*constants.ParsedConstant, error) {
-	constant, err := constants.Parse(spec.Type, constantName, nil, spec.Enum, spec.Extensions)
+	constant, err := c.parseConstant(spec.Type, constantName, nil, spec.Enum, spec.Extensions, c.definitionPointer(constantName, ""))
 	if err != nil {
 		return nil, fmt.Errorf("parsing constant: %+v", err)
 	}
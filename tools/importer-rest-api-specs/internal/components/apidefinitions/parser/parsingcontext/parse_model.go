@@ -5,13 +5,14 @@ package parsingcontext
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/go-openapi/spec"
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
 	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/apidefinitions/parser/cleanup"
-	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/apidefinitions/parser/constants"
+	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/apidefinitions/parser/diagnostics"
 	parserModels "github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/apidefinitions/parser/models"
 	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/logging"
 )
@@ -67,7 +68,11 @@ func (c *Context) findConstantsWithinModel(fieldName string, modelName *string,
 	result.Append(known)
 
 	if len(input.Enum) > 0 {
-		constant, err := constants.Parse(input.Type, fieldName, modelName, input.Enum, input.Extensions)
+		jsonPointer := c.definitionPointer(fieldName, "")
+		if modelName != nil {
+			jsonPointer = c.definitionPointer(*modelName, fieldName)
+		}
+		constant, err := c.parseConstant(input.Type, fieldName, modelName, input.Enum, input.Extensions, jsonPointer)
 		if err != nil {
 			return nil, fmt.Errorf("parsing constant: %+v", err)
 		}
@@ -133,6 +138,32 @@ func (c *Context) findConstantsWithinModel(fieldName string, modelName *string,
 	return &result, nil
 }
 
+// mutabilityForField returns the operations during which this field can be used, as defined by `x-ms-mutability`
+// - or nil when this isn't specified, meaning that the field can be used during all operations.
+func mutabilityForField(value spec.Schema) []sdkModels.SDKFieldMutability {
+	values, ok := value.Extensions.GetStringSlice("x-ms-mutability")
+	if !ok || len(values) == 0 {
+		return nil
+	}
+
+	mappings := map[string]sdkModels.SDKFieldMutability{
+		"create": sdkModels.CreateSDKFieldMutability,
+		"read":   sdkModels.ReadSDKFieldMutability,
+		"update": sdkModels.UpdateSDKFieldMutability,
+	}
+	output := make([]sdkModels.SDKFieldMutability, 0)
+	for _, v := range values {
+		mutability, ok := mappings[strings.ToLower(v)]
+		if !ok {
+			logging.Debugf("Ignoring unknown `x-ms-mutability` value %q", v)
+			continue
+		}
+		output = append(output, mutability)
+	}
+	sort.Strings(output)
+	return output
+}
+
 func (c *Context) detailsForField(modelName string, propertyName string, value spec.Schema, isRequired bool, known parserModels.ParseResult) (*sdkModels.SDKField, *parserModels.ParseResult, error) {
 	logging.Tracef("Parsing details for field %q in %q..", propertyName, modelName)
 
@@ -142,12 +173,16 @@ func (c *Context) detailsForField(modelName string, propertyName string, value s
 	}
 	result.Append(known)
 
+	// fields marked with `x-ms-secret` contain a Sensitive value which isn't returned by the API
+	isSecret, _ := value.Extensions.GetBool("x-ms-secret")
+
 	field := sdkModels.SDKField{
-		Required:  isRequired,
-		Optional:  !isRequired, //TODO: re-enable readonly && !value.ReadOnly,
-		ReadOnly:  false,       // TODO: re-enable readonly value.ReadOnly,
-		Sensitive: false,       // todo: this probably needs to be a predefined list, unless there's something we can parse
-		JsonName:  propertyName,
+		Required:   isRequired,
+		Optional:   !isRequired, //TODO: re-enable readonly && !value.ReadOnly,
+		ReadOnly:   false,       // TODO: re-enable readonly value.ReadOnly,
+		Sensitive:  isSecret,
+		JsonName:   propertyName,
+		Mutability: mutabilityForField(value),
 		//Description: value.Description, // TODO: currently causes flapping diff in api definitions, see https://github.com/hashicorp/pandora/issues/3325
 	}
 
@@ -171,6 +206,7 @@ func (c *Context) detailsForField(modelName string, propertyName string, value s
 	if len(value.Properties) > 0 || len(value.AllOf) > 1 {
 		// there's a nested model we need to pull out
 		inlinedName := inlinedModelName(modelName, propertyName)
+		c.AddDiagnostic(diagnostics.InlinedModelRenamedCode, diagnostics.InfoSeverity, c.definitionPointer(modelName, propertyName), fmt.Sprintf("the Model defined inline within the field %q of %q has been named %q", propertyName, modelName, cleanup.Title(inlinedName)))
 		nestedFields := make(map[string]sdkModels.SDKField, 0)
 		for propName, propVal := range value.Properties {
 			nestedFieldRequired := false
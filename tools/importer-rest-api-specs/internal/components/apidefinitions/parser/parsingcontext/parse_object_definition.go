@@ -11,7 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
 	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/apidefinitions/parser/cleanup"
-	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/apidefinitions/parser/constants"
+	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/apidefinitions/parser/diagnostics"
 	parserModels "github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/apidefinitions/parser/models"
 	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/featureflags"
 )
@@ -29,7 +29,7 @@ func (c *Context) ParseObjectDefinition(modelName, propertyName string, input *s
 
 	// if it's an enum then parse that out
 	if len(input.Enum) > 0 {
-		constant, err := constants.Parse(input.Type, propertyName, &modelName, input.Enum, input.Extensions)
+		constant, err := c.parseConstant(input.Type, propertyName, &modelName, input.Enum, input.Extensions, c.definitionPointer(modelName, propertyName))
 		if err != nil {
 			return nil, nil, fmt.Errorf("parsing constant: %+v", err)
 		}
@@ -183,6 +183,9 @@ func (c *Context) ParseObjectDefinition(modelName, propertyName string, input *s
 		if nestedItem == nil {
 			return nil, nil, fmt.Errorf("parsing nested item for array: no nested item returned")
 		}
+		if input.Items.Schema.Title == "" && nestedItem.ReferenceName != nil && *nestedItem.ReferenceName == inlinedName {
+			c.AddDiagnostic(diagnostics.InlinedModelRenamedCode, diagnostics.InfoSeverity, c.definitionPointer(modelName, propertyName), fmt.Sprintf("the Model defined inline within the items of the field %q of %q has been named %q", propertyName, modelName, inlinedName))
+		}
 
 		// TODO: re-enable min/max/unique
 		//if input.MaxItems != nil {
@@ -211,6 +214,10 @@ func (c *Context) ParseObjectDefinition(modelName, propertyName string, input *s
 		return nil, nil, fmt.Errorf("parsing the Data Factory Object Definition: %+v", err)
 	}
 	if dataFactoryObjectDefinition != nil {
+		if dataFactoryObjectDefinition.Type == sdkModels.RawObjectSDKObjectDefinitionType {
+			formatVal, _ := input.Extensions.GetString("x-ms-format")
+			c.AddDiagnostic(diagnostics.UnknownFormatCode, diagnostics.WarningSeverity, c.definitionPointer(modelName, propertyName), fmt.Sprintf("the `x-ms-format` %q used for the field %q of %q is unknown, so it has been parsed as a RawObject", formatVal, propertyName, modelName))
+		}
 		if parseResult != nil {
 			if err := result.Append(*parseResult); err != nil {
 				return nil, nil, fmt.Errorf("appending parseResult: %+v", err)
@@ -221,6 +228,9 @@ func (c *Context) ParseObjectDefinition(modelName, propertyName string, input *s
 
 	// if it's a simple type, there'll be no other objects
 	if nativeType := c.parseNativeType(input); nativeType != nil {
+		if nativeType.Type == sdkModels.RawObjectSDKObjectDefinitionType && len(input.Type) == 0 {
+			c.AddDiagnostic(diagnostics.UnknownFormatCode, diagnostics.WarningSeverity, c.definitionPointer(modelName, propertyName), fmt.Sprintf("the field %q of %q has no type (format %q), so it has been parsed as a RawObject", propertyName, modelName, input.Format))
+		}
 		return nativeType, &result, nil
 	}
 
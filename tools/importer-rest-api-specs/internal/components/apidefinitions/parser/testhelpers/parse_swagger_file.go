@@ -14,6 +14,15 @@ import (
 )
 
 func ParseSwaggerFileForTesting(t *testing.T, filePath string, serviceName *string) (*sdkModels.APIVersion, error) {
+	return parseSwaggerFileForTesting(t, filePath, serviceName, sdkModels.ResourceManagerSourceDataType)
+}
+
+// ParseDataPlaneSwaggerFileForTesting parses the specified Swagger file as a Data Plane API Definition.
+func ParseDataPlaneSwaggerFileForTesting(t *testing.T, filePath string) (*sdkModels.APIVersion, error) {
+	return parseSwaggerFileForTesting(t, filePath, nil, sdkModels.DataPlaneSourceDataType)
+}
+
+func parseSwaggerFileForTesting(t *testing.T, filePath string, serviceName *string, sourceDataType sdkModels.SourceDataType) (*sdkModels.APIVersion, error) {
 	if serviceName == nil {
 		serviceName = pointer.To("Example")
 	}
@@ -29,6 +38,7 @@ func ParseSwaggerFileForTesting(t *testing.T, filePath string, serviceName *stri
 			},
 		},
 		ResourceProvider: nil,
+		SourceDataType:   sourceDataType,
 	}
 	return ParseDataSetForTesting(t, input, "2020-01-01")
 }
@@ -5,6 +5,7 @@ package testhelpers
 
 import (
 	"fmt"
+	"reflect"
 	"sort"
 	"testing"
 
@@ -53,6 +54,9 @@ func validateParsedSDKFieldsMatch(t *testing.T, expected, actual sdkModels.SDKFi
 	if expected.Sensitive != actual.Sensitive {
 		t.Fatalf("expected `Sensitive` to be %t but got %t for Field %q", expected.Sensitive, actual.Sensitive, fieldName)
 	}
+	if !reflect.DeepEqual(expected.Mutability, actual.Mutability) {
+		t.Fatalf("expected `Mutability` to be %+v but got %+v for Field %q", expected.Mutability, actual.Mutability, fieldName)
+	}
 
 	validateParsedObjectDefinitionsMatch(t, expected.ObjectDefinition, actual.ObjectDefinition, fieldName)
 }
@@ -104,6 +108,7 @@ func validateParsedOperationsMatch(t *testing.T, expected, actual sdkModels.SDKO
 	if expected.ContentType != actual.ContentType {
 		t.Fatalf("expected `ContentType` to be %q but got %q for Operation %q", expected.ContentType, actual.ContentType, operationName)
 	}
+	validateMapsMatch(t, expected.Examples, actual.Examples, "Examples", validateParsedOperationExamplesMatch)
 	validateSlicesMatch(t, expected.ExpectedStatusCodes, actual.ExpectedStatusCodes, "ExpectedStatusCodes", validateIntegersMatch)
 	if pointer.From(expected.FieldContainingPaginationDetails) != pointer.From(actual.FieldContainingPaginationDetails) {
 		t.Fatalf("expected `FieldContainingPaginationDetails` to be %q but got %q for Operation %q", pointer.From(expected.FieldContainingPaginationDetails), pointer.From(actual.FieldContainingPaginationDetails), operationName)
@@ -111,6 +116,9 @@ func validateParsedOperationsMatch(t *testing.T, expected, actual sdkModels.SDKO
 	if expected.LongRunning != actual.LongRunning {
 		t.Fatalf("expected `LongRunning` to be %t but got %t for Operation %q", expected.LongRunning, actual.LongRunning, operationName)
 	}
+	if pointer.From(expected.LongRunningFinalStateVia) != pointer.From(actual.LongRunningFinalStateVia) {
+		t.Fatalf("expected `LongRunningFinalStateVia` to be %q but got %q for Operation %q", pointer.From(expected.LongRunningFinalStateVia), pointer.From(actual.LongRunningFinalStateVia), operationName)
+	}
 	if expected.Method != actual.Method {
 		t.Fatalf("expected `Method` to be %q but got %q for Operation %q", expected.Method, actual.Method, operationName)
 	}
@@ -125,6 +133,12 @@ func validateParsedOperationsMatch(t *testing.T, expected, actual sdkModels.SDKO
 	}
 }
 
+func validateParsedOperationExamplesMatch(t *testing.T, expected, actual sdkModels.SDKOperationExample, exampleName string) {
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("expected the Example %q to be %+v but got %+v", exampleName, expected, actual)
+	}
+}
+
 func validateParsedOptionsMatch(t *testing.T, expected, actual sdkModels.SDKOperationOption, optionName string) {
 	if pointer.From(expected.HeaderName) != pointer.From(actual.HeaderName) {
 		t.Errorf("expected `HeaderName` to be %q but got %q for Option %q", pointer.From(expected.HeaderName), pointer.From(actual.HeaderName), optionName)
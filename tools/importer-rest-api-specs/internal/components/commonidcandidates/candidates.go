@@ -0,0 +1,262 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package commonidcandidates
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/apidefinitions/parser/cleanup"
+	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/apidefinitions/parser/comparison"
+	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/apidefinitions/parser/resourceids/commonids"
+)
+
+// Candidate is a Resource ID which is used across multiple Services, but which isn't (yet) a Common ID.
+type Candidate struct {
+	// NormalizedResourceID is the Resource ID with the names of any user-specified segments removed
+	// (e.g. `/subscriptions/{}/resourceGroups/{}/providers/microsoft.keyvault/vaults/{}`) which is used
+	// to group the same Resource ID across Services.
+	NormalizedResourceID string
+
+	// ResourceID is the Resource ID which should be used for this Common ID - where the names of each
+	// segment are the most commonly used across the Services.
+	ResourceID sdkModels.ResourceID
+
+	// ServiceNames is a sorted list of the Services which use this Resource ID.
+	ServiceNames []string
+
+	// SuggestedName is the suggested name for this Common ID (e.g. `KeyVault`).
+	SuggestedName string
+}
+
+// occurrence is a single usage of a Resource ID within a Service.
+type occurrence struct {
+	name       string
+	resourceID sdkModels.ResourceID
+}
+
+// FindCandidates returns the Resource IDs which are used in at least minimumNumberOfServices of the specified
+// Services but aren't already a Common ID, sorted by the number of Services using them (most used first).
+//
+// NOTE: Resource IDs containing Constant segments are skipped, since the Constant would also need to be
+// defined as a Common Type.
+func FindCandidates(services map[string]sdkModels.Service, minimumNumberOfServices int) ([]Candidate, error) {
+	if minimumNumberOfServices < 2 {
+		return nil, fmt.Errorf("the minimum number of Services must be at least 2 but got %d", minimumNumberOfServices)
+	}
+
+	normalizedResourceIDsToServiceNames := make(map[string]map[string]struct{})
+	normalizedResourceIDsToOccurrences := make(map[string][]occurrence)
+	for serviceName, service := range services {
+		for _, apiVersion := range service.APIVersions {
+			for _, resource := range apiVersion.Resources {
+				for name, resourceID := range resource.ResourceIDs {
+					if !isCandidate(resourceID) {
+						continue
+					}
+
+					key := normalizedResourceID(resourceID)
+					if _, ok := normalizedResourceIDsToServiceNames[key]; !ok {
+						normalizedResourceIDsToServiceNames[key] = make(map[string]struct{})
+					}
+					normalizedResourceIDsToServiceNames[key][serviceName] = struct{}{}
+					normalizedResourceIDsToOccurrences[key] = append(normalizedResourceIDsToOccurrences[key], occurrence{
+						name:       name,
+						resourceID: resourceID,
+					})
+				}
+			}
+		}
+	}
+
+	existingNames := make(map[string]struct{})
+	for _, commonId := range commonids.CommonIDTypes {
+		if alias := commonId.ID().CommonIDAlias; alias != nil {
+			existingNames[*alias] = struct{}{}
+		}
+	}
+
+	output := make([]Candidate, 0)
+	for key, serviceNames := range normalizedResourceIDsToServiceNames {
+		if len(serviceNames) < minimumNumberOfServices {
+			continue
+		}
+
+		sortedServiceNames := make([]string, 0)
+		for serviceName := range serviceNames {
+			sortedServiceNames = append(sortedServiceNames, serviceName)
+		}
+		sort.Strings(sortedServiceNames)
+
+		occurrences := normalizedResourceIDsToOccurrences[key]
+		output = append(output, Candidate{
+			NormalizedResourceID: key,
+			ResourceID:           resourceIDFromOccurrences(occurrences),
+			ServiceNames:         sortedServiceNames,
+			SuggestedName:        strings.TrimSuffix(mostCommonValue(occurrences, func(o occurrence) string { return o.name }), "Id"),
+		})
+	}
+
+	sort.Slice(output, func(i, j int) bool {
+		if len(output[i].ServiceNames) != len(output[j].ServiceNames) {
+			return len(output[i].ServiceNames) > len(output[j].ServiceNames)
+		}
+		return output[i].NormalizedResourceID < output[j].NormalizedResourceID
+	})
+
+	// the suggested names need to be unique, both across the Candidates and the existing Common IDs
+	// so where these conflict we prefix the name with the Resource Provider (e.g. `Server` -> `SqlServer`)
+	suggestedNameCounts := make(map[string]int)
+	for _, candidate := range output {
+		suggestedNameCounts[candidate.SuggestedName]++
+	}
+	for i, candidate := range output {
+		name := candidate.SuggestedName
+		_, conflictsWithExisting := existingNames[name]
+		if conflictsWithExisting || suggestedNameCounts[name] > 1 {
+			name = fmt.Sprintf("%s%s", resourceProviderPrefix(candidate.ResourceID), name)
+		}
+		if _, exists := existingNames[name]; exists {
+			name = fmt.Sprintf("%s%d", name, i)
+		}
+		output[i].SuggestedName = name
+		existingNames[name] = struct{}{}
+	}
+
+	return output, nil
+}
+
+// isCandidate determines whether the specified Resource ID could become a Common ID.
+func isCandidate(input sdkModels.ResourceID) bool {
+	if input.CommonIDAlias != nil {
+		return false
+	}
+
+	// Resource IDs which are entirely user-specified (e.g. `/{policyAssignmentId}`) aren't useful as a Common ID
+	containsStaticSegment := false
+	containsUserSpecifiedSegment := false
+	for _, segment := range input.Segments {
+		switch segment.Type {
+		case sdkModels.ConstantResourceIDSegmentType:
+			return false
+
+		case sdkModels.ResourceProviderResourceIDSegmentType, sdkModels.StaticResourceIDSegmentType:
+			containsStaticSegment = true
+
+		case sdkModels.UserSpecifiedResourceIDSegmentType:
+			containsUserSpecifiedSegment = true
+		}
+	}
+	if !containsStaticSegment || !containsUserSpecifiedSegment {
+		return false
+	}
+
+	for _, commonId := range commonids.CommonIDTypes {
+		if comparison.ResourceIDsMatch(commonId.ID(), input) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// normalizedResourceID returns the Resource ID with the names of the user-specified segments removed, since these
+// (along with the casing of the Static segments) commonly differ between Services.
+func normalizedResourceID(input sdkModels.ResourceID) string {
+	components := make([]string, 0)
+	for _, segment := range input.Segments {
+		switch segment.Type {
+		case sdkModels.ResourceProviderResourceIDSegmentType, sdkModels.StaticResourceIDSegmentType:
+			if segment.FixedValue != nil {
+				components = append(components, strings.ToLower(*segment.FixedValue))
+			}
+
+		case sdkModels.ScopeResourceIDSegmentType:
+			components = append(components, "{scope}")
+
+		default:
+			components = append(components, "{}")
+		}
+	}
+	return fmt.Sprintf("/%s", strings.Join(components, "/"))
+}
+
+// resourceIDFromOccurrences builds the Resource ID for a Candidate - using the most common casing for each of the
+// Static segments and the most common name for each of the user-specified segments.
+func resourceIDFromOccurrences(input []occurrence) sdkModels.ResourceID {
+	first := input[0].resourceID
+	segments := make([]sdkModels.ResourceIDSegment, 0)
+	for i, segment := range first.Segments {
+		switch segment.Type {
+		case sdkModels.ResourceGroupResourceIDSegmentType:
+			segments = append(segments, sdkModels.NewResourceGroupNameResourceIDSegment("resourceGroupName"))
+
+		case sdkModels.ResourceProviderResourceIDSegmentType:
+			value := mostCommonValue(input, func(o occurrence) string { return *o.resourceID.Segments[i].FixedValue })
+			segments = append(segments, sdkModels.NewResourceProviderResourceIDSegment(staticSegmentName(value), value))
+
+		case sdkModels.ScopeResourceIDSegmentType:
+			segments = append(segments, sdkModels.NewScopeResourceIDSegment("scope"))
+
+		case sdkModels.StaticResourceIDSegmentType:
+			value := mostCommonValue(input, func(o occurrence) string { return *o.resourceID.Segments[i].FixedValue })
+			segments = append(segments, sdkModels.NewStaticValueResourceIDSegment(staticSegmentName(value), value))
+
+		case sdkModels.SubscriptionIDResourceIDSegmentType:
+			segments = append(segments, sdkModels.NewSubscriptionIDResourceIDSegment("subscriptionId"))
+
+		case sdkModels.UserSpecifiedResourceIDSegmentType:
+			name := mostCommonValue(input, func(o occurrence) string { return o.resourceID.Segments[i].Name })
+			segments = append(segments, sdkModels.NewUserSpecifiedResourceIDSegment(name, name))
+		}
+	}
+
+	return sdkModels.ResourceID{
+		ConstantNames: []string{},
+		Segments:      segments,
+	}
+}
+
+// staticSegmentName returns the name for a Static/Resource Provider segment with the specified value, which is
+// prefixed with `static` (e.g. `staticSubscriptions` or `staticMicrosoftKeyVault`) to match the names used when
+// parsing Resource IDs from the API Definitions.
+func staticSegmentName(value string) string {
+	return fmt.Sprintf("static%s", cleanup.RemoveInvalidCharacters(cleanup.Title(value), false))
+}
+
+// mostCommonValue returns the most common value within the occurrences, using the value which sorts first
+// alphabetically when multiple values are used the same number of times.
+func mostCommonValue(input []occurrence, valueFunc func(o occurrence) string) string {
+	counts := make(map[string]int)
+	for _, item := range input {
+		counts[valueFunc(item)]++
+	}
+
+	mostCommon := ""
+	mostCommonCount := 0
+	for value, count := range counts {
+		if count > mostCommonCount || (count == mostCommonCount && value < mostCommon) {
+			mostCommon = value
+			mostCommonCount = count
+		}
+	}
+	return mostCommon
+}
+
+// resourceProviderPrefix returns the name of the Resource Provider used in the Resource ID, without the
+// `Microsoft.` prefix (e.g. `Microsoft.KeyVault` -> `KeyVault`) for use as a prefix for the Suggested Name.
+func resourceProviderPrefix(input sdkModels.ResourceID) string {
+	for _, segment := range input.Segments {
+		if segment.Type == sdkModels.ResourceProviderResourceIDSegmentType && segment.FixedValue != nil {
+			value := *segment.FixedValue
+			if index := strings.LastIndex(value, "."); index >= 0 {
+				value = value[index+1:]
+			}
+			return strings.ToUpper(value[0:1]) + value[1:]
+		}
+	}
+	return "Scoped"
+}
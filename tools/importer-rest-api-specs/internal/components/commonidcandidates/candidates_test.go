@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package commonidcandidates
+
+import (
+	"strings"
+	"testing"
+
+	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+func TestFindCandidates(t *testing.T) {
+	workspaceID := func(userSpecifiedSegmentName, resourceProvider string) sdkModels.ResourceID {
+		return sdkModels.ResourceID{
+			Segments: []sdkModels.ResourceIDSegment{
+				sdkModels.NewStaticValueResourceIDSegment("staticSubscriptions", "subscriptions"),
+				sdkModels.NewSubscriptionIDResourceIDSegment("subscriptionId"),
+				sdkModels.NewStaticValueResourceIDSegment("staticResourceGroups", "resourceGroups"),
+				sdkModels.NewResourceGroupNameResourceIDSegment("resourceGroupName"),
+				sdkModels.NewStaticValueResourceIDSegment("staticProviders", "providers"),
+				sdkModels.NewResourceProviderResourceIDSegment("staticMicrosoftOperationalInsights", resourceProvider),
+				sdkModels.NewStaticValueResourceIDSegment("staticWorkspaces", "workspaces"),
+				sdkModels.NewUserSpecifiedResourceIDSegment(userSpecifiedSegmentName, userSpecifiedSegmentName),
+			},
+		}
+	}
+	// the Key Vault ID is already a Common ID, so shouldn't be suggested
+	keyVaultID := sdkModels.ResourceID{
+		Segments: []sdkModels.ResourceIDSegment{
+			sdkModels.NewStaticValueResourceIDSegment("staticSubscriptions", "subscriptions"),
+			sdkModels.NewSubscriptionIDResourceIDSegment("subscriptionId"),
+			sdkModels.NewStaticValueResourceIDSegment("staticResourceGroups", "resourceGroups"),
+			sdkModels.NewResourceGroupNameResourceIDSegment("resourceGroupName"),
+			sdkModels.NewStaticValueResourceIDSegment("staticProviders", "providers"),
+			sdkModels.NewResourceProviderResourceIDSegment("staticMicrosoftKeyVault", "Microsoft.KeyVault"),
+			sdkModels.NewStaticValueResourceIDSegment("staticVaults", "vaults"),
+			sdkModels.NewUserSpecifiedResourceIDSegment("vaultName", "vaultName"),
+		},
+	}
+	// a Resource ID which is entirely user-specified isn't useful as a Common ID, so shouldn't be suggested
+	scopedID := sdkModels.ResourceID{
+		Segments: []sdkModels.ResourceIDSegment{
+			sdkModels.NewUserSpecifiedResourceIDSegment("policyAssignmentId", "policyAssignmentId"),
+		},
+	}
+	serviceUsing := func(resourceIDs map[string]sdkModels.ResourceID) sdkModels.Service {
+		return sdkModels.Service{
+			APIVersions: map[string]sdkModels.APIVersion{
+				"2020-01-01": {
+					Resources: map[string]sdkModels.APIResource{
+						"Example": {
+							ResourceIDs: resourceIDs,
+						},
+					},
+				},
+			},
+		}
+	}
+	services := map[string]sdkModels.Service{
+		"First": serviceUsing(map[string]sdkModels.ResourceID{
+			"WorkspaceId": workspaceID("workspaceName", "Microsoft.OperationalInsights"),
+			"VaultId":     keyVaultID,
+			"ScopeId":     scopedID,
+		}),
+		"Second": serviceUsing(map[string]sdkModels.ResourceID{
+			"WorkspaceId": workspaceID("workspaceName", "Microsoft.OperationalInsights"),
+			"VaultId":     keyVaultID,
+			"ScopeId":     scopedID,
+		}),
+		"Third": serviceUsing(map[string]sdkModels.ResourceID{
+			// the casing of the Resource Provider and the name of the segment differ, but it's the same Resource ID
+			"LogAnalyticsWorkspaceId": workspaceID("name", "Microsoft.Operationalinsights"),
+			"VaultId":                 keyVaultID,
+			"ScopeId":                 scopedID,
+		}),
+		"Fourth": serviceUsing(map[string]sdkModels.ResourceID{
+			"WorkspaceId": workspaceID("workspaceName", "Microsoft.Example"),
+		}),
+	}
+
+	actual, err := FindCandidates(services, 3)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if len(actual) != 1 {
+		t.Fatalf("expected 1 Candidate but got %d: %+v", len(actual), actual)
+	}
+
+	candidate := actual[0]
+	if candidate.SuggestedName != "Workspace" {
+		t.Fatalf("expected the Suggested Name to be `Workspace` but got %q", candidate.SuggestedName)
+	}
+	if strings.Join(candidate.ServiceNames, ",") != "First,Second,Third" {
+		t.Fatalf("expected the Services to be `First,Second,Third` but got %q", strings.Join(candidate.ServiceNames, ","))
+	}
+	if candidate.NormalizedResourceID != "/subscriptions/{}/resourcegroups/{}/providers/microsoft.operationalinsights/workspaces/{}" {
+		t.Fatalf("unexpected Normalized Resource ID %q", candidate.NormalizedResourceID)
+	}
+	if v := *candidate.ResourceID.Segments[5].FixedValue; v != "Microsoft.OperationalInsights" {
+		t.Fatalf("expected the most common casing of the Resource Provider to be used but got %q", v)
+	}
+	if v := candidate.ResourceID.Segments[5].Name; v != "staticMicrosoftOperationalInsights" {
+		t.Fatalf("expected the Resource Provider segment to be named `staticMicrosoftOperationalInsights` but got %q", v)
+	}
+	if v := candidate.ResourceID.Segments[6].Name; v != "staticWorkspaces" {
+		t.Fatalf("expected the static segment to be named `staticWorkspaces` but got %q", v)
+	}
+	if v := candidate.ResourceID.Segments[7].Name; v != "workspaceName" {
+		t.Fatalf("expected the most common name for the user-specified segment to be used but got %q", v)
+	}
+
+	if _, err := FindCandidates(services, 1); err == nil {
+		t.Fatalf("expected an error when the minimum number of Services is less than 2 but didn't get one")
+	}
+}
+
+func TestGenerateSkeleton(t *testing.T) {
+	candidates := []Candidate{
+		{
+			ResourceID: sdkModels.ResourceID{
+				Segments: []sdkModels.ResourceIDSegment{
+					sdkModels.NewStaticValueResourceIDSegment("staticSubscriptions", "subscriptions"),
+					sdkModels.NewSubscriptionIDResourceIDSegment("subscriptionId"),
+					sdkModels.NewStaticValueResourceIDSegment("staticResourceGroups", "resourceGroups"),
+					sdkModels.NewResourceGroupNameResourceIDSegment("resourceGroupName"),
+					sdkModels.NewStaticValueResourceIDSegment("staticProviders", "providers"),
+					sdkModels.NewResourceProviderResourceIDSegment("staticMicrosoftOperationalInsights", "Microsoft.OperationalInsights"),
+					sdkModels.NewStaticValueResourceIDSegment("staticWorkspaces", "workspaces"),
+					sdkModels.NewUserSpecifiedResourceIDSegment("workspaceName", "workspaceName"),
+				},
+			},
+			ServiceNames:  []string{"First", "Second", "Third"},
+			SuggestedName: "Workspace",
+		},
+	}
+	actual, err := GenerateSkeleton(candidates)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	expected := []string{
+		"package commonids",
+		"var _ commonIdMatcher = commonIdWorkspace{}",
+		"// commonIdWorkspace is used by the Services: First, Second, Third.",
+		"type commonIdWorkspace struct{}",
+		`name := "Workspace"`,
+		`sdkModels.NewResourceProviderResourceIDSegment("staticMicrosoftOperationalInsights", "Microsoft.OperationalInsights"),`,
+		`sdkModels.NewUserSpecifiedResourceIDSegment("workspaceName", "workspaceName"),`,
+	}
+	for _, line := range expected {
+		if !strings.Contains(*actual, line) {
+			t.Fatalf("expected the generated code to contain %q but it didn't:\n\n%s", line, *actual)
+		}
+	}
+}
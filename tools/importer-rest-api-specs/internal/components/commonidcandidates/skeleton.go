@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package commonidcandidates
+
+import (
+	"fmt"
+	"go/format"
+	"strings"
+
+	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+// GenerateSkeleton generates the Go source for a file within the `commonids` package containing a Common ID for
+// each of the Candidates - which is intended to be reviewed (and split into a file per Common ID) before use.
+func GenerateSkeleton(candidates []Candidate) (*string, error) {
+	lines := []string{
+		"// Copyright (c) HashiCorp, Inc.",
+		"// SPDX-License-Identifier: MPL-2.0",
+		"",
+		"package commonids",
+		"",
+		"// NOTE: this file contains the suggested Common IDs output by the `suggest-common-ids` command of `importer-rest-api-specs`",
+		"// each of which needs to be reviewed, moved into its own file and then added to `CommonIDTypes` before it's used.",
+		"",
+		"import (",
+		"\tsdkModels \"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models\"",
+		")",
+	}
+
+	for _, candidate := range candidates {
+		segments := make([]string, 0)
+		for _, segment := range candidate.ResourceID.Segments {
+			code, err := codeForSegment(segment)
+			if err != nil {
+				return nil, fmt.Errorf("generating the code for the Candidate %q: %+v", candidate.SuggestedName, err)
+			}
+			segments = append(segments, fmt.Sprintf("\t\t\t%s,", *code))
+		}
+
+		typeName := fmt.Sprintf("commonId%s", candidate.SuggestedName)
+		lines = append(lines, fmt.Sprintf(`
+var _ commonIdMatcher = %[1]s{}
+
+// %[1]s is used by the Services: %[3]s.
+type %[1]s struct{}
+
+func (c %[1]s) ID() sdkModels.ResourceID {
+	name := %[2]q
+	return sdkModels.ResourceID{
+		CommonIDAlias: &name,
+		ConstantNames: []string{},
+		Segments: []sdkModels.ResourceIDSegment{
+%[4]s
+		},
+	}
+}`, typeName, candidate.SuggestedName, strings.Join(candidate.ServiceNames, ", "), strings.Join(segments, "\n")))
+	}
+
+	formatted, err := format.Source([]byte(strings.Join(lines, "\n") + "\n"))
+	if err != nil {
+		return nil, fmt.Errorf("formatting the generated code: %+v", err)
+	}
+	output := string(formatted)
+	return &output, nil
+}
+
+func codeForSegment(input sdkModels.ResourceIDSegment) (*string, error) {
+	var code string
+	switch input.Type {
+	case sdkModels.ResourceGroupResourceIDSegmentType:
+		code = fmt.Sprintf("sdkModels.NewResourceGroupNameResourceIDSegment(%q)", input.Name)
+
+	case sdkModels.ResourceProviderResourceIDSegmentType:
+		code = fmt.Sprintf("sdkModels.NewResourceProviderResourceIDSegment(%q, %q)", input.Name, *input.FixedValue)
+
+	case sdkModels.ScopeResourceIDSegmentType:
+		code = fmt.Sprintf("sdkModels.NewScopeResourceIDSegment(%q)", input.Name)
+
+	case sdkModels.StaticResourceIDSegmentType:
+		code = fmt.Sprintf("sdkModels.NewStaticValueResourceIDSegment(%q, %q)", input.Name, *input.FixedValue)
+
+	case sdkModels.SubscriptionIDResourceIDSegmentType:
+		code = fmt.Sprintf("sdkModels.NewSubscriptionIDResourceIDSegment(%q)", input.Name)
+
+	case sdkModels.UserSpecifiedResourceIDSegmentType:
+		code = fmt.Sprintf("sdkModels.NewUserSpecifiedResourceIDSegment(%q, %q)", input.Name, input.ExampleValue)
+
+	default:
+		return nil, fmt.Errorf("unsupported Segment Type %q", string(input.Type))
+	}
+
+	return &code, nil
+}
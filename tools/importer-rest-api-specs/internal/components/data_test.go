@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
 	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/apidefinitions"
 	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/discovery"
 	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/terraform"
@@ -35,7 +36,7 @@ func TestCanDiscoverFilesForAllServicesFromConfig(t *testing.T) {
 
 	for _, service := range servicesFromConfigurationFile.Services {
 		t.Run(service.Name, func(t *testing.T) {
-			availableDataSet, err := discovery.DiscoverForService(service, restAPISpecsDirectory)
+			availableDataSet, err := discovery.DiscoverForService(service, restAPISpecsDirectory, sdkModels.ResourceManagerSourceDataType)
 			if err != nil {
 				t.Fatalf("discovering Data for Service %q: %+v", service.Name, err)
 			}
@@ -57,7 +58,7 @@ func TestCanParseFilesForAllServicesFromConfig(t *testing.T) {
 
 	for _, service := range servicesFromConfigurationFile.Services {
 		t.Run(service.Name, func(t *testing.T) {
-			availableDataSet, err := discovery.DiscoverForService(service, restAPISpecsDirectory)
+			availableDataSet, err := discovery.DiscoverForService(service, restAPISpecsDirectory, sdkModels.ResourceManagerSourceDataType)
 			if err != nil {
 				t.Fatalf("discovering Data for Service %q: %+v", service.Name, err)
 			}
@@ -100,7 +101,7 @@ func TestCanBuildTerraformResources(t *testing.T) {
 			t.Fatalf("Unable to find the Configuration for the Service %q referenced in Terraform Resources", serviceName)
 		}
 
-		availableDataSet, err := discovery.DiscoverForService(*service, restAPISpecsDirectory)
+		availableDataSet, err := discovery.DiscoverForService(*service, restAPISpecsDirectory, sdkModels.ResourceManagerSourceDataType)
 		if err != nil {
 			t.Fatalf("discovering Data for Service %q: %+v", serviceName, err)
 		}
@@ -110,7 +111,7 @@ func TestCanBuildTerraformResources(t *testing.T) {
 			t.Errorf("parsing Data for Service %q: %+v", serviceName, err)
 		}
 
-		buildTerraform, err := terraform.BuildForService(*parsedService, serviceDetails.resourceLabelToResourceDefinitions, providerPrefix, serviceDetails.terraformPackageName)
+		buildTerraform, err := terraform.BuildForService(*parsedService, serviceDetails.resourceLabelToResourceDefinitions, providerPrefix, serviceDetails.terraformPackageName, serviceDetails.terraformFramework, nil)
 		if err != nil {
 			t.Fatalf("building Terraform for Service %q: %+v", serviceName, err)
 		}
@@ -122,6 +123,7 @@ func TestCanBuildTerraformResources(t *testing.T) {
 type terraformDetailsForService struct {
 	resourceLabelToResourceDefinitions map[string]definitions.ResourceDefinition
 	terraformPackageName               *string
+	terraformFramework                 definitions.TerraformFramework
 }
 
 func loadTerraformConfigurations(terraformDefinitionsDirectory string) (map[string]terraformDetailsForService, error) {
@@ -146,6 +148,7 @@ func loadTerraformConfigurations(terraformDefinitionsDirectory string) (map[stri
 		servicesToTerraformDetails[serviceName] = terraformDetailsForService{
 			resourceLabelToResourceDefinitions: terraformResourceDefinition,
 			terraformPackageName:               pointer.To(serviceData.TerraformPackageName),
+			terraformFramework:                 serviceData.TerraformFramework,
 		}
 	}
 	return servicesToTerraformDetails, nil
@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package discovery
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/logging"
+)
+
+// DiscoverAPIVersionsForService discovers the API Versions available for the Service within the specified
+// directory (e.g. `appconfiguration`) for the Source Data Type, ordered by name.
+// `workingDirectory` is the path to the `Azure/azure-rest-api-specs` dependency
+func DiscoverAPIVersionsForService(serviceDirectoryName, workingDirectory string, sourceDataType sdkModels.SourceDataType) ([]string, error) {
+	logging.Infof("Discovering API Versions for the Service Directory %q within %q..", serviceDirectoryName, workingDirectory)
+	serviceDirectory, err := filepath.Abs(filepath.Join(workingDirectory, "specification", serviceDirectoryName))
+	if err != nil {
+		return nil, fmt.Errorf("determining the absolute path to the Service Directory %q in %q: %+v", serviceDirectoryName, workingDirectory, err)
+	}
+
+	filePaths, err := filesWithinDirectory(serviceDirectory)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving a list of files within %q: %+v", serviceDirectory, err)
+	}
+
+	return apiVersionsWithinFilePaths(serviceDirectory, *filePaths, sourceDataType), nil
+}
+
+// LatestAPIVersion returns the most recent Stable API Version within apiVersions - or the most recent API Version
+// when no Stable API Versions are available.
+func LatestAPIVersion(apiVersions []string) *string {
+	var latest *string
+	var latestStable *string
+	for i := range apiVersions {
+		apiVersion := apiVersions[i]
+		if latest == nil || apiVersion > *latest {
+			latest = &apiVersion
+		}
+		if isStableAPIVersion(apiVersion) && (latestStable == nil || apiVersion > *latestStable) {
+			latestStable = &apiVersion
+		}
+	}
+	if latestStable != nil {
+		return latestStable
+	}
+	return latest
+}
+
+// apiVersionsWithinFilePaths returns the distinct API Versions for the API Definitions within filePaths, which
+// are structured as `{serviceDirectory}/{sourceDataType}/.../(stable|preview)/{apiVersion}/{fileName}`.
+func apiVersionsWithinFilePaths(serviceDirectory string, filePaths []string, sourceDataType sdkModels.SourceDataType) []string {
+	apiVersions := make(map[string]struct{})
+	for _, filePath := range filePaths {
+		relativePath, err := filepath.Rel(serviceDirectory, filePath)
+		if err != nil {
+			continue
+		}
+
+		components := strings.Split(relativePath, fmt.Sprintf("%c", filepath.Separator))
+		if len(components) < 4 || !strings.EqualFold(components[0], string(sourceDataType)) {
+			continue
+		}
+
+		// the last component is the file name, so the API Version has to come before it
+		directories := components[:len(components)-1]
+		for i := 0; i < len(directories)-1; i++ {
+			if strings.EqualFold(directories[i], "examples") {
+				break
+			}
+			if strings.EqualFold(directories[i], "stable") || strings.EqualFold(directories[i], "preview") {
+				apiVersions[directories[i+1]] = struct{}{}
+				break
+			}
+		}
+	}
+
+	output := make([]string, 0)
+	for apiVersion := range apiVersions {
+		output = append(output, apiVersion)
+	}
+	sort.Strings(output)
+	return output
+}
@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package discovery
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+func TestAPIVersionsWithinFilePaths(t *testing.T) {
+	filePaths := []string{
+		"specification/compute/Compute.Management/examples/2022-01-01/VirtualMachine_Get.json",
+		"specification/compute/data-plane/Microsoft.Compute/stable/7.4/compute.json",
+		"specification/compute/resource-manager/Microsoft.Compute/CloudserviceRP/stable/2022-09-04/cloudService.json",
+		"specification/compute/resource-manager/Microsoft.Compute/ComputeRP/stable/2020-01-01/virtualMachines.json",
+		"specification/compute/resource-manager/Microsoft.Compute/ComputeRP/stable/2020-01-01/examples/VirtualMachine_Get.json",
+		"specification/compute/resource-manager/Microsoft.Compute/ComputeRP/preview/2021-01-01-preview/virtualMachines.json",
+		"specification/compute/resource-manager/Microsoft.Compute/ComputeRP/stable/2020-01-01/disks.json",
+		"specification/compute/resource-manager/Microsoft.Compute/common-types/v1/common.json",
+	}
+
+	actual := apiVersionsWithinFilePaths("specification/compute", filePaths, sdkModels.ResourceManagerSourceDataType)
+	expected := []string{
+		"2020-01-01",
+		"2021-01-01-preview",
+		"2022-09-04",
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+
+	actual = apiVersionsWithinFilePaths("specification/compute", filePaths, sdkModels.DataPlaneSourceDataType)
+	expected = []string{
+		"7.4",
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestLatestAPIVersion(t *testing.T) {
+	testData := []struct {
+		apiVersions []string
+		expected    *string
+	}{
+		{
+			apiVersions: []string{},
+			expected:    nil,
+		},
+		{
+			apiVersions: []string{"2020-01-01", "2022-01-01", "2023-01-01-preview"},
+			expected:    pointer.To("2022-01-01"),
+		},
+		{
+			apiVersions: []string{"2021-01-01-preview", "2023-01-01-preview"},
+			expected:    pointer.To("2023-01-01-preview"),
+		},
+	}
+	for _, v := range testData {
+		actual := LatestAPIVersion(v.apiVersions)
+		if pointer.From(actual) != pointer.From(v.expected) {
+			t.Fatalf("expected %q but got %q for %+v", pointer.From(v.expected), pointer.From(actual), v.apiVersions)
+		}
+	}
+}
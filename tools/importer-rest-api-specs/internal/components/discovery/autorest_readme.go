@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package discovery
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/discovery/models"
+	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/logging"
+)
+
+// autoRestTagConditionRegex matches the condition on a YAML code block within an AutoRest `readme.md` which
+// applies only to a specific Tag, for example "```yaml $(tag) == 'package-2023-01'".
+var autoRestTagConditionRegex = regexp.MustCompile("^```\\s*yaml\\s+.*\\$\\(tag\\)\\s*==\\s*'([^']+)'")
+
+// discoverDataSetForAPIVersionFromAutoRestTag identifies the files which contain API Definitions for the API Version
+// using the `input-file`s defined for the specified Tag within the AutoRest `readme.md` at readmeFilePath.
+func discoverDataSetForAPIVersionFromAutoRestTag(apiVersion, tag, readmeFilePath string) (*models.AvailableDataSetForAPIVersion, error) {
+	contents, err := os.ReadFile(readmeFilePath)
+	if err != nil {
+		return nil, fmt.Errorf("reading the AutoRest readme %q: %+v", readmeFilePath, err)
+	}
+
+	tagsToInputFiles, err := parseInputFilesFromAutoRestReadme(string(contents))
+	if err != nil {
+		return nil, fmt.Errorf("parsing the AutoRest readme %q: %+v", readmeFilePath, err)
+	}
+	inputFiles, ok := tagsToInputFiles[tag]
+	if !ok {
+		return nil, fmt.Errorf("the Tag %q was not found within the AutoRest readme %q", tag, readmeFilePath)
+	}
+
+	// the `input-file`s are relative to the directory containing the readme
+	directory := filepath.Dir(readmeFilePath)
+	filePathsContainingAPIDefinitions := make([]string, 0)
+	for _, inputFile := range inputFiles {
+		filePath := filepath.Join(directory, filepath.FromSlash(inputFile))
+		if _, err := os.Stat(filePath); err != nil {
+			return nil, fmt.Errorf("the `input-file` %q for the Tag %q was not found: %+v", inputFile, tag, err)
+		}
+		filePathsContainingAPIDefinitions = append(filePathsContainingAPIDefinitions, filePath)
+	}
+	sort.Strings(filePathsContainingAPIDefinitions)
+
+	return &models.AvailableDataSetForAPIVersion{
+		APIVersion:                        apiVersion,
+		ContainsStableAPIVersion:          isStableAPIVersion(apiVersion),
+		FilePathsContainingAPIDefinitions: filePathsContainingAPIDefinitions,
+	}, nil
+}
+
+// parseInputFilesFromAutoRestReadme parses the contents of an AutoRest `readme.md` file, returning a map of
+// Tag (key) to the `input-file`s defined for that Tag (value).
+//
+// The `input-file`s for each Tag are defined within a YAML code block conditional on that Tag, for example:
+//
+//	```yaml $(tag) == 'package-2023-01'
+//	input-file:
+//	  - Microsoft.Example/stable/2023-01-01/example.json
+//	```
+func parseInputFilesFromAutoRestReadme(contents string) (map[string][]string, error) {
+	output := make(map[string][]string)
+
+	var currentTag *string
+	inInputFiles := false
+	scanner := bufio.NewScanner(strings.NewReader(contents))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		if currentTag == nil {
+			if matches := autoRestTagConditionRegex.FindStringSubmatch(line); len(matches) == 2 {
+				tag := matches[1]
+				currentTag = &tag
+				inInputFiles = false
+			}
+			continue
+		}
+
+		if strings.HasPrefix(line, "```") {
+			// the end of the code block for this Tag
+			currentTag = nil
+			inInputFiles = false
+			continue
+		}
+
+		if strings.HasPrefix(line, "input-file:") {
+			inInputFiles = true
+			continue
+		}
+		if !inInputFiles || line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if !strings.HasPrefix(line, "-") {
+			// another key within the YAML block
+			inInputFiles = false
+			continue
+		}
+
+		inputFile := strings.TrimSpace(strings.TrimPrefix(line, "-"))
+		inputFile = strings.Trim(inputFile, `"'`)
+		// newer readme's prefix each path with the directory containing the readme
+		inputFile = strings.TrimPrefix(inputFile, "$(this-folder)/")
+		if inputFile == "" || containsString(output[*currentTag], inputFile) {
+			continue
+		}
+		output[*currentTag] = append(output[*currentTag], inputFile)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("scanning: %+v", err)
+	}
+
+	logging.Tracef("Found %d Tags within the AutoRest readme", len(output))
+	return output, nil
+}
+
+func containsString(input []string, value string) bool {
+	for _, item := range input {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
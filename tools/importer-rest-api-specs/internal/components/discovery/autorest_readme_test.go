@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package discovery
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/discovery/models"
+	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/logging"
+)
+
+const exampleAutoRestReadme = "# Example\n" +
+	"\n" +
+	"``` yaml\n" +
+	"openapi-type: arm\n" +
+	"tag: package-2023-01\n" +
+	"```\n" +
+	"\n" +
+	"### Tag: package-2023-01\n" +
+	"\n" +
+	"These settings apply only when `--tag=package-2023-01` is specified on the command line.\n" +
+	"\n" +
+	"```yaml $(tag) == 'package-2023-01'\n" +
+	"input-file:\n" +
+	"  - Microsoft.Example/stable/2023-01-01/example.json\n" +
+	"  - Microsoft.Example/stable/2023-01-01/other.json\n" +
+	"suppressions:\n" +
+	"  - code: SomeRule\n" +
+	"```\n" +
+	"\n" +
+	"### Tag: package-2022-01-preview\n" +
+	"\n" +
+	"``` yaml $(tag) == 'package-2022-01-preview'\n" +
+	"input-file:\n" +
+	"  - $(this-folder)/Microsoft.Example/preview/2022-01-01-preview/example.json\n" +
+	"```\n" +
+	"\n" +
+	"``` yaml $(python)\n" +
+	"input-file:\n" +
+	"  - Microsoft.Example/stable/2020-01-01/ignored.json\n" +
+	"```\n"
+
+func TestParseInputFilesFromAutoRestReadme(t *testing.T) {
+	expected := map[string][]string{
+		"package-2023-01": {
+			"Microsoft.Example/stable/2023-01-01/example.json",
+			"Microsoft.Example/stable/2023-01-01/other.json",
+		},
+		"package-2022-01-preview": {
+			"Microsoft.Example/preview/2022-01-01-preview/example.json",
+		},
+	}
+	logging.Log = hclog.New(hclog.DefaultOptions)
+	actual, err := parseInputFilesFromAutoRestReadme(exampleAutoRestReadme)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("expected and actual did not match - expected |%+v| but got |%+v|", expected, actual)
+	}
+}
+
+func TestDiscoverDataSetForAPIVersionFromAutoRestTag(t *testing.T) {
+	directory := t.TempDir()
+	files := []string{
+		"readme.md",
+		"Microsoft.Example/stable/2023-01-01/example.json",
+		"Microsoft.Example/stable/2023-01-01/other.json",
+		// not included in the Tag and so should be ignored
+		"Microsoft.Example/stable/2023-01-01/unused.json",
+	}
+	for _, file := range files {
+		filePath := filepath.Join(directory, filepath.FromSlash(file))
+		if err := os.MkdirAll(filepath.Dir(filePath), os.ModePerm); err != nil {
+			t.Fatalf("creating directory for %q: %+v", filePath, err)
+		}
+		contents := "{}"
+		if file == "readme.md" {
+			contents = exampleAutoRestReadme
+		}
+		if err := os.WriteFile(filePath, []byte(contents), 0644); err != nil {
+			t.Fatalf("writing %q: %+v", filePath, err)
+		}
+	}
+
+	expected := models.AvailableDataSetForAPIVersion{
+		APIVersion:               "2023-01-01",
+		ContainsStableAPIVersion: true,
+		FilePathsContainingAPIDefinitions: []string{
+			filepath.Join(directory, "Microsoft.Example", "stable", "2023-01-01", "example.json"),
+			filepath.Join(directory, "Microsoft.Example", "stable", "2023-01-01", "other.json"),
+		},
+	}
+	logging.Log = hclog.New(hclog.DefaultOptions)
+	actual, err := discoverDataSetForAPIVersionFromAutoRestTag("2023-01-01", "package-2023-01", filepath.Join(directory, "readme.md"))
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if !reflect.DeepEqual(expected, *actual) {
+		t.Fatalf("expected and actual did not match - expected |%+v| but got |%+v|", expected, *actual)
+	}
+
+	// the files for the preview Tag don't exist, so this should fail
+	if _, err := discoverDataSetForAPIVersionFromAutoRestTag("2022-01-01-preview", "package-2022-01-preview", filepath.Join(directory, "readme.md")); err == nil {
+		t.Fatalf("expected an error for a missing `input-file` but didn't get one")
+	}
+	if _, err := discoverDataSetForAPIVersionFromAutoRestTag("2023-01-01", "package-missing", filepath.Join(directory, "readme.md")); err == nil {
+		t.Fatalf("expected an error for a missing Tag but didn't get one")
+	}
+}
@@ -9,12 +9,14 @@ import (
 	"sort"
 	"strings"
 
+	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
 	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/discovery/models"
 	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/logging"
 )
 
 // discoverDataSetForAPIVersion parses a set of filePaths and identifies the files which contain API Definitions for the API Version.
-func discoverDataSetForAPIVersion(apiVersion string, filePaths []string) (*models.AvailableDataSetForAPIVersion, error) {
+// Files within the `data-plane` directory are only used when the sourceDataType is Data Plane, and are otherwise ignored.
+func discoverDataSetForAPIVersion(apiVersion string, filePaths []string, sourceDataType sdkModels.SourceDataType) (*models.AvailableDataSetForAPIVersion, error) {
 	// handle this being Stable/Preview etc
 	// e.g. /2020-02-01/ to ensure we don't unintentionally also bring in Preview API versions
 	apiVersionDirectory := fmt.Sprintf("%c%s%c", filepath.Separator, apiVersion, filepath.Separator)
@@ -27,12 +29,13 @@ func discoverDataSetForAPIVersion(apiVersion string, filePaths []string) (*model
 			// TypeSpec examples live within `./{service}/{namespace}/examples/{apiVersion}/{fileName}`
 			// Swagger examples live within `./{service}/resource-manager/(stable|preview)/{apiVersion}/examples/{fileName}`
 			// So just handling the directory name here is fine
+			// NOTE: Swagger examples are instead loaded via the `x-ms-examples` extension on each Operation, since
+			// these aren't API Definitions themselves
 			shouldIgnore := false
+			containsDataPlane := false
 			for _, item := range strings.Split(filePath, fmt.Sprintf("%c", filepath.Separator)) {
 				if strings.EqualFold(item, "data-plane") {
-					logging.Tracef("File contains `data-plane`, skipping..")
-					shouldIgnore = true
-					break
+					containsDataPlane = true
 				}
 				if strings.EqualFold(item, "examples") {
 					logging.Tracef("File contains examples, skipping..")
@@ -40,6 +43,12 @@ func discoverDataSetForAPIVersion(apiVersion string, filePaths []string) (*model
 					break
 				}
 			}
+			// Data Plane API Definitions live within `./{service}/data-plane/...` - and so are only used when
+			// importing Data Plane, whereas everything else (e.g. `resource-manager`) is used otherwise
+			if isDataPlane := sourceDataType == sdkModels.DataPlaneSourceDataType; containsDataPlane != isDataPlane {
+				logging.Tracef("File isn't applicable to the Source Data Type %q, skipping..", string(sourceDataType))
+				shouldIgnore = true
+			}
 			if shouldIgnore {
 				continue
 			}
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/go-hclog"
+	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
 	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/discovery/models"
 	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/logging"
 )
@@ -27,7 +28,7 @@ func TestDiscoverDataSetForAPIVersion_IgnoresExamples(t *testing.T) {
 		},
 	}
 	logging.Log = hclog.New(hclog.DefaultOptions)
-	actual, err := discoverDataSetForAPIVersion(apiVersion, filePaths)
+	actual, err := discoverDataSetForAPIVersion(apiVersion, filePaths, sdkModels.ResourceManagerSourceDataType)
 	if err != nil {
 		t.Fatalf("unexpected error: %+v", err)
 	}
@@ -56,7 +57,7 @@ func TestDiscoverDataSetForAPIVersion_IgnoresNonJSONFiles(t *testing.T) {
 		},
 	}
 	logging.Log = hclog.New(hclog.DefaultOptions)
-	actual, err := discoverDataSetForAPIVersion(apiVersion, filePaths)
+	actual, err := discoverDataSetForAPIVersion(apiVersion, filePaths, sdkModels.ResourceManagerSourceDataType)
 	if err != nil {
 		t.Fatalf("unexpected error: %+v", err)
 	}
@@ -82,7 +83,7 @@ func TestDiscoverDataSetForAPIVersion_PreviewAPIVersion(t *testing.T) {
 		},
 	}
 	logging.Log = hclog.New(hclog.DefaultOptions)
-	actual, err := discoverDataSetForAPIVersion(apiVersion, filePaths)
+	actual, err := discoverDataSetForAPIVersion(apiVersion, filePaths, sdkModels.ResourceManagerSourceDataType)
 	if err != nil {
 		t.Fatalf("unexpected error: %+v", err)
 	}
@@ -108,7 +109,60 @@ func TestDiscoverDataSetForAPIVersion_StableAPIVersion(t *testing.T) {
 		},
 	}
 	logging.Log = hclog.New(hclog.DefaultOptions)
-	actual, err := discoverDataSetForAPIVersion(apiVersion, filePaths)
+	actual, err := discoverDataSetForAPIVersion(apiVersion, filePaths, sdkModels.ResourceManagerSourceDataType)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if actual == nil {
+		t.Fatalf("expected a value but got nil")
+	}
+	if !reflect.DeepEqual(expected, *actual) {
+		t.Fatalf("expected and actual did not match - expected |%+v| but got |%+v", expected, *actual)
+	}
+}
+
+func TestDiscoverDataSetForAPIVersion_IgnoresDataPlaneForResourceManager(t *testing.T) {
+	apiVersion := "7.4"
+	filePaths := []string{
+		"specification/keyvault/data-plane/Microsoft.KeyVault/stable/7.4/secrets.json",
+		"specification/keyvault/resource-manager/Microsoft.KeyVault/stable/7.4/keyvault.json",
+	}
+	expected := models.AvailableDataSetForAPIVersion{
+		APIVersion:               "7.4",
+		ContainsStableAPIVersion: true,
+		FilePathsContainingAPIDefinitions: []string{
+			"specification/keyvault/resource-manager/Microsoft.KeyVault/stable/7.4/keyvault.json",
+		},
+	}
+	logging.Log = hclog.New(hclog.DefaultOptions)
+	actual, err := discoverDataSetForAPIVersion(apiVersion, filePaths, sdkModels.ResourceManagerSourceDataType)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if actual == nil {
+		t.Fatalf("expected a value but got nil")
+	}
+	if !reflect.DeepEqual(expected, *actual) {
+		t.Fatalf("expected and actual did not match - expected |%+v| but got |%+v", expected, *actual)
+	}
+}
+
+func TestDiscoverDataSetForAPIVersion_DataPlane(t *testing.T) {
+	apiVersion := "7.4"
+	filePaths := []string{
+		"specification/keyvault/data-plane/Microsoft.KeyVault/stable/7.4/examples/GetSecret-example.json",
+		"specification/keyvault/data-plane/Microsoft.KeyVault/stable/7.4/secrets.json",
+		"specification/keyvault/resource-manager/Microsoft.KeyVault/stable/7.4/keyvault.json",
+	}
+	expected := models.AvailableDataSetForAPIVersion{
+		APIVersion:               "7.4",
+		ContainsStableAPIVersion: true,
+		FilePathsContainingAPIDefinitions: []string{
+			"specification/keyvault/data-plane/Microsoft.KeyVault/stable/7.4/secrets.json",
+		},
+	}
+	logging.Log = hclog.New(hclog.DefaultOptions)
+	actual, err := discoverDataSetForAPIVersion(apiVersion, filePaths, sdkModels.DataPlaneSourceDataType)
 	if err != nil {
 		t.Fatalf("unexpected error: %+v", err)
 	}
@@ -8,6 +8,7 @@ import (
 	"path/filepath"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
 	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/discovery/models"
 	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/logging"
 	"github.com/hashicorp/pandora/tools/sdk/config/services"
@@ -16,7 +17,9 @@ import (
 // DiscoverForService discovers the Available Data Set for the specified Service.
 // `workingDirectory` is the path to the `Azure/azure-rest-api-specs` dependency
 // `service` is the Configuration File for the Service which should be loaded.
-func DiscoverForService(service services.Service, workingDirectory string) (*models.AvailableDataSet, error) {
+// `sourceDataType` is the Source Data Type being imported, which determines whether the `resource-manager`
+// or `data-plane` API Definitions within the Service directory are used.
+func DiscoverForService(service services.Service, workingDirectory string, sourceDataType sdkModels.SourceDataType) (*models.AvailableDataSet, error) {
 	logging.Infof("Discovering API Definitions for Service %q within %q..", service.Name, workingDirectory)
 	specificationsDirectory := filepath.Join(workingDirectory, "specification")
 	serviceDirectory, err := filepath.Abs(filepath.Join(specificationsDirectory, service.Directory))
@@ -30,24 +33,42 @@ func DiscoverForService(service services.Service, workingDirectory string) (*mod
 		return nil, fmt.Errorf("retrieving a list of files within %q: %+v", workingDirectory, err)
 	}
 
-	// determine the Resource Provider for this Resource Manager service
-	resourceProvider := pointer.From(service.ResourceProvider)
-	if service.ResourceProvider == nil {
-		logging.Debugf("Determining the Resource Provider for Service %q in %q..", service.Name, serviceDirectory)
-		resourceProviderName, err := determineDefaultResourceProviderForService(serviceDirectory, service.Name, *filePaths)
-		if err != nil {
-			return nil, fmt.Errorf("determining the Resource Provider for Service %q in %q: %+v", service.Name, serviceDirectory, err)
+	// determine the Resource Provider for this Resource Manager service - Data Plane services are addressed
+	// by paths relative to the (Parameterized) Host rather than by Resource ID, so don't have one
+	var resourceProvider *string
+	if sourceDataType == sdkModels.ResourceManagerSourceDataType {
+		resourceProvider = service.ResourceProvider
+		if resourceProvider == nil {
+			logging.Debugf("Determining the Resource Provider for Service %q in %q..", service.Name, serviceDirectory)
+			resourceProviderName, err := determineDefaultResourceProviderForService(serviceDirectory, service.Name, *filePaths)
+			if err != nil {
+				return nil, fmt.Errorf("determining the Resource Provider for Service %q in %q: %+v", service.Name, serviceDirectory, err)
+			}
+			resourceProvider = resourceProviderName
+		}
+		logging.Tracef("Identified %q as the Resource Provider for the Service %q..", *resourceProvider, service.Name)
+	}
+
+	for apiVersion := range pointer.From(service.Tags) {
+		if !containsString(service.Available, apiVersion) {
+			return nil, fmt.Errorf("a Tag is specified for the API Version %q of Service %q which isn't available", apiVersion, service.Name)
 		}
-		resourceProvider = *resourceProviderName
 	}
-	logging.Tracef("Identified %q as the Resource Provider for the Service %q..", resourceProvider, service.Name)
 
 	// now that we know the files within this directory, iterate over the API versions we're expecting and pull out those files
 	dataSetsForAPIVersions := make(map[string]models.AvailableDataSetForAPIVersion)
 	for _, apiVersion := range service.Available {
 		// NOTE: information on the available paths can be found in the README for this package
 		logging.Debugf("Discovering the available Data Set for API Version %q..", apiVersion)
-		dataSet, err := discoverDataSetForAPIVersion(apiVersion, *filePaths)
+		var dataSet *models.AvailableDataSetForAPIVersion
+		if tag, ok := pointer.From(service.Tags)[apiVersion]; ok {
+			// when a Tag is specified, the files defined for that Tag within the AutoRest readme are used
+			readmeFilePath := filepath.Join(serviceDirectory, string(sourceDataType), "readme.md")
+			logging.Debugf("Using the `input-file`s for the Tag %q within %q..", tag, readmeFilePath)
+			dataSet, err = discoverDataSetForAPIVersionFromAutoRestTag(apiVersion, tag, readmeFilePath)
+		} else {
+			dataSet, err = discoverDataSetForAPIVersion(apiVersion, *filePaths, sourceDataType)
+		}
 		if err != nil {
 			return nil, fmt.Errorf("discovering the Data Set for the API Version %q for Service %q: %+v", apiVersion, service.Name, err)
 		}
@@ -59,6 +80,7 @@ func DiscoverForService(service services.Service, workingDirectory string) (*mod
 	return &models.AvailableDataSet{
 		ServiceName:            service.Name,
 		DataSetsForAPIVersions: dataSetsForAPIVersions,
-		ResourceProvider:       pointer.To(resourceProvider),
+		ResourceProvider:       resourceProvider,
+		SourceDataType:         sourceDataType,
 	}, nil
 }
@@ -3,6 +3,8 @@
 
 package models
 
+import sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+
 // AvailableDataSet defines the available Data Sets for a Service - including the API Definitions
 // for each API Version and any additional files.
 type AvailableDataSet struct {
@@ -15,4 +17,7 @@ type AvailableDataSet struct {
 
 	// ResourceProvider is the Resource Provider associated with this Data Set.
 	ResourceProvider *string
+
+	// SourceDataType is the Source Data Type which this Data Set was discovered for.
+	SourceDataType sdkModels.SourceDataType
 }
@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scaffold
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/gohcl"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/terraform/identification"
+	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/terraform/schema/helpers"
+	"github.com/hashicorp/pandora/tools/sdk/config/services"
+	"github.com/zclconf/go-cty/cty"
+)
+
+const fileHeader = `# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+`
+
+// ResourceDefinition describes a Terraform Resource to be defined within the Resource Definitions (`./config/resources`).
+type ResourceDefinition struct {
+	// APIResource is the name of the APIResource containing the Operations for this Resource.
+	APIResource string
+
+	// Description is the description for this Resource (e.g. `Manages a Virtual Network`).
+	Description string
+
+	// DisplayName is the human-friendly display name for this Resource (e.g. `Virtual Network`).
+	DisplayName string
+
+	// ID is the Resource ID which defines this Resource.
+	ID string
+
+	// ResourceLabel is the label for this Resource, without the provider prefix (e.g. `virtual_network`).
+	ResourceLabel string
+
+	// SupportsUpdate specifies whether this Resource can be updated in-place, when false the Update method
+	// isn't generated.
+	SupportsUpdate bool
+
+	// WebsiteSubcategory is the subcategory which this Resource should appear under on the website.
+	WebsiteSubcategory string
+}
+
+// ResourceDefinitionsFromCandidates builds a ResourceDefinition for each of the Resource ID Candidates - where the
+// same Resource Label would be used for multiple Candidates only the first is used.
+func ResourceDefinitionsFromCandidates(websiteSubcategory string, candidates []identification.ResourceIDCandidate) []ResourceDefinition {
+	output := make([]ResourceDefinition, 0)
+	resourceLabels := make(map[string]struct{})
+	for _, candidate := range candidates {
+		resourceLabel := helpers.ConvertToSnakeCase(strings.TrimSuffix(candidate.ResourceIDName, "Id"))
+		if _, exists := resourceLabels[resourceLabel]; exists {
+			continue
+		}
+		resourceLabels[resourceLabel] = struct{}{}
+
+		displayName := displayNameForResourceLabel(resourceLabel)
+		output = append(output, ResourceDefinition{
+			APIResource:        candidate.APIResource,
+			Description:        fmt.Sprintf("Manages %s %s", indefiniteArticleFor(displayName), displayName),
+			DisplayName:        displayName,
+			ID:                 candidate.ResourceID.ExampleValue,
+			ResourceLabel:      resourceLabel,
+			SupportsUpdate:     candidate.SupportsUpdate,
+			WebsiteSubcategory: websiteSubcategory,
+		})
+	}
+	return output
+}
+
+// displayNameForResourceLabel returns the human-friendly display name for the Resource Label,
+// e.g. `Virtual Network` for `virtual_network`.
+func displayNameForResourceLabel(input string) string {
+	words := make([]string, 0)
+	for _, word := range strings.Split(input, "_") {
+		if word == "" {
+			continue
+		}
+		words = append(words, strings.ToUpper(word[:1])+word[1:])
+	}
+	return strings.Join(words, " ")
+}
+
+// indefiniteArticleFor returns the indefinite article (`a` or `an`) for the specified Display Name, based on
+// whether it starts with a vowel (e.g. `an Application Gateway` and `a Virtual Network`).
+func indefiniteArticleFor(input string) string {
+	if input != "" && strings.ContainsRune("AEIOU", rune(strings.ToUpper(input)[0])) {
+		return "an"
+	}
+	return "a"
+}
+
+// ServiceConfiguration returns the HCL for the Service Configuration (e.g. `./config/resource-manager.hcl`)
+// containing the specified Service.
+func ServiceConfiguration(service services.Service) []byte {
+	config := services.Config{
+		Services: []services.Service{
+			service,
+		},
+	}
+	file := hclwrite.NewEmptyFile()
+	gohcl.EncodeIntoBody(&config, file.Body())
+	return withFileHeader(hclwrite.Format(file.Bytes()))
+}
+
+// ResourceDefinitionsConfiguration returns the HCL for the Resource Definitions (`./config/resources`) containing
+// the specified Resources within the API Version of the Service.
+func ResourceDefinitionsConfiguration(serviceName, terraformPackageName, apiVersion string, resources []ResourceDefinition) []byte {
+	apiResourcesToResources := make(map[string][]ResourceDefinition)
+	for _, resource := range resources {
+		apiResourcesToResources[resource.APIResource] = append(apiResourcesToResources[resource.APIResource], resource)
+	}
+	apiResourceNames := make([]string, 0)
+	for apiResourceName := range apiResourcesToResources {
+		apiResourceNames = append(apiResourceNames, apiResourceName)
+	}
+	sort.Strings(apiResourceNames)
+
+	file := hclwrite.NewEmptyFile()
+	serviceBody := file.Body().AppendNewBlock("service", []string{serviceName}).Body()
+	serviceBody.SetAttributeValue("terraform_package", cty.StringVal(terraformPackageName))
+	serviceBody.AppendNewline()
+
+	apiVersionBody := serviceBody.AppendNewBlock("api", []string{apiVersion}).Body()
+	for i, apiResourceName := range apiResourceNames {
+		if i > 0 {
+			apiVersionBody.AppendNewline()
+		}
+		packageBody := apiVersionBody.AppendNewBlock("package", []string{apiResourceName}).Body()
+		for _, resource := range apiResourcesToResources[apiResourceName] {
+			definitionBody := packageBody.AppendNewBlock("definition", []string{resource.ResourceLabel}).Body()
+			definitionBody.SetAttributeValue("id", cty.StringVal(resource.ID))
+			definitionBody.SetAttributeValue("display_name", cty.StringVal(resource.DisplayName))
+			definitionBody.SetAttributeValue("website_subcategory", cty.StringVal(resource.WebsiteSubcategory))
+			definitionBody.SetAttributeValue("description", cty.StringVal(resource.Description))
+			if !resource.SupportsUpdate {
+				definitionBody.SetAttributeValue("generate_update", cty.False)
+			}
+		}
+	}
+
+	return withFileHeader(hclwrite.Format(file.Bytes()))
+}
+
+func withFileHeader(input []byte) []byte {
+	return []byte(fmt.Sprintf("%s%s\n", fileHeader, strings.TrimSpace(string(input))))
+}
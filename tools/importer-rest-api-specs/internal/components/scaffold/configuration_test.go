@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scaffold
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/terraform/identification"
+	"github.com/hashicorp/pandora/tools/sdk/config/definitions"
+	"github.com/hashicorp/pandora/tools/sdk/config/services"
+)
+
+func TestServiceConfigurationRoundTrips(t *testing.T) {
+	expected := services.Service{
+		Directory: "network",
+		Name:      "Network",
+		Available: []string{
+			"2022-07-01",
+			"2023-09-01",
+		},
+		ResourceProvider: pointer.To("Microsoft.Network"),
+	}
+	filePath := filepath.Join(t.TempDir(), "resource-manager.hcl")
+	if err := os.WriteFile(filePath, ServiceConfiguration(expected), 0644); err != nil {
+		t.Fatalf("writing %q: %+v", filePath, err)
+	}
+
+	actual, err := services.LoadFromFile(filePath)
+	if err != nil {
+		t.Fatalf("loading %q: %+v", filePath, err)
+	}
+	if len(actual.Services) != 1 {
+		t.Fatalf("expected 1 Service but got %d", len(actual.Services))
+	}
+	if !reflect.DeepEqual(expected, actual.Services[0]) {
+		t.Fatalf("expected %+v but got %+v", expected, actual.Services[0])
+	}
+}
+
+func TestResourceDefinitionsConfigurationRoundTrips(t *testing.T) {
+	candidates := []identification.ResourceIDCandidate{
+		{
+			APIResource: "Subnets",
+			ResourceID: sdkModels.ResourceID{
+				ExampleValue: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Network/virtualNetworks/{virtualNetworkName}/subnets/{subnetName}",
+			},
+			ResourceIDName: "SubnetId",
+		},
+		{
+			APIResource: "VirtualNetworks",
+			ResourceID: sdkModels.ResourceID{
+				ExampleValue: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Network/virtualNetworks/{virtualNetworkName}",
+			},
+			ResourceIDName: "VirtualNetworkId",
+			SupportsUpdate: true,
+		},
+	}
+	resources := ResourceDefinitionsFromCandidates("Network", candidates)
+	directory := t.TempDir()
+	filePath := filepath.Join(directory, "network.hcl")
+	if err := os.WriteFile(filePath, ResourceDefinitionsConfiguration("Network", "network", "2023-09-01", resources), 0644); err != nil {
+		t.Fatalf("writing %q: %+v", filePath, err)
+	}
+
+	actual, err := definitions.LoadFromDirectory(directory)
+	if err != nil {
+		t.Fatalf("loading %q: %+v", directory, err)
+	}
+	service, ok := actual.Services["Network"]
+	if !ok {
+		t.Fatalf("expected the Service `Network` but it wasn't found")
+	}
+	if service.TerraformPackageName != "network" {
+		t.Fatalf("expected the Terraform Package to be `network` but got %q", service.TerraformPackageName)
+	}
+	apiVersion, ok := service.ApiVersions["2023-09-01"]
+	if !ok {
+		t.Fatalf("expected the API Version `2023-09-01` but it wasn't found")
+	}
+	for _, candidate := range candidates {
+		pkg, ok := apiVersion.Packages[candidate.APIResource]
+		if !ok {
+			t.Fatalf("expected the Package %q but it wasn't found", candidate.APIResource)
+		}
+		if len(pkg.Definitions) != 1 {
+			t.Fatalf("expected 1 Definition within the Package %q but got %d", candidate.APIResource, len(pkg.Definitions))
+		}
+		for _, definition := range pkg.Definitions {
+			if definition.ID != candidate.ResourceID.ExampleValue {
+				t.Fatalf("expected the ID to be %q but got %q", candidate.ResourceID.ExampleValue, definition.ID)
+			}
+			if !definition.GenerateCreate || !definition.GenerateRead || !definition.GenerateDelete {
+				t.Fatalf("expected the Create, Read and Delete methods to be generated for %q", definition.ResourceLabel)
+			}
+			if definition.GenerateUpdate != candidate.SupportsUpdate {
+				t.Fatalf("expected the Update method to be generated for %q to be %t but got %t", definition.ResourceLabel, candidate.SupportsUpdate, definition.GenerateUpdate)
+			}
+		}
+	}
+	if _, ok := apiVersion.Packages["VirtualNetworks"].Definitions["virtual_network"]; !ok {
+		t.Fatalf("expected the Definition `virtual_network` but it wasn't found")
+	}
+	if v := apiVersion.Packages["VirtualNetworks"].Definitions["virtual_network"].Name; v != "Virtual Network" {
+		t.Fatalf("expected the Display Name to be `Virtual Network` but got %q", v)
+	}
+}
+
+func TestResourceDefinitionsFromCandidates_Description(t *testing.T) {
+	testData := []struct {
+		resourceIDName string
+		expected       string
+	}{
+		{
+			resourceIDName: "ApplicationGatewayId",
+			expected:       "Manages an Application Gateway",
+		},
+		{
+			resourceIDName: "VirtualNetworkId",
+			expected:       "Manages a Virtual Network",
+		},
+	}
+	for _, v := range testData {
+		t.Logf("Test %q", v.resourceIDName)
+
+		actual := ResourceDefinitionsFromCandidates("Network", []identification.ResourceIDCandidate{
+			{
+				ResourceIDName: v.resourceIDName,
+			},
+		})
+		if actual[0].Description != v.expected {
+			t.Fatalf("expected the Description to be %q but got %q", v.expected, actual[0].Description)
+		}
+	}
+}
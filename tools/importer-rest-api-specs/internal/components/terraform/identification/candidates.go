@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identification
+
+import (
+	"fmt"
+	"sort"
+
+	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	"github.com/hashicorp/pandora/tools/sdk/config/definitions"
+)
+
+// ResourceIDCandidate describes a Resource ID within an APIResource which has the Create, Read and Delete
+// Operations required for a Terraform Resource to be generated for it.
+type ResourceIDCandidate struct {
+	// APIResource is the name of the APIResource containing the Operations for this Resource ID.
+	APIResource string
+
+	// ResourceID is the Resource ID itself.
+	ResourceID sdkModels.ResourceID
+
+	// ResourceIDName is the name of the Resource ID within the APIResource (e.g. `VirtualNetworkId`).
+	ResourceIDName string
+
+	// SupportsUpdate specifies whether an Update Operation is also available for this Resource ID.
+	SupportsUpdate bool
+}
+
+// CandidatesWithinAPIVersion identifies each of the Resource IDs within the APIVersion which a Terraform Resource
+// could be generated for - using the same logic as WithinService, but without requiring a Resource Definition -
+// ordered by APIResource and then Resource ID name.
+func CandidatesWithinAPIVersion(input sdkModels.APIVersion) ([]ResourceIDCandidate, error) {
+	output := make([]ResourceIDCandidate, 0)
+
+	for apiResourceName, apiResource := range input.Resources {
+		for resourceIDName, resourceID := range apiResource.ResourceIDs {
+			resourceMetaData := definitions.ResourceDefinition{
+				APIResource:    apiResourceName,
+				APIVersion:     input.APIVersion,
+				GenerateCreate: true,
+				GenerateDelete: true,
+				GenerateRead:   true,
+				GenerateUpdate: true,
+				ID:             resourceID.ExampleValue,
+			}
+			methods, err := identifyMethodsForAPIResource(apiResource, resourceMetaData, resourceIDName)
+			if err != nil {
+				return nil, fmt.Errorf("identifying the methods for the Resource ID %q within the APIResource %q: %+v", resourceIDName, apiResourceName, err)
+			}
+
+			resourceDefinition := buildResource(resourceIDName, resourceMetaData, *methods)
+			if resourceDefinition == nil {
+				continue
+			}
+
+			// Resources containing a Discriminated Type aren't supported at this time, see WithinService
+			hasDiscriminatedType, err := containsDiscriminatedTypes(resourceDefinition, apiResource)
+			if err != nil {
+				return nil, fmt.Errorf("determining if the Resource ID %q within the APIResource %q contains Discriminated Types: %+v", resourceIDName, apiResourceName, err)
+			}
+			if *hasDiscriminatedType {
+				continue
+			}
+
+			output = append(output, ResourceIDCandidate{
+				APIResource:    apiResourceName,
+				ResourceID:     resourceID,
+				ResourceIDName: resourceIDName,
+				SupportsUpdate: methods.updateMethod != nil,
+			})
+		}
+	}
+
+	sort.Slice(output, func(i, j int) bool {
+		if output[i].APIResource != output[j].APIResource {
+			return output[i].APIResource < output[j].APIResource
+		}
+		return output[i].ResourceIDName < output[j].ResourceIDName
+	})
+	return output, nil
+}
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identification
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+func TestCandidatesWithinAPIVersion(t *testing.T) {
+	apiVersion := sdkModels.APIVersion{
+		APIVersion: "2020-01-01",
+		Generate:   true,
+		Resources: map[string]sdkModels.APIResource{
+			"VirtualNetworks": {
+				Operations: map[string]sdkModels.SDKOperation{
+					"CreateOrUpdate": {
+						Method: "PUT",
+						RequestObject: &sdkModels.SDKObjectDefinition{
+							Type:          sdkModels.ReferenceSDKObjectDefinitionType,
+							ReferenceName: pointer.To("SomeModel"),
+						},
+						ResourceIDName: pointer.To("VirtualNetwork"),
+					},
+					"Get": {
+						Method: "GET",
+						ResponseObject: &sdkModels.SDKObjectDefinition{
+							Type:          sdkModels.ReferenceSDKObjectDefinitionType,
+							ReferenceName: pointer.To("SomeModel"),
+						},
+						ResourceIDName: pointer.To("VirtualNetwork"),
+					},
+					"Delete": {
+						Method:         "DELETE",
+						ResourceIDName: pointer.To("VirtualNetwork"),
+					},
+					// there's no Create/Delete for the Subnet, so this shouldn't be a candidate
+					"GetSubnet": {
+						Method: "GET",
+						ResponseObject: &sdkModels.SDKObjectDefinition{
+							Type:          sdkModels.ReferenceSDKObjectDefinitionType,
+							ReferenceName: pointer.To("SomeModel"),
+						},
+						ResourceIDName: pointer.To("Subnet"),
+					},
+				},
+				Models: map[string]sdkModels.SDKModel{
+					"SomeModel": {
+						Fields: map[string]sdkModels.SDKField{
+							"Properties": {},
+						},
+					},
+				},
+				ResourceIDs: map[string]sdkModels.ResourceID{
+					"VirtualNetwork": {
+						ExampleValue: "/virtualNetworks/{virtualNetworkName}",
+						Segments: []sdkModels.ResourceIDSegment{
+							sdkModels.NewStaticValueResourceIDSegment("staticVirtualNetworks", "virtualNetworks"),
+							sdkModels.NewUserSpecifiedResourceIDSegment("virtualNetworkName", "virtualNetworkName"),
+						},
+					},
+					"Subnet": {
+						ExampleValue: "/virtualNetworks/{virtualNetworkName}/subnets/{subnetName}",
+						Segments: []sdkModels.ResourceIDSegment{
+							sdkModels.NewStaticValueResourceIDSegment("staticVirtualNetworks", "virtualNetworks"),
+							sdkModels.NewUserSpecifiedResourceIDSegment("virtualNetworkName", "virtualNetworkName"),
+							sdkModels.NewStaticValueResourceIDSegment("staticSubnets", "subnets"),
+							sdkModels.NewUserSpecifiedResourceIDSegment("subnetName", "subnetName"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	actual, err := CandidatesWithinAPIVersion(apiVersion)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if len(actual) != 1 {
+		t.Fatalf("expected 1 Candidate but got %d: %+v", len(actual), actual)
+	}
+	if actual[0].APIResource != "VirtualNetworks" {
+		t.Fatalf("expected the APIResource to be `VirtualNetworks` but got %q", actual[0].APIResource)
+	}
+	if actual[0].ResourceIDName != "VirtualNetwork" {
+		t.Fatalf("expected the ResourceIDName to be `VirtualNetwork` but got %q", actual[0].ResourceIDName)
+	}
+	if !actual[0].SupportsUpdate {
+		t.Fatalf("expected the Candidate to support Update since the Create method is a CreateOrUpdate")
+	}
+}
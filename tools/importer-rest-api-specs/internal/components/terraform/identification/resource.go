@@ -25,10 +25,25 @@ func buildResource(resourceIdName string, resourceMetaData definitions.ResourceD
 		return nil
 	}
 
+	var dataSource *sdkModels.TerraformDataSourceDefinition
+	if resourceMetaData.DataSource != nil {
+		// the Data Source uses the same timeout as the Read function of the Resource, unless overridden
+		timeoutInMinutes := methods.getMethod.TimeoutInMinutes
+		if v := resourceMetaData.DataSource.TimeoutInMinutes; v != nil {
+			timeoutInMinutes = *v
+		}
+		dataSource = &sdkModels.TerraformDataSourceDefinition{
+			Description:      resourceMetaData.DataSource.Description,
+			Generate:         true,
+			TimeoutInMinutes: timeoutInMinutes,
+		}
+	}
+
 	return &sdkModels.TerraformResourceDefinition{
 		APIResource:  resourceMetaData.APIResource,
 		APIVersion:   resourceMetaData.APIVersion,
 		CreateMethod: *methods.createMethod,
+		DataSource:   dataSource,
 		DeleteMethod: *methods.deleteMethod,
 		Documentation: sdkModels.TerraformDocumentationDefinition{
 			Category:    resourceMetaData.WebsiteSubcategory,
@@ -552,3 +552,142 @@ func TestIdentityWithinServiceCreateReadDeleteAndUpdateTags(t *testing.T) {
 		t.Fatalf("expected the Delete SDKOperationName to be `Delete` but got %q", virtualNetworkResource.Resource.DeleteMethod.SDKOperationName)
 	}
 }
+
+func TestIdentityWithinServiceWithDataSource(t *testing.T) {
+	service := sdkModels.Service{
+		Name:     "Networking",
+		Generate: true,
+		APIVersions: map[string]sdkModels.APIVersion{
+			"2020-01-01": {
+				APIVersion: "2020-01-01",
+				Generate:   true,
+				Resources: map[string]sdkModels.APIResource{
+					"VirtualNetworks": {
+						Operations: map[string]sdkModels.SDKOperation{
+							"CreateOrUpdate": {
+								Method: "PUT",
+								RequestObject: &sdkModels.SDKObjectDefinition{
+									Type:          sdkModels.ReferenceSDKObjectDefinitionType,
+									ReferenceName: pointer.To("SomeModel"),
+								},
+								ResourceIDName: pointer.To("VirtualNetwork"),
+							},
+							"Get": {
+								Method: "GET",
+								ResponseObject: &sdkModels.SDKObjectDefinition{
+									Type:          sdkModels.ReferenceSDKObjectDefinitionType,
+									ReferenceName: pointer.To("SomeModel"),
+								},
+								ResourceIDName: pointer.To("VirtualNetwork"),
+							},
+							"Delete": {
+								Method:         "DELETE",
+								ResourceIDName: pointer.To("VirtualNetwork"),
+							},
+						},
+						Models: map[string]sdkModels.SDKModel{
+							"SomeModel": {
+								Fields: map[string]sdkModels.SDKField{
+									"Properties": {},
+								},
+							},
+						},
+						ResourceIDs: map[string]sdkModels.ResourceID{
+							"VirtualNetwork": {
+								ExampleValue: "/virtualNetworks/{virtualNetworkName}",
+								Segments: []sdkModels.ResourceIDSegment{
+									sdkModels.NewStaticValueResourceIDSegment("staticVirtualNetworks", "virtualNetworks"),
+									sdkModels.NewUserSpecifiedResourceIDSegment("virtualNetworkName", "virtualNetworkName"),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	resources := map[string]definitions.ResourceDefinition{
+		"VirtualNetwork": {
+			ServiceName:    "Networking",
+			APIVersion:     "2020-01-01",
+			APIResource:    "VirtualNetworks",
+			ResourceLabel:  "virtual_network",
+			ID:             "/virtualNetworks/{virtualNetworkName}",
+			Name:           "virtual_network",
+			GenerateCreate: true,
+			GenerateDelete: true,
+			GenerateRead:   true,
+			GenerateUpdate: true,
+			TestData:       definitions.ResourceTestDataDefinition{},
+			Overrides:      &[]definitions.Override{},
+			DataSource: &definitions.DataSourceDefinition{
+				Description: "Gets information about an existing Virtual Network",
+			},
+		},
+	}
+	result, err := WithinService("azurerm", service, resources)
+	if err != nil {
+		t.Fatalf("error: %+v", err)
+	}
+	if result == nil {
+		t.Fatalf("expected a result but didn't get one")
+	}
+	virtualNetworkResource, ok := result.Resources["VirtualNetwork"]
+	if !ok {
+		t.Fatalf("expected there to be a resource named `VirtualNetwork` but there wasn't")
+	}
+	dataSource := virtualNetworkResource.Resource.DataSource
+	if dataSource == nil {
+		t.Fatalf("expected the Data Source to exist but was nil")
+	}
+	if !dataSource.Generate {
+		t.Fatalf("expected the Data Source to be generated but it wasn't")
+	}
+	if dataSource.Description != "Gets information about an existing Virtual Network" {
+		t.Fatalf("expected the Data Source Description to be `Gets information about an existing Virtual Network` but got %q", dataSource.Description)
+	}
+	if dataSource.TimeoutInMinutes != virtualNetworkResource.Resource.ReadMethod.TimeoutInMinutes {
+		t.Fatalf("expected the Data Source Timeout to match the Read Method (%d) but got %d", virtualNetworkResource.Resource.ReadMethod.TimeoutInMinutes, dataSource.TimeoutInMinutes)
+	}
+}
+
+func TestBuildResourceWithDataSourceTimeout(t *testing.T) {
+	methods := methodsForResource{
+		createMethod: &sdkModels.TerraformMethodDefinition{SDKOperationName: "CreateOrUpdate", TimeoutInMinutes: 30},
+		deleteMethod: &sdkModels.TerraformMethodDefinition{SDKOperationName: "Delete", TimeoutInMinutes: 30},
+		getMethod:    &sdkModels.TerraformMethodDefinition{SDKOperationName: "Get", TimeoutInMinutes: 10},
+	}
+	testData := []struct {
+		name     string
+		override *int
+		expected int
+	}{
+		{
+			name:     "defaults to the Read Method",
+			override: nil,
+			expected: 10,
+		},
+		{
+			name:     "overridden in the Data Source",
+			override: pointer.To(15),
+			expected: 15,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("Test %q", v.name)
+
+		resourceMetaData := definitions.ResourceDefinition{
+			DataSource: &definitions.DataSourceDefinition{
+				Description:      "Gets information about an existing Virtual Network",
+				TimeoutInMinutes: v.override,
+			},
+		}
+		actual := buildResource("VirtualNetwork", resourceMetaData, methods)
+		if actual == nil || actual.DataSource == nil {
+			t.Fatalf("expected a Data Source but didn't get one")
+		}
+		if actual.DataSource.TimeoutInMinutes != v.expected {
+			t.Fatalf("expected the Data Source Timeout to be %d but got %d", v.expected, actual.DataSource.TimeoutInMinutes)
+		}
+	}
+}
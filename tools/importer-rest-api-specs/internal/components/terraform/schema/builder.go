@@ -116,6 +116,16 @@ func (b Builder) Build(input sdkModels.TerraformResourceDefinition, resourceDefi
 		schemaModels[modelName] = model
 	}
 
+	// then apply any overrides for the fields within this Resource (e.g. ForceNew/Validation)
+	if resourceDefinition.Overrides != nil {
+		updatedSchemaModels, updatedMappings, err := applyFieldOverrides(input.SchemaModelName, schemaModels, mappings, *resourceDefinition.Overrides)
+		if err != nil {
+			return nil, nil, fmt.Errorf("applying overrides: %+v", err)
+		}
+		schemaModels = updatedSchemaModels
+		mappings = *updatedMappings
+	}
+
 	// finally go through and remove any unused models
 	outputSchemaModels, outputMappings, err := b.removeUnusedModelsAndMappings(input, schemaModels, mappings)
 	if err != nil {
@@ -201,6 +211,30 @@ func (b Builder) removeUnusedModelToModelMappings(input sdkModels.TerraformMappi
 					break
 				}
 			}
+			if o, ok := other.(sdkModels.TerraformBooleanEqualsFieldMappingDefinition); ok {
+				if o.BooleanEquals.SDKModelName == associatedModelName {
+					hasMappings = true
+					break
+				}
+			}
+			if o, ok := other.(sdkModels.TerraformBooleanInvertFieldMappingDefinition); ok {
+				if o.BooleanInvert.SDKModelName == associatedModelName {
+					hasMappings = true
+					break
+				}
+			}
+			if o, ok := other.(sdkModels.TerraformSubResourceIdFieldMappingDefinition); ok {
+				if o.SubResourceId.SDKModelName == associatedModelName {
+					hasMappings = true
+					break
+				}
+			}
+			if o, ok := other.(sdkModels.TerraformSubResourceIdListFieldMappingDefinition); ok {
+				if o.SubResourceIdList.SDKModelName == associatedModelName {
+					hasMappings = true
+					break
+				}
+			}
 		}
 		if hasMappings {
 			output.Fields = append(output.Fields, mapping)
@@ -228,6 +262,30 @@ func removeUnusedMappingsFromSchemaModelNamed(modelName string, inputMappings []
 			}
 			continue
 		}
+		if v, ok := item.(sdkModels.TerraformBooleanEqualsFieldMappingDefinition); ok {
+			if v.BooleanEquals.TerraformSchemaModelName != modelName {
+				output = append(output, v)
+			}
+			continue
+		}
+		if v, ok := item.(sdkModels.TerraformBooleanInvertFieldMappingDefinition); ok {
+			if v.BooleanInvert.TerraformSchemaModelName != modelName {
+				output = append(output, v)
+			}
+			continue
+		}
+		if v, ok := item.(sdkModels.TerraformSubResourceIdFieldMappingDefinition); ok {
+			if v.SubResourceId.TerraformSchemaModelName != modelName {
+				output = append(output, v)
+			}
+			continue
+		}
+		if v, ok := item.(sdkModels.TerraformSubResourceIdListFieldMappingDefinition); ok {
+			if v.SubResourceIdList.TerraformSchemaModelName != modelName {
+				output = append(output, v)
+			}
+			continue
+		}
 
 		return nil, fmt.Errorf("internal-error: unimplemented mapping type %T", item)
 	}
@@ -432,10 +490,11 @@ func (b Builder) buildNestedModelDefinition(schemaModelName, topLevelModelName,
 		isOptional := sdkField.Optional
 
 		definition := sdkModels.TerraformSchemaField{
-			Required: isRequired,
-			ForceNew: isForceNew,
-			Optional: isOptional,
-			Computed: isComputed,
+			Required:  isRequired,
+			ForceNew:  isForceNew,
+			Optional:  isOptional,
+			Computed:  isComputed,
+			Sensitive: sdkField.Sensitive,
 		}
 
 		fieldObjectDefinition, err := b.convertToFieldObjectDefinition(topLevelModelName, sdkField.ObjectDefinition)
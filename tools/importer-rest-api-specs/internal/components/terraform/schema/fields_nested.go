@@ -43,6 +43,20 @@ func (b Builder) identifyFieldsWithinPropertiesBlock(schemaModelName string, inp
 			}
 		}
 
+		if hasCreate || hasUpdate {
+			mutabilityField := updateField
+			if hasCreate {
+				mutabilityField = createField
+			}
+			// when there's no separate Update payload the Create payload is also used to update the resource
+			createAndUpdateShareModel := input.updateModelName == nil || (input.updatePropertiesModelName != nil && *input.updatePropertiesModelName == input.createPropertiesModelName)
+			hasCreate, hasUpdate = applyFieldMutability(*mutabilityField, hasCreate, hasUpdate, createAndUpdateShareModel)
+			if !hasCreate && !hasUpdate && !hasRead {
+				log.Printf("[DEBUG] Skipping Properties Field %q since it can't be specified during Create or Update", k)
+				continue
+			}
+		}
+
 		// based on this information
 		isReadOnlyField := (hasCreate && createField.ReadOnly) || (hasRead && readField.ReadOnly)
 		isForceNew := false
@@ -72,6 +86,8 @@ func (b Builder) identifyFieldsWithinPropertiesBlock(schemaModelName string, inp
 			return nil, nil, fmt.Errorf("internal-error: the Field %q was both Required and Optional", k)
 		}
 
+		isSensitive := (hasCreate && createField.Sensitive) || (hasUpdate && updateField.Sensitive) || (hasRead && readField.Sensitive)
+
 		var validation sdkModels.TerraformSchemaFieldValidationDefinition
 		var err error
 		if hasCreate {
@@ -113,11 +129,12 @@ func (b Builder) identifyFieldsWithinPropertiesBlock(schemaModelName string, inp
 
 		// TODO(@tombuildsstuff): refactor this and the "nested model" field to use the same parser ideally..?!
 		definition := sdkModels.TerraformSchemaField{
-			HCLName:  schemaFieldName,
-			Required: isRequired,
-			ForceNew: isForceNew,
-			Optional: isOptional,
-			Computed: isReadOnlyField,
+			HCLName:   schemaFieldName,
+			Required:  isRequired,
+			ForceNew:  isForceNew,
+			Optional:  isOptional,
+			Computed:  isReadOnlyField,
+			Sensitive: isSensitive,
 			// this is only used when outputting the mappings
 			// 4 types of mappings: Create/Read/Update/Resource ID - all nullable
 			// If a Create and Update Mapping are present but a Read isn't it's implicitly WriteOnly
@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	"github.com/hashicorp/pandora/tools/sdk/config/definitions"
+)
+
+func apiResourceForMutabilityTesting() sdkModels.APIResource {
+	propertiesFields := map[string]sdkModels.SDKField{
+		"Mode": {
+			JsonName: "mode",
+			Mutability: []sdkModels.SDKFieldMutability{
+				sdkModels.CreateSDKFieldMutability,
+				sdkModels.ReadSDKFieldMutability,
+			},
+			ObjectDefinition: sdkModels.SDKObjectDefinition{
+				Type: sdkModels.StringSDKObjectDefinitionType,
+			},
+			Optional: true,
+		},
+		"Password": {
+			JsonName: "password",
+			Mutability: []sdkModels.SDKFieldMutability{
+				sdkModels.UpdateSDKFieldMutability,
+			},
+			ObjectDefinition: sdkModels.SDKObjectDefinition{
+				Type: sdkModels.StringSDKObjectDefinitionType,
+			},
+			Optional: true,
+		},
+		"Size": {
+			JsonName: "size",
+			ObjectDefinition: sdkModels.SDKObjectDefinition{
+				Type: sdkModels.IntegerSDKObjectDefinitionType,
+			},
+			Optional: true,
+		},
+	}
+	return sdkModels.APIResource{
+		Constants: map[string]sdkModels.SDKConstant{},
+		Models: map[string]sdkModels.SDKModel{
+			"Thing": {
+				Fields: map[string]sdkModels.SDKField{
+					"Properties": {
+						JsonName: "properties",
+						ObjectDefinition: sdkModels.SDKObjectDefinition{
+							Type:          sdkModels.ReferenceSDKObjectDefinitionType,
+							ReferenceName: pointer.To("ThingProperties"),
+						},
+						Optional: true,
+					},
+				},
+			},
+			"ThingProperties": {
+				Fields: propertiesFields,
+			},
+			"ThingUpdate": {
+				Fields: map[string]sdkModels.SDKField{
+					"Properties": {
+						JsonName: "properties",
+						ObjectDefinition: sdkModels.SDKObjectDefinition{
+							Type:          sdkModels.ReferenceSDKObjectDefinitionType,
+							ReferenceName: pointer.To("ThingUpdateProperties"),
+						},
+						Optional: true,
+					},
+				},
+			},
+			"ThingUpdateProperties": {
+				Fields: propertiesFields,
+			},
+		},
+	}
+}
+
+func TestIdentifyFieldsWithinPropertiesBlock_Mutability(t *testing.T) {
+	apiResource := apiResourceForMutabilityTesting()
+	payloads := operationPayloads{
+		createModelName:           "Thing",
+		createPayload:             apiResource.Models["Thing"],
+		createPropertiesModelName: "ThingProperties",
+		createPropertiesPayload:   apiResource.Models["ThingProperties"],
+		readModelName:             "Thing",
+		readPayload:               apiResource.Models["Thing"],
+		readPropertiesModelName:   "ThingProperties",
+		readPropertiesPayload:     apiResource.Models["ThingProperties"],
+		updateModelName:           pointer.To("ThingUpdate"),
+		updatePayload:             pointer.To(apiResource.Models["ThingUpdate"]),
+		updatePropertiesModelName: pointer.To("ThingUpdateProperties"),
+		updatePropertiesPayload:   pointer.To(apiResource.Models["ThingUpdateProperties"]),
+	}
+
+	builder := NewBuilder(apiResource)
+	resource := sdkModels.TerraformResourceDefinition{}
+	mappings := sdkModels.TerraformMappingDefinition{}
+	fields, actualMappings, err := builder.identifyFieldsWithinPropertiesBlock("ThingResource", payloads, &resource, &mappings, definitions.ResourceDefinition{})
+	if err != nil {
+		t.Fatalf("identifying fields: %+v", err)
+	}
+
+	// a field which can only be specified during Create can't be updated
+	if !fields["Mode"].ForceNew {
+		t.Fatalf("expected the field `Mode` to be ForceNew but it wasn't")
+	}
+	checkNoDirectAssignmentMappingExistsFor(t, actualMappings.Fields, "Mode", "ThingUpdateProperties")
+
+	// a field which can only be specified during Update is omitted from Create
+	if fields["Password"].ForceNew {
+		t.Fatalf("expected the field `Password` not to be ForceNew but it was")
+	}
+	checkDirectAssignmentMappingExistsBetween(t, actualMappings.Fields, "ThingResource", "Password", "ThingUpdateProperties", "Password")
+	checkNoDirectAssignmentMappingExistsFor(t, actualMappings.Fields, "Password", "ThingProperties")
+
+	// a field without a mutability can be specified during both Create and Update
+	if fields["Size"].ForceNew {
+		t.Fatalf("expected the field `Size` not to be ForceNew but it was")
+	}
+	checkDirectAssignmentMappingExistsBetween(t, actualMappings.Fields, "ThingResource", "Size", "ThingProperties", "Size")
+	checkDirectAssignmentMappingExistsBetween(t, actualMappings.Fields, "ThingResource", "Size", "ThingUpdateProperties", "Size")
+}
+
+func TestIdentifyFieldsWithinPropertiesBlock_MutabilityWithoutAnUpdatePayload(t *testing.T) {
+	// a resource which is only created/updated via a PUT has no separate Update payload, and so any fields which
+	// can only be specified during Update are sent in the Create payload
+	apiResource := apiResourceForMutabilityTesting()
+	payloads := operationPayloads{
+		createModelName:           "Thing",
+		createPayload:             apiResource.Models["Thing"],
+		createPropertiesModelName: "ThingProperties",
+		createPropertiesPayload:   apiResource.Models["ThingProperties"],
+		readModelName:             "Thing",
+		readPayload:               apiResource.Models["Thing"],
+		readPropertiesModelName:   "ThingProperties",
+		readPropertiesPayload:     apiResource.Models["ThingProperties"],
+	}
+
+	builder := NewBuilder(apiResource)
+	resource := sdkModels.TerraformResourceDefinition{}
+	mappings := sdkModels.TerraformMappingDefinition{}
+	fields, actualMappings, err := builder.identifyFieldsWithinPropertiesBlock("ThingResource", payloads, &resource, &mappings, definitions.ResourceDefinition{})
+	if err != nil {
+		t.Fatalf("identifying fields: %+v", err)
+	}
+
+	if _, ok := fields["Password"]; !ok {
+		t.Fatalf("expected the field `Password` to be present but it wasn't")
+	}
+	checkDirectAssignmentMappingExistsBetween(t, actualMappings.Fields, "ThingResource", "Password", "ThingProperties", "Password")
+	if _, ok := fields["Mode"]; !ok {
+		t.Fatalf("expected the field `Mode` to be present but it wasn't")
+	}
+	checkDirectAssignmentMappingExistsBetween(t, actualMappings.Fields, "ThingResource", "Mode", "ThingProperties", "Mode")
+}
+
+func checkNoDirectAssignmentMappingExistsFor(t *testing.T, mappings []sdkModels.TerraformFieldMappingDefinition, schemaFieldPath, sdkModelName string) {
+	for _, item := range mappings {
+		v, ok := item.(sdkModels.TerraformDirectAssignmentFieldMappingDefinition)
+		if !ok {
+			continue
+		}
+
+		if v.DirectAssignment.TerraformSchemaFieldName == schemaFieldPath && v.DirectAssignment.SDKModelName == sdkModelName {
+			t.Fatalf("expected there to be no DirectAssignment Mapping from Schema Path %q to SDK Model %q but there was", schemaFieldPath, sdkModelName)
+		}
+	}
+}
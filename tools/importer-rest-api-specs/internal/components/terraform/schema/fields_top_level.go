@@ -36,6 +36,12 @@ func (b Builder) schemaFromTopLevelFields(schemaModelName string, input operatio
 			hasRead = true
 		}
 
+		if field, ok := getField(input.createPayload, fieldName); ok {
+			// when there's no separate Update payload the Create payload is also used to update the resource
+			createAndUpdateShareModel := input.updateModelName == nil || *input.updateModelName == input.createModelName
+			hasCreate, hasUpdate = applyFieldMutability(*field, hasCreate, hasUpdate, createAndUpdateShareModel)
+		}
+
 		// TODO: ExtendedLocation, SystemData as Computed etc?
 		if strings.EqualFold(fieldName, "Identity") {
 			field, ok := getField(input.createPayload, fieldName)
@@ -81,20 +87,13 @@ func (b Builder) schemaFromTopLevelFields(schemaModelName string, input operatio
 		}
 
 		if strings.EqualFold(fieldName, "Tags") {
-			canBeUpdated := false
-			if input.updatePayload != nil {
-				if _, ok := getField(*input.updatePayload, fieldName); ok {
-					canBeUpdated = true
-				}
-			}
-
 			schemaFields["Tags"] = sdkModels.TerraformSchemaField{
 				HCLName: "tags",
 				ObjectDefinition: sdkModels.TerraformSchemaObjectDefinition{
 					Type: sdkModels.TagsTerraformSchemaObjectDefinitionType,
 				},
 				Optional: true,
-				ForceNew: !canBeUpdated,
+				ForceNew: !hasUpdate,
 				Documentation: sdkModels.TerraformSchemaFieldDocumentationDefinition{
 					Markdown: fmt.Sprintf("A mapping of tags which should be assigned to the %s.", resourceDisplayName),
 				},
@@ -47,6 +47,23 @@ func fieldShouldBeIgnored(key string, definition sdkModels.SDKField, constants m
 	return false
 }
 
+// applyFieldMutability returns whether the field should be specified during Create and Update, taking into
+// account any `x-ms-mutability` defined for it - fields which can only be specified during Create can't be
+// updated (and so are ForceNew), whereas fields which can only be specified during Update are omitted from Create.
+//
+// NOTE: when the Create and Update operations share the same payload model (including when there's no separate
+// Update payload, e.g. for a resource which is only created/updated via a PUT), fields which can only be specified
+// during Update can't be omitted from the Create payload, and so are left as-is.
+func applyFieldMutability(field sdkModels.SDKField, hasCreate, hasUpdate, createAndUpdateShareModel bool) (bool, bool) {
+	if field.IsCreateOnly() {
+		return hasCreate, false
+	}
+	if field.IsUpdateOnly() && !createAndUpdateShareModel {
+		return false, hasUpdate
+	}
+	return hasCreate, hasUpdate
+}
+
 func getField(model sdkModels.SDKModel, fieldName string) (*sdkModels.SDKField, bool) {
 	for field, val := range model.Fields {
 		if strings.EqualFold(field, fieldName) {
@@ -100,3 +100,53 @@ func TestUpdateField_ApplySchemaOverrides(t *testing.T) {
 		}
 	}
 }
+
+func TestApplyFieldMutability(t *testing.T) {
+	testData := []struct {
+		mutability                []sdkModels.SDKFieldMutability
+		createAndUpdateShareModel bool
+		expectedCreate            bool
+		expectedUpdate            bool
+	}{
+		{
+			// unspecified, so can be used during both
+			expectedCreate: true,
+			expectedUpdate: true,
+		},
+		{
+			mutability:     []sdkModels.SDKFieldMutability{sdkModels.CreateSDKFieldMutability, sdkModels.ReadSDKFieldMutability, sdkModels.UpdateSDKFieldMutability},
+			expectedCreate: true,
+			expectedUpdate: true,
+		},
+		{
+			mutability:     []sdkModels.SDKFieldMutability{sdkModels.CreateSDKFieldMutability, sdkModels.ReadSDKFieldMutability},
+			expectedCreate: true,
+			expectedUpdate: false,
+		},
+		{
+			mutability:     []sdkModels.SDKFieldMutability{sdkModels.ReadSDKFieldMutability, sdkModels.UpdateSDKFieldMutability},
+			expectedCreate: false,
+			expectedUpdate: true,
+		},
+		{
+			// the field can't be omitted from the Create payload when it's shared with Update
+			mutability:                []sdkModels.SDKFieldMutability{sdkModels.UpdateSDKFieldMutability},
+			createAndUpdateShareModel: true,
+			expectedCreate:            true,
+			expectedUpdate:            true,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("Testing %+v (shared model %t)", v.mutability, v.createAndUpdateShareModel)
+		field := sdkModels.SDKField{
+			Mutability: v.mutability,
+		}
+		actualCreate, actualUpdate := applyFieldMutability(field, true, true, v.createAndUpdateShareModel)
+		if actualCreate != v.expectedCreate {
+			t.Fatalf("expected Create to be %t but got %t", v.expectedCreate, actualCreate)
+		}
+		if actualUpdate != v.expectedUpdate {
+			t.Fatalf("expected Update to be %t but got %t", v.expectedUpdate, actualUpdate)
+		}
+	}
+}
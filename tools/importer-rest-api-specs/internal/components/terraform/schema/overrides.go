@@ -3,6 +3,17 @@
 
 package schema
 
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+
+	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/logging"
+	"github.com/hashicorp/pandora/tools/sdk/config/definitions"
+)
+
 var fieldsWhichShouldBeIgnoredExactMatch = []string{
 	"Kind",
 	"ProvisioningState",
@@ -18,3 +29,350 @@ var fieldsWhichShouldBeIgnoredIfContains = []string{
 	"ResourceState",
 	"Type",
 }
+
+// applyFieldOverrides applies the changes to the Schema defined within the `overrides` blocks for this Resource
+// (e.g. ForceNew, Validation or excluding a field) - returning an error if an override doesn't match any field.
+//
+// NOTE: renames (and descriptions for fields within the Resource ID) are applied whilst building the Schema, as
+// such an override is matched against the updated name of the field when one is specified.
+func applyFieldOverrides(topLevelModelName string, schemaModels map[string]sdkModels.TerraformSchemaModel, mappings sdkModels.TerraformMappingDefinition, overrides []definitions.Override) (map[string]sdkModels.TerraformSchemaModel, *sdkModels.TerraformMappingDefinition, error) {
+	modelNames := make([]string, 0)
+	for modelName := range schemaModels {
+		modelNames = append(modelNames, modelName)
+	}
+	sort.Strings(modelNames)
+
+	for _, override := range overrides {
+		hclName := override.Name
+		if override.UpdatedName != nil {
+			hclName = *override.UpdatedName
+		}
+
+		matched := false
+		for _, modelName := range modelNames {
+			model := schemaModels[modelName]
+			for fieldName, field := range model.Fields {
+				if field.HCLName != hclName {
+					continue
+				}
+				matched = true
+
+				if override.Exclude {
+					if modelName == topLevelModelName && fieldIsPartOfResourceId(fieldName, mappings) {
+						return nil, nil, fmt.Errorf("the field %q cannot be excluded since it's part of the Resource ID", hclName)
+					}
+
+					logging.Tracef("Excluding the field %q from Schema Model %q", fieldName, modelName)
+					delete(model.Fields, fieldName)
+					mappings.Fields = removeMappingsForSchemaField(modelName, fieldName, mappings.Fields)
+					continue
+				}
+
+				if override.Mapping != nil {
+					updatedField, updatedMappings, err := applyMappingOverrideToField(modelName, fieldName, field, *override.Mapping, mappings.Fields)
+					if err != nil {
+						return nil, nil, fmt.Errorf("applying the mapping override for %q to the field %q within Schema Model %q: %+v", override.Name, fieldName, modelName, err)
+					}
+					field = *updatedField
+					mappings.Fields = updatedMappings
+				}
+
+				updated, err := applyOverrideToField(field, override)
+				if err != nil {
+					return nil, nil, fmt.Errorf("applying the override for %q to the field %q within Schema Model %q: %+v", override.Name, fieldName, modelName, err)
+				}
+				model.Fields[fieldName] = *updated
+			}
+			schemaModels[modelName] = model
+		}
+
+		if !matched {
+			return nil, nil, fmt.Errorf("the override for %q doesn't match any field within the Schema (looking for a field named %q)", override.Name, hclName)
+		}
+	}
+
+	return schemaModels, &mappings, nil
+}
+
+func applyOverrideToField(input sdkModels.TerraformSchemaField, override definitions.Override) (*sdkModels.TerraformSchemaField, error) {
+	output := input
+
+	if v := override.Description; v != nil {
+		output.Documentation.Markdown = *v
+	}
+	if v := override.ForceNew; v != nil {
+		output.ForceNew = *v
+	}
+	if v := override.Sensitive; v != nil {
+		output.Sensitive = *v
+	}
+	if v := override.Computed; v != nil {
+		output.Computed = *v
+	}
+	if v := override.Optional; v != nil {
+		output.Optional = *v
+		if *v {
+			output.Required = false
+		}
+	}
+	if v := override.Required; v != nil {
+		output.Required = *v
+		if *v {
+			output.Optional = false
+		}
+	}
+	if output.Required && output.Computed {
+		return nil, fmt.Errorf("a field cannot be both Required and Computed")
+	}
+	if !output.Required && !output.Optional && !output.Computed {
+		return nil, fmt.Errorf("a field must be at least one of Computed, Optional or Required")
+	}
+
+	if v := override.CollectionType; v != nil {
+		if output.ObjectDefinition.Type != sdkModels.ListTerraformSchemaObjectDefinitionType && output.ObjectDefinition.Type != sdkModels.SetTerraformSchemaObjectDefinitionType {
+			return nil, fmt.Errorf("`collection_type` can only be specified for a List or Set but got %q", string(output.ObjectDefinition.Type))
+		}
+		output.ObjectDefinition.Type = sdkModels.ListTerraformSchemaObjectDefinitionType
+		if *v == definitions.SetOverrideCollectionType {
+			output.ObjectDefinition.Type = sdkModels.SetTerraformSchemaObjectDefinitionType
+		}
+	}
+
+	if v := override.Default; v != nil {
+		if output.Required {
+			return nil, fmt.Errorf("a Required field cannot have a Default value")
+		}
+		value, err := parseOverrideValueForField(*v, output.ObjectDefinition.Type)
+		if err != nil {
+			return nil, fmt.Errorf("parsing the Default value: %+v", err)
+		}
+		output.Default = value
+	}
+
+	if v := override.Validation; v != nil {
+		validation, err := validationForOverride(*v, output.ObjectDefinition.Type)
+		if err != nil {
+			return nil, fmt.Errorf("building the Validation: %+v", err)
+		}
+		output.Validation = validation
+	}
+
+	return &output, nil
+}
+
+// applyMappingOverrideToField replaces the DirectAssignment mapping for this field with the mapping type defined in
+// the override, updating the type of the field to match (e.g. a Constant becomes a Boolean for `boolean_equals`).
+//
+// NOTE: this is applied prior to the other overrides, so that a Default/Validation is parsed using the updated type.
+func applyMappingOverrideToField(modelName, fieldName string, input sdkModels.TerraformSchemaField, override definitions.OverrideMapping, mappings []sdkModels.TerraformFieldMappingDefinition) (*sdkModels.TerraformSchemaField, []sdkModels.TerraformFieldMappingDefinition, error) {
+	var directAssignment *sdkModels.TerraformDirectAssignmentFieldMappingDefinitionImpl
+	otherMappings := make([]sdkModels.TerraformFieldMappingDefinition, 0)
+	for _, item := range mappings {
+		if v, ok := item.(sdkModels.TerraformDirectAssignmentFieldMappingDefinition); ok {
+			if v.DirectAssignment.TerraformSchemaModelName == modelName && v.DirectAssignment.TerraformSchemaFieldName == fieldName {
+				directAssignment = &v.DirectAssignment
+				continue
+			}
+		}
+		otherMappings = append(otherMappings, item)
+	}
+	if directAssignment == nil {
+		return nil, nil, fmt.Errorf("a `mapping` can only be specified for a field with a DirectAssignment mapping")
+	}
+
+	output := input
+	var mapping sdkModels.TerraformFieldMappingDefinition
+	switch override.Type {
+	case definitions.BooleanEqualsOverrideMappingType:
+		if input.ObjectDefinition.Type != sdkModels.StringTerraformSchemaObjectDefinitionType || input.ObjectDefinition.ReferenceName == nil {
+			return nil, nil, fmt.Errorf("`boolean_equals` can only be specified for a String Constant field")
+		}
+		output.ObjectDefinition = sdkModels.TerraformSchemaObjectDefinition{
+			Type: sdkModels.BooleanTerraformSchemaObjectDefinitionType,
+		}
+		output.Validation = nil
+		mapping = sdkModels.TerraformBooleanEqualsFieldMappingDefinition{
+			BooleanEquals: sdkModels.TerraformBooleanEqualsFieldMappingDefinitionImpl{
+				TerraformSchemaModelName: directAssignment.TerraformSchemaModelName,
+				TerraformSchemaFieldName: directAssignment.TerraformSchemaFieldName,
+				SDKModelName:             directAssignment.SDKModelName,
+				SDKFieldName:             directAssignment.SDKFieldName,
+				TrueValue:                *override.TrueValue,
+				FalseValue:               *override.FalseValue,
+			},
+		}
+
+	case definitions.BooleanInvertOverrideMappingType:
+		if input.ObjectDefinition.Type != sdkModels.BooleanTerraformSchemaObjectDefinitionType {
+			return nil, nil, fmt.Errorf("`boolean_invert` can only be specified for a Boolean field but got %q", string(input.ObjectDefinition.Type))
+		}
+		mapping = sdkModels.TerraformBooleanInvertFieldMappingDefinition{
+			BooleanInvert: sdkModels.TerraformBooleanInvertFieldMappingDefinitionImpl{
+				TerraformSchemaModelName: directAssignment.TerraformSchemaModelName,
+				TerraformSchemaFieldName: directAssignment.TerraformSchemaFieldName,
+				SDKModelName:             directAssignment.SDKModelName,
+				SDKFieldName:             directAssignment.SDKFieldName,
+			},
+		}
+
+	case definitions.SubResourceIdOverrideMappingType:
+		if input.ObjectDefinition.Type != sdkModels.ReferenceTerraformSchemaObjectDefinitionType {
+			return nil, nil, fmt.Errorf("`sub_resource_id` can only be specified for a Reference field but got %q", string(input.ObjectDefinition.Type))
+		}
+		output.ObjectDefinition = sdkModels.TerraformSchemaObjectDefinition{
+			Type: sdkModels.StringTerraformSchemaObjectDefinitionType,
+		}
+		mapping = sdkModels.TerraformSubResourceIdFieldMappingDefinition{
+			SubResourceId: sdkModels.TerraformSubResourceIdFieldMappingDefinitionImpl{
+				TerraformSchemaModelName: directAssignment.TerraformSchemaModelName,
+				TerraformSchemaFieldName: directAssignment.TerraformSchemaFieldName,
+				SDKModelName:             directAssignment.SDKModelName,
+				SDKFieldName:             directAssignment.SDKFieldName,
+			},
+		}
+
+	case definitions.SubResourceIdListOverrideMappingType:
+		isListOrSet := input.ObjectDefinition.Type == sdkModels.ListTerraformSchemaObjectDefinitionType || input.ObjectDefinition.Type == sdkModels.SetTerraformSchemaObjectDefinitionType
+		if !isListOrSet || input.ObjectDefinition.NestedObject == nil || input.ObjectDefinition.NestedObject.Type != sdkModels.ReferenceTerraformSchemaObjectDefinitionType {
+			return nil, nil, fmt.Errorf("`sub_resource_id_list` can only be specified for a List or Set of References field")
+		}
+		output.ObjectDefinition = sdkModels.TerraformSchemaObjectDefinition{
+			Type: input.ObjectDefinition.Type,
+			NestedObject: &sdkModels.TerraformSchemaObjectDefinition{
+				Type: sdkModels.StringTerraformSchemaObjectDefinitionType,
+			},
+		}
+		mapping = sdkModels.TerraformSubResourceIdListFieldMappingDefinition{
+			SubResourceIdList: sdkModels.TerraformSubResourceIdListFieldMappingDefinitionImpl{
+				TerraformSchemaModelName: directAssignment.TerraformSchemaModelName,
+				TerraformSchemaFieldName: directAssignment.TerraformSchemaFieldName,
+				SDKModelName:             directAssignment.SDKModelName,
+				SDKFieldName:             directAssignment.SDKFieldName,
+			},
+		}
+
+	default:
+		return nil, nil, fmt.Errorf("internal-error: unimplemented mapping type %q", string(override.Type))
+	}
+
+	return &output, append(otherMappings, mapping), nil
+}
+
+func parseOverrideValueForField(input string, fieldType sdkModels.TerraformSchemaObjectDefinitionType) (any, error) {
+	switch fieldType {
+	case sdkModels.BooleanTerraformSchemaObjectDefinitionType:
+		return strconv.ParseBool(input)
+
+	case sdkModels.FloatTerraformSchemaObjectDefinitionType:
+		return strconv.ParseFloat(input, 64)
+
+	case sdkModels.IntegerTerraformSchemaObjectDefinitionType:
+		return strconv.ParseInt(input, 10, 64)
+
+	case sdkModels.StringTerraformSchemaObjectDefinitionType:
+		return input, nil
+	}
+
+	return nil, fmt.Errorf("values can only be specified for a Boolean, Float, Integer or String field but got %q", string(fieldType))
+}
+
+func validationForOverride(input definitions.OverrideValidation, fieldType sdkModels.TerraformSchemaObjectDefinitionType) (sdkModels.TerraformSchemaFieldValidationDefinition, error) {
+	if input.PossibleValues != nil {
+		possibleValueTypes := map[sdkModels.TerraformSchemaObjectDefinitionType]sdkModels.TerraformSchemaFieldValidationPossibleValuesType{
+			sdkModels.FloatTerraformSchemaObjectDefinitionType:   sdkModels.FloatTerraformSchemaFieldValidationPossibleValuesType,
+			sdkModels.IntegerTerraformSchemaObjectDefinitionType: sdkModels.IntegerTerraformSchemaFieldValidationPossibleValuesType,
+			sdkModels.StringTerraformSchemaObjectDefinitionType:  sdkModels.StringTerraformSchemaFieldValidationPossibleValuesType,
+		}
+		possibleValuesType, ok := possibleValueTypes[fieldType]
+		if !ok {
+			return nil, fmt.Errorf("`possible_values` can only be specified for a Float, Integer or String field but got %q", string(fieldType))
+		}
+
+		values := make([]any, 0)
+		for _, item := range *input.PossibleValues {
+			value, err := parseOverrideValueForField(item, fieldType)
+			if err != nil {
+				return nil, fmt.Errorf("parsing the possible value %q: %+v", item, err)
+			}
+			values = append(values, value)
+		}
+
+		return sdkModels.TerraformSchemaFieldValidationPossibleValuesDefinition{
+			PossibleValues: &sdkModels.TerraformSchemaFieldValidationPossibleValuesDefinitionImpl{
+				Type:   possibleValuesType,
+				Values: values,
+			},
+		}, nil
+	}
+
+	if input.Minimum != nil || input.Maximum != nil {
+		if fieldType != sdkModels.FloatTerraformSchemaObjectDefinitionType && fieldType != sdkModels.IntegerTerraformSchemaObjectDefinitionType {
+			return nil, fmt.Errorf("`minimum` and `maximum` can only be specified for a Float or Integer field but got %q", string(fieldType))
+		}
+		if input.Minimum != nil && input.Maximum != nil && *input.Minimum > *input.Maximum {
+			return nil, fmt.Errorf("`minimum` (%v) must be less than or equal to `maximum` (%v)", *input.Minimum, *input.Maximum)
+		}
+
+		return sdkModels.TerraformSchemaFieldValidationRangeDefinition{
+			Minimum: input.Minimum,
+			Maximum: input.Maximum,
+		}, nil
+	}
+
+	if input.Regex != nil {
+		if fieldType != sdkModels.StringTerraformSchemaObjectDefinitionType {
+			return nil, fmt.Errorf("`regex` can only be specified for a String field but got %q", string(fieldType))
+		}
+		if _, err := regexp.Compile(*input.Regex); err != nil {
+			return nil, fmt.Errorf("parsing the Regular Expression %q: %+v", *input.Regex, err)
+		}
+
+		return sdkModels.TerraformSchemaFieldValidationRegexDefinition{
+			Regex: *input.Regex,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("internal-error: no validation was specified")
+}
+
+func fieldIsPartOfResourceId(fieldName string, mappings sdkModels.TerraformMappingDefinition) bool {
+	for _, item := range mappings.ResourceID {
+		if item.TerraformSchemaFieldName == fieldName {
+			return true
+		}
+	}
+	return false
+}
+
+func removeMappingsForSchemaField(modelName, fieldName string, input []sdkModels.TerraformFieldMappingDefinition) []sdkModels.TerraformFieldMappingDefinition {
+	output := make([]sdkModels.TerraformFieldMappingDefinition, 0)
+	for _, item := range input {
+		if v, ok := item.(sdkModels.TerraformDirectAssignmentFieldMappingDefinition); ok {
+			if v.DirectAssignment.TerraformSchemaModelName == modelName && v.DirectAssignment.TerraformSchemaFieldName == fieldName {
+				continue
+			}
+		}
+		if v, ok := item.(sdkModels.TerraformBooleanEqualsFieldMappingDefinition); ok {
+			if v.BooleanEquals.TerraformSchemaModelName == modelName && v.BooleanEquals.TerraformSchemaFieldName == fieldName {
+				continue
+			}
+		}
+		if v, ok := item.(sdkModels.TerraformBooleanInvertFieldMappingDefinition); ok {
+			if v.BooleanInvert.TerraformSchemaModelName == modelName && v.BooleanInvert.TerraformSchemaFieldName == fieldName {
+				continue
+			}
+		}
+		if v, ok := item.(sdkModels.TerraformSubResourceIdFieldMappingDefinition); ok {
+			if v.SubResourceId.TerraformSchemaModelName == modelName && v.SubResourceId.TerraformSchemaFieldName == fieldName {
+				continue
+			}
+		}
+		if v, ok := item.(sdkModels.TerraformSubResourceIdListFieldMappingDefinition); ok {
+			if v.SubResourceIdList.TerraformSchemaModelName == modelName && v.SubResourceIdList.TerraformSchemaFieldName == fieldName {
+				continue
+			}
+		}
+		output = append(output, item)
+	}
+	return output
+}
@@ -0,0 +1,618 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	"github.com/hashicorp/pandora/tools/sdk/config/definitions"
+)
+
+func schemaModelsForOverrideTesting() map[string]sdkModels.TerraformSchemaModel {
+	return map[string]sdkModels.TerraformSchemaModel{
+		"ExampleResource": {
+			Fields: map[string]sdkModels.TerraformSchemaField{
+				"Name": {
+					HCLName:  "name",
+					ForceNew: true,
+					ObjectDefinition: sdkModels.TerraformSchemaObjectDefinition{
+						Type: sdkModels.StringTerraformSchemaObjectDefinitionType,
+					},
+					Required: true,
+				},
+				"Enabled": {
+					HCLName: "enabled",
+					ObjectDefinition: sdkModels.TerraformSchemaObjectDefinition{
+						Type: sdkModels.BooleanTerraformSchemaObjectDefinitionType,
+					},
+					Optional: true,
+				},
+				"Capacity": {
+					HCLName: "capacity",
+					ObjectDefinition: sdkModels.TerraformSchemaObjectDefinition{
+						Type: sdkModels.IntegerTerraformSchemaObjectDefinitionType,
+					},
+					Required: true,
+				},
+				"Password": {
+					HCLName: "password",
+					ObjectDefinition: sdkModels.TerraformSchemaObjectDefinition{
+						Type: sdkModels.StringTerraformSchemaObjectDefinitionType,
+					},
+					Optional: true,
+				},
+				"Zones": {
+					HCLName: "zones",
+					ObjectDefinition: sdkModels.TerraformSchemaObjectDefinition{
+						Type: sdkModels.ListTerraformSchemaObjectDefinitionType,
+						NestedObject: &sdkModels.TerraformSchemaObjectDefinition{
+							Type: sdkModels.StringTerraformSchemaObjectDefinitionType,
+						},
+					},
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func mappingsForOverrideTesting() sdkModels.TerraformMappingDefinition {
+	directAssignment := func(fieldName string) sdkModels.TerraformFieldMappingDefinition {
+		return sdkModels.TerraformDirectAssignmentFieldMappingDefinition{
+			DirectAssignment: sdkModels.TerraformDirectAssignmentFieldMappingDefinitionImpl{
+				SDKFieldName:             fieldName,
+				SDKModelName:             "ExampleModel",
+				TerraformSchemaFieldName: fieldName,
+				TerraformSchemaModelName: "ExampleResource",
+			},
+		}
+	}
+	return sdkModels.TerraformMappingDefinition{
+		Fields: []sdkModels.TerraformFieldMappingDefinition{
+			directAssignment("Enabled"),
+			directAssignment("Capacity"),
+			directAssignment("Password"),
+		},
+		ResourceID: []sdkModels.TerraformResourceIDMappingDefinition{
+			{
+				SegmentName:              "exampleName",
+				TerraformSchemaFieldName: "Name",
+			},
+		},
+	}
+}
+
+func TestApplyFieldOverrides(t *testing.T) {
+	testData := []struct {
+		name        string
+		override    definitions.Override
+		fieldName   string
+		expected    *sdkModels.TerraformSchemaField
+		expectError bool
+	}{
+		{
+			name: "ForceNew, Sensitive and Description",
+			override: definitions.Override{
+				Name:        "password",
+				Description: pointer.To("The password used to connect."),
+				ForceNew:    pointer.To(true),
+				Sensitive:   pointer.To(true),
+			},
+			fieldName: "Password",
+			expected: &sdkModels.TerraformSchemaField{
+				Documentation: sdkModels.TerraformSchemaFieldDocumentationDefinition{
+					Markdown: "The password used to connect.",
+				},
+				ForceNew: true,
+				HCLName:  "password",
+				ObjectDefinition: sdkModels.TerraformSchemaObjectDefinition{
+					Type: sdkModels.StringTerraformSchemaObjectDefinitionType,
+				},
+				Optional:  true,
+				Sensitive: true,
+			},
+		},
+		{
+			name: "Optional and Computed",
+			override: definitions.Override{
+				Name:     "capacity",
+				Computed: pointer.To(true),
+				Optional: pointer.To(true),
+			},
+			fieldName: "Capacity",
+			expected: &sdkModels.TerraformSchemaField{
+				Computed: true,
+				HCLName:  "capacity",
+				ObjectDefinition: sdkModels.TerraformSchemaObjectDefinition{
+					Type: sdkModels.IntegerTerraformSchemaObjectDefinitionType,
+				},
+				Optional: true,
+			},
+		},
+		{
+			name: "Required and Computed",
+			override: definitions.Override{
+				Name:     "capacity",
+				Computed: pointer.To(true),
+			},
+			expectError: true,
+		},
+		{
+			name: "Default for a Boolean",
+			override: definitions.Override{
+				Name:    "enabled",
+				Default: pointer.To("true"),
+			},
+			fieldName: "Enabled",
+			expected: &sdkModels.TerraformSchemaField{
+				Default: true,
+				HCLName: "enabled",
+				ObjectDefinition: sdkModels.TerraformSchemaObjectDefinition{
+					Type: sdkModels.BooleanTerraformSchemaObjectDefinitionType,
+				},
+				Optional: true,
+			},
+		},
+		{
+			name: "Default for an Integer",
+			override: definitions.Override{
+				Name:     "capacity",
+				Default:  pointer.To("5"),
+				Optional: pointer.To(true),
+			},
+			fieldName: "Capacity",
+			expected: &sdkModels.TerraformSchemaField{
+				Default: int64(5),
+				HCLName: "capacity",
+				ObjectDefinition: sdkModels.TerraformSchemaObjectDefinition{
+					Type: sdkModels.IntegerTerraformSchemaObjectDefinitionType,
+				},
+				Optional: true,
+			},
+		},
+		{
+			name: "Default for a Required field",
+			override: definitions.Override{
+				Name:    "capacity",
+				Default: pointer.To("5"),
+			},
+			expectError: true,
+		},
+		{
+			name: "Default of the wrong type",
+			override: definitions.Override{
+				Name:    "enabled",
+				Default: pointer.To("sure"),
+			},
+			expectError: true,
+		},
+		{
+			name: "Possible Values",
+			override: definitions.Override{
+				Name: "capacity",
+				Validation: &definitions.OverrideValidation{
+					PossibleValues: pointer.To([]string{"1", "2"}),
+				},
+			},
+			fieldName: "Capacity",
+			expected: &sdkModels.TerraformSchemaField{
+				HCLName: "capacity",
+				ObjectDefinition: sdkModels.TerraformSchemaObjectDefinition{
+					Type: sdkModels.IntegerTerraformSchemaObjectDefinitionType,
+				},
+				Required: true,
+				Validation: sdkModels.TerraformSchemaFieldValidationPossibleValuesDefinition{
+					PossibleValues: &sdkModels.TerraformSchemaFieldValidationPossibleValuesDefinitionImpl{
+						Type:   sdkModels.IntegerTerraformSchemaFieldValidationPossibleValuesType,
+						Values: []any{int64(1), int64(2)},
+					},
+				},
+			},
+		},
+		{
+			name: "Range",
+			override: definitions.Override{
+				Name: "capacity",
+				Validation: &definitions.OverrideValidation{
+					Minimum: pointer.To(1.0),
+					Maximum: pointer.To(10.0),
+				},
+			},
+			fieldName: "Capacity",
+			expected: &sdkModels.TerraformSchemaField{
+				HCLName: "capacity",
+				ObjectDefinition: sdkModels.TerraformSchemaObjectDefinition{
+					Type: sdkModels.IntegerTerraformSchemaObjectDefinitionType,
+				},
+				Required: true,
+				Validation: sdkModels.TerraformSchemaFieldValidationRangeDefinition{
+					Minimum: pointer.To(1.0),
+					Maximum: pointer.To(10.0),
+				},
+			},
+		},
+		{
+			name: "Range for a String",
+			override: definitions.Override{
+				Name: "password",
+				Validation: &definitions.OverrideValidation{
+					Minimum: pointer.To(1.0),
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "Regex",
+			override: definitions.Override{
+				Name: "password",
+				Validation: &definitions.OverrideValidation{
+					Regex: pointer.To("^[a-z]+$"),
+				},
+			},
+			fieldName: "Password",
+			expected: &sdkModels.TerraformSchemaField{
+				HCLName: "password",
+				ObjectDefinition: sdkModels.TerraformSchemaObjectDefinition{
+					Type: sdkModels.StringTerraformSchemaObjectDefinitionType,
+				},
+				Optional: true,
+				Validation: sdkModels.TerraformSchemaFieldValidationRegexDefinition{
+					Regex: "^[a-z]+$",
+				},
+			},
+		},
+		{
+			name: "Invalid Regex",
+			override: definitions.Override{
+				Name: "password",
+				Validation: &definitions.OverrideValidation{
+					Regex: pointer.To("^[a-z+$"),
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "List to Set",
+			override: definitions.Override{
+				Name:           "zones",
+				CollectionType: pointer.To(definitions.SetOverrideCollectionType),
+			},
+			fieldName: "Zones",
+			expected: &sdkModels.TerraformSchemaField{
+				HCLName: "zones",
+				ObjectDefinition: sdkModels.TerraformSchemaObjectDefinition{
+					Type: sdkModels.SetTerraformSchemaObjectDefinitionType,
+					NestedObject: &sdkModels.TerraformSchemaObjectDefinition{
+						Type: sdkModels.StringTerraformSchemaObjectDefinitionType,
+					},
+				},
+				Optional: true,
+			},
+		},
+		{
+			name: "Collection Type for a field which isn't a List",
+			override: definitions.Override{
+				Name:           "password",
+				CollectionType: pointer.To(definitions.SetOverrideCollectionType),
+			},
+			expectError: true,
+		},
+		{
+			name: "Matched using the Updated Name",
+			override: definitions.Override{
+				Name:        "some_password",
+				UpdatedName: pointer.To("password"),
+				Sensitive:   pointer.To(true),
+			},
+			fieldName: "Password",
+			expected: &sdkModels.TerraformSchemaField{
+				HCLName: "password",
+				ObjectDefinition: sdkModels.TerraformSchemaObjectDefinition{
+					Type: sdkModels.StringTerraformSchemaObjectDefinitionType,
+				},
+				Optional:  true,
+				Sensitive: true,
+			},
+		},
+		{
+			name: "Matching no field",
+			override: definitions.Override{
+				Name:     "does_not_exist",
+				ForceNew: pointer.To(true),
+			},
+			expectError: true,
+		},
+		{
+			name: "Excluding a field within the Resource ID",
+			override: definitions.Override{
+				Name:    "name",
+				Exclude: true,
+			},
+			expectError: true,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("Test %q", v.name)
+
+		actualModels, _, err := applyFieldOverrides("ExampleResource", schemaModelsForOverrideTesting(), mappingsForOverrideTesting(), []definitions.Override{v.override})
+		if v.expectError {
+			if err == nil {
+				t.Fatalf("expected an error but didn't get one")
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+
+		actual := actualModels["ExampleResource"].Fields[v.fieldName]
+		if !reflect.DeepEqual(*v.expected, actual) {
+			t.Fatalf("expected %+v but got %+v", *v.expected, actual)
+		}
+	}
+}
+
+func TestApplyFieldOverrides_Exclude(t *testing.T) {
+	overrides := []definitions.Override{
+		{
+			Name:    "password",
+			Exclude: true,
+		},
+	}
+	actualModels, actualMappings, err := applyFieldOverrides("ExampleResource", schemaModelsForOverrideTesting(), mappingsForOverrideTesting(), overrides)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	if _, ok := actualModels["ExampleResource"].Fields["Password"]; ok {
+		t.Fatalf("expected the field `Password` to be removed but it wasn't")
+	}
+	if len(actualModels["ExampleResource"].Fields) != 4 {
+		t.Fatalf("expected 4 fields to remain but got %d", len(actualModels["ExampleResource"].Fields))
+	}
+	if len(actualMappings.Fields) != 2 {
+		t.Fatalf("expected 2 mappings to remain but got %d", len(actualMappings.Fields))
+	}
+	checkDirectAssignmentMappingExistsBetween(t, actualMappings.Fields, "ExampleResource", "Enabled", "ExampleModel", "Enabled")
+	checkDirectAssignmentMappingExistsBetween(t, actualMappings.Fields, "ExampleResource", "Capacity", "ExampleModel", "Capacity")
+}
+
+func TestApplyFieldOverrides_Mapping(t *testing.T) {
+	schemaModels := func() map[string]sdkModels.TerraformSchemaModel {
+		return map[string]sdkModels.TerraformSchemaModel{
+			"ExampleResource": {
+				Fields: map[string]sdkModels.TerraformSchemaField{
+					"PublicNetworkAccess": {
+						HCLName: "public_network_access",
+						ObjectDefinition: sdkModels.TerraformSchemaObjectDefinition{
+							Type:          sdkModels.StringTerraformSchemaObjectDefinitionType,
+							ReferenceName: pointer.To("PublicNetworkAccess"),
+						},
+						Optional: true,
+					},
+					"DisableLocalAuth": {
+						HCLName: "disable_local_auth",
+						ObjectDefinition: sdkModels.TerraformSchemaObjectDefinition{
+							Type: sdkModels.BooleanTerraformSchemaObjectDefinitionType,
+						},
+						Optional: true,
+					},
+					"Subnet": {
+						HCLName: "subnet",
+						ObjectDefinition: sdkModels.TerraformSchemaObjectDefinition{
+							Type:          sdkModels.ReferenceTerraformSchemaObjectDefinitionType,
+							ReferenceName: pointer.To("ExampleResourceSubResource"),
+						},
+						Required: true,
+					},
+					"Subnets": {
+						HCLName: "subnets",
+						ObjectDefinition: sdkModels.TerraformSchemaObjectDefinition{
+							Type: sdkModels.ListTerraformSchemaObjectDefinitionType,
+							NestedObject: &sdkModels.TerraformSchemaObjectDefinition{
+								Type:          sdkModels.ReferenceTerraformSchemaObjectDefinitionType,
+								ReferenceName: pointer.To("ExampleResourceSubResource"),
+							},
+						},
+						Optional: true,
+					},
+				},
+			},
+		}
+	}
+	mappings := func() sdkModels.TerraformMappingDefinition {
+		output := sdkModels.TerraformMappingDefinition{}
+		for _, fieldName := range []string{"PublicNetworkAccess", "DisableLocalAuth", "Subnet", "Subnets"} {
+			output.Fields = append(output.Fields, sdkModels.TerraformDirectAssignmentFieldMappingDefinition{
+				DirectAssignment: sdkModels.TerraformDirectAssignmentFieldMappingDefinitionImpl{
+					SDKFieldName:             fieldName,
+					SDKModelName:             "ExampleModel",
+					TerraformSchemaFieldName: fieldName,
+					TerraformSchemaModelName: "ExampleResource",
+				},
+			})
+		}
+		return output
+	}
+
+	testData := []struct {
+		name            string
+		override        definitions.Override
+		fieldName       string
+		expectedField   *sdkModels.TerraformSchemaField
+		expectedMapping sdkModels.TerraformFieldMappingDefinition
+		expectError     bool
+	}{
+		{
+			name: "Boolean Equals",
+			override: definitions.Override{
+				Name:    "public_network_access",
+				Default: pointer.To("true"),
+				Mapping: &definitions.OverrideMapping{
+					Type:       definitions.BooleanEqualsOverrideMappingType,
+					TrueValue:  pointer.To("Enabled"),
+					FalseValue: pointer.To("Disabled"),
+				},
+			},
+			fieldName: "PublicNetworkAccess",
+			expectedField: &sdkModels.TerraformSchemaField{
+				Default: true,
+				HCLName: "public_network_access",
+				ObjectDefinition: sdkModels.TerraformSchemaObjectDefinition{
+					Type: sdkModels.BooleanTerraformSchemaObjectDefinitionType,
+				},
+				Optional: true,
+			},
+			expectedMapping: sdkModels.TerraformBooleanEqualsFieldMappingDefinition{
+				BooleanEquals: sdkModels.TerraformBooleanEqualsFieldMappingDefinitionImpl{
+					TerraformSchemaModelName: "ExampleResource",
+					TerraformSchemaFieldName: "PublicNetworkAccess",
+					SDKModelName:             "ExampleModel",
+					SDKFieldName:             "PublicNetworkAccess",
+					TrueValue:                "Enabled",
+					FalseValue:               "Disabled",
+				},
+			},
+		},
+		{
+			name: "Boolean Equals for a Boolean",
+			override: definitions.Override{
+				Name: "disable_local_auth",
+				Mapping: &definitions.OverrideMapping{
+					Type:       definitions.BooleanEqualsOverrideMappingType,
+					TrueValue:  pointer.To("Enabled"),
+					FalseValue: pointer.To("Disabled"),
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "Boolean Invert",
+			override: definitions.Override{
+				Name: "disable_local_auth",
+				Mapping: &definitions.OverrideMapping{
+					Type: definitions.BooleanInvertOverrideMappingType,
+				},
+			},
+			fieldName: "DisableLocalAuth",
+			expectedField: &sdkModels.TerraformSchemaField{
+				HCLName: "disable_local_auth",
+				ObjectDefinition: sdkModels.TerraformSchemaObjectDefinition{
+					Type: sdkModels.BooleanTerraformSchemaObjectDefinitionType,
+				},
+				Optional: true,
+			},
+			expectedMapping: sdkModels.TerraformBooleanInvertFieldMappingDefinition{
+				BooleanInvert: sdkModels.TerraformBooleanInvertFieldMappingDefinitionImpl{
+					TerraformSchemaModelName: "ExampleResource",
+					TerraformSchemaFieldName: "DisableLocalAuth",
+					SDKModelName:             "ExampleModel",
+					SDKFieldName:             "DisableLocalAuth",
+				},
+			},
+		},
+		{
+			name: "Sub Resource ID",
+			override: definitions.Override{
+				Name: "subnet",
+				Mapping: &definitions.OverrideMapping{
+					Type: definitions.SubResourceIdOverrideMappingType,
+				},
+			},
+			fieldName: "Subnet",
+			expectedField: &sdkModels.TerraformSchemaField{
+				HCLName: "subnet",
+				ObjectDefinition: sdkModels.TerraformSchemaObjectDefinition{
+					Type: sdkModels.StringTerraformSchemaObjectDefinitionType,
+				},
+				Required: true,
+			},
+			expectedMapping: sdkModels.TerraformSubResourceIdFieldMappingDefinition{
+				SubResourceId: sdkModels.TerraformSubResourceIdFieldMappingDefinitionImpl{
+					TerraformSchemaModelName: "ExampleResource",
+					TerraformSchemaFieldName: "Subnet",
+					SDKModelName:             "ExampleModel",
+					SDKFieldName:             "Subnet",
+				},
+			},
+		},
+		{
+			name: "Sub Resource ID for a List",
+			override: definitions.Override{
+				Name: "subnets",
+				Mapping: &definitions.OverrideMapping{
+					Type: definitions.SubResourceIdOverrideMappingType,
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "Sub Resource ID List",
+			override: definitions.Override{
+				Name: "subnets",
+				Mapping: &definitions.OverrideMapping{
+					Type: definitions.SubResourceIdListOverrideMappingType,
+				},
+			},
+			fieldName: "Subnets",
+			expectedField: &sdkModels.TerraformSchemaField{
+				HCLName: "subnets",
+				ObjectDefinition: sdkModels.TerraformSchemaObjectDefinition{
+					Type: sdkModels.ListTerraformSchemaObjectDefinitionType,
+					NestedObject: &sdkModels.TerraformSchemaObjectDefinition{
+						Type: sdkModels.StringTerraformSchemaObjectDefinitionType,
+					},
+				},
+				Optional: true,
+			},
+			expectedMapping: sdkModels.TerraformSubResourceIdListFieldMappingDefinition{
+				SubResourceIdList: sdkModels.TerraformSubResourceIdListFieldMappingDefinitionImpl{
+					TerraformSchemaModelName: "ExampleResource",
+					TerraformSchemaFieldName: "Subnets",
+					SDKModelName:             "ExampleModel",
+					SDKFieldName:             "Subnets",
+				},
+			},
+		},
+	}
+	for _, v := range testData {
+		t.Logf("Test %q", v.name)
+
+		actualModels, actualMappings, err := applyFieldOverrides("ExampleResource", schemaModels(), mappings(), []definitions.Override{v.override})
+		if v.expectError {
+			if err == nil {
+				t.Fatalf("expected an error but didn't get one")
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+
+		actualField := actualModels["ExampleResource"].Fields[v.fieldName]
+		if !reflect.DeepEqual(*v.expectedField, actualField) {
+			t.Fatalf("expected %+v but got %+v", *v.expectedField, actualField)
+		}
+
+		if len(actualMappings.Fields) != 4 {
+			t.Fatalf("expected 4 mappings but got %d", len(actualMappings.Fields))
+		}
+		found := false
+		for _, item := range actualMappings.Fields {
+			if reflect.DeepEqual(item, v.expectedMapping) {
+				found = true
+				continue
+			}
+			if _, ok := item.(sdkModels.TerraformDirectAssignmentFieldMappingDefinition); !ok {
+				t.Fatalf("expected the other mappings to remain DirectAssignments but got %+v", item)
+			}
+		}
+		if !found {
+			t.Fatalf("expected the mapping %+v but got %+v", v.expectedMapping, actualMappings.Fields)
+		}
+	}
+}
@@ -27,6 +27,30 @@ func applyFieldRenameToFieldMapping(input sdkModels.TerraformFieldMappingDefinit
 		}
 		return v
 	}
+	if v, ok := input.(sdkModels.TerraformBooleanEqualsFieldMappingDefinition); ok {
+		if v.BooleanEquals.TerraformSchemaModelName == modelName && v.BooleanEquals.TerraformSchemaFieldName == oldFieldName {
+			v.BooleanEquals.TerraformSchemaFieldName = updatedFieldName
+		}
+		return v
+	}
+	if v, ok := input.(sdkModels.TerraformBooleanInvertFieldMappingDefinition); ok {
+		if v.BooleanInvert.TerraformSchemaModelName == modelName && v.BooleanInvert.TerraformSchemaFieldName == oldFieldName {
+			v.BooleanInvert.TerraformSchemaFieldName = updatedFieldName
+		}
+		return v
+	}
+	if v, ok := input.(sdkModels.TerraformSubResourceIdFieldMappingDefinition); ok {
+		if v.SubResourceId.TerraformSchemaModelName == modelName && v.SubResourceId.TerraformSchemaFieldName == oldFieldName {
+			v.SubResourceId.TerraformSchemaFieldName = updatedFieldName
+		}
+		return v
+	}
+	if v, ok := input.(sdkModels.TerraformSubResourceIdListFieldMappingDefinition); ok {
+		if v.SubResourceIdList.TerraformSchemaModelName == modelName && v.SubResourceIdList.TerraformSchemaFieldName == oldFieldName {
+			v.SubResourceIdList.TerraformSchemaFieldName = updatedFieldName
+		}
+		return v
+	}
 	if v, ok := input.(sdkModels.TerraformModelToModelFieldMappingDefinition); ok {
 		// nothing to do
 		return v
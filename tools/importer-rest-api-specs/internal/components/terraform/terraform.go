@@ -15,7 +15,7 @@ import (
 	"github.com/hashicorp/pandora/tools/sdk/config/definitions"
 )
 
-func BuildForService(input sdkModels.Service, terraformConfig map[string]definitions.ResourceDefinition, providerPrefix string, terraformPackageName *string) (*sdkModels.Service, error) {
+func BuildForService(input sdkModels.Service, terraformConfig map[string]definitions.ResourceDefinition, providerPrefix string, terraformPackageName *string, terraformFramework definitions.TerraformFramework, testDependencyCatalog *testing.TestDependencyCatalog) (*sdkModels.Service, error) {
 	if len(terraformConfig) == 0 || terraformPackageName == nil {
 		logging.Debugf("No Terraform Definition exists for the Service %q - skipping", input.Name)
 		return &input, nil
@@ -34,7 +34,7 @@ func BuildForService(input sdkModels.Service, terraformConfig map[string]definit
 	}
 
 	logging.Debugf("Building the Terraform Tests..")
-	data, err = testing.Build(*data)
+	data, err = testing.Build(*data, testDependencyCatalog)
 	if err != nil {
 		return nil, fmt.Errorf("building the Terraform Tests: %+v", err)
 	}
@@ -48,7 +48,12 @@ func BuildForService(input sdkModels.Service, terraformConfig map[string]definit
 	logging.Tracef("Exporting the completed Terraform Data..")
 	resources := data.TerraformResources()
 	logging.Tracef("%q has %d Resources", input.Name, len(resources))
+	framework := sdkModels.PluginSdkTerraformFrameworkType
+	if terraformFramework == definitions.PluginFrameworkTerraformFramework {
+		framework = sdkModels.PluginFrameworkTerraformFrameworkType
+	}
 	input.TerraformDefinition = &sdkModels.TerraformDefinition{
+		Framework:            framework,
 		Resources:            resources,
 		TerraformPackageName: *terraformPackageName,
 	}
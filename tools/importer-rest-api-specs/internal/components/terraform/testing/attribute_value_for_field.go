@@ -33,6 +33,10 @@ func (tb testBuilder) getAttributeValueForField(field sdkModels.TerraformSchemaF
 		return out, nil
 	}
 
+	if value := tb.getExampleValueForField(field, testData); value != nil {
+		return value, nil
+	}
+
 	if function, isBasicType := attributeValuesForBasicTypes[field.ObjectDefinition.Type]; isBasicType {
 		out, err := function(field, dependencies, tb.resourceLabel, tb.providerPrefix, tb.details.DisplayName, testData)
 		if err != nil {
@@ -220,7 +224,9 @@ var attributeValuesForListsOfBasicTypes = map[models.TerraformSchemaObjectDefini
 var commonSchemaAttributeValueFunctions = map[models.TerraformSchemaObjectDefinitionType]attributeValueFunction{
 	// NOTE: there's a handful of top-level resources which have specific overrides (e.g. the Resource Group Name etc.)
 	models.EdgeZoneTerraformSchemaObjectDefinitionType: func(field sdkModels.TerraformSchemaField, dependencies *testDependencies, resourceLabel, providerPrefix, resourceDisplayName string, testData definitions.VariablesDefinition) (*hclwrite.Tokens, error) {
-		dependencies.setNeedsEdgeZones()
+		if err := dependencies.setNeeds("edge_zone"); err != nil {
+			return nil, err
+		}
 		val := hclwrite.TokensForTraversal(hcl.Traversal{
 			hcl.TraverseRoot{
 				Name: "element(data.azurerm_extended_locations.test.extended_locations, 0)",
@@ -239,7 +245,9 @@ var commonSchemaAttributeValueFunctions = map[models.TerraformSchemaObjectDefini
 			return &val, nil
 		}
 
-		dependencies.setNeedsResourceGroup()
+		if err := dependencies.setNeeds("resource_group"); err != nil {
+			return nil, err
+		}
 		val := hclwrite.TokensForTraversal(hcl.Traversal{
 			hcl.TraverseRoot{
 				Name: fmt.Sprintf("%s_resource_group.test.location", providerPrefix),
@@ -258,7 +266,9 @@ var commonSchemaAttributeValueFunctions = map[models.TerraformSchemaObjectDefini
 			return &val, nil
 		}
 
-		dependencies.setNeedsResourceGroup()
+		if err := dependencies.setNeeds("resource_group"); err != nil {
+			return nil, err
+		}
 		val := hclwrite.TokensForTraversal(hcl.Traversal{
 			hcl.TraverseRoot{
 				Name: fmt.Sprintf("%s_resource_group.test.name", providerPrefix),
@@ -42,12 +42,7 @@ func TestAttributeValueForField_EdgeZone(t *testing.T) {
 		variables: testVariables{
 			needsPrimaryLocation: true,
 		},
-		needsEdgeZone:             true,
-		needsPublicIP:             false,
-		needsResourceGroup:        false,
-		needsSubnet:               false,
-		needsUserAssignedIdentity: false,
-		needsVirtualNetwork:       false,
+		needs: []string{"edge_zone"},
 	}
 	assertDependenciesMatch(t, expectedDependencies, actualDependencies)
 }
@@ -84,12 +79,7 @@ func TestAttributeValueForField_Location(t *testing.T) {
 			needsRandomInteger:   true,
 			needsPrimaryLocation: true,
 		},
-		needsEdgeZone:             false,
-		needsPublicIP:             false,
-		needsResourceGroup:        true,
-		needsSubnet:               false,
-		needsUserAssignedIdentity: false,
-		needsVirtualNetwork:       false,
+		needs: []string{"resource_group"},
 	}
 	assertDependenciesMatch(t, expectedDependencies, actualDependencies)
 }
@@ -127,12 +117,6 @@ func TestAttributeValueForField_LocationWithinTheResourceGroupResource(t *testin
 		variables: testVariables{
 			needsPrimaryLocation: true,
 		},
-		needsEdgeZone:             false,
-		needsPublicIP:             false,
-		needsResourceGroup:        false,
-		needsSubnet:               false,
-		needsUserAssignedIdentity: false,
-		needsVirtualNetwork:       false,
 	}
 	assertDependenciesMatch(t, expectedDependencies, actualDependencies)
 }
@@ -169,12 +153,7 @@ func TestAttributeValueForField_ResourceGroup(t *testing.T) {
 			needsRandomInteger:   true,
 			needsPrimaryLocation: true,
 		},
-		needsEdgeZone:             false,
-		needsPublicIP:             false,
-		needsResourceGroup:        true,
-		needsSubnet:               false,
-		needsUserAssignedIdentity: false,
-		needsVirtualNetwork:       false,
+		needs: []string{"resource_group"},
 	}
 	assertDependenciesMatch(t, expectedDependencies, actualDependencies)
 }
@@ -212,12 +191,6 @@ func TestAttributeValueForField_ResourceGroupWithinTheResourceGroupResource(t *t
 		variables: testVariables{
 			needsRandomInteger: true,
 		},
-		needsEdgeZone:             false,
-		needsPublicIP:             false,
-		needsResourceGroup:        false,
-		needsSubnet:               false,
-		needsUserAssignedIdentity: false,
-		needsVirtualNetwork:       false,
 	}
 	assertDependenciesMatch(t, expectedDependencies, actualDependencies)
 }
@@ -259,12 +232,6 @@ func TestAttributeValueForField_Tags(t *testing.T) {
 			needsRandomInteger:   false,
 			needsPrimaryLocation: false,
 		},
-		needsEdgeZone:             false,
-		needsPublicIP:             false,
-		needsResourceGroup:        false,
-		needsSubnet:               false,
-		needsUserAssignedIdentity: false,
-		needsVirtualNetwork:       false,
 	}
 	assertDependenciesMatch(t, expectedDependencies, actualDependencies)
 }
@@ -297,13 +264,7 @@ func TestAttributeValueForField_Zone(t *testing.T) {
 	}
 	testhelpers.AssertTemplatedCodeMatches(t, expected, string(actual.Bytes()))
 	expectedDependencies := testDependencies{
-		variables:                 testVariables{},
-		needsEdgeZone:             false,
-		needsPublicIP:             false,
-		needsResourceGroup:        false,
-		needsSubnet:               false,
-		needsUserAssignedIdentity: false,
-		needsVirtualNetwork:       false,
+		variables: testVariables{},
 	}
 	assertDependenciesMatch(t, expectedDependencies, actualDependencies)
 }
@@ -336,13 +297,7 @@ func TestAttributeValueForField_Zones(t *testing.T) {
 	}
 	testhelpers.AssertTemplatedCodeMatches(t, expected, string(actual.Bytes()))
 	expectedDependencies := testDependencies{
-		variables:                 testVariables{},
-		needsEdgeZone:             false,
-		needsPublicIP:             false,
-		needsResourceGroup:        false,
-		needsSubnet:               false,
-		needsUserAssignedIdentity: false,
-		needsVirtualNetwork:       false,
+		variables: testVariables{},
 	}
 	assertDependenciesMatch(t, expectedDependencies, actualDependencies)
 }
@@ -49,13 +49,7 @@ func TestAttributeValueForField_PossibleValuesInteger(t *testing.T) {
 	}
 	testhelpers.AssertTemplatedCodeMatches(t, expected, string(actual.Bytes()))
 	expectedDependencies := testDependencies{
-		variables:                 testVariables{},
-		needsEdgeZone:             false,
-		needsPublicIP:             false,
-		needsResourceGroup:        false,
-		needsSubnet:               false,
-		needsUserAssignedIdentity: false,
-		needsVirtualNetwork:       false,
+		variables: testVariables{},
 	}
 	assertDependenciesMatch(t, expectedDependencies, actualDependencies)
 }
@@ -98,13 +92,7 @@ func TestAttributeValueForField_PossibleValuesFloat(t *testing.T) {
 	}
 	testhelpers.AssertTemplatedCodeMatches(t, expected, string(actual.Bytes()))
 	expectedDependencies := testDependencies{
-		variables:                 testVariables{},
-		needsEdgeZone:             false,
-		needsPublicIP:             false,
-		needsResourceGroup:        false,
-		needsSubnet:               false,
-		needsUserAssignedIdentity: false,
-		needsVirtualNetwork:       false,
+		variables: testVariables{},
 	}
 	assertDependenciesMatch(t, expectedDependencies, actualDependencies)
 }
@@ -147,13 +135,7 @@ func TestAttributeValueForField_PossibleValuesString(t *testing.T) {
 	}
 	testhelpers.AssertTemplatedCodeMatches(t, expected, string(actual.Bytes()))
 	expectedDependencies := testDependencies{
-		variables:                 testVariables{},
-		needsEdgeZone:             false,
-		needsPublicIP:             false,
-		needsResourceGroup:        false,
-		needsSubnet:               false,
-		needsUserAssignedIdentity: false,
-		needsVirtualNetwork:       false,
+		variables: testVariables{},
 	}
 	assertDependenciesMatch(t, expectedDependencies, actualDependencies)
 }
@@ -39,13 +39,7 @@ func TestAttributeValueForField_BasicTypeBoolean(t *testing.T) {
 	}
 	testhelpers.AssertTemplatedCodeMatches(t, expected, string(actual.Bytes()))
 	expectedDependencies := testDependencies{
-		variables:                 testVariables{},
-		needsEdgeZone:             false,
-		needsPublicIP:             false,
-		needsResourceGroup:        false,
-		needsSubnet:               false,
-		needsUserAssignedIdentity: false,
-		needsVirtualNetwork:       false,
+		variables: testVariables{},
 	}
 	assertDependenciesMatch(t, expectedDependencies, actualDependencies)
 }
@@ -78,13 +72,7 @@ func TestAttributeValueForField_BasicTypeInteger(t *testing.T) {
 	}
 	testhelpers.AssertTemplatedCodeMatches(t, expected, string(actual.Bytes()))
 	expectedDependencies := testDependencies{
-		variables:                 testVariables{},
-		needsEdgeZone:             false,
-		needsPublicIP:             false,
-		needsResourceGroup:        false,
-		needsSubnet:               false,
-		needsUserAssignedIdentity: false,
-		needsVirtualNetwork:       false,
+		variables: testVariables{},
 	}
 	assertDependenciesMatch(t, expectedDependencies, actualDependencies)
 }
@@ -117,13 +105,7 @@ func TestAttributeValueForField_BasicTypeFloat(t *testing.T) {
 	}
 	testhelpers.AssertTemplatedCodeMatches(t, expected, string(actual.Bytes()))
 	expectedDependencies := testDependencies{
-		variables:                 testVariables{},
-		needsEdgeZone:             false,
-		needsPublicIP:             false,
-		needsResourceGroup:        false,
-		needsSubnet:               false,
-		needsUserAssignedIdentity: false,
-		needsVirtualNetwork:       false,
+		variables: testVariables{},
 	}
 	assertDependenciesMatch(t, expectedDependencies, actualDependencies)
 }
@@ -159,12 +141,6 @@ func TestAttributeValueForField_BasicTypeString(t *testing.T) {
 		variables: testVariables{
 			needsRandomString: true,
 		},
-		needsEdgeZone:             false,
-		needsPublicIP:             false,
-		needsResourceGroup:        false,
-		needsSubnet:               false,
-		needsUserAssignedIdentity: false,
-		needsVirtualNetwork:       false,
 	}
 	assertDependenciesMatch(t, expectedDependencies, actualDependencies)
 }
@@ -245,12 +221,6 @@ func TestAttributeValueForField_BasicTypeStringName(t *testing.T) {
 			variables: testVariables{
 				needsRandomString: true,
 			},
-			needsEdgeZone:             false,
-			needsPublicIP:             false,
-			needsResourceGroup:        false,
-			needsSubnet:               false,
-			needsUserAssignedIdentity: false,
-			needsVirtualNetwork:       false,
 		}
 		assertDependenciesMatch(t, expectedDependencies, actualDependencies)
 	}
@@ -290,9 +260,7 @@ func TestAttributeValueForField_BasicTypeStringKeyVaultID(t *testing.T) {
 			needsPrimaryLocation: true,
 			needsRandomInteger:   true,
 		},
-		needsClientConfig:  true,
-		needsResourceGroup: true,
-		needsKeyVault:      true,
+		needs: []string{"client_config", "key_vault", "resource_group"},
 	}
 	assertDependenciesMatch(t, expectedDependencies, actualDependencies)
 }
@@ -332,10 +300,7 @@ func TestAttributeValueForField_BasicTypeStringKeyVaultKeyID(t *testing.T) {
 			needsRandomInteger:   true,
 			needsRandomString:    true,
 		},
-		needsClientConfig:  true,
-		needsResourceGroup: true,
-		needsKeyVault:      true,
-		needsKeyVaultKey:   true,
+		needs: []string{"client_config", "key_vault", "key_vault_key", "resource_group"},
 	}
 	assertDependenciesMatch(t, expectedDependencies, actualDependencies)
 }
@@ -375,8 +340,7 @@ func TestAttributeValueForField_BasicTypeStringKubernetesClusterID(t *testing.T)
 			needsRandomInteger:   true,
 			needsRandomString:    true,
 		},
-		needsResourceGroup:     true,
-		needsKubernetesCluster: true,
+		needs: []string{"kubernetes_cluster", "resource_group"},
 	}
 	assertDependenciesMatch(t, expectedDependencies, actualDependencies)
 }
@@ -415,10 +379,7 @@ func TestAttributeValueForField_BasicTypeStringNetworkInterfaceID(t *testing.T)
 			needsPrimaryLocation: true,
 			needsRandomInteger:   true,
 		},
-		needsNetworkInterface: true,
-		needsResourceGroup:    true,
-		needsSubnet:           true,
-		needsVirtualNetwork:   true,
+		needs: []string{"network_interface", "resource_group", "subnet", "virtual_network"},
 	}
 	assertDependenciesMatch(t, expectedDependencies, actualDependencies)
 }
@@ -457,9 +418,7 @@ func TestAttributeValueForField_BasicTypeStringSubnetID(t *testing.T) {
 			needsPrimaryLocation: true,
 			needsRandomInteger:   true,
 		},
-		needsResourceGroup:  true,
-		needsSubnet:         true,
-		needsVirtualNetwork: true,
+		needs: []string{"resource_group", "subnet", "virtual_network"},
 	}
 	assertDependenciesMatch(t, expectedDependencies, actualDependencies)
 }
@@ -494,7 +453,7 @@ func TestAttributeValueForField_BasicTypeStringSubscriptionID(t *testing.T) {
 	expected := `data.example_client_config.test.subscription_id`
 	testhelpers.AssertTemplatedCodeMatches(t, expected, string(actual.Bytes()))
 	expectedDependencies := testDependencies{
-		needsClientConfig: true,
+		needs: []string{"client_config"},
 	}
 	assertDependenciesMatch(t, expectedDependencies, actualDependencies)
 }
@@ -529,7 +488,7 @@ func TestAttributeValueForField_BasicTypeStringTenantID(t *testing.T) {
 	expected := `data.example_client_config.test.tenant_id`
 	testhelpers.AssertTemplatedCodeMatches(t, expected, string(actual.Bytes()))
 	expectedDependencies := testDependencies{
-		needsClientConfig: true,
+		needs: []string{"client_config"},
 	}
 	assertDependenciesMatch(t, expectedDependencies, actualDependencies)
 }
@@ -568,8 +527,7 @@ func TestAttributeValueForField_BasicTypeStringUserAssignedIdentityID(t *testing
 			needsPrimaryLocation: true,
 			needsRandomInteger:   true,
 		},
-		needsResourceGroup:        true,
-		needsUserAssignedIdentity: true,
+		needs: []string{"resource_group", "user_assigned_identity"},
 	}
 	assertDependenciesMatch(t, expectedDependencies, actualDependencies)
 }
@@ -608,8 +566,7 @@ func TestAttributeValueForField_BasicTypeStringVirtualNetworkID(t *testing.T) {
 			needsPrimaryLocation: true,
 			needsRandomInteger:   true,
 		},
-		needsResourceGroup:  true,
-		needsVirtualNetwork: true,
+		needs: []string{"resource_group", "virtual_network"},
 	}
 	assertDependenciesMatch(t, expectedDependencies, actualDependencies)
 }
@@ -15,7 +15,10 @@ import (
 
 func (tb testBuilder) getBlockValueForField(field sdkModels.TerraformSchemaField, dependencies *testDependencies, onlyRequiredFields bool, testData definitions.VariablesDefinition) (*[]*hclwrite.Block, error) {
 	if function, isCommonSchema := blocksToCommonSchemaFunctions[field.ObjectDefinition.Type]; isCommonSchema {
-		val := function(field, dependencies, tb.resourceLabel, tb.providerPrefix)
+		val, err := function(field, dependencies, tb.resourceLabel, tb.providerPrefix)
+		if err != nil {
+			return nil, fmt.Errorf("for commonschema type: %+v", err)
+		}
 		return &[]*hclwrite.Block{
 			val,
 		}, nil
@@ -68,16 +71,18 @@ func (tb testBuilder) getBlockValueForField(field sdkModels.TerraformSchemaField
 	return nil, fmt.Errorf("not implemented")
 }
 
-type blockValueFunction func(field sdkModels.TerraformSchemaField, dependencies *testDependencies, resourceLabel, providerPrefix string) *hclwrite.Block
+type blockValueFunction func(field sdkModels.TerraformSchemaField, dependencies *testDependencies, resourceLabel, providerPrefix string) (*hclwrite.Block, error)
 
 var blocksToCommonSchemaFunctions = map[sdkModels.TerraformSchemaObjectDefinitionType]blockValueFunction{
-	sdkModels.SystemAssignedIdentityTerraformSchemaObjectDefinitionType: func(field sdkModels.TerraformSchemaField, dependencies *testDependencies, resourceLabel, providerPrefix string) *hclwrite.Block {
+	sdkModels.SystemAssignedIdentityTerraformSchemaObjectDefinitionType: func(field sdkModels.TerraformSchemaField, dependencies *testDependencies, resourceLabel, providerPrefix string) (*hclwrite.Block, error) {
 		block := hclwrite.NewBlock(field.HCLName, []string{})
 		block.Body().SetAttributeValue("type", cty.StringVal("SystemAssigned"))
-		return block
+		return block, nil
 	},
-	sdkModels.SystemAndUserAssignedIdentityTerraformSchemaObjectDefinitionType: func(field sdkModels.TerraformSchemaField, dependencies *testDependencies, resourceLabel, providerPrefix string) *hclwrite.Block {
-		dependencies.setNeedsUserAssignedIdentity()
+	sdkModels.SystemAndUserAssignedIdentityTerraformSchemaObjectDefinitionType: func(field sdkModels.TerraformSchemaField, dependencies *testDependencies, resourceLabel, providerPrefix string) (*hclwrite.Block, error) {
+		if err := dependencies.setNeeds("user_assigned_identity"); err != nil {
+			return nil, err
+		}
 
 		block := hclwrite.NewBlock(field.HCLName, []string{})
 		block.Body().SetAttributeValue("type", cty.StringVal("SystemAssigned, UserAssigned"))
@@ -88,16 +93,18 @@ var blocksToCommonSchemaFunctions = map[sdkModels.TerraformSchemaObjectDefinitio
 				},
 			}),
 		}))
-		return block
+		return block, nil
 	},
-	sdkModels.SystemOrUserAssignedIdentityTerraformSchemaObjectDefinitionType: func(field sdkModels.TerraformSchemaField, dependencies *testDependencies, resourceLabel, providerPrefix string) *hclwrite.Block {
+	sdkModels.SystemOrUserAssignedIdentityTerraformSchemaObjectDefinitionType: func(field sdkModels.TerraformSchemaField, dependencies *testDependencies, resourceLabel, providerPrefix string) (*hclwrite.Block, error) {
 		block := hclwrite.NewBlock(field.HCLName, []string{})
 		block.Body().SetAttributeValue("type", cty.StringVal("SystemAssigned"))
 		block.Body().SetAttributeValue("identity_ids", cty.ListValEmpty(cty.String))
-		return block
+		return block, nil
 	},
-	sdkModels.UserAssignedIdentityTerraformSchemaObjectDefinitionType: func(field sdkModels.TerraformSchemaField, dependencies *testDependencies, resourceLabel, providerPrefix string) *hclwrite.Block {
-		dependencies.setNeedsUserAssignedIdentity()
+	sdkModels.UserAssignedIdentityTerraformSchemaObjectDefinitionType: func(field sdkModels.TerraformSchemaField, dependencies *testDependencies, resourceLabel, providerPrefix string) (*hclwrite.Block, error) {
+		if err := dependencies.setNeeds("user_assigned_identity"); err != nil {
+			return nil, err
+		}
 
 		block := hclwrite.NewBlock(field.HCLName, []string{})
 		block.Body().SetAttributeValue("type", cty.StringVal("UserAssigned"))
@@ -108,6 +115,6 @@ var blocksToCommonSchemaFunctions = map[sdkModels.TerraformSchemaObjectDefinitio
 				},
 			}),
 		}))
-		return block
+		return block, nil
 	},
 }
@@ -46,15 +46,7 @@ identity {
 	actualRendered := renderBlocksToHcl(*actual)
 	testhelpers.AssertTemplatedCodeMatches(t, expected, actualRendered)
 	expectedDependencies := testDependencies{
-		variables:                 testVariables{},
-		needsClientConfig:         false,
-		needsEdgeZone:             false,
-		needsNetworkInterface:     false,
-		needsPublicIP:             false,
-		needsResourceGroup:        false,
-		needsSubnet:               false,
-		needsUserAssignedIdentity: false,
-		needsVirtualNetwork:       false,
+		variables: testVariables{},
 	}
 	assertDependenciesMatch(t, expectedDependencies, actualDependencies)
 }
@@ -95,14 +87,7 @@ identity {
 			needsRandomInteger:   true,
 			needsPrimaryLocation: true,
 		},
-		needsClientConfig:         false,
-		needsEdgeZone:             false,
-		needsNetworkInterface:     false,
-		needsPublicIP:             false,
-		needsResourceGroup:        true,
-		needsSubnet:               false,
-		needsUserAssignedIdentity: true,
-		needsVirtualNetwork:       false,
+		needs: []string{"resource_group", "user_assigned_identity"},
 	}
 	assertDependenciesMatch(t, expectedDependencies, actualDependencies)
 }
@@ -141,15 +126,7 @@ identity {
 	actualRendered := renderBlocksToHcl(*actual)
 	testhelpers.AssertTemplatedCodeMatches(t, expected, actualRendered)
 	expectedDependencies := testDependencies{
-		variables:                 testVariables{},
-		needsClientConfig:         false,
-		needsEdgeZone:             false,
-		needsNetworkInterface:     false,
-		needsPublicIP:             false,
-		needsResourceGroup:        false,
-		needsSubnet:               false,
-		needsUserAssignedIdentity: false,
-		needsVirtualNetwork:       false,
+		variables: testVariables{},
 	}
 	assertDependenciesMatch(t, expectedDependencies, actualDependencies)
 }
@@ -190,14 +167,7 @@ identity {
 			needsRandomInteger:   true,
 			needsPrimaryLocation: true,
 		},
-		needsClientConfig:         false,
-		needsEdgeZone:             false,
-		needsNetworkInterface:     false,
-		needsPublicIP:             false,
-		needsResourceGroup:        true,
-		needsSubnet:               false,
-		needsUserAssignedIdentity: true,
-		needsVirtualNetwork:       false,
+		needs: []string{"resource_group", "user_assigned_identity"},
 	}
 	assertDependenciesMatch(t, expectedDependencies, actualDependencies)
 }
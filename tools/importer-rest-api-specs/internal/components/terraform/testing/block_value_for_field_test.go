@@ -59,14 +59,6 @@ some_nested_item {
 		variables: testVariables{
 			needsRandomString: true,
 		},
-		needsClientConfig:         false,
-		needsEdgeZone:             false,
-		needsNetworkInterface:     false,
-		needsPublicIP:             false,
-		needsResourceGroup:        false,
-		needsSubnet:               false,
-		needsUserAssignedIdentity: false,
-		needsVirtualNetwork:       false,
 	}
 	assertDependenciesMatch(t, expectedDependencies, actualDependencies)
 }
@@ -142,14 +134,6 @@ some_nested_item {
 		variables: testVariables{
 			needsRandomString: true,
 		},
-		needsClientConfig:         false,
-		needsEdgeZone:             false,
-		needsNetworkInterface:     false,
-		needsPublicIP:             false,
-		needsResourceGroup:        false,
-		needsSubnet:               false,
-		needsUserAssignedIdentity: false,
-		needsVirtualNetwork:       false,
 	}
 	assertDependenciesMatch(t, expectedDependencies, actualDependencies)
 }
@@ -248,14 +232,6 @@ some_nested_item {
 		variables: testVariables{
 			needsRandomString: true,
 		},
-		needsClientConfig:         false,
-		needsEdgeZone:             false,
-		needsNetworkInterface:     false,
-		needsPublicIP:             false,
-		needsResourceGroup:        false,
-		needsSubnet:               false,
-		needsUserAssignedIdentity: false,
-		needsVirtualNetwork:       false,
 	}
 	assertDependenciesMatch(t, expectedDependencies, actualDependencies)
 }
@@ -311,14 +287,6 @@ some_nested_item {
 		variables: testVariables{
 			needsRandomString: true,
 		},
-		needsClientConfig:         false,
-		needsEdgeZone:             false,
-		needsNetworkInterface:     false,
-		needsPublicIP:             false,
-		needsResourceGroup:        false,
-		needsSubnet:               false,
-		needsUserAssignedIdentity: false,
-		needsVirtualNetwork:       false,
 	}
 	assertDependenciesMatch(t, expectedDependencies, actualDependencies)
 }
@@ -374,14 +342,6 @@ some_nested_item {
 		variables: testVariables{
 			needsRandomString: true,
 		},
-		needsClientConfig:         false,
-		needsEdgeZone:             false,
-		needsNetworkInterface:     false,
-		needsPublicIP:             false,
-		needsResourceGroup:        false,
-		needsSubnet:               false,
-		needsUserAssignedIdentity: false,
-		needsVirtualNetwork:       false,
 	}
 	assertDependenciesMatch(t, expectedDependencies, actualDependencies)
 }
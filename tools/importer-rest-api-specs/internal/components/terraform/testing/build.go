@@ -10,13 +10,17 @@ import (
 	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/logging"
 )
 
-// Build works through each of the Resources within the WorkInProgressData and builds up the Tests for these.
-func Build(input models.WorkInProgressData) (*models.WorkInProgressData, error) {
+// Build works through each of the Resources within the WorkInProgressData and builds up the Tests for these,
+// using the Test Dependency Catalog `testDependencyCatalog` (or the default Test Dependency Catalog when nil).
+func Build(input models.WorkInProgressData, testDependencyCatalog *TestDependencyCatalog) (*models.WorkInProgressData, error) {
 	for resourceLabel := range input.Resources {
 		resource := input.Resources[resourceLabel]
 
 		logging.Infof("Generating Tests for the Resource %q..", resourceLabel)
 		builder := newTestBuilder(input.ProviderPrefix, resourceLabel, resource.Resource)
+		builder.exampleValues = exampleValuesForResource(resource.APIResource, resource.Resource)
+		builder.resourceIDsForFields = resourceIDsForFields(resource.APIResource, resource.Resource)
+		builder.testDependencyCatalog = testDependencyCatalog
 		tests, err := builder.generateTestsForResource(resource.InputData.TestData)
 		if err != nil {
 			return nil, fmt.Errorf("generating the tests for Resource %q: %+v", resourceLabel, err)
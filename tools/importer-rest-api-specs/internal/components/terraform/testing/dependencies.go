@@ -5,197 +5,123 @@ package testing
 
 import (
 	"fmt"
+	"sort"
+
+	sdkHelpers "github.com/hashicorp/pandora/tools/data-api-sdk/v1/helpers"
+	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
 )
 
 type testDependencies struct {
 	variables testVariables
 
-	// NOTE: use the Set methods
-	needsApplicationInsights      bool
-	needsClientConfig             bool
-	needsDevCenter                bool
-	needsEdgeZone                 bool
-	needsKeyVault                 bool
-	needsKeyVaultAccessPolicy     bool
-	needsKeyVaultKey              bool
-	needsKubernetesCluster        bool
-	needsKubernetesFleetManager   bool
-	needsMachineLearningWorkspace bool
-	needsNetworkInterface         bool
-	needsPublicIP                 bool
-	needsResourceGroup            bool
-	needsStorageAccount           bool
-	needsSubnet                   bool
-	needsUserAssignedIdentity     bool
-	needsVirtualNetwork           bool
-
-	// TODO: this obviously isn't going to scale, so we should:
-	//
-	//   1. In the medium-term, look these up using a struct which
-	//      defines it's dependencies so this is manageable.
-	//
-	//   2. In the longer-term, can we infer the dependencies from
-	//      the reference name, for example `virtual_network.test`
-	//      and find the matching `basic` test and reference that?
-}
-
-func (d *testDependencies) setNeedsApplicationInsights() {
-	d.setNeedsResourceGroup()
-	d.needsApplicationInsights = true
-
-	d.variables.needsRandomInteger = true
-}
-
-func (d *testDependencies) setNeedsClientConfig() {
-	d.needsClientConfig = true
-}
-
-func (d *testDependencies) setNeedsDevCenter() {
-	d.setNeedsResourceGroup()
-	d.needsDevCenter = true
-
-	d.variables.needsRandomString = true
-}
-
-func (d *testDependencies) setNeedsEdgeZones() {
-	d.needsEdgeZone = true
-
-	d.variables.needsPrimaryLocation = true
-}
-
-func (d *testDependencies) setNeedsKeyVault() {
-	d.setNeedsResourceGroup()
-	d.setNeedsClientConfig()
-	d.needsKeyVault = true
-
-	d.variables.needsRandomInteger = true
-}
-
-func (d *testDependencies) setNeedsKeyVaultAccessPolicy() {
-	d.setNeedsResourceGroup()
-	d.setNeedsClientConfig()
-	d.setNeedsKeyVault()
-	d.needsKeyVaultAccessPolicy = true
-}
-
-func (d *testDependencies) setNeedsKeyVaultKey() {
-	d.setNeedsKeyVault()
-	d.needsKeyVaultKey = true
-
-	d.variables.needsRandomString = true
-}
-
-func (d *testDependencies) setNeedsKubernetesCluster() {
-	d.setNeedsResourceGroup()
-	d.needsKubernetesCluster = true
-
-	d.variables.needsRandomString = true
-}
-
-func (d *testDependencies) setNeedsKubernetesFleetManager() {
-	d.setNeedsResourceGroup()
-	d.needsKubernetesFleetManager = true
-
-	d.variables.needsRandomString = true
-}
-
-func (d *testDependencies) setNeedsMachineLearningWorkspace() {
-	d.setNeedsResourceGroup()
-	d.setNeedsKeyVault()
-	d.setNeedsKeyVaultAccessPolicy()
-	d.setNeedsApplicationInsights()
-	d.setNeedsStorageAccount()
-	d.needsMachineLearningWorkspace = true
+	// catalog is the Test Dependency Catalog used to look up the Test Dependencies, when nil the
+	// default Test Dependency Catalog is used.
+	catalog *TestDependencyCatalog
 
-	d.variables.needsRandomString = true
-}
-
-func (d *testDependencies) setNeedsNetworkInterface() {
-	d.setNeedsSubnet()
-
-	d.needsNetworkInterface = true
-}
-
-func (d *testDependencies) setNeedsPublicIP() {
-	d.setNeedsVirtualNetwork()
-	d.needsPublicIP = true
-
-	d.variables.needsRandomInteger = true
-}
+	// resourceIDsForFields is a map of the HCL Name of a field (key) to the Resource ID referenced by this
+	// field (value), which is used to match the Test Dependency using a Resource ID pattern.
+	resourceIDsForFields map[string]string
 
-func (d *testDependencies) setNeedsResourceGroup() {
-	d.needsResourceGroup = true
-
-	d.variables.needsRandomInteger = true
-	d.variables.needsPrimaryLocation = true
+	// needs is a sorted list of the names of the Test Dependencies (from the Test Dependency Catalog)
+	// which are needed. NOTE: use the setNeeds method.
+	needs []string
 }
 
-func (d *testDependencies) setNeedsStorageAccount() {
-	d.setNeedsResourceGroup()
-	d.needsStorageAccount = true
-
-	d.variables.needsRandomString = true
+func (d *testDependencies) catalogOrDefault() *TestDependencyCatalog {
+	if d.catalog != nil {
+		return d.catalog
+	}
+	return defaultTestDependencyCatalog
 }
 
-func (d *testDependencies) setNeedsSubnet() {
-	d.setNeedsVirtualNetwork()
-	d.needsSubnet = true
-
-	d.variables.needsRandomInteger = true
+// isNeeded returns whether the Test Dependency `name` is needed.
+func (d *testDependencies) isNeeded(name string) bool {
+	for _, item := range d.needs {
+		if item == name {
+			return true
+		}
+	}
+	return false
 }
 
-func (d *testDependencies) setNeedsUserAssignedIdentity() {
-	d.setNeedsResourceGroup()
-	d.needsUserAssignedIdentity = true
-
-	d.variables.needsRandomInteger = true
-}
+// setNeeds marks the Test Dependency `name` as needed, along with any Test Dependencies and Variables it requires.
+func (d *testDependencies) setNeeds(name string) error {
+	dependency, ok := d.catalogOrDefault().dependencies[name]
+	if !ok {
+		return fmt.Errorf("the Test Dependency %q was not found within the Test Dependency Catalog", name)
+	}
+	if d.isNeeded(name) {
+		return nil
+	}
 
-func (d *testDependencies) setNeedsVirtualNetwork() {
-	d.setNeedsResourceGroup()
-	d.needsVirtualNetwork = true
+	for _, dependsOn := range dependency.dependsOn {
+		if err := d.setNeeds(dependsOn); err != nil {
+			return fmt.Errorf("setting the Test Dependency %q needed by %q: %+v", dependsOn, name, err)
+		}
+	}
 
-	d.variables.needsPrimaryLocation = true
-	d.variables.needsRandomInteger = true
-}
+	d.needs = append(d.needs, name)
+	sort.Strings(d.needs)
 
-type dependencyDefinition struct {
-	// Instruction is the dependency func that sets the dependencies needed for the tests
-	Instruction func()
+	for _, variable := range dependency.variables {
+		setter, ok := testVariableSetters[variable]
+		if !ok {
+			return fmt.Errorf("the Test Dependency %q uses the unsupported Variable %q", name, variable)
+		}
+		setter(&d.variables)
+	}
 
-	// Reference is the hcl reference to the dependency
-	Reference string
+	return nil
 }
 
+// DetermineDependencies sets the Test Dependency referenced by the field `field` as needed, returning the
+// reference to this Test Dependency (e.g. `azurerm_subnet.test.id`).
 func DetermineDependencies(field, providerPrefix string, dependencies *testDependencies) (*string, *testDependencies, error) {
-	dependencyMapping := map[string]dependencyDefinition{
-		"application_insights_id":       {dependencies.setNeedsApplicationInsights, fmt.Sprintf("%s_application_insights.test.id", providerPrefix)},
-		"dev_center_id":                 {dependencies.setNeedsDevCenter, fmt.Sprintf("azurerm_dev_center.test.id")},
-		"key_vault_id":                  {dependencies.setNeedsKeyVault, fmt.Sprintf("%s_key_vault.test.id", providerPrefix)},
-		"key_vault_access_policy_id":    {dependencies.setNeedsKeyVaultAccessPolicy, fmt.Sprintf("%s_key_vault_access_policy.test.id", providerPrefix)},
-		"key_vault_key_id":              {dependencies.setNeedsKeyVaultKey, fmt.Sprintf("%s_key_vault_key.test.id", providerPrefix)},
-		"kubernetes_cluster_id":         {dependencies.setNeedsKubernetesCluster, fmt.Sprintf("%s_kubernetes_cluster.test.id", providerPrefix)},
-		"kubernetes_fleet_id":           {dependencies.setNeedsKubernetesFleetManager, fmt.Sprintf("%s_kubernetes_fleet_manager.test.id", providerPrefix)},
-		"machine_learning_workspace_id": {dependencies.setNeedsMachineLearningWorkspace, fmt.Sprintf("%s_machine_learning_workspace.test.id", providerPrefix)},
-		"network_interface_id":          {dependencies.setNeedsNetworkInterface, fmt.Sprintf("%s_network_interface.test.id", providerPrefix)},
-		"storage_account_id":            {dependencies.setNeedsStorageAccount, fmt.Sprintf("%s_storage_account.test.id", providerPrefix)},
-		"subnet_id":                     {dependencies.setNeedsSubnet, fmt.Sprintf("%s_subnet.test.id", providerPrefix)},
-		"subscription_id":               {dependencies.setNeedsClientConfig, fmt.Sprintf("data.%s_client_config.test.subscription_id", providerPrefix)},
-		// Currently only Chaos Studio Targets has this property which can accept many different resource IDs
-		// for now setting this to kubernetes is sufficient, but we will need to consider how to proceed in future
-		"target_resource_id":        {dependencies.setNeedsKubernetesCluster, fmt.Sprintf("%s_kubernetes_cluster.test.id", providerPrefix)},
-		"tenant_id":                 {dependencies.setNeedsClientConfig, fmt.Sprintf("data.%s_client_config.test.tenant_id", providerPrefix)},
-		"user_assigned_identity_id": {dependencies.setNeedsUserAssignedIdentity, fmt.Sprintf("%s_user_assigned_identity.test.id", providerPrefix)},
-		"virtual_network_id":        {dependencies.setNeedsVirtualNetwork, fmt.Sprintf("%s_virtual_network.test.id", providerPrefix)},
+	var resourceID *string
+	if v, ok := dependencies.resourceIDsForFields[field]; ok {
+		resourceID = &v
 	}
-
-	if _, ok := dependencyMapping[field]; !ok {
+	name, reference, err := dependencies.catalogOrDefault().referenceForField(field, resourceID, providerPrefix)
+	if err != nil {
+		return nil, nil, fmt.Errorf("determining the Test Dependency for %q: %+v", field, err)
+	}
+	if name == nil || reference == nil {
 		return nil, nil, fmt.Errorf("internal-error: missing dependency mapping for Resource ID Reference %q", field)
 	}
 
-	dependency := dependencyMapping[field]
+	if err := dependencies.setNeeds(*name); err != nil {
+		return nil, nil, err
+	}
 
-	dependency.Instruction()
+	return reference, dependencies, nil
+}
 
-	return &dependency.Reference, dependencies, nil
+// resourceIDsForFields returns a map of the HCL Name of each field which is parsed from the Parent Resource ID
+// (key) to the Parent Resource ID (value, e.g. `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}`).
+func resourceIDsForFields(apiResource sdkModels.APIResource, details sdkModels.TerraformResourceDefinition) map[string]string {
+	output := make(map[string]string)
+	resourceID, ok := apiResource.ResourceIDs[details.ResourceIDName]
+	if !ok || len(resourceID.Segments) <= 2 {
+		return output
+	}
+	schemaModel, ok := details.SchemaModels[details.SchemaModelName]
+	if !ok {
+		return output
+	}
+
+	// the Parent Resource ID is the Resource ID without the last (static and user-specifiable) segments
+	parentResourceID := sdkModels.ResourceID{
+		Segments: resourceID.Segments[0 : len(resourceID.Segments)-2],
+	}
+	for _, mapping := range details.Mappings.ResourceID {
+		if !mapping.ParsedFromParentID {
+			continue
+		}
+		field, ok := schemaModel.Fields[mapping.TerraformSchemaFieldName]
+		if !ok {
+			continue
+		}
+		output[field.HCLName] = sdkHelpers.DisplayValueForResourceID(parentResourceID)
+	}
+	return output
 }
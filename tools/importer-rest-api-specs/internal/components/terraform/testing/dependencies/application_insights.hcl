@@ -0,0 +1,18 @@
+dependency "application_insights" {
+  depends_on = ["resource_group"]
+  variables  = ["random_integer"]
+
+  reference {
+    field_name_pattern = "^application_insights_id$"
+    value              = "${provider_prefix}_application_insights.test.id"
+  }
+
+  template = <<EOT
+resource "${provider_prefix}_application_insights" "test" {
+  name                = "acctestai-${var.random_integer}"
+  location            = ${provider_prefix}_resource_group.test.location
+  resource_group_name = ${provider_prefix}_resource_group.test.name
+  application_type    = "web"
+}
+EOT
+}
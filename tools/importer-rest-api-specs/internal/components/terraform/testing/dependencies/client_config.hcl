@@ -0,0 +1,15 @@
+dependency "client_config" {
+  reference {
+    field_name_pattern = "^subscription_id$"
+    value              = "data.${provider_prefix}_client_config.test.subscription_id"
+  }
+
+  reference {
+    field_name_pattern = "^tenant_id$"
+    value              = "data.${provider_prefix}_client_config.test.tenant_id"
+  }
+
+  template = <<EOT
+data "${provider_prefix}_client_config" "test" {}
+EOT
+}
@@ -0,0 +1,21 @@
+dependency "dev_center" {
+  depends_on = ["resource_group"]
+  variables  = ["random_string"]
+
+  reference {
+    field_name_pattern = "^dev_center_id$"
+    value              = "${provider_prefix}_dev_center.test.id"
+  }
+
+  template = <<EOT
+resource "${provider_prefix}_dev_center" "test" {
+  name                = "acctestdc-${var.random_string}"
+  resource_group_name = ${provider_prefix}_resource_group.test.name
+  location            = ${provider_prefix}_resource_group.test.location
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+EOT
+}
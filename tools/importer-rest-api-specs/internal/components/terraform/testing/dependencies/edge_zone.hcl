@@ -0,0 +1,9 @@
+dependency "edge_zone" {
+  variables = ["primary_location"]
+
+  template = <<EOT
+data "${provider_prefix}_extended_locations" "test" {
+  location = var.primary_location
+}
+EOT
+}
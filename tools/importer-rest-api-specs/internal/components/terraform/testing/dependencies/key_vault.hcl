@@ -0,0 +1,61 @@
+dependency "key_vault" {
+  depends_on = ["client_config", "resource_group"]
+  variables  = ["random_integer"]
+
+  reference {
+    field_name_pattern = "^key_vault_id$"
+    value              = "${provider_prefix}_key_vault.test.id"
+  }
+
+  template = <<EOT
+resource "${provider_prefix}_key_vault" "test" {
+  name                       = "acctest-${var.random_string}"
+  location                   = ${provider_prefix}_resource_group.test.location
+  resource_group_name        = ${provider_prefix}_resource_group.test.name
+  tenant_id                  = data.${provider_prefix}_client_config.test.tenant_id
+  sku_name                   = "standard"
+  soft_delete_retention_days = 7
+
+  access_policy {
+    tenant_id = data.${provider_prefix}_client_config.test.tenant_id
+    object_id = data.${provider_prefix}_client_config.test.object_id
+
+    certificate_permissions = [
+      "ManageContacts",
+    ]
+
+    key_permissions = [
+      "Create",
+      "Delete",
+      "Get",
+      "Purge",
+      "Recover",
+      "Update",
+      "SetRotationPolicy",
+      "GetRotationPolicy",
+      "Rotate",
+    ]
+
+    secret_permissions = [
+      "Delete",
+      "Get",
+      "Set",
+    ]
+  }
+}
+EOT
+
+  # the Access Policy is defined as a separate Resource when the Key Vault Access Policy is also needed
+  variant "key_vault_access_policy" {
+    template = <<EOT
+resource "${provider_prefix}_key_vault" "test" {
+  name                       = "acctest-${var.random_string}"
+  location                   = ${provider_prefix}_resource_group.test.location
+  resource_group_name        = ${provider_prefix}_resource_group.test.name
+  tenant_id                  = data.${provider_prefix}_client_config.test.tenant_id
+  sku_name                   = "standard"
+  soft_delete_retention_days = 7
+}
+EOT
+  }
+}
@@ -0,0 +1,24 @@
+dependency "key_vault_access_policy" {
+  depends_on = ["client_config", "key_vault", "resource_group"]
+
+  reference {
+    field_name_pattern = "^key_vault_access_policy_id$"
+    value              = "${provider_prefix}_key_vault_access_policy.test.id"
+  }
+
+  template = <<EOT
+resource "${provider_prefix}_key_vault_access_policy" "test" {
+  key_vault_id = ${provider_prefix}_key_vault.test.id
+  tenant_id    = data.${provider_prefix}_client_config.test.tenant_id
+  object_id    = data.${provider_prefix}_client_config.test.object_id
+
+  key_permissions = [
+    "Create",
+    "Get",
+    "Delete",
+    "Purge",
+    "GetRotationPolicy",
+  ]
+}
+EOT
+}
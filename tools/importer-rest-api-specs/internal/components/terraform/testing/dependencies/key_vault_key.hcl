@@ -0,0 +1,23 @@
+dependency "key_vault_key" {
+  depends_on = ["key_vault"]
+  variables  = ["random_string"]
+
+  reference {
+    field_name_pattern = "^key_vault_key_id$"
+    value              = "${provider_prefix}_key_vault_key.test.id"
+  }
+
+  template = <<EOT
+resource "${provider_prefix}_key_vault_key" "test" {
+  name         = "key-${var.random_string}"
+  key_vault_id = ${provider_prefix}_key_vault.test.id
+  key_type     = "EC"
+  key_size     = 2048
+
+  key_opts = [
+    "sign",
+    "verify",
+  ]
+}
+EOT
+}
@@ -0,0 +1,39 @@
+dependency "kubernetes_cluster" {
+  depends_on = ["resource_group"]
+  variables  = ["random_string"]
+
+  reference {
+    field_name_pattern = "^kubernetes_cluster_id$"
+    value              = "${provider_prefix}_kubernetes_cluster.test.id"
+  }
+
+  # Currently only Chaos Studio Targets has this property which can accept many different resource IDs
+  # for now setting this to kubernetes is sufficient, but we will need to consider how to proceed in future
+  reference {
+    field_name_pattern = "^target_resource_id$"
+    value              = "${provider_prefix}_kubernetes_cluster.test.id"
+  }
+
+  # NOTE: the Test Configuration is string-formatted by the Terraform Generator, so `%%` outputs a `%`
+  template = <<EOT
+resource "${provider_prefix}_kubernetes_cluster" "test" {
+  name                = "acctestaks${var.random_string}"
+  location            = ${provider_prefix}_resource_group.test.location
+  resource_group_name = ${provider_prefix}_resource_group.test.name
+  dns_prefix          = "acctestaks${var.random_string}"
+
+  default_node_pool {
+    name       = "default"
+    node_count = 1
+    vm_size    = "Standard_DS2_v2"
+    upgrade_settings {
+      max_surge = "10%%"
+    }
+  }
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+EOT
+}
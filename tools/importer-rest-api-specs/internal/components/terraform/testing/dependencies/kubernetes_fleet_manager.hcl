@@ -0,0 +1,17 @@
+dependency "kubernetes_fleet_manager" {
+  depends_on = ["resource_group"]
+  variables  = ["random_string"]
+
+  reference {
+    field_name_pattern = "^kubernetes_fleet_id$"
+    value              = "${provider_prefix}_kubernetes_fleet_manager.test.id"
+  }
+
+  template = <<EOT
+resource "${provider_prefix}_kubernetes_fleet_manager" "test" {
+  name                = "acctestkfm${var.random_string}"
+  location            = ${provider_prefix}_resource_group.test.location
+  resource_group_name = ${provider_prefix}_resource_group.test.name
+}
+EOT
+}
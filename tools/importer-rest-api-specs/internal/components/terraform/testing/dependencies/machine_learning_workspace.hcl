@@ -0,0 +1,24 @@
+dependency "machine_learning_workspace" {
+  depends_on = ["application_insights", "key_vault", "key_vault_access_policy", "resource_group", "storage_account"]
+  variables  = ["random_string"]
+
+  reference {
+    field_name_pattern = "^machine_learning_workspace_id$"
+    value              = "${provider_prefix}_machine_learning_workspace.test.id"
+  }
+
+  template = <<EOT
+resource "${provider_prefix}_machine_learning_workspace" "test" {
+  name                    = "acctestmlw-${var.random_integer}"
+  location                = ${provider_prefix}_resource_group.test.location
+  resource_group_name     = ${provider_prefix}_resource_group.test.name
+  key_vault_id            = ${provider_prefix}_key_vault.test.id
+  storage_account_id      = ${provider_prefix}_storage_account.test.id
+  application_insights_id = ${provider_prefix}_application_insights.test.id
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+EOT
+}
@@ -0,0 +1,22 @@
+dependency "network_interface" {
+  depends_on = ["subnet"]
+
+  reference {
+    field_name_pattern = "^network_interface_id$"
+    value              = "${provider_prefix}_network_interface.test.id"
+  }
+
+  template = <<EOT
+resource "${provider_prefix}_network_interface" "test" {
+  name                = "acctestnic-${var.random_integer}"
+  location            = ${provider_prefix}_resource_group.test.location
+  resource_group_name = ${provider_prefix}_resource_group.test.name
+
+  ip_configuration {
+    name                          = "internal"
+    subnet_id                     = ${provider_prefix}_subnet.test.id
+    private_ip_address_allocation = "Static"
+  }
+}
+EOT
+}
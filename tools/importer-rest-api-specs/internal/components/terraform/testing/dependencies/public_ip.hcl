@@ -0,0 +1,13 @@
+dependency "public_ip" {
+  depends_on = ["virtual_network"]
+  variables  = ["random_integer"]
+
+  template = <<EOT
+resource "${provider_prefix}_public_ip" "test" {
+  name                = "acctest-${var.random_integer}"
+  location            = ${provider_prefix}_resource_group.test.location
+  resource_group_name = ${provider_prefix}_resource_group.test.name
+  allocation_method   = "Static"
+}
+EOT
+}
@@ -0,0 +1,10 @@
+dependency "resource_group" {
+  variables = ["primary_location", "random_integer"]
+
+  template = <<EOT
+resource "${provider_prefix}_resource_group" "test" {
+  name     = "acctestrg-${var.random_integer}"
+  location = var.primary_location
+}
+EOT
+}
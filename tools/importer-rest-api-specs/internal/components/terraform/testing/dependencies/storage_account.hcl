@@ -0,0 +1,19 @@
+dependency "storage_account" {
+  depends_on = ["resource_group"]
+  variables  = ["random_string"]
+
+  reference {
+    field_name_pattern = "^storage_account_id$"
+    value              = "${provider_prefix}_storage_account.test.id"
+  }
+
+  template = <<EOT
+resource "${provider_prefix}_storage_account" "test" {
+  name                     = "acctestsa${var.random_string}"
+  location                 = ${provider_prefix}_resource_group.test.location
+  resource_group_name      = ${provider_prefix}_resource_group.test.name
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+EOT
+}
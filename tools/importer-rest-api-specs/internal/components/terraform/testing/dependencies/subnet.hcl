@@ -0,0 +1,18 @@
+dependency "subnet" {
+  depends_on = ["virtual_network"]
+  variables  = ["random_integer"]
+
+  reference {
+    field_name_pattern = "^subnet_id$"
+    value              = "${provider_prefix}_subnet.test.id"
+  }
+
+  template = <<EOT
+resource "${provider_prefix}_subnet" "test" {
+  name                 = "internal"
+  resource_group_name  = ${provider_prefix}_resource_group.test.name
+  virtual_network_name = ${provider_prefix}_virtual_network.test.name
+  address_prefixes     = ["10.0.2.0/24"]
+}
+EOT
+}
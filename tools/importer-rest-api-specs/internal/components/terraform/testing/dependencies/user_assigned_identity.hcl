@@ -0,0 +1,17 @@
+dependency "user_assigned_identity" {
+  depends_on = ["resource_group"]
+  variables  = ["random_integer"]
+
+  reference {
+    field_name_pattern = "^user_assigned_identity_id$"
+    value              = "${provider_prefix}_user_assigned_identity.test.id"
+  }
+
+  template = <<EOT
+resource "${provider_prefix}_user_assigned_identity" "test" {
+  name                = "acctest-${var.random_integer}"
+  resource_group_name = ${provider_prefix}_resource_group.test.name
+  location            = ${provider_prefix}_resource_group.test.location
+}
+EOT
+}
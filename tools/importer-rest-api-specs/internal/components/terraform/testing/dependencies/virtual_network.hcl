@@ -0,0 +1,18 @@
+dependency "virtual_network" {
+  depends_on = ["resource_group"]
+  variables  = ["primary_location", "random_integer"]
+
+  reference {
+    field_name_pattern = "^virtual_network_id$"
+    value              = "${provider_prefix}_virtual_network.test.id"
+  }
+
+  template = <<EOT
+resource "${provider_prefix}_virtual_network" "test" {
+  name                = "acctest-${var.random_integer}"
+  resource_group_name = ${provider_prefix}_resource_group.test.name
+  location            = ${provider_prefix}_resource_group.test.location
+  address_space       = ["10.0.0.0/16"]
+}
+EOT
+}
@@ -4,8 +4,11 @@
 package testing
 
 import (
+	"reflect"
 	"strings"
 	"testing"
+
+	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
 )
 
 func TestDependencies_VirtualNetworkID(t *testing.T) {
@@ -25,11 +28,11 @@ func TestDependencies_VirtualNetworkID(t *testing.T) {
 		t.Fatalf("expected %s got %s for dependency reference", "example_virtual_network.test.id", *ref)
 	}
 
-	if !deps.needsResourceGroup {
+	if !deps.isNeeded("resource_group") {
 		t.Fatalf("expected Resource Group dependency to be set")
 	}
 
-	if !deps.needsVirtualNetwork {
+	if !deps.isNeeded("virtual_network") {
 		t.Fatalf("expected Virtual Network dependency to be set")
 	}
 }
@@ -44,3 +47,61 @@ func TestDependencies_NonExistentID(t *testing.T) {
 		t.Fatal("expected an error but got none")
 	}
 }
+
+func TestResourceIDsForFields(t *testing.T) {
+	apiResource := sdkModels.APIResource{
+		ResourceIDs: map[string]sdkModels.ResourceID{
+			"SubnetId": {
+				Segments: []sdkModels.ResourceIDSegment{
+					sdkModels.NewStaticValueResourceIDSegment("staticSubscriptions", "subscriptions"),
+					sdkModels.NewSubscriptionIDResourceIDSegment("subscriptionId"),
+					sdkModels.NewStaticValueResourceIDSegment("staticResourceGroups", "resourceGroups"),
+					sdkModels.NewResourceGroupNameResourceIDSegment("resourceGroupName"),
+					sdkModels.NewStaticValueResourceIDSegment("staticProviders", "providers"),
+					sdkModels.NewResourceProviderResourceIDSegment("staticMicrosoftNetwork", "Microsoft.Network"),
+					sdkModels.NewStaticValueResourceIDSegment("staticVirtualNetworks", "virtualNetworks"),
+					sdkModels.NewUserSpecifiedResourceIDSegment("virtualNetworkName", "virtualNetworkName"),
+					sdkModels.NewStaticValueResourceIDSegment("staticSubnets", "subnets"),
+					sdkModels.NewUserSpecifiedResourceIDSegment("subnetName", "subnetName"),
+				},
+			},
+		},
+	}
+	details := sdkModels.TerraformResourceDefinition{
+		Mappings: sdkModels.TerraformMappingDefinition{
+			ResourceID: []sdkModels.TerraformResourceIDMappingDefinition{
+				{
+					TerraformSchemaFieldName: "Name",
+					SegmentName:              "subnetName",
+				},
+				{
+					TerraformSchemaFieldName: "VirtualNetworkId",
+					SegmentName:              "virtualNetworkName",
+					ParsedFromParentID:       true,
+				},
+			},
+		},
+		ResourceIDName:  "SubnetId",
+		SchemaModelName: "SubnetResource",
+		SchemaModels: map[string]sdkModels.TerraformSchemaModel{
+			"SubnetResource": {
+				Fields: map[string]sdkModels.TerraformSchemaField{
+					"Name": {
+						HCLName: "name",
+					},
+					"VirtualNetworkId": {
+						HCLName: "virtual_network_id",
+					},
+				},
+			},
+		},
+	}
+
+	expected := map[string]string{
+		"virtual_network_id": "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Network/virtualNetworks/{virtualNetworkName}",
+	}
+	actual := resourceIDsForFields(apiResource, details)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+}
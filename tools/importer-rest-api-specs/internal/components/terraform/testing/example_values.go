@@ -0,0 +1,174 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testing
+
+import (
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/logging"
+	"github.com/hashicorp/pandora/tools/sdk/config/definitions"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// maximumExampleValuesDepth limits how deeply nested models are walked when finding the values within an
+// Example, to avoid recursing infinitely for self-referential models.
+const maximumExampleValuesDepth = 10
+
+// exampleValuesForResource returns a map of Schema Field HCL Name (key) to the value used for the mapped SDK
+// Field within the first Example (ordered by name) for the Create Operation (value) - which allows the Tests to
+// use realistic values, rather than placeholders.
+//
+// NOTE: since the Tests look up these values by HCL Name, a HCL Name used for different values across multiple
+// Schema Models is ambiguous and so is omitted.
+func exampleValuesForResource(apiResource sdkModels.APIResource, details sdkModels.TerraformResourceDefinition) map[string]cty.Value {
+	createOperation, ok := apiResource.Operations[details.CreateMethod.SDKOperationName]
+	if !ok || createOperation.RequestObject == nil || createOperation.RequestObject.Type != sdkModels.ReferenceSDKObjectDefinitionType || createOperation.RequestObject.ReferenceName == nil {
+		return nil
+	}
+
+	exampleNames := make([]string, 0)
+	for name := range createOperation.Examples {
+		exampleNames = append(exampleNames, name)
+	}
+	sort.Strings(exampleNames)
+
+	var requestBody map[string]interface{}
+	for _, name := range exampleNames {
+		if body, ok := createOperation.Examples[name].RequestBody.(map[string]interface{}); ok {
+			logging.Tracef("Using the Example %q for the values in the Tests", name)
+			requestBody = body
+			break
+		}
+	}
+	if requestBody == nil {
+		return nil
+	}
+
+	// SDK Model Name (key) to a map of SDK Field Name (key) to the value within the Example (value)
+	sdkValues := make(map[string]map[string]interface{})
+	collectExampleValuesForModel(*createOperation.RequestObject.ReferenceName, requestBody, apiResource.Models, sdkValues, 0)
+
+	output := make(map[string]cty.Value)
+	ambiguous := make(map[string]struct{})
+	for _, item := range details.Mappings.Fields {
+		mapping, ok := item.(sdkModels.TerraformDirectAssignmentFieldMappingDefinition)
+		if !ok {
+			continue
+		}
+		schemaField, ok := details.SchemaModels[mapping.DirectAssignment.TerraformSchemaModelName].Fields[mapping.DirectAssignment.TerraformSchemaFieldName]
+		if !ok {
+			continue
+		}
+		raw, ok := sdkValues[mapping.DirectAssignment.SDKModelName][mapping.DirectAssignment.SDKFieldName]
+		if !ok {
+			continue
+		}
+		value := ctyValueForExampleValue(schemaField.ObjectDefinition.Type, raw)
+		if value == nil {
+			continue
+		}
+
+		if _, isAmbiguous := ambiguous[schemaField.HCLName]; isAmbiguous {
+			continue
+		}
+		if existing, ok := output[schemaField.HCLName]; ok && !existing.RawEquals(*value) {
+			delete(output, schemaField.HCLName)
+			ambiguous[schemaField.HCLName] = struct{}{}
+			continue
+		}
+		output[schemaField.HCLName] = *value
+	}
+
+	return output
+}
+
+func collectExampleValuesForModel(modelName string, input map[string]interface{}, models map[string]sdkModels.SDKModel, output map[string]map[string]interface{}, depth int) {
+	model, ok := models[modelName]
+	if !ok || depth > maximumExampleValuesDepth {
+		return
+	}
+	if _, ok := output[modelName]; !ok {
+		output[modelName] = make(map[string]interface{})
+	}
+
+	for fieldName, field := range model.Fields {
+		value, ok := input[field.JsonName]
+		if !ok {
+			continue
+		}
+		if _, exists := output[modelName][fieldName]; !exists {
+			output[modelName][fieldName] = value
+		}
+
+		if field.ObjectDefinition.Type == sdkModels.ReferenceSDKObjectDefinitionType && field.ObjectDefinition.ReferenceName != nil {
+			if nested, ok := value.(map[string]interface{}); ok {
+				collectExampleValuesForModel(*field.ObjectDefinition.ReferenceName, nested, models, output, depth+1)
+			}
+		}
+	}
+}
+
+// ctyValueForExampleValue returns the cty.Value for the value within the Example, providing this matches
+// the type of the Schema Field - at this time only basic types are supported.
+func ctyValueForExampleValue(fieldType sdkModels.TerraformSchemaObjectDefinitionType, input interface{}) *cty.Value {
+	var output cty.Value
+	switch fieldType {
+	case sdkModels.BooleanTerraformSchemaObjectDefinitionType:
+		v, ok := input.(bool)
+		if !ok {
+			return nil
+		}
+		output = cty.BoolVal(v)
+
+	case sdkModels.FloatTerraformSchemaObjectDefinitionType:
+		v, ok := input.(float64)
+		if !ok {
+			return nil
+		}
+		output = cty.NumberFloatVal(v)
+
+	case sdkModels.IntegerTerraformSchemaObjectDefinitionType:
+		v, ok := input.(float64)
+		if !ok || v != math.Trunc(v) {
+			return nil
+		}
+		output = cty.NumberIntVal(int64(v))
+
+	case sdkModels.StringTerraformSchemaObjectDefinitionType:
+		v, ok := input.(string)
+		if !ok || v == "" {
+			return nil
+		}
+		output = cty.StringVal(v)
+
+	default:
+		return nil
+	}
+
+	return &output
+}
+
+// getExampleValueForField returns the value from the Example for this Schema Field, when one is available and
+// the value isn't otherwise determined (e.g. from the Test Data, or a reference to a dependency).
+func (tb testBuilder) getExampleValueForField(field sdkModels.TerraformSchemaField, testData definitions.VariablesDefinition) *hclwrite.Tokens {
+	value, ok := tb.exampleValues[field.HCLName]
+	if !ok {
+		return nil
+	}
+
+	// names are randomized to avoid conflicts, and IDs reference dependencies
+	if strings.EqualFold(field.HCLName, "name") || strings.HasSuffix(field.HCLName, "_id") {
+		return nil
+	}
+	if findTestDataValue(field.HCLName, testData.Strings) != nil || findTestDataValue(field.HCLName, testData.Bools) != nil || findTestDataValue(field.HCLName, testData.Integers) != nil {
+		return nil
+	}
+
+	out := hclwrite.TokensForValue(value)
+	return &out
+}
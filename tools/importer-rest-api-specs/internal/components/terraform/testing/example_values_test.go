@@ -0,0 +1,199 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testing
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	"github.com/hashicorp/pandora/tools/sdk/config/definitions"
+	"github.com/hashicorp/pandora/tools/sdk/testhelpers"
+)
+
+func TestExampleValuesForResource(t *testing.T) {
+	apiResource := sdkModels.APIResource{
+		Models: map[string]sdkModels.SDKModel{
+			"Thing": {
+				Fields: map[string]sdkModels.SDKField{
+					"Name": {
+						JsonName:         "name",
+						ObjectDefinition: sdkModels.SDKObjectDefinition{Type: sdkModels.StringSDKObjectDefinitionType},
+					},
+					"Properties": {
+						JsonName: "properties",
+						ObjectDefinition: sdkModels.SDKObjectDefinition{
+							ReferenceName: pointer.To("ThingProperties"),
+							Type:          sdkModels.ReferenceSDKObjectDefinitionType,
+						},
+					},
+				},
+			},
+			"ThingProperties": {
+				Fields: map[string]sdkModels.SDKField{
+					"Capacity": {
+						JsonName:         "capacity",
+						ObjectDefinition: sdkModels.SDKObjectDefinition{Type: sdkModels.IntegerSDKObjectDefinitionType},
+					},
+					"Enabled": {
+						JsonName:         "enabled",
+						ObjectDefinition: sdkModels.SDKObjectDefinition{Type: sdkModels.BooleanSDKObjectDefinitionType},
+					},
+					"SkuName": {
+						JsonName:         "skuName",
+						ObjectDefinition: sdkModels.SDKObjectDefinition{Type: sdkModels.StringSDKObjectDefinitionType},
+					},
+				},
+			},
+		},
+		Operations: map[string]sdkModels.SDKOperation{
+			"CreateOrUpdate": {
+				Examples: map[string]sdkModels.SDKOperationExample{
+					"Create a Thing": {
+						RequestBody: map[string]interface{}{
+							"name": "myThing",
+							"properties": map[string]interface{}{
+								// JSON numbers are unmarshaled as float64's
+								"capacity": float64(3),
+								"enabled":  true,
+								"skuName":  "Premium_LRS",
+							},
+						},
+					},
+				},
+				RequestObject: &sdkModels.SDKObjectDefinition{
+					ReferenceName: pointer.To("Thing"),
+					Type:          sdkModels.ReferenceSDKObjectDefinitionType,
+				},
+			},
+		},
+	}
+	details := sdkModels.TerraformResourceDefinition{
+		CreateMethod: sdkModels.TerraformMethodDefinition{
+			SDKOperationName: "CreateOrUpdate",
+		},
+		Mappings: sdkModels.TerraformMappingDefinition{
+			Fields: []sdkModels.TerraformFieldMappingDefinition{
+				directAssignmentMapping("ThingResource", "Name", "Thing", "Name"),
+				directAssignmentMapping("ThingResource", "Capacity", "ThingProperties", "Capacity"),
+				directAssignmentMapping("ThingResource", "Enabled", "ThingProperties", "Enabled"),
+				directAssignmentMapping("ThingResource", "SkuName", "ThingProperties", "SkuName"),
+				// mapping an Integer into a String Schema Field isn't supported
+				directAssignmentMapping("ThingResource", "Tier", "ThingProperties", "Capacity"),
+			},
+		},
+		SchemaModelName: "ThingResource",
+		SchemaModels: map[string]sdkModels.TerraformSchemaModel{
+			"ThingResource": {
+				Fields: map[string]sdkModels.TerraformSchemaField{
+					"Capacity": {
+						HCLName:          "capacity",
+						ObjectDefinition: sdkModels.TerraformSchemaObjectDefinition{Type: sdkModels.IntegerTerraformSchemaObjectDefinitionType},
+					},
+					"Enabled": {
+						HCLName:          "enabled",
+						ObjectDefinition: sdkModels.TerraformSchemaObjectDefinition{Type: sdkModels.BooleanTerraformSchemaObjectDefinitionType},
+					},
+					"Name": {
+						HCLName:          "name",
+						ObjectDefinition: sdkModels.TerraformSchemaObjectDefinition{Type: sdkModels.StringTerraformSchemaObjectDefinitionType},
+					},
+					"SkuName": {
+						HCLName:          "sku_name",
+						ObjectDefinition: sdkModels.TerraformSchemaObjectDefinition{Type: sdkModels.StringTerraformSchemaObjectDefinitionType},
+					},
+					"Tier": {
+						HCLName:          "tier",
+						ObjectDefinition: sdkModels.TerraformSchemaObjectDefinition{Type: sdkModels.StringTerraformSchemaObjectDefinitionType},
+					},
+				},
+			},
+		},
+	}
+
+	builder := newTestBuilder("example", "thing", details)
+	builder.exampleValues = exampleValuesForResource(apiResource, details)
+	if len(builder.exampleValues) != 4 {
+		t.Fatalf("expected 4 example values but got %d: %+v", len(builder.exampleValues), builder.exampleValues)
+	}
+
+	testData := []struct {
+		fieldName string
+		variables definitions.VariablesDefinition
+		expected  string
+	}{
+		{
+			fieldName: "Capacity",
+			expected:  `3`,
+		},
+		{
+			fieldName: "Enabled",
+			expected:  `true`,
+		},
+		{
+			fieldName: "SkuName",
+			expected:  `"Premium_LRS"`,
+		},
+		{
+			// the value from the Test Data takes precedence over the Example
+			fieldName: "SkuName",
+			variables: definitions.VariablesDefinition{
+				Strings: map[string]string{
+					"sku_name": "Standard_LRS",
+				},
+			},
+			expected: `"Standard_LRS"`,
+		},
+		{
+			// names are randomized to avoid conflicts
+			fieldName: "Name",
+			expected:  `"acctestt-${var.random_string}"`,
+		},
+		{
+			fieldName: "Tier",
+			expected:  `"val-${var.random_string}"`,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("Field %q", v.fieldName)
+		dependencies := testDependencies{}
+		actual, err := builder.getAttributeValueForField(details.SchemaModels["ThingResource"].Fields[v.fieldName], &dependencies, v.variables)
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		testhelpers.AssertTemplatedCodeMatches(t, v.expected, string(actual.Bytes()))
+	}
+}
+
+func TestExampleValuesForResourceWithoutExamples(t *testing.T) {
+	apiResource := sdkModels.APIResource{
+		Operations: map[string]sdkModels.SDKOperation{
+			"CreateOrUpdate": {
+				RequestObject: &sdkModels.SDKObjectDefinition{
+					ReferenceName: pointer.To("Thing"),
+					Type:          sdkModels.ReferenceSDKObjectDefinitionType,
+				},
+			},
+		},
+	}
+	details := sdkModels.TerraformResourceDefinition{
+		CreateMethod: sdkModels.TerraformMethodDefinition{
+			SDKOperationName: "CreateOrUpdate",
+		},
+	}
+	if actual := exampleValuesForResource(apiResource, details); actual != nil {
+		t.Fatalf("expected no example values but got %+v", actual)
+	}
+}
+
+func directAssignmentMapping(schemaModelName, schemaFieldName, sdkModelName, sdkFieldName string) sdkModels.TerraformDirectAssignmentFieldMappingDefinition {
+	return sdkModels.TerraformDirectAssignmentFieldMappingDefinition{
+		DirectAssignment: sdkModels.TerraformDirectAssignmentFieldMappingDefinitionImpl{
+			TerraformSchemaModelName: schemaModelName,
+			TerraformSchemaFieldName: schemaFieldName,
+			SDKModelName:             sdkModelName,
+			SDKFieldName:             sdkFieldName,
+		},
+	}
+}
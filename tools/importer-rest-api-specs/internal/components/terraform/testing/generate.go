@@ -6,8 +6,10 @@ package testing
 import (
 	"fmt"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
 	"github.com/hashicorp/pandora/tools/sdk/config/definitions"
+	"github.com/zclconf/go-cty/cty"
 )
 
 type testBuilder struct {
@@ -19,6 +21,17 @@ type testBuilder struct {
 
 	// details is the Terraform Resource Details for this Resource
 	details sdkModels.TerraformResourceDefinition
+
+	// exampleValues is a map of Schema Field HCL Name (key) to the value for this field taken from the
+	// Examples within the API Definitions (value), which are used in place of placeholder values
+	exampleValues map[string]cty.Value
+
+	// resourceIDsForFields is a map of the HCL Name of a field (key) to the Resource ID referenced by this field (value)
+	resourceIDsForFields map[string]string
+
+	// testDependencyCatalog is the Test Dependency Catalog used to determine the dependencies for the Tests,
+	// when nil the default Test Dependency Catalog is used
+	testDependencyCatalog *TestDependencyCatalog
 }
 
 func newTestBuilder(providerPrefix, resourceLabel string, details sdkModels.TerraformResourceDefinition) testBuilder {
@@ -32,7 +45,9 @@ func newTestBuilder(providerPrefix, resourceLabel string, details sdkModels.Terr
 // generateTestsForResource builds a TerraformResourceTestsDefinition for the specified Terraform Resource
 func (tb testBuilder) generateTestsForResource(testData definitions.ResourceTestDataDefinition) (*sdkModels.TerraformResourceTestsDefinition, error) {
 	dependencies := testDependencies{
-		variables: testVariables{},
+		variables:            testVariables{},
+		catalog:              tb.testDependencyCatalog,
+		resourceIDsForFields: tb.resourceIDsForFields,
 	}
 	basicConfig, err := tb.generateBasicTest(&dependencies, testData)
 	if err != nil {
@@ -48,16 +63,19 @@ func (tb testBuilder) generateTestsForResource(testData definitions.ResourceTest
 		return nil, fmt.Errorf("generating complete test: %+v", err)
 	}
 
-	templateConfig := tb.generateTemplateConfigForDependencies(dependencies)
+	templateConfig, err := tb.generateTemplateConfigForDependencies(dependencies)
+	if err != nil {
+		return nil, fmt.Errorf("generating the template for the dependencies: %+v", err)
+	}
 	variablesConfig := generateTemplateForLocalVariables(dependencies.variables)
-	templateConfig = fmt.Sprintf("%s\n%s", variablesConfig, templateConfig)
+	templateConfig = pointer.To(fmt.Sprintf("%s\n%s", variablesConfig, *templateConfig))
 
 	out := sdkModels.TerraformResourceTestsDefinition{
 		BasicConfiguration:          *basicConfig,
 		RequiresImportConfiguration: *requiresImportConfig,
 		Generate:                    true,
 		OtherTests:                  &map[string][]sdkModels.TerraformTestDefinition{},
-		TemplateConfiguration:       &templateConfig,
+		TemplateConfiguration:       templateConfig,
 		// TODO: we should split variables config out into it's own property too
 	}
 
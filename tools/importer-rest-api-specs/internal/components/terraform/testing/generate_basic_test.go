@@ -58,12 +58,6 @@ resource "example_resource" "test" {
 			needsRandomInteger:   false,
 			needsPrimaryLocation: false,
 		},
-		needsEdgeZone:             false,
-		needsPublicIP:             false,
-		needsResourceGroup:        false,
-		needsSubnet:               false,
-		needsUserAssignedIdentity: false,
-		needsVirtualNetwork:       false,
 	}
 	assertDependenciesMatch(t, expectedDependencies, actualDependencies)
 }
@@ -115,12 +109,6 @@ resource "example_resource" "test" {
 		variables: testVariables{
 			needsRandomString: true,
 		},
-		needsEdgeZone:             false,
-		needsPublicIP:             false,
-		needsResourceGroup:        false,
-		needsSubnet:               false,
-		needsUserAssignedIdentity: false,
-		needsVirtualNetwork:       false,
 	}
 	assertDependenciesMatch(t, expectedDependencies, actualDependencies)
 }
@@ -169,13 +157,7 @@ resource "example_resource" "test" {
 
 	assertTerraformConfigurationsAreSemanticallyTheSame(t, expected, *actual, hclContext)
 	expectedDependencies := testDependencies{
-		variables:                 testVariables{},
-		needsEdgeZone:             false,
-		needsPublicIP:             false,
-		needsResourceGroup:        false,
-		needsSubnet:               false,
-		needsUserAssignedIdentity: false,
-		needsVirtualNetwork:       false,
+		variables: testVariables{},
 	}
 	assertDependenciesMatch(t, expectedDependencies, actualDependencies)
 }
@@ -251,12 +233,7 @@ resource "example_resource" "test" {
 			needsRandomString:    true,
 			needsPrimaryLocation: true,
 		},
-		needsEdgeZone:             false,
-		needsPublicIP:             false,
-		needsResourceGroup:        true,
-		needsSubnet:               false,
-		needsUserAssignedIdentity: false,
-		needsVirtualNetwork:       false,
+		needs: []string{"resource_group"},
 	}
 	assertDependenciesMatch(t, expectedDependencies, actualDependencies)
 }
@@ -315,12 +292,6 @@ resource "example_resource" "test" {
 		variables: testVariables{
 			needsRandomString: true,
 		},
-		needsEdgeZone:             false,
-		needsPublicIP:             false,
-		needsResourceGroup:        false,
-		needsSubnet:               false,
-		needsUserAssignedIdentity: false,
-		needsVirtualNetwork:       false,
 	}
 	assertDependenciesMatch(t, expectedDependencies, actualDependencies)
 }
@@ -396,12 +367,6 @@ resource "example_resource" "test" {
 		variables: testVariables{
 			needsRandomString: true,
 		},
-		needsEdgeZone:             false,
-		needsPublicIP:             false,
-		needsResourceGroup:        false,
-		needsSubnet:               false,
-		needsUserAssignedIdentity: false,
-		needsVirtualNetwork:       false,
 	}
 	assertDependenciesMatch(t, expectedDependencies, actualDependencies)
 }
@@ -479,12 +444,6 @@ resource "example_resource" "test" {
 		variables: testVariables{
 			needsRandomString: true,
 		},
-		needsEdgeZone:             false,
-		needsPublicIP:             false,
-		needsResourceGroup:        false,
-		needsSubnet:               false,
-		needsUserAssignedIdentity: false,
-		needsVirtualNetwork:       false,
 	}
 	assertDependenciesMatch(t, expectedDependencies, actualDependencies)
 }
@@ -562,12 +521,6 @@ resource "example_resource" "test" {
 		variables: testVariables{
 			needsRandomString: true,
 		},
-		needsEdgeZone:             false,
-		needsPublicIP:             false,
-		needsResourceGroup:        false,
-		needsSubnet:               false,
-		needsUserAssignedIdentity: false,
-		needsVirtualNetwork:       false,
 	}
 	assertDependenciesMatch(t, expectedDependencies, actualDependencies)
 }
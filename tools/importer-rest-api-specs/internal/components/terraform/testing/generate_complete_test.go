@@ -93,12 +93,6 @@ resource "example_resource" "test" {
 		variables: testVariables{
 			needsRandomString: true,
 		},
-		needsEdgeZone:             false,
-		needsPublicIP:             false,
-		needsResourceGroup:        false,
-		needsSubnet:               false,
-		needsUserAssignedIdentity: false,
-		needsVirtualNetwork:       false,
 	}
 	assertDependenciesMatch(t, expectedDependencies, actualDependencies)
 }
@@ -146,13 +140,7 @@ resource "example_resource" "test" {
 
 	assertTerraformConfigurationsAreSemanticallyTheSame(t, expected, *actual, hclContext)
 	expectedDependencies := testDependencies{
-		variables:                 testVariables{},
-		needsEdgeZone:             false,
-		needsPublicIP:             false,
-		needsResourceGroup:        false,
-		needsSubnet:               false,
-		needsUserAssignedIdentity: false,
-		needsVirtualNetwork:       false,
+		variables: testVariables{},
 	}
 	assertDependenciesMatch(t, expectedDependencies, actualDependencies)
 }
@@ -201,13 +189,7 @@ resource "example_resource" "test" {
 
 	assertTerraformConfigurationsAreSemanticallyTheSame(t, expected, *actual, hclContext)
 	expectedDependencies := testDependencies{
-		variables:                 testVariables{},
-		needsEdgeZone:             false,
-		needsPublicIP:             false,
-		needsResourceGroup:        false,
-		needsSubnet:               false,
-		needsUserAssignedIdentity: false,
-		needsVirtualNetwork:       false,
+		variables: testVariables{},
 	}
 	assertDependenciesMatch(t, expectedDependencies, actualDependencies)
 }
@@ -283,12 +265,7 @@ resource "example_resource" "test" {
 			needsRandomString:    true,
 			needsPrimaryLocation: true,
 		},
-		needsEdgeZone:             false,
-		needsPublicIP:             false,
-		needsResourceGroup:        true,
-		needsSubnet:               false,
-		needsUserAssignedIdentity: false,
-		needsVirtualNetwork:       false,
+		needs: []string{"resource_group"},
 	}
 	assertDependenciesMatch(t, expectedDependencies, actualDependencies)
 }
@@ -350,12 +327,6 @@ resource "example_resource" "test" {
 		variables: testVariables{
 			needsRandomString: true,
 		},
-		needsEdgeZone:             false,
-		needsPublicIP:             false,
-		needsResourceGroup:        false,
-		needsSubnet:               false,
-		needsUserAssignedIdentity: false,
-		needsVirtualNetwork:       false,
 	}
 	assertDependenciesMatch(t, expectedDependencies, actualDependencies)
 }
@@ -431,12 +402,6 @@ resource "example_resource" "test" {
 		variables: testVariables{
 			needsRandomString: true,
 		},
-		needsEdgeZone:             false,
-		needsPublicIP:             false,
-		needsResourceGroup:        false,
-		needsSubnet:               false,
-		needsUserAssignedIdentity: false,
-		needsVirtualNetwork:       false,
 	}
 	assertDependenciesMatch(t, expectedDependencies, actualDependencies)
 }
@@ -514,12 +479,6 @@ resource "example_resource" "test" {
 		variables: testVariables{
 			needsRandomString: true,
 		},
-		needsEdgeZone:             false,
-		needsPublicIP:             false,
-		needsResourceGroup:        false,
-		needsSubnet:               false,
-		needsUserAssignedIdentity: false,
-		needsVirtualNetwork:       false,
 	}
 	assertDependenciesMatch(t, expectedDependencies, actualDependencies)
 }
@@ -600,12 +559,6 @@ resource "example_resource" "test" {
 		variables: testVariables{
 			needsRandomString: true,
 		},
-		needsEdgeZone:             false,
-		needsPublicIP:             false,
-		needsResourceGroup:        false,
-		needsSubnet:               false,
-		needsUserAssignedIdentity: false,
-		needsVirtualNetwork:       false,
 	}
 	assertDependenciesMatch(t, expectedDependencies, actualDependencies)
 }
@@ -686,12 +639,6 @@ resource "example_resource" "test" {
 		variables: testVariables{
 			needsRandomString: true,
 		},
-		needsEdgeZone:             false,
-		needsPublicIP:             false,
-		needsResourceGroup:        false,
-		needsSubnet:               false,
-		needsUserAssignedIdentity: false,
-		needsVirtualNetwork:       false,
 	}
 	assertDependenciesMatch(t, expectedDependencies, actualDependencies)
 }
@@ -17,24 +17,18 @@ func TestDependenciesTemplate_NoDependenciesShouldOutputNothing(t *testing.T) {
 		variables: testVariables{},
 	}
 	expected := ""
-	actual := builder.generateTemplateConfigForDependencies(dependencies)
-	testhelpers.AssertTemplatedCodeMatches(t, expected, actual)
+	actual, err := builder.generateTemplateConfigForDependencies(dependencies)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
 }
 
 func TestDependenciesTemplate_EverythingEnabled(t *testing.T) {
 	builder := newTestBuilder("example", "resource", sdkModels.TerraformResourceDefinition{})
 	dependencies := testDependencies{
 		variables: testVariables{},
-
-		needsClientConfig:         true,
-		needsDevCenter:            true,
-		needsEdgeZone:             true,
-		needsNetworkInterface:     true,
-		needsPublicIP:             true,
-		needsResourceGroup:        true,
-		needsSubnet:               true,
-		needsUserAssignedIdentity: true,
-		needsVirtualNetwork:       true,
+		needs:     []string{"client_config", "dev_center", "edge_zone", "network_interface", "public_ip", "resource_group", "subnet", "user_assigned_identity", "virtual_network"},
 	}
 	expected := `
 data "example_client_config" "test" {}
@@ -97,28 +91,33 @@ resource "example_virtual_network" "test" {
   address_space       = ["10.0.0.0/16"]
 }
 `
-	actual := builder.generateTemplateConfigForDependencies(dependencies)
-	testhelpers.AssertTemplatedCodeMatches(t, expected, actual)
+	actual, err := builder.generateTemplateConfigForDependencies(dependencies)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
 }
 
 func TestDependenciesTemplate_NeedsClientConfig(t *testing.T) {
 	builder := newTestBuilder("example", "resource", sdkModels.TerraformResourceDefinition{})
 	dependencies := testDependencies{
-		needsClientConfig: true,
+		needs: []string{"client_config"},
 	}
 	expected := `
 data "example_client_config" "test" {}
 `
-	actual := builder.generateTemplateConfigForDependencies(dependencies)
-	testhelpers.AssertTemplatedCodeMatches(t, expected, actual)
+	actual, err := builder.generateTemplateConfigForDependencies(dependencies)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
 }
 
 func TestDependenciesTemplate_NeedsDevCenter(t *testing.T) {
 	builder := newTestBuilder("example", "resource", sdkModels.TerraformResourceDefinition{})
 	dependencies := testDependencies{
 		variables: testVariables{},
-
-		needsDevCenter: true,
+		needs:     []string{"dev_center"},
 	}
 	expected := `
 resource "example_dev_center" "test" {
@@ -131,8 +130,11 @@ resource "example_dev_center" "test" {
   }
 }
 `
-	actual := builder.generateTemplateConfigForDependencies(dependencies)
-	testhelpers.AssertTemplatedCodeMatches(t, expected, actual)
+	actual, err := builder.generateTemplateConfigForDependencies(dependencies)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
 }
 
 func TestDependenciesTemplate_NeedsEdgeZone(t *testing.T) {
@@ -141,24 +143,25 @@ func TestDependenciesTemplate_NeedsEdgeZone(t *testing.T) {
 		variables: testVariables{
 			needsPrimaryLocation: true,
 		},
-
-		needsEdgeZone: true,
+		needs: []string{"edge_zone"},
 	}
 	expected := `
 data "example_extended_locations" "test" {
   location = var.primary_location
 }
 `
-	actual := builder.generateTemplateConfigForDependencies(dependencies)
-	testhelpers.AssertTemplatedCodeMatches(t, expected, actual)
+	actual, err := builder.generateTemplateConfigForDependencies(dependencies)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
 }
 
 func TestDependenciesTemplate_NeedsKeyVault(t *testing.T) {
 	builder := newTestBuilder("example", "resource", sdkModels.TerraformResourceDefinition{})
 	dependencies := testDependencies{
 		variables: testVariables{},
-
-		needsKeyVault: true,
+		needs:     []string{"key_vault"},
 	}
 	expected := `
 resource "example_key_vault" "test" {
@@ -197,16 +200,18 @@ resource "example_key_vault" "test" {
   }
 }
 `
-	actual := builder.generateTemplateConfigForDependencies(dependencies)
-	testhelpers.AssertTemplatedCodeMatches(t, expected, actual)
+	actual, err := builder.generateTemplateConfigForDependencies(dependencies)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
 }
 
 func TestDependenciesTemplate_NeedsKeyVaultKey(t *testing.T) {
 	builder := newTestBuilder("example", "resource", sdkModels.TerraformResourceDefinition{})
 	dependencies := testDependencies{
 		variables: testVariables{},
-
-		needsKeyVaultKey: true,
+		needs:     []string{"key_vault_key"},
 	}
 	expected := `
 resource "example_key_vault_key" "test" {
@@ -221,8 +226,11 @@ resource "example_key_vault_key" "test" {
   ]
 }
 `
-	actual := builder.generateTemplateConfigForDependencies(dependencies)
-	testhelpers.AssertTemplatedCodeMatches(t, expected, actual)
+	actual, err := builder.generateTemplateConfigForDependencies(dependencies)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
 }
 
 func TestDependenciesTemplate_NeedsNetworkInterface(t *testing.T) {
@@ -231,8 +239,7 @@ func TestDependenciesTemplate_NeedsNetworkInterface(t *testing.T) {
 		variables: testVariables{
 			needsPrimaryLocation: true,
 		},
-
-		needsNetworkInterface: true,
+		needs: []string{"network_interface"},
 	}
 	expected := `
 resource "example_network_interface" "test" {
@@ -247,16 +254,18 @@ resource "example_network_interface" "test" {
   }
 }
 `
-	actual := builder.generateTemplateConfigForDependencies(dependencies)
-	testhelpers.AssertTemplatedCodeMatches(t, expected, actual)
+	actual, err := builder.generateTemplateConfigForDependencies(dependencies)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
 }
 
 func TestDependenciesTemplate_NeedsPublicIP(t *testing.T) {
 	builder := newTestBuilder("example", "resource", sdkModels.TerraformResourceDefinition{})
 	dependencies := testDependencies{
 		variables: testVariables{},
-
-		needsPublicIP: true,
+		needs:     []string{"public_ip"},
 	}
 	expected := `
 resource "example_public_ip" "test" {
@@ -266,16 +275,18 @@ resource "example_public_ip" "test" {
   allocation_method   = "Static"
 }
 `
-	actual := builder.generateTemplateConfigForDependencies(dependencies)
-	testhelpers.AssertTemplatedCodeMatches(t, expected, actual)
+	actual, err := builder.generateTemplateConfigForDependencies(dependencies)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
 }
 
 func TestDependenciesTemplate_NeedsResourceGroup(t *testing.T) {
 	builder := newTestBuilder("example", "resource", sdkModels.TerraformResourceDefinition{})
 	dependencies := testDependencies{
 		variables: testVariables{},
-
-		needsResourceGroup: true,
+		needs:     []string{"resource_group"},
 	}
 	expected := `
 resource "example_resource_group" "test" {
@@ -283,16 +294,18 @@ resource "example_resource_group" "test" {
   location = var.primary_location
 }
 `
-	actual := builder.generateTemplateConfigForDependencies(dependencies)
-	testhelpers.AssertTemplatedCodeMatches(t, expected, actual)
+	actual, err := builder.generateTemplateConfigForDependencies(dependencies)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
 }
 
 func TestDependenciesTemplate_NeedsSubnet(t *testing.T) {
 	builder := newTestBuilder("example", "resource", sdkModels.TerraformResourceDefinition{})
 	dependencies := testDependencies{
 		variables: testVariables{},
-
-		needsSubnet: true,
+		needs:     []string{"subnet"},
 	}
 	expected := `
 resource "example_subnet" "test" {
@@ -302,16 +315,18 @@ resource "example_subnet" "test" {
   address_prefixes     = ["10.0.2.0/24"]
 }
 `
-	actual := builder.generateTemplateConfigForDependencies(dependencies)
-	testhelpers.AssertTemplatedCodeMatches(t, expected, actual)
+	actual, err := builder.generateTemplateConfigForDependencies(dependencies)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
 }
 
 func TestDependenciesTemplate_NeedsUserAssignedIdentity(t *testing.T) {
 	builder := newTestBuilder("example", "resource", sdkModels.TerraformResourceDefinition{})
 	dependencies := testDependencies{
 		variables: testVariables{},
-
-		needsUserAssignedIdentity: true,
+		needs:     []string{"user_assigned_identity"},
 	}
 	expected := `
 resource "example_user_assigned_identity" "test" {
@@ -320,16 +335,18 @@ resource "example_user_assigned_identity" "test" {
   location            = example_resource_group.test.location
 }
 `
-	actual := builder.generateTemplateConfigForDependencies(dependencies)
-	testhelpers.AssertTemplatedCodeMatches(t, expected, actual)
+	actual, err := builder.generateTemplateConfigForDependencies(dependencies)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
 }
 
 func TestDependenciesTemplate_NeedsVirtualNetwork(t *testing.T) {
 	builder := newTestBuilder("example", "resource", sdkModels.TerraformResourceDefinition{})
 	dependencies := testDependencies{
 		variables: testVariables{},
-
-		needsVirtualNetwork: true,
+		needs:     []string{"virtual_network"},
 	}
 	expected := `
 resource "example_virtual_network" "test" {
@@ -339,15 +356,18 @@ resource "example_virtual_network" "test" {
   address_space       = ["10.0.0.0/16"]
 }
 `
-	actual := builder.generateTemplateConfigForDependencies(dependencies)
-	testhelpers.AssertTemplatedCodeMatches(t, expected, actual)
+	actual, err := builder.generateTemplateConfigForDependencies(dependencies)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
 }
 
 func TestDependenciesTemplate_NeedsKubernetesFleetManager(t *testing.T) {
 	builder := newTestBuilder("example", "resource", sdkModels.TerraformResourceDefinition{})
 	dependencies := testDependencies{
-		variables:                   testVariables{},
-		needsKubernetesFleetManager: true,
+		variables: testVariables{},
+		needs:     []string{"kubernetes_fleet_manager"},
 	}
 	expected := `
 resource "example_kubernetes_fleet_manager" "test" {
@@ -356,6 +376,80 @@ resource "example_kubernetes_fleet_manager" "test" {
   resource_group_name = example_resource_group.test.name
 }
 `
-	actual := builder.generateTemplateConfigForDependencies(dependencies)
-	testhelpers.AssertTemplatedCodeMatches(t, expected, actual)
+	actual, err := builder.generateTemplateConfigForDependencies(dependencies)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
+}
+
+func TestDependenciesTemplate_NeedsKeyVaultAndKeyVaultAccessPolicy(t *testing.T) {
+	builder := newTestBuilder("example", "resource", sdkModels.TerraformResourceDefinition{})
+	dependencies := testDependencies{
+		variables: testVariables{},
+		needs:     []string{"key_vault", "key_vault_access_policy"},
+	}
+	expected := `
+resource "example_key_vault" "test" {
+  name                       = "acctest-${var.random_string}"
+  location                   = example_resource_group.test.location
+  resource_group_name        = example_resource_group.test.name
+  tenant_id                  = data.example_client_config.test.tenant_id
+  sku_name                   = "standard"
+  soft_delete_retention_days = 7
+}
+
+resource "example_key_vault_access_policy" "test" {
+  key_vault_id = example_key_vault.test.id
+  tenant_id    = data.example_client_config.test.tenant_id
+  object_id    = data.example_client_config.test.object_id
+
+  key_permissions = [
+    "Create",
+    "Get",
+    "Delete",
+    "Purge",
+    "GetRotationPolicy",
+  ]
+}
+`
+	actual, err := builder.generateTemplateConfigForDependencies(dependencies)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
+}
+
+func TestDependenciesTemplate_NeedsKubernetesCluster(t *testing.T) {
+	builder := newTestBuilder("example", "resource", sdkModels.TerraformResourceDefinition{})
+	dependencies := testDependencies{
+		variables: testVariables{},
+		needs:     []string{"kubernetes_cluster"},
+	}
+	expected := `
+resource "example_kubernetes_cluster" "test" {
+  name                = "acctestaks${var.random_string}"
+  location            = example_resource_group.test.location
+  resource_group_name = example_resource_group.test.name
+  dns_prefix          = "acctestaks${var.random_string}"
+
+  default_node_pool {
+    name       = "default"
+    node_count = 1
+    vm_size    = "Standard_DS2_v2"
+    upgrade_settings {
+      max_surge = "10%%"
+    }
+  }
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+`
+	actual, err := builder.generateTemplateConfigForDependencies(dependencies)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
 }
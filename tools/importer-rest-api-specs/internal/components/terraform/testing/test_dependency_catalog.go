@@ -0,0 +1,357 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testing
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/gohcl"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+)
+
+//go:embed dependencies/*.hcl
+var defaultTestDependencyCatalogFiles embed.FS
+
+// defaultTestDependencyCatalog is the Test Dependency Catalog shipped with the importer, which is used when
+// no other Test Dependency Catalog has been specified.
+var defaultTestDependencyCatalog = mustLoadDefaultTestDependencyCatalog()
+
+// TestDependencyCatalog is a catalog of the Terraform Resources and Data Sources which can be provisioned as
+// dependencies within the generated Acceptance Tests.
+type TestDependencyCatalog struct {
+	// dependencies is a map of the Test Dependency Name (key) to the Test Dependency (value).
+	dependencies map[string]testDependencyDefinition
+}
+
+type testDependencyDefinition struct {
+	// dependsOn is a list of the names of the other Test Dependencies which this Test Dependency requires.
+	dependsOn []string
+
+	// references is a list of the fields which can reference this Test Dependency.
+	references []testDependencyReference
+
+	// template is the HCL template for this Test Dependency.
+	template hcl.Expression
+
+	// variables is a list of the names of the Terraform Variables required by this Test Dependency.
+	variables []string
+
+	// variants is a map of the name of another Test Dependency (key) to an HCL template (value) which is
+	// used instead of `template` when that other Test Dependency is also needed.
+	variants map[string]hcl.Expression
+}
+
+type testDependencyReference struct {
+	// fieldNamePattern is a regular expression matching the HCL Name of the field which references this Test Dependency,
+	// when nil fields are only matched using resourceIDPattern.
+	fieldNamePattern *regexp.Regexp
+
+	// resourceIDPattern is a regular expression matching the Resource ID (e.g. `/subscriptions/{subscriptionId}/...`)
+	// referenced by the field, when nil fields are only matched using fieldNamePattern.
+	resourceIDPattern *regexp.Regexp
+
+	// value is the HCL template for the reference to this Test Dependency (e.g. `azurerm_subnet.test.id`).
+	value hcl.Expression
+}
+
+// these types are used to decode the Test Dependency Catalog files
+type testDependencyCatalogFile struct {
+	Dependencies []testDependencyCatalogFileDependency `hcl:"dependency,block"`
+}
+
+type testDependencyCatalogFileDependency struct {
+	Name       string                               `hcl:"name,label"`
+	DependsOn  []string                             `hcl:"depends_on,optional"`
+	References []testDependencyCatalogFileReference `hcl:"reference,block"`
+	Template   hcl.Expression                       `hcl:"template"`
+	Variables  []string                             `hcl:"variables,optional"`
+	Variants   []testDependencyCatalogFileVariant   `hcl:"variant,block"`
+}
+
+type testDependencyCatalogFileReference struct {
+	FieldNamePattern  *string        `hcl:"field_name_pattern,optional"`
+	ResourceIDPattern *string        `hcl:"resource_id_pattern,optional"`
+	Value             hcl.Expression `hcl:"value"`
+}
+
+type testDependencyCatalogFileVariant struct {
+	WhenNeeded string         `hcl:"when_needed,label"`
+	Template   hcl.Expression `hcl:"template"`
+}
+
+// LoadTestDependencyCatalog returns the default Test Dependency Catalog, combined with any Test Dependencies defined
+// within the `*.hcl` files in `directory` - where a Test Dependency with the same name replaces the default.
+func LoadTestDependencyCatalog(directory string) (*TestDependencyCatalog, error) {
+	output := TestDependencyCatalog{
+		dependencies: map[string]testDependencyDefinition{},
+	}
+	for name, dependency := range defaultTestDependencyCatalog.dependencies {
+		output.dependencies[name] = dependency
+	}
+
+	if err := output.loadFromFileSystem(os.DirFS(directory), "."); err != nil {
+		return nil, fmt.Errorf("loading the Test Dependency Catalog from %q: %+v", directory, err)
+	}
+	if err := output.validate(); err != nil {
+		return nil, fmt.Errorf("validating the Test Dependency Catalog: %+v", err)
+	}
+
+	return &output, nil
+}
+
+func mustLoadDefaultTestDependencyCatalog() *TestDependencyCatalog {
+	output := TestDependencyCatalog{
+		dependencies: map[string]testDependencyDefinition{},
+	}
+	if err := output.loadFromFileSystem(defaultTestDependencyCatalogFiles, "dependencies"); err != nil {
+		panic(fmt.Sprintf("loading the default Test Dependency Catalog: %+v", err))
+	}
+	if err := output.validate(); err != nil {
+		panic(fmt.Sprintf("validating the default Test Dependency Catalog: %+v", err))
+	}
+	return &output
+}
+
+func (c *TestDependencyCatalog) loadFromFileSystem(fileSystem fs.FS, directory string) error {
+	fileNames, err := fs.Glob(fileSystem, path.Join(directory, "*.hcl"))
+	if err != nil {
+		return fmt.Errorf("finding the Test Dependency files: %+v", err)
+	}
+	sort.Strings(fileNames)
+
+	// Test Dependencies can only be defined once within a given file system, but can replace those in the default catalog
+	definedIn := make(map[string]string)
+	for _, fileName := range fileNames {
+		contents, err := fs.ReadFile(fileSystem, fileName)
+		if err != nil {
+			return fmt.Errorf("reading %q: %+v", fileName, err)
+		}
+
+		file, diags := hclsyntax.ParseConfig(contents, filepath.Base(fileName), hcl.InitialPos)
+		if diags.HasErrors() {
+			return fmt.Errorf("parsing %q: %+v", fileName, diags.Error())
+		}
+		var decoded testDependencyCatalogFile
+		if diags := gohcl.DecodeBody(file.Body, nil, &decoded); diags.HasErrors() {
+			return fmt.Errorf("decoding %q: %+v", fileName, diags.Error())
+		}
+
+		for _, item := range decoded.Dependencies {
+			if existing, ok := definedIn[item.Name]; ok {
+				return fmt.Errorf("the Test Dependency %q is defined in both %q and %q", item.Name, existing, fileName)
+			}
+			definedIn[item.Name] = fileName
+
+			dependency, err := mapTestDependencyDefinition(item)
+			if err != nil {
+				return fmt.Errorf("mapping the Test Dependency %q defined in %q: %+v", item.Name, fileName, err)
+			}
+			c.dependencies[item.Name] = *dependency
+		}
+	}
+
+	return nil
+}
+
+func mapTestDependencyDefinition(input testDependencyCatalogFileDependency) (*testDependencyDefinition, error) {
+	output := testDependencyDefinition{
+		dependsOn:  input.DependsOn,
+		references: make([]testDependencyReference, 0),
+		template:   input.Template,
+		variables:  input.Variables,
+		variants:   map[string]hcl.Expression{},
+	}
+
+	for _, reference := range input.References {
+		if reference.FieldNamePattern == nil && reference.ResourceIDPattern == nil {
+			return nil, fmt.Errorf("a reference must specify either `field_name_pattern` or `resource_id_pattern`")
+		}
+
+		item := testDependencyReference{
+			value: reference.Value,
+		}
+		if reference.FieldNamePattern != nil {
+			pattern, err := regexp.Compile(*reference.FieldNamePattern)
+			if err != nil {
+				return nil, fmt.Errorf("compiling the field name pattern %q: %+v", *reference.FieldNamePattern, err)
+			}
+			item.fieldNamePattern = pattern
+		}
+		if reference.ResourceIDPattern != nil {
+			pattern, err := regexp.Compile(*reference.ResourceIDPattern)
+			if err != nil {
+				return nil, fmt.Errorf("compiling the Resource ID pattern %q: %+v", *reference.ResourceIDPattern, err)
+			}
+			item.resourceIDPattern = pattern
+		}
+		output.references = append(output.references, item)
+	}
+
+	for _, variant := range input.Variants {
+		if _, ok := output.variants[variant.WhenNeeded]; ok {
+			return nil, fmt.Errorf("the variant %q is defined more than once", variant.WhenNeeded)
+		}
+		output.variants[variant.WhenNeeded] = variant.Template
+	}
+
+	return &output, nil
+}
+
+// validate ensures that the Test Dependencies within this catalog reference valid Test Dependencies/Variables,
+// don't contain any circular dependencies - and that each of the templates can be rendered.
+func (c *TestDependencyCatalog) validate() error {
+	for _, name := range c.sortedNames() {
+		dependency := c.dependencies[name]
+
+		for _, dependsOn := range dependency.dependsOn {
+			if _, ok := c.dependencies[dependsOn]; !ok {
+				return fmt.Errorf("the Test Dependency %q depends on %q which doesn't exist", name, dependsOn)
+			}
+		}
+		for whenNeeded := range dependency.variants {
+			if _, ok := c.dependencies[whenNeeded]; !ok {
+				return fmt.Errorf("the Test Dependency %q defines a variant for %q which doesn't exist", name, whenNeeded)
+			}
+		}
+		for _, variable := range dependency.variables {
+			if _, ok := testVariableSetters[variable]; !ok {
+				return fmt.Errorf("the Test Dependency %q uses the unsupported Variable %q", name, variable)
+			}
+		}
+		if err := c.validateNoCircularDependencies(name, []string{}); err != nil {
+			return err
+		}
+
+		// render each of the templates to ensure these are valid
+		if _, err := renderTestDependencyTemplate(dependency.template, "example"); err != nil {
+			return fmt.Errorf("rendering the template for the Test Dependency %q: %+v", name, err)
+		}
+		for whenNeeded, template := range dependency.variants {
+			if _, err := renderTestDependencyTemplate(template, "example"); err != nil {
+				return fmt.Errorf("rendering the template for the Test Dependency %q (variant %q): %+v", name, whenNeeded, err)
+			}
+		}
+		for i, reference := range dependency.references {
+			if _, err := renderTestDependencyTemplate(reference.value, "example"); err != nil {
+				return fmt.Errorf("rendering the reference at index %d for the Test Dependency %q: %+v", i, name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (c *TestDependencyCatalog) validateNoCircularDependencies(name string, path []string) error {
+	for _, item := range path {
+		if item == name {
+			return fmt.Errorf("circular dependency found for the Test Dependency %q: %s", name, strings.Join(append(path, name), " -> "))
+		}
+	}
+
+	for _, dependsOn := range c.dependencies[name].dependsOn {
+		if err := c.validateNoCircularDependencies(dependsOn, append(path, name)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// referenceForField returns the name of the Test Dependency referenced by the field `hclName` (which references
+// the Resource ID `resourceID`, when known) and the reference expression for it - or nil if no Test Dependency is
+// referenced by this field.
+func (c *TestDependencyCatalog) referenceForField(hclName string, resourceID *string, providerPrefix string) (*string, *string, error) {
+	for _, name := range c.sortedNames() {
+		for _, reference := range c.dependencies[name].references {
+			if !reference.matches(hclName, resourceID) {
+				continue
+			}
+
+			value, err := renderTestDependencyTemplate(reference.value, providerPrefix)
+			if err != nil {
+				return nil, nil, fmt.Errorf("rendering the reference for the Test Dependency %q: %+v", name, err)
+			}
+			return &name, value, nil
+		}
+	}
+
+	return nil, nil, nil
+}
+
+// matches returns whether this reference matches either the field `hclName` or the Resource ID `resourceID`.
+func (r testDependencyReference) matches(hclName string, resourceID *string) bool {
+	if r.fieldNamePattern != nil && r.fieldNamePattern.MatchString(hclName) {
+		return true
+	}
+	return r.resourceIDPattern != nil && resourceID != nil && r.resourceIDPattern.MatchString(*resourceID)
+}
+
+// templateForDependency returns the rendered HCL template for the Test Dependency `name`, taking into account
+// the other Test Dependencies which are needed.
+func (c *TestDependencyCatalog) templateForDependency(name, providerPrefix string, dependencies testDependencies) (*string, error) {
+	dependency, ok := c.dependencies[name]
+	if !ok {
+		return nil, fmt.Errorf("the Test Dependency %q was not found", name)
+	}
+
+	template := dependency.template
+	whenNeeded := make([]string, 0)
+	for key := range dependency.variants {
+		whenNeeded = append(whenNeeded, key)
+	}
+	sort.Strings(whenNeeded)
+	for _, key := range whenNeeded {
+		if dependencies.isNeeded(key) {
+			template = dependency.variants[key]
+			break
+		}
+	}
+
+	return renderTestDependencyTemplate(template, providerPrefix)
+}
+
+func (c *TestDependencyCatalog) sortedNames() []string {
+	names := make([]string, 0)
+	for name := range c.dependencies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// renderTestDependencyTemplate renders the HCL template `input`, where `${provider_prefix}` is replaced with
+// the Provider Prefix and references to Terraform Variables (e.g. `${var.random_integer}`) are output as-is.
+func renderTestDependencyTemplate(input hcl.Expression, providerPrefix string) (*string, error) {
+	variables := make(map[string]cty.Value)
+	for name := range testVariableSetters {
+		variables[name] = cty.StringVal(fmt.Sprintf("${var.%s}", name))
+	}
+	ctx := &hcl.EvalContext{
+		Variables: map[string]cty.Value{
+			"provider_prefix": cty.StringVal(providerPrefix),
+			"var":             cty.ObjectVal(variables),
+		},
+	}
+
+	value, diags := input.Value(ctx)
+	if diags.HasErrors() {
+		return nil, fmt.Errorf("evaluating the template: %+v", diags.Error())
+	}
+	if value.IsNull() || !value.Type().Equals(cty.String) {
+		return nil, fmt.Errorf("expected the template to be a string but got %s", value.Type().FriendlyName())
+	}
+
+	output := value.AsString()
+	return &output, nil
+}
@@ -0,0 +1,254 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testing
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	"github.com/hashicorp/pandora/tools/sdk/testhelpers"
+)
+
+func TestTestDependencyCatalog_Default(t *testing.T) {
+	expected := []string{
+		"application_insights",
+		"client_config",
+		"dev_center",
+		"edge_zone",
+		"key_vault",
+		"key_vault_access_policy",
+		"key_vault_key",
+		"kubernetes_cluster",
+		"kubernetes_fleet_manager",
+		"machine_learning_workspace",
+		"network_interface",
+		"public_ip",
+		"resource_group",
+		"storage_account",
+		"subnet",
+		"user_assigned_identity",
+		"virtual_network",
+	}
+	actual := defaultTestDependencyCatalog.sortedNames()
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestTestDependencyCatalog_LoadFromDirectory(t *testing.T) {
+	directory := t.TempDir()
+	writeTestDependencyCatalogFile(t, directory, "example.hcl", `
+dependency "example_widget" {
+  depends_on = ["resource_group"]
+  variables  = ["random_string"]
+
+  reference {
+    field_name_pattern = "^(primary_)?widget_id$"
+    value              = "${provider_prefix}_widget.test.id"
+  }
+
+  template = <<EOT
+resource "${provider_prefix}_widget" "test" {
+  name                = "acctestw-${var.random_string}"
+  resource_group_name = ${provider_prefix}_resource_group.test.name
+}
+EOT
+}
+`)
+
+	catalog, err := LoadTestDependencyCatalog(directory)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if _, ok := catalog.dependencies["virtual_network"]; !ok {
+		t.Fatalf("expected the default Test Dependencies to be included")
+	}
+
+	dependencies := testDependencies{
+		catalog: catalog,
+	}
+	reference, _, err := DetermineDependencies("primary_widget_id", "example", &dependencies)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if *reference != "example_widget.test.id" {
+		t.Fatalf("expected the reference to be %q but got %q", "example_widget.test.id", *reference)
+	}
+	expectedDependencies := testDependencies{
+		catalog: catalog,
+		variables: testVariables{
+			needsPrimaryLocation: true,
+			needsRandomInteger:   true,
+			needsRandomString:    true,
+		},
+		needs: []string{"example_widget", "resource_group"},
+	}
+	assertDependenciesMatch(t, expectedDependencies, dependencies)
+
+	builder := newTestBuilder("example", "resource", sdkModels.TerraformResourceDefinition{})
+	expected := `
+resource "example_widget" "test" {
+  name                = "acctestw-${var.random_string}"
+  resource_group_name = example_resource_group.test.name
+}
+
+resource "example_resource_group" "test" {
+  name     = "acctestrg-${var.random_integer}"
+  location = var.primary_location
+}
+`
+	actual, err := builder.generateTemplateConfigForDependencies(dependencies)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
+}
+
+func TestTestDependencyCatalog_LoadFromDirectoryOverridesDefault(t *testing.T) {
+	directory := t.TempDir()
+	writeTestDependencyCatalogFile(t, directory, "resource_group.hcl", `
+dependency "resource_group" {
+  variables = ["primary_location"]
+
+  template = <<EOT
+resource "${provider_prefix}_resource_group" "test" {
+  name     = "custom-rg"
+  location = var.primary_location
+}
+EOT
+}
+`)
+
+	catalog, err := LoadTestDependencyCatalog(directory)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	actual, err := catalog.templateForDependency("resource_group", "example", testDependencies{})
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	expected := `
+resource "example_resource_group" "test" {
+  name     = "custom-rg"
+  location = var.primary_location
+}
+`
+	testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
+}
+
+func TestTestDependencyCatalog_ResourceIDPattern(t *testing.T) {
+	directory := t.TempDir()
+	writeTestDependencyCatalogFile(t, directory, "example.hcl", `
+dependency "example_widget" {
+  depends_on = ["resource_group"]
+
+  reference {
+    resource_id_pattern = "(?i)^/subscriptions/\\{[^}]+\\}/resourceGroups/\\{[^}]+\\}/providers/Example.Widgets/widgets/\\{[^}]+\\}$"
+    value               = "${provider_prefix}_widget.test.id"
+  }
+
+  template = "resource \"${provider_prefix}_widget\" \"test\" {}"
+}
+`)
+
+	catalog, err := LoadTestDependencyCatalog(directory)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	dependencies := testDependencies{
+		catalog: catalog,
+		resourceIDsForFields: map[string]string{
+			"parent_id": "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Example.Widgets/widgets/{widgetName}",
+		},
+	}
+	reference, _, err := DetermineDependencies("parent_id", "example", &dependencies)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if *reference != "example_widget.test.id" {
+		t.Fatalf("expected the reference to be %q but got %q", "example_widget.test.id", *reference)
+	}
+	if !dependencies.isNeeded("example_widget") {
+		t.Fatalf("expected the Test Dependency %q to be needed", "example_widget")
+	}
+
+	// a field which doesn't reference a (matching) Resource ID isn't matched
+	if _, _, err := DetermineDependencies("other_parent_id", "example", &dependencies); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+}
+
+func TestTestDependencyCatalog_ReferenceWithoutPattern(t *testing.T) {
+	directory := t.TempDir()
+	writeTestDependencyCatalogFile(t, directory, "example.hcl", `
+dependency "example_widget" {
+  reference {
+    value = "${provider_prefix}_widget.test.id"
+  }
+
+  template = "resource \"${provider_prefix}_widget\" \"test\" {}"
+}
+`)
+
+	if _, err := LoadTestDependencyCatalog(directory); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+}
+
+func TestTestDependencyCatalog_UnknownDependsOn(t *testing.T) {
+	directory := t.TempDir()
+	writeTestDependencyCatalogFile(t, directory, "example.hcl", `
+dependency "example_widget" {
+  depends_on = ["does_not_exist"]
+  template   = "resource \"${provider_prefix}_widget\" \"test\" {}"
+}
+`)
+
+	if _, err := LoadTestDependencyCatalog(directory); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+}
+
+func TestTestDependencyCatalog_UnknownVariable(t *testing.T) {
+	directory := t.TempDir()
+	writeTestDependencyCatalogFile(t, directory, "example.hcl", `
+dependency "example_widget" {
+  variables = ["random_password"]
+  template  = "resource \"${provider_prefix}_widget\" \"test\" {}"
+}
+`)
+
+	if _, err := LoadTestDependencyCatalog(directory); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+}
+
+func TestTestDependencyCatalog_CircularDependency(t *testing.T) {
+	directory := t.TempDir()
+	writeTestDependencyCatalogFile(t, directory, "example.hcl", `
+dependency "first" {
+  depends_on = ["second"]
+  template   = "resource \"${provider_prefix}_first\" \"test\" {}"
+}
+
+dependency "second" {
+  depends_on = ["first"]
+  template   = "resource \"${provider_prefix}_second\" \"test\" {}"
+}
+`)
+
+	if _, err := LoadTestDependencyCatalog(directory); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+}
+
+func writeTestDependencyCatalogFile(t *testing.T, directory, fileName, contents string) {
+	if err := os.WriteFile(filepath.Join(directory, fileName), []byte(contents), 0644); err != nil {
+		t.Fatalf("writing %q: %+v", fileName, err)
+	}
+}
@@ -8,3 +8,17 @@ type testVariables struct {
 	needsRandomString    bool
 	needsPrimaryLocation bool
 }
+
+// testVariableSetters is a map of the name of the Terraform Variable (key) to a function which marks that
+// Terraform Variable as needed (value), used for the Variables referenced by the Test Dependency Catalog.
+var testVariableSetters = map[string]func(variables *testVariables){
+	"primary_location": func(variables *testVariables) {
+		variables.needsPrimaryLocation = true
+	},
+	"random_integer": func(variables *testVariables) {
+		variables.needsRandomInteger = true
+	},
+	"random_string": func(variables *testVariables) {
+		variables.needsRandomString = true
+	},
+}
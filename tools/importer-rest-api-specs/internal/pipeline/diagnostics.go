@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pipeline
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"text/tabwriter"
+
+	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/apidefinitions/parser/diagnostics"
+	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/logging"
+)
+
+// diagnosticsSummary records the number of Diagnostics of each Code which were raised for each Service.
+type diagnosticsSummary struct {
+	serviceNamesToCodeCounts map[string]map[diagnostics.Code]int
+	lock                     sync.Mutex
+}
+
+func newDiagnosticsSummary() *diagnosticsSummary {
+	return &diagnosticsSummary{
+		serviceNamesToCodeCounts: make(map[string]map[diagnostics.Code]int),
+	}
+}
+
+// recordDiagnostics records the Diagnostics raised for the specified Service within the summary - and when a
+// Diagnostics Directory has been configured, writes these to a (JSON) file for the Service within it.
+func (p *Pipeline) recordDiagnostics(serviceName string, collector *diagnostics.Collector, summary *diagnosticsSummary) error {
+	items := collector.Diagnostics()
+	summary.add(serviceName, items)
+
+	if p.opts.DiagnosticsDirectory == nil {
+		return nil
+	}
+
+	if err := os.MkdirAll(*p.opts.DiagnosticsDirectory, os.ModePerm); err != nil {
+		return fmt.Errorf("creating the Diagnostics Directory %q: %+v", *p.opts.DiagnosticsDirectory, err)
+	}
+	data, err := json.MarshalIndent(items, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshaling the Diagnostics for Service %q: %+v", serviceName, err)
+	}
+	filePath := filepath.Join(*p.opts.DiagnosticsDirectory, fmt.Sprintf("%s.json", serviceName))
+	if err := os.WriteFile(filePath, data, 0644); err != nil {
+		return fmt.Errorf("writing the Diagnostics for Service %q to %q: %+v", serviceName, filePath, err)
+	}
+	return nil
+}
+
+func (s *diagnosticsSummary) add(serviceName string, items []diagnostics.Diagnostic) {
+	counts := make(map[diagnostics.Code]int)
+	for _, item := range items {
+		counts[item.Code]++
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.serviceNamesToCodeCounts[serviceName] = counts
+}
+
+// log outputs a table containing the number of Diagnostics of each Code raised for each Service.
+func (s *diagnosticsSummary) log() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	serviceNames := make([]string, 0)
+	for serviceName := range s.serviceNamesToCodeCounts {
+		serviceNames = append(serviceNames, serviceName)
+	}
+	sort.Strings(serviceNames)
+
+	codes := diagnostics.PossibleValuesForCode()
+	header := []string{"Service"}
+	for _, code := range codes {
+		header = append(header, string(code))
+	}
+	header = append(header, "Total")
+
+	var builder strings.Builder
+	writer := tabwriter.NewWriter(&builder, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, strings.Join(header, "\t"))
+
+	totals := make(map[diagnostics.Code]int)
+	for _, serviceName := range serviceNames {
+		row := []string{serviceName}
+		total := 0
+		for _, code := range codes {
+			count := s.serviceNamesToCodeCounts[serviceName][code]
+			row = append(row, fmt.Sprintf("%d", count))
+			totals[code] += count
+			total += count
+		}
+		row = append(row, fmt.Sprintf("%d", total))
+		fmt.Fprintln(writer, strings.Join(row, "\t"))
+	}
+
+	row := []string{"Total"}
+	total := 0
+	for _, code := range codes {
+		row = append(row, fmt.Sprintf("%d", totals[code]))
+		total += totals[code]
+	}
+	row = append(row, fmt.Sprintf("%d", total))
+	fmt.Fprintln(writer, strings.Join(row, "\t"))
+	writer.Flush()
+
+	logging.Infof("Diagnostics Summary:")
+	for _, line := range strings.Split(strings.TrimSuffix(builder.String(), "\n"), "\n") {
+		logging.Infof("%s", line)
+	}
+}
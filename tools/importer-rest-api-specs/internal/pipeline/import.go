@@ -5,10 +5,16 @@ package pipeline
 
 import (
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/hashicorp/pandora/tools/data-api-repository/repository"
+	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/apidefinitions/parser/dataworkarounds"
+	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/apidefinitions/parser/diagnostics"
 	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/terraform"
 	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/logging"
+	"github.com/hashicorp/pandora/tools/sdk/config/services"
 )
 
 func RunImporter(opts Options) error {
@@ -46,7 +52,7 @@ func RunImporter(opts Options) error {
 	}
 	logging.Debugf("Completed - Clearing any existing API Definitions.")
 
-	logging.Infof("Processing %d Services..", len(p.servicesFromConfigurationFiles))
+	servicesToProcess := make([]services.Service, 0)
 	for _, service := range p.servicesFromConfigurationFiles {
 		if len(opts.ServiceNamesToLimitTo) > 0 {
 			processThisService := false
@@ -61,40 +67,137 @@ func RunImporter(opts Options) error {
 				continue
 			}
 		}
+		servicesToProcess = append(servicesToProcess, service)
+	}
 
-		logging.Infof("Discovering the Data for Service %q..", service.Name)
-		data, err := p.parseDataForService(service)
-		if err != nil {
-			return fmt.Errorf("parsing Data for the Service %q: %+v", service.Name, err)
-		}
-		logging.Debugf("Completed - Discovering the Data for Service %q.", service.Name)
-
-		terraformDetails, ok := p.servicesToTerraformDetails[service.Name]
-		if ok {
-			logging.Infof("Building the Terraform Data for the Service %q..", service.Name)
-			data, err = terraform.BuildForService(*data, terraformDetails.resourceLabelToResourceDefinitions, p.opts.ProviderPrefix, terraformDetails.terraformPackageName)
-			if err != nil {
-				return fmt.Errorf("building the Terraform Data for Service %q: %+v", service.Name, err)
+	logging.Infof("Processing %d Services (with a parallelism of %d)..", len(servicesToProcess), opts.Parallelism)
+	pool := newWorkerPool(opts.Parallelism)
+	summary := newDiagnosticsSummary()
+	report := &importReport{
+		ContinueOnError: opts.ContinueOnError,
+		SourceCommitSHA: restAPISpecsCommitSHA,
+		Services:        make([]serviceImportReport, 0),
+	}
+
+	// NOTE: each Service is processed in its own goroutine, however the units of work within it (discovering the
+	// Service, parsing each API Version and then building/saving the Service) are bounded by the worker pool.
+	var wg sync.WaitGroup
+	for _, service := range servicesToProcess {
+		wg.Add(1)
+		go func(service services.Service) {
+			defer wg.Done()
+			started := time.Now()
+
+			collector := diagnostics.NewCollector()
+			ignored, workaroundsApplied, err := p.importService(service, restAPISpecsCommitSHA, pool, collector)
+			if ignored {
+				report.addIgnored(service.Name, started)
+				return
+			}
+			if diagnosticsErr := p.recordDiagnostics(service.Name, collector, summary); diagnosticsErr != nil && err == nil {
+				err = diagnosticsErr
 			}
-			logging.Debugf("Completed - Building the Terraform Data for the Service %q.", service.Name)
-		} else {
-			logging.Debugf("Skipping - no Terraform Definitions for the Service %q..", service.Name)
+			if err != nil && err != errImportCancelled {
+				logging.Errorf("Importing the Service %q: %+v", service.Name, err)
+				if !opts.ContinueOnError {
+					pool.cancel()
+				}
+			}
+			report.add(service.Name, started, workaroundsApplied, err)
+		}(service)
+	}
+	wg.Wait()
+
+	if opts.ReportFilePath != nil {
+		logging.Infof("Writing the Import Report to %q..", *opts.ReportFilePath)
+		if err := report.writeTo(*opts.ReportFilePath); err != nil {
+			return fmt.Errorf("writing the Import Report: %+v", err)
+		}
+	}
+
+	summary.log()
+
+	if opts.DetectStaleWorkarounds {
+		logStaleWorkarounds(report.staleWorkarounds())
+	}
+
+	if failures := report.failures(); len(failures) > 0 {
+		if !opts.ContinueOnError {
+			return fmt.Errorf("importing the Service %q: %s", failures[0].Name, *failures[0].Error)
 		}
 
-		logging.Infof("Writing Data for Service %q..", service.Name)
-		saveServiceOpts := repository.SaveServiceOptions{
-			ResourceProvider: service.ResourceProvider,
-			Service:          *data,
+		for _, failure := range failures {
+			logging.Warnf("The Service %q failed to be imported, the existing API Definitions have been retained: %s", failure.Name, *failure.Error)
+		}
+	}
+
+	logging.Infof("Completed - Importing Data.")
+	return nil
+}
+
+// importService parses the Data for the specified Service, builds any Terraform Data and then saves this into the
+// repository - returning whether the Service was ignored and the Data Workarounds applied to each API Version.
+// Any Diagnostics raised whilst parsing the Service are recorded in the Diagnostics Collector.
+func (p *Pipeline) importService(service services.Service, restAPISpecsCommitSHA *string, pool *workerPool, collector *diagnostics.Collector) (bool, map[string][]dataworkarounds.AppliedWorkaround, error) {
+	logging.Infof("Discovering the Data for Service %q..", service.Name)
+	data, workaroundsApplied, err := p.parseDataForService(service, pool, collector)
+	if err != nil {
+		if err == errImportCancelled {
+			return false, nil, err
+		}
+		return false, nil, fmt.Errorf("parsing Data for the Service %q: %+v", service.Name, err)
+	}
+	if data == nil {
+		return true, nil, nil
+	}
+	logging.Debugf("Completed - Discovering the Data for Service %q.", service.Name)
+
+	started := pool.run(func() {
+		err = p.buildAndSaveService(service, *data, restAPISpecsCommitSHA)
+	})
+	if !started {
+		return false, workaroundsApplied, errImportCancelled
+	}
+	return false, workaroundsApplied, err
+}
+
+func (p *Pipeline) buildAndSaveService(service services.Service, data sdkModels.Service, restAPISpecsCommitSHA *string) error {
+	terraformDetails, ok := p.servicesToTerraformDetails[service.Name]
+	if ok {
+		logging.Infof("Building the Terraform Data for the Service %q..", service.Name)
+		updated, err := terraform.BuildForService(data, terraformDetails.resourceLabelToResourceDefinitions, p.opts.ProviderPrefix, terraformDetails.terraformPackageName, terraformDetails.terraformFramework, p.testDependencyCatalog)
+		if err != nil {
+			return fmt.Errorf("building the Terraform Data for Service %q: %+v", service.Name, err)
+		}
+		data = *updated
+		logging.Debugf("Completed - Building the Terraform Data for the Service %q.", service.Name)
+	} else {
+		logging.Debugf("Skipping - no Terraform Definitions for the Service %q..", service.Name)
+	}
+
+	if p.opts.ContinueOnError {
+		// the existing Data for this Service is retained until the Service has been successfully processed
+		logging.Debugf("Removing the existing Data for Service %q..", service.Name)
+		removeServiceOpts := repository.RemoveServiceOptions{
 			ServiceName:      service.Name,
-			SourceCommitSHA:  restAPISpecsCommitSHA,
 			SourceDataOrigin: p.opts.SourceDataOrigin,
 		}
-		if err := p.repository.SaveService(saveServiceOpts); err != nil {
-			return fmt.Errorf("saving the Service %q: %+v", service.Name, err)
+		if err := p.repository.RemoveService(removeServiceOpts); err != nil {
+			return fmt.Errorf("removing the existing Data for Service %q: %+v", service.Name, err)
 		}
-		logging.Debugf("Completed - writing Data for Service %q.", service.Name)
 	}
 
-	logging.Infof("Completed - Importing Data.")
+	logging.Infof("Writing Data for Service %q..", service.Name)
+	saveServiceOpts := repository.SaveServiceOptions{
+		ResourceProvider: service.ResourceProvider,
+		Service:          data,
+		ServiceName:      service.Name,
+		SourceCommitSHA:  restAPISpecsCommitSHA,
+		SourceDataOrigin: p.opts.SourceDataOrigin,
+	}
+	if err := p.repository.SaveService(saveServiceOpts); err != nil {
+		return fmt.Errorf("saving the Service %q: %+v", service.Name, err)
+	}
+	logging.Debugf("Completed - writing Data for Service %q.", service.Name)
 	return nil
 }
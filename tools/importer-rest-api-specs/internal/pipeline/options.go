@@ -9,10 +9,35 @@ type Options struct {
 	// TODO: docs etc
 	APIDefinitionsDirectory       string
 	ConfigFilePath                string
+	DataWorkaroundsDirectory      string
 	ProviderPrefix                string
 	RestAPISpecsDirectory         string
 	ServiceNamesToLimitTo         []string
 	SourceDataOrigin              models.SourceDataOrigin
 	SourceDataType                models.SourceDataType
 	TerraformDefinitionsDirectory string
+
+	// ContinueOnError specifies whether the import should continue when a Service fails to be imported, in
+	// which case the existing API Definitions for that Service are retained.
+	ContinueOnError bool
+
+	// DiagnosticsDirectory is an optional path to a directory where a (JSON) file containing the Diagnostics
+	// raised whilst parsing each Service should be written.
+	DiagnosticsDirectory *string
+
+	// DetectStaleWorkarounds specifies whether each Data Workaround should be checked to determine whether it
+	// made any changes to the API Version it was applied to, so that stale Data Workarounds can be reported.
+	DetectStaleWorkarounds bool
+
+	// Parallelism specifies the maximum number of units of work (for example parsing an API Version, or
+	// building and saving a Service) which can run concurrently across all of the Services being imported.
+	Parallelism int
+
+	// ReportFilePath is an optional path to a file where a (JSON) report detailing the outcome of importing
+	// each Service should be written.
+	ReportFilePath *string
+
+	// TestDependencyCatalogDirectory is an optional path to a directory containing additional Test Dependencies
+	// used when generating the Terraform Acceptance Tests, in addition to the default Test Dependency Catalog.
+	TestDependencyCatalogDirectory *string
 }
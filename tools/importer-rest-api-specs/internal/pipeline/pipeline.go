@@ -5,6 +5,7 @@ package pipeline
 
 import (
 	"github.com/hashicorp/pandora/tools/data-api-repository/repository"
+	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/terraform/testing"
 	"github.com/hashicorp/pandora/tools/sdk/config/definitions"
 	"github.com/hashicorp/pandora/tools/sdk/config/services"
 )
@@ -14,9 +15,11 @@ type Pipeline struct {
 	repository                     repository.Repository
 	servicesFromConfigurationFiles []services.Service
 	servicesToTerraformDetails     map[string]terraformDetailsForService
+	testDependencyCatalog          *testing.TestDependencyCatalog
 }
 
 type terraformDetailsForService struct {
 	resourceLabelToResourceDefinitions map[string]definitions.ResourceDefinition
 	terraformPackageName               *string
+	terraformFramework                 definitions.TerraformFramework
 }
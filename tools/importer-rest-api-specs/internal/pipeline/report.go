@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pipeline
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/apidefinitions/parser/dataworkarounds"
+)
+
+type serviceImportOutcome string
+
+const (
+	// cancelledServiceImportOutcome specifies that the Service wasn't imported since another Service failed.
+	cancelledServiceImportOutcome serviceImportOutcome = "Cancelled"
+
+	// failedServiceImportOutcome specifies that the Service failed to be imported.
+	failedServiceImportOutcome serviceImportOutcome = "Failed"
+
+	// ignoredServiceImportOutcome specifies that the Service is configured to be ignored.
+	ignoredServiceImportOutcome serviceImportOutcome = "Ignored"
+
+	// importedServiceImportOutcome specifies that the Service was imported successfully.
+	importedServiceImportOutcome serviceImportOutcome = "Imported"
+)
+
+// importReport is a machine-readable summary of the outcome of importing each Service.
+type importReport struct {
+	// SourceCommitSHA is the Git Commit SHA of the Rest API Specs repository which was imported.
+	SourceCommitSHA *string `json:"sourceCommitSha,omitempty"`
+
+	// ContinueOnError specifies whether the import continued when a Service failed to be imported, in which
+	// case the existing API Definitions for the failed Service(s) have been retained.
+	ContinueOnError bool `json:"continueOnError"`
+
+	// Services contains the outcome for each Service, ordered by name.
+	Services []serviceImportReport `json:"services"`
+
+	lock sync.Mutex
+}
+
+type serviceImportReport struct {
+	// Name is the name of the Service.
+	Name string `json:"name"`
+
+	// Outcome specifies the outcome of importing this Service.
+	Outcome serviceImportOutcome `json:"outcome"`
+
+	// DurationInSeconds is how long it took to process this Service, including any time spent waiting for
+	// capacity within the worker pool.
+	DurationInSeconds float64 `json:"durationInSeconds"`
+
+	// Error contains the error which occurred when importing this Service, if any.
+	Error *string `json:"error,omitempty"`
+
+	// WorkaroundsApplied is a map of API Version (key) to the names of the Data Workarounds which were
+	// applied to that API Version (value).
+	WorkaroundsApplied map[string][]string `json:"workaroundsApplied,omitempty"`
+
+	// StaleWorkarounds contains the Data Workarounds which made no changes to the API Version they were
+	// applied to - which is only populated when detecting stale Data Workarounds.
+	StaleWorkarounds []staleWorkaround `json:"staleWorkarounds,omitempty"`
+}
+
+// add records the outcome of importing a Service within the report.
+func (r *importReport) add(serviceName string, started time.Time, workaroundsApplied map[string][]dataworkarounds.AppliedWorkaround, err error) {
+	item := serviceImportReport{
+		Name:               serviceName,
+		Outcome:            importedServiceImportOutcome,
+		DurationInSeconds:  time.Since(started).Seconds(),
+		WorkaroundsApplied: workaroundNamesFromAppliedWorkarounds(workaroundsApplied),
+	}
+	if stale := staleWorkaroundsFromAppliedWorkarounds(workaroundsApplied); len(stale) > 0 {
+		item.StaleWorkarounds = stale
+	}
+	if err == errImportCancelled {
+		item.Outcome = cancelledServiceImportOutcome
+	} else if err != nil {
+		item.Outcome = failedServiceImportOutcome
+		item.Error = pointer.To(err.Error())
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.Services = append(r.Services, item)
+}
+
+// addIgnored records that the Service was ignored within the report.
+func (r *importReport) addIgnored(serviceName string, started time.Time) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.Services = append(r.Services, serviceImportReport{
+		Name:              serviceName,
+		Outcome:           ignoredServiceImportOutcome,
+		DurationInSeconds: time.Since(started).Seconds(),
+	})
+}
+
+// staleWorkarounds returns a map of Service Name (key) to the stale Data Workarounds found for that Service (value).
+func (r *importReport) staleWorkarounds() map[string][]staleWorkaround {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	output := make(map[string][]staleWorkaround)
+	for _, item := range r.Services {
+		if len(item.StaleWorkarounds) > 0 {
+			output[item.Name] = item.StaleWorkarounds
+		}
+	}
+	return output
+}
+
+// failures returns the Services which failed to be imported, ordered by name.
+func (r *importReport) failures() []serviceImportReport {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	output := make([]serviceImportReport, 0)
+	for _, item := range r.Services {
+		if item.Outcome == failedServiceImportOutcome {
+			output = append(output, item)
+		}
+	}
+	sort.Slice(output, func(i, j int) bool {
+		return output[i].Name < output[j].Name
+	})
+	return output
+}
+
+// writeTo writes the report as JSON to the specified file path.
+func (r *importReport) writeTo(filePath string) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	sort.Slice(r.Services, func(i, j int) bool {
+		return r.Services[i].Name < r.Services[j].Name
+	})
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshaling the report: %+v", err)
+	}
+	if err := os.WriteFile(filePath, data, 0644); err != nil {
+		return fmt.Errorf("writing the report to %q: %+v", filePath, err)
+	}
+	return nil
+}
@@ -20,6 +20,14 @@ func (p *Pipeline) clearExistingAPIDefinitions() error {
 		return fmt.Errorf("purging any existing Common Types for for the Source Data Type %q / Source Data Origin %q: %+v", p.opts.SourceDataType, p.opts.SourceDataOrigin, err)
 	}
 
+	if p.opts.ContinueOnError {
+		// the existing Data for each Service is instead removed once that Service has been successfully processed
+		// so that the existing API Definitions are retained for any Service which fails to be imported.
+		// NOTE: as such Services which have been removed from the Configuration are retained in this mode.
+		logging.Infof("Retaining the existing Source Data until each Service has been processed..")
+		return nil
+	}
+
 	if len(p.opts.ServiceNamesToLimitTo) == 0 {
 		logging.Infof("Purging all existing Source Data for Source Data Type %q / Source Data Origin %q..", p.opts.SourceDataType, p.opts.SourceDataOrigin)
 		if err := p.repository.PurgeExistingData(p.opts.SourceDataOrigin); err != nil {
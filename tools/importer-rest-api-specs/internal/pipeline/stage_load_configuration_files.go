@@ -7,6 +7,9 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/apidefinitions/parser/dataworkarounds"
+	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/terraform/testing"
 	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/logging"
 	"github.com/hashicorp/pandora/tools/sdk/config/definitions"
 	"github.com/hashicorp/pandora/tools/sdk/config/services"
@@ -21,6 +24,34 @@ func (p *Pipeline) loadConfigurationFiles() error {
 	p.servicesFromConfigurationFiles = servicesFromConfigurationFile.Services
 	logging.Debugf("Completed - Parsing the Configuration File.")
 
+	// Terraform Resources are only generated for Resource Manager, so there's nothing to load otherwise
+	if p.opts.SourceDataType != sdkModels.ResourceManagerSourceDataType {
+		logging.Debugf("Skipping - parsing the Terraform Resource Definitions for Source Data Type %q.", string(p.opts.SourceDataType))
+		p.servicesToTerraformDetails = make(map[string]terraformDetailsForService)
+	} else if err := p.loadTerraformResourceDefinitions(); err != nil {
+		return err
+	}
+
+	logging.Debugf("Loading the Declarative Data Workarounds from %q..", p.opts.DataWorkaroundsDirectory)
+	if err := dataworkarounds.LoadDeclarativeWorkarounds(p.opts.DataWorkaroundsDirectory); err != nil {
+		return fmt.Errorf("loading the Declarative Data Workarounds from %q: %+v", p.opts.DataWorkaroundsDirectory, err)
+	}
+	logging.Debugf("Completed - Loading the Declarative Data Workarounds.")
+
+	if p.opts.TestDependencyCatalogDirectory != nil {
+		logging.Debugf("Loading the Test Dependency Catalog from %q..", *p.opts.TestDependencyCatalogDirectory)
+		testDependencyCatalog, err := testing.LoadTestDependencyCatalog(*p.opts.TestDependencyCatalogDirectory)
+		if err != nil {
+			return fmt.Errorf("loading the Test Dependency Catalog from %q: %+v", *p.opts.TestDependencyCatalogDirectory, err)
+		}
+		p.testDependencyCatalog = testDependencyCatalog
+		logging.Debugf("Completed - Loading the Test Dependency Catalog.")
+	}
+
+	return nil
+}
+
+func (p *Pipeline) loadTerraformResourceDefinitions() error {
 	logging.Debugf("Parsing the Terraform Resource Definitions..")
 	terraformResourceDefinitions, err := definitions.LoadFromDirectory(p.opts.TerraformDefinitionsDirectory)
 	if err != nil {
@@ -42,6 +73,7 @@ func (p *Pipeline) loadConfigurationFiles() error {
 		servicesToTerraformDetails[serviceName] = terraformDetailsForService{
 			resourceLabelToResourceDefinitions: terraformResourceDefinition,
 			terraformPackageName:               pointer.To(serviceData.TerraformPackageName),
+			terraformFramework:                 serviceData.TerraformFramework,
 		}
 	}
 	p.servicesToTerraformDetails = servicesToTerraformDetails
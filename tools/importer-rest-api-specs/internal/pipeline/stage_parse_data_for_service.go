@@ -4,29 +4,102 @@
 package pipeline
 
 import (
+	"errors"
 	"fmt"
+	"sort"
+	"sync"
 
 	sdkModels "github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
 	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/apidefinitions"
+	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/apidefinitions/parser/dataworkarounds"
+	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/apidefinitions/parser/diagnostics"
+	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/apidefinitions/parser/ignore"
 	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/discovery"
+	discoveryModels "github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/discovery/models"
 	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/logging"
 	"github.com/hashicorp/pandora/tools/sdk/config/services"
 )
 
-func (p *Pipeline) parseDataForService(input services.Service) (*sdkModels.Service, error) {
-	logging.Debugf("Discovering Data for Service %q in %q..", input.Name, p.opts.RestAPISpecsDirectory)
-	data, err := discovery.DiscoverForService(input, p.opts.RestAPISpecsDirectory)
+// errImportCancelled is returned when a Service wasn't (fully) processed since the import was cancelled,
+// due to another Service failing to be imported.
+var errImportCancelled = errors.New("the import was cancelled since another Service failed to be imported")
+
+// parseDataForService discovers and then parses the Data for the specified Service, with each API Version being
+// parsed concurrently within the worker pool. This returns the parsed Service (or nil if the Service should be
+// ignored) and a map of API Version (key) to the Data Workarounds applied to it (value). Any Diagnostics raised
+// whilst parsing the Service are recorded in the Diagnostics Collector.
+func (p *Pipeline) parseDataForService(input services.Service, pool *workerPool, collector *diagnostics.Collector) (*sdkModels.Service, map[string][]dataworkarounds.AppliedWorkaround, error) {
+	var data *discoveryModels.AvailableDataSet
+	var err error
+	started := pool.run(func() {
+		logging.Debugf("Discovering Data for Service %q in %q..", input.Name, p.opts.RestAPISpecsDirectory)
+		data, err = discovery.DiscoverForService(input, p.opts.RestAPISpecsDirectory, p.opts.SourceDataType)
+	})
+	if !started {
+		return nil, nil, errImportCancelled
+	}
 	if err != nil {
-		return nil, fmt.Errorf("discovering for Service %q: %+v", input.Name, err)
+		return nil, nil, fmt.Errorf("discovering for Service %q: %+v", input.Name, err)
+	}
+	if data.ResourceProvider != nil {
+		logging.Tracef("Resource Provider is %q for the Service %q", *data.ResourceProvider, input.Name)
 	}
-	logging.Tracef("Resource Provider is %q for the Service %q", *data.ResourceProvider, input.Name)
 	logging.Debugf("Discovering Data for Service %q in %q - Completed", input.Name, p.opts.RestAPISpecsDirectory)
 
+	// Some Services have been deprecated or should otherwise be ignored - check before proceeding
+	if ignore.Services(data.ServiceName) {
+		logging.Debugf("Service %q should be ignored - skipping", data.ServiceName)
+		return nil, nil, nil
+	}
+
 	logging.Debugf("Parsing Data for Service %q..", input.Name)
-	service, err := apidefinitions.ParseService(*data)
-	if err != nil {
-		return nil, fmt.Errorf("parsing Data for Service %q: %+v", input.Name, err)
+	apiVersionNames := make([]string, 0)
+	for apiVersionName := range data.DataSetsForAPIVersions {
+		apiVersionNames = append(apiVersionNames, apiVersionName)
+	}
+	sort.Strings(apiVersionNames)
+
+	var lock sync.Mutex
+	apiVersions := make(map[string]sdkModels.APIVersion)
+	workaroundsApplied := make(map[string][]dataworkarounds.AppliedWorkaround)
+	errs := make(map[string]error)
+	runForEach(pool, apiVersionNames, func(apiVersionName string) {
+		logging.Infof("Parsing Data for Service %q / API Version %q..", input.Name, apiVersionName)
+		parsed, workarounds, err := apidefinitions.ParseAPIVersion(data.ServiceName, data.DataSetsForAPIVersions[apiVersionName], data.ResourceProvider, apidefinitions.ParseAPIVersionOptions{
+			DetectStaleWorkarounds: p.opts.DetectStaleWorkarounds,
+			Diagnostics:            collector,
+			SourceDataType:         data.SourceDataType,
+		})
+
+		lock.Lock()
+		defer lock.Unlock()
+		if err != nil {
+			errs[apiVersionName] = err
+			return
+		}
+		apiVersions[apiVersionName] = *parsed
+		if len(workarounds) > 0 {
+			workaroundsApplied[apiVersionName] = workarounds
+		}
+		logging.Infof("Parsing Data for Service %q / API Version %q - Completed", input.Name, apiVersionName)
+	})
+
+	for _, apiVersionName := range apiVersionNames {
+		if err, ok := errs[apiVersionName]; ok {
+			return nil, nil, fmt.Errorf("parsing Data for Service %q / API Version %q: %+v", input.Name, apiVersionName, err)
+		}
+	}
+	if len(apiVersions) != len(apiVersionNames) {
+		return nil, nil, errImportCancelled
 	}
 	logging.Debugf("Parsing Data for Service %q - Completed", input.Name)
-	return service, nil
+
+	service := sdkModels.Service{
+		APIVersions:         apiVersions,
+		Generate:            true,
+		Name:                data.ServiceName,
+		ResourceProvider:    data.ResourceProvider,
+		TerraformDefinition: nil, // built-up later in the process
+	}
+	return &service, workaroundsApplied, nil
 }
@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pipeline
+
+import (
+	"sort"
+
+	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/apidefinitions/parser/dataworkarounds"
+	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/logging"
+)
+
+// staleWorkaround describes a Data Workaround which made no changes to the API Version it was applied to - which
+// likely means the issue has been fixed upstream and that the Data Workaround can be removed.
+type staleWorkaround struct {
+	// APIVersion is the API Version which the Data Workaround was applied to.
+	APIVersion string `json:"apiVersion"`
+
+	// Name is the name of the Data Workaround.
+	Name string `json:"name"`
+
+	// PullRequest is a link to the upstream Pull Request referenced by the Data Workaround, where known.
+	PullRequest *string `json:"pullRequest,omitempty"`
+}
+
+// workaroundNamesFromAppliedWorkarounds returns a map of API Version (key) to the names of the Data Workarounds
+// applied to that API Version (value).
+func workaroundNamesFromAppliedWorkarounds(input map[string][]dataworkarounds.AppliedWorkaround) map[string][]string {
+	if len(input) == 0 {
+		return nil
+	}
+
+	output := make(map[string][]string)
+	for apiVersion, workarounds := range input {
+		names := make([]string, 0)
+		for _, item := range workarounds {
+			names = append(names, item.Name)
+		}
+		output[apiVersion] = names
+	}
+	return output
+}
+
+// staleWorkaroundsFromAppliedWorkarounds returns the Data Workarounds which made no changes to the API Version
+// they were applied to, ordered by API Version and then name.
+func staleWorkaroundsFromAppliedWorkarounds(input map[string][]dataworkarounds.AppliedWorkaround) []staleWorkaround {
+	output := make([]staleWorkaround, 0)
+	for apiVersion, workarounds := range input {
+		for _, item := range workarounds {
+			if !item.IsStale() {
+				continue
+			}
+			output = append(output, staleWorkaround{
+				APIVersion:  apiVersion,
+				Name:        item.Name,
+				PullRequest: item.PullRequest,
+			})
+		}
+	}
+	sort.Slice(output, func(i, j int) bool {
+		if output[i].APIVersion != output[j].APIVersion {
+			return output[i].APIVersion < output[j].APIVersion
+		}
+		return output[i].Name < output[j].Name
+	})
+	return output
+}
+
+// logStaleWorkarounds outputs a summary of the stale Data Workarounds found for each Service.
+func logStaleWorkarounds(serviceNamesToStaleWorkarounds map[string][]staleWorkaround) {
+	serviceNames := make([]string, 0)
+	total := 0
+	for serviceName, items := range serviceNamesToStaleWorkarounds {
+		if len(items) == 0 {
+			continue
+		}
+		serviceNames = append(serviceNames, serviceName)
+		total += len(items)
+	}
+	sort.Strings(serviceNames)
+
+	if total == 0 {
+		logging.Infof("No stale Data Workarounds were found.")
+		return
+	}
+
+	logging.Infof("Found %d stale Data Workaround(s) which made no changes and can likely be removed:", total)
+	for _, serviceName := range serviceNames {
+		for _, item := range serviceNamesToStaleWorkarounds[serviceName] {
+			pullRequest := "unknown"
+			if item.PullRequest != nil {
+				pullRequest = *item.PullRequest
+			}
+			logging.Infof("⚠️ Service %q / API Version %q - %q (Pull Request: %s)", serviceName, item.APIVersion, item.Name, pullRequest)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pipeline
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/apidefinitions/parser/dataworkarounds"
+)
+
+func TestStaleWorkaroundsFromAppliedWorkarounds(t *testing.T) {
+	input := map[string][]dataworkarounds.AppliedWorkaround{
+		"2022-01-01": {
+			{
+				Name:        "Redis / 22407",
+				PullRequest: pointer.To("https://github.com/Azure/azure-rest-api-specs/pull/22407"),
+				MadeChanges: pointer.To(false),
+			},
+			{
+				Name:        "Redis / 12345",
+				MadeChanges: pointer.To(true),
+			},
+		},
+		"2020-01-01": {
+			{
+				Name:        "Redis / 22407",
+				MadeChanges: pointer.To(false),
+			},
+			{
+				// not determined, so not stale
+				Name: "Workaround Invalid Go Package Names",
+			},
+		},
+	}
+
+	actual := staleWorkaroundsFromAppliedWorkarounds(input)
+	expected := []staleWorkaround{
+		{
+			APIVersion: "2020-01-01",
+			Name:       "Redis / 22407",
+		},
+		{
+			APIVersion:  "2022-01-01",
+			Name:        "Redis / 22407",
+			PullRequest: pointer.To("https://github.com/Azure/azure-rest-api-specs/pull/22407"),
+		},
+	}
+	if len(actual) != len(expected) {
+		t.Fatalf("expected %d stale workarounds but got %d: %+v", len(expected), len(actual), actual)
+	}
+	for i := range expected {
+		if actual[i].APIVersion != expected[i].APIVersion || actual[i].Name != expected[i].Name || pointer.From(actual[i].PullRequest) != pointer.From(expected[i].PullRequest) {
+			t.Fatalf("expected item %d to be %+v but got %+v", i, expected[i], actual[i])
+		}
+	}
+
+	names := workaroundNamesFromAppliedWorkarounds(input)
+	if len(names["2022-01-01"]) != 2 || len(names["2020-01-01"]) != 2 {
+		t.Fatalf("expected 2 workaround names for each API Version but got %+v", names)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"sort"
 
 	"github.com/hashicorp/pandora/tools/data-api-repository/repository"
+	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/components/apidefinitions/parser/diagnostics"
 	"github.com/hashicorp/pandora/tools/importer-rest-api-specs/internal/logging"
 )
 
@@ -32,10 +33,19 @@ func RunValidate(opts Options) error {
 		return fmt.Errorf("loading the Configuration Files: %+v", err)
 	}
 
+	pool := newWorkerPool(opts.Parallelism)
 	serviceNamesToResults := make(map[string]validationResult)
+	serviceNamesToStaleWorkarounds := make(map[string][]staleWorkaround)
+	summary := newDiagnosticsSummary()
 	for _, service := range p.servicesFromConfigurationFiles {
 		logging.Infof("Parsing the Data for Service %q..", service.Name)
-		data, err := p.parseDataForService(service)
+		collector := diagnostics.NewCollector()
+		data, workaroundsApplied, err := p.parseDataForService(service, pool, collector)
+		if data != nil || err != nil {
+			if diagnosticsErr := p.recordDiagnostics(service.Name, collector, summary); diagnosticsErr != nil && err == nil {
+				err = diagnosticsErr
+			}
+		}
 		if err != nil {
 			serviceNamesToResults[service.Name] = validationResult{
 				succeeded: false,
@@ -44,10 +54,19 @@ func RunValidate(opts Options) error {
 			continue
 		}
 
+		if data == nil {
+			serviceNamesToResults[service.Name] = validationResult{
+				succeeded: true,
+				summary:   "Ignored",
+			}
+			continue
+		}
+
 		serviceNamesToResults[service.Name] = validationResult{
 			succeeded: true,
 			summary:   fmt.Sprintf("%d API Versions", len(data.APIVersions)),
 		}
+		serviceNamesToStaleWorkarounds[service.Name] = staleWorkaroundsFromAppliedWorkarounds(workaroundsApplied)
 	}
 	serviceNames := make([]string, 0)
 	succeeded := true
@@ -70,6 +89,12 @@ func RunValidate(opts Options) error {
 		}
 	}
 
+	summary.log()
+
+	if opts.DetectStaleWorkarounds {
+		logStaleWorkarounds(serviceNamesToStaleWorkarounds)
+	}
+
 	if !succeeded {
 		os.Exit(1)
 	}
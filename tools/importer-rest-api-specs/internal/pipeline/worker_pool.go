@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pipeline
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// workerPool bounds the number of units of work (such as parsing an API Version, or building and saving
+// a Service) which can run concurrently across all Services being imported.
+type workerPool struct {
+	slots chan struct{}
+
+	// cancelled is set once a unit of work has failed and no further work should be started.
+	cancelled atomic.Bool
+}
+
+func newWorkerPool(parallelism int) *workerPool {
+	if parallelism < 1 {
+		parallelism = 1
+	}
+	return &workerPool{
+		slots: make(chan struct{}, parallelism),
+	}
+}
+
+// run runs the specified function once a slot within the pool is available, returning false without
+// running the function if the pool has been cancelled.
+func (p *workerPool) run(fn func()) bool {
+	p.slots <- struct{}{}
+	defer func() {
+		<-p.slots
+	}()
+
+	if p.cancelled.Load() {
+		return false
+	}
+	fn()
+	return true
+}
+
+// cancel prevents any further units of work from being started within the pool.
+func (p *workerPool) cancel() {
+	p.cancelled.Store(true)
+}
+
+// runForEach runs the specified function for each of the keys concurrently (bounded by the pool), waiting
+// for all of them to complete.
+func runForEach[T any](pool *workerPool, keys []T, fn func(key T)) {
+	var wg sync.WaitGroup
+	for _, key := range keys {
+		wg.Add(1)
+		go func(key T) {
+			defer wg.Done()
+			pool.run(func() {
+				fn(key)
+			})
+		}(key)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pipeline
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWorkerPool_BoundsConcurrency(t *testing.T) {
+	pool := newWorkerPool(2)
+
+	var running, maximum atomic.Int32
+	var lock sync.Mutex
+	processed := make([]int, 0)
+	runForEach(pool, []int{1, 2, 3, 4, 5, 6}, func(key int) {
+		current := running.Add(1)
+		for {
+			existing := maximum.Load()
+			if current <= existing || maximum.CompareAndSwap(existing, current) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		running.Add(-1)
+
+		lock.Lock()
+		processed = append(processed, key)
+		lock.Unlock()
+	})
+
+	if len(processed) != 6 {
+		t.Fatalf("expected 6 items to be processed but got %d", len(processed))
+	}
+	if maximum.Load() > 2 {
+		t.Fatalf("expected at most 2 items to run concurrently but got %d", maximum.Load())
+	}
+}
+
+func TestWorkerPool_Cancelled(t *testing.T) {
+	pool := newWorkerPool(1)
+	pool.cancel()
+
+	called := false
+	if pool.run(func() { called = true }) {
+		t.Fatalf("expected the function not to run once the pool was cancelled")
+	}
+	if called {
+		t.Fatalf("expected the function not to be called once the pool was cancelled")
+	}
+}
+
+func TestWorkerPool_InvalidParallelism(t *testing.T) {
+	pool := newWorkerPool(0)
+	if cap(pool.slots) != 1 {
+		t.Fatalf("expected the pool to have a single slot but got %d", cap(pool.slots))
+	}
+}
@@ -14,6 +14,8 @@ import (
 )
 
 const (
+	dataPlaneConfig                     = "../../config/data-plane.hcl"
+	dataWorkaroundsDirectory            = "../../config/data-workarounds"
 	outputDirectoryJson                 = "../../api-definitions"
 	restAPISpecsRepositoryDirectoryPath = "../../submodules/rest-api-specs"
 	resourceManagerConfig               = "../../config/resource-manager.hcl"
@@ -39,8 +41,10 @@ func run() error {
 	c := cli.NewCLI("importer-rest-api-specs", "1.0.0")
 	c.Args = os.Args[1:]
 	c.Commands = map[string]cli.CommandFactory{
-		"import":   cmd.NewImportCommand(restAPISpecsRepositoryDirectoryPath, resourceManagerConfig, terraformDefinitionsPath, outputDirectoryJson),
-		"validate": cmd.NewValidateCommand(restAPISpecsRepositoryDirectoryPath, resourceManagerConfig, terraformDefinitionsPath),
+		"import":             cmd.NewImportCommand(restAPISpecsRepositoryDirectoryPath, resourceManagerConfig, dataPlaneConfig, terraformDefinitionsPath, dataWorkaroundsDirectory, outputDirectoryJson),
+		"scaffold":           cmd.NewScaffoldCommand(restAPISpecsRepositoryDirectoryPath),
+		"suggest-common-ids": cmd.NewSuggestCommonIDsCommand(outputDirectoryJson),
+		"validate":           cmd.NewValidateCommand(restAPISpecsRepositoryDirectoryPath, resourceManagerConfig, dataPlaneConfig, terraformDefinitionsPath, dataWorkaroundsDirectory),
 	}
 
 	exitStatus, err := c.Run()
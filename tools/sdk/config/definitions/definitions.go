@@ -150,15 +150,29 @@ func consolidateIntoASingleDefinition(input []definition) (*Config, error) {
 						overrides := make([]Override, 0)
 						if def.Overrides != nil {
 							for _, details := range def.Overrides {
-								if details.UpdatedName == nil && details.Description == nil {
-									return nil, fmt.Errorf("definition %q within package %q within api version %q within service %q: override for property %q must have at least one attribute specified", def.ResourceType, pkg.Name, api.Version, service.Name, details.Name)
+								override, err := mapOverride(details)
+								if err != nil {
+									return nil, fmt.Errorf("definition %q within package %q within api version %q within service %q: override for property %q: %+v", def.ResourceType, pkg.Name, api.Version, service.Name, details.Name, err)
 								}
-								overrides = append(overrides, Override{
-									Name:        details.Name,
-									UpdatedName: details.UpdatedName,
-									Description: details.Description,
-								})
+								overrides = append(overrides, *override)
+							}
+						}
 
+						var dataSource *DataSourceDefinition
+						if len(def.DataSource) > 1 {
+							return nil, fmt.Errorf("definition %q within package %q within api version %q within service %q should define at most 1 data_source block", def.ResourceType, pkg.Name, api.Version, service.Name)
+						}
+						if len(def.DataSource) == 1 {
+							description := fmt.Sprintf("Gets information about an existing %s", def.DisplayName)
+							if v := def.DataSource[0].Description; v != nil {
+								description = *v
+							}
+							if v := def.DataSource[0].TimeoutInMinutes; v != nil && *v <= 0 {
+								return nil, fmt.Errorf("definition %q within package %q within api version %q within service %q: the data_source timeout_in_minutes must be greater than 0", def.ResourceType, pkg.Name, api.Version, service.Name)
+							}
+							dataSource = &DataSourceDefinition{
+								Description:      description,
+								TimeoutInMinutes: def.DataSource[0].TimeoutInMinutes,
 							}
 						}
 
@@ -197,7 +211,8 @@ func consolidateIntoASingleDefinition(input []definition) (*Config, error) {
 								BasicVariables:    basicVariables,
 								CompleteVariables: completeVariables,
 							},
-							Overrides: &overrides,
+							Overrides:  &overrides,
+							DataSource: dataSource,
 						}
 					}
 
@@ -210,8 +225,29 @@ func consolidateIntoASingleDefinition(input []definition) (*Config, error) {
 					Packages: packages,
 				}
 			}
+			terraformFramework := PluginSdkTerraformFramework
+			if service.TerraformFramework != nil {
+				terraformFramework = TerraformFramework(*service.TerraformFramework)
+				if terraformFramework != PluginSdkTerraformFramework && terraformFramework != PluginFrameworkTerraformFramework {
+					return nil, fmt.Errorf("service %q: `terraform_framework` must be either %q or %q but got %q", service.Name, string(PluginSdkTerraformFramework), string(PluginFrameworkTerraformFramework), *service.TerraformFramework)
+				}
+			}
+			if terraformFramework == PluginFrameworkTerraformFramework {
+				// Data Sources aren't supported for the Plugin Framework at this time
+				for apiVersion, api := range apis {
+					for packageName, pkg := range api.Packages {
+						for resourceType, def := range pkg.Definitions {
+							if def.DataSource != nil {
+								return nil, fmt.Errorf("definition %q within package %q within api version %q within service %q: a data_source block can't be used when `terraform_framework` is %q", resourceType, packageName, apiVersion, service.Name, string(PluginFrameworkTerraformFramework))
+							}
+						}
+					}
+				}
+			}
+
 			services[service.Name] = ServiceDefinition{
 				ApiVersions:          apis,
+				TerraformFramework:   terraformFramework,
 				TerraformPackageName: service.TerraformPackageName,
 			}
 		}
@@ -221,3 +257,115 @@ func consolidateIntoASingleDefinition(input []definition) (*Config, error) {
 		Services: services,
 	}, nil
 }
+
+func mapOverride(input override) (*Override, error) {
+	output := Override{
+		Name:        input.Name,
+		UpdatedName: input.UpdatedName,
+		Description: input.Description,
+		ForceNew:    input.ForceNew,
+		Sensitive:   input.Sensitive,
+		Computed:    input.Computed,
+		Optional:    input.Optional,
+		Required:    input.Required,
+		Default:     input.Default,
+	}
+	if input.Exclude != nil {
+		output.Exclude = *input.Exclude
+	}
+
+	if input.Optional != nil && *input.Optional && input.Required != nil && *input.Required {
+		return nil, fmt.Errorf("`optional` and `required` cannot both be set to `true`")
+	}
+
+	if v := input.CollectionType; v != nil {
+		collectionType := OverrideCollectionType(*v)
+		if collectionType != ListOverrideCollectionType && collectionType != SetOverrideCollectionType {
+			return nil, fmt.Errorf("`collection_type` must be either %q or %q but got %q", string(ListOverrideCollectionType), string(SetOverrideCollectionType), *v)
+		}
+		output.CollectionType = &collectionType
+	}
+
+	if len(input.Validation) > 1 {
+		return nil, fmt.Errorf("at most 1 `validation` block can be specified")
+	}
+	if len(input.Validation) == 1 {
+		validation := input.Validation[0]
+		validationTypes := 0
+		if validation.PossibleValues != nil {
+			validationTypes++
+		}
+		if validation.Minimum != nil || validation.Maximum != nil {
+			validationTypes++
+		}
+		if validation.Regex != nil {
+			validationTypes++
+		}
+		if validationTypes != 1 {
+			return nil, fmt.Errorf("the `validation` block must specify exactly one of `possible_values`, `minimum`/`maximum` or `regex`")
+		}
+
+		output.Validation = &OverrideValidation{
+			PossibleValues: validation.PossibleValues,
+			Minimum:        validation.Minimum,
+			Maximum:        validation.Maximum,
+			Regex:          validation.Regex,
+		}
+	}
+
+	if len(input.Mapping) > 1 {
+		return nil, fmt.Errorf("at most 1 `mapping` block can be specified")
+	}
+	if len(input.Mapping) == 1 {
+		mapping, err := mapOverrideMapping(input.Mapping[0])
+		if err != nil {
+			return nil, fmt.Errorf("the `mapping` block: %+v", err)
+		}
+		output.Mapping = mapping
+	}
+
+	hasChanges := output.UpdatedName != nil || output.Description != nil || output.ForceNew != nil || output.Sensitive != nil || output.Computed != nil || output.Optional != nil || output.Required != nil || output.Default != nil || output.Exclude || output.CollectionType != nil || output.Validation != nil || output.Mapping != nil
+	if !hasChanges {
+		return nil, fmt.Errorf("must have at least one attribute specified")
+	}
+
+	return &output, nil
+}
+
+func mapOverrideMapping(input overrideMapping) (*OverrideMapping, error) {
+	mappingTypes := []OverrideMappingType{
+		BooleanEqualsOverrideMappingType,
+		BooleanInvertOverrideMappingType,
+		SubResourceIdOverrideMappingType,
+		SubResourceIdListOverrideMappingType,
+	}
+	mappingType := OverrideMappingType(input.Type)
+	valid := false
+	for _, item := range mappingTypes {
+		if item == mappingType {
+			valid = true
+			break
+		}
+	}
+	if !valid {
+		return nil, fmt.Errorf("`type` must be one of %q, %q, %q or %q but got %q", string(BooleanEqualsOverrideMappingType), string(BooleanInvertOverrideMappingType), string(SubResourceIdOverrideMappingType), string(SubResourceIdListOverrideMappingType), input.Type)
+	}
+
+	hasConstantValues := input.TrueValue != nil || input.FalseValue != nil
+	if mappingType == BooleanEqualsOverrideMappingType {
+		if input.TrueValue == nil || input.FalseValue == nil {
+			return nil, fmt.Errorf("`true_value` and `false_value` must be specified when `type` is %q", string(mappingType))
+		}
+		if *input.TrueValue == *input.FalseValue {
+			return nil, fmt.Errorf("`true_value` and `false_value` must be different")
+		}
+	} else if hasConstantValues {
+		return nil, fmt.Errorf("`true_value` and `false_value` can only be specified when `type` is %q", string(BooleanEqualsOverrideMappingType))
+	}
+
+	return &OverrideMapping{
+		Type:       mappingType,
+		TrueValue:  input.TrueValue,
+		FalseValue: input.FalseValue,
+	}, nil
+}
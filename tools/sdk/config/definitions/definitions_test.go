@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package definitions
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestLoadFromDirectory_DataSource(t *testing.T) {
+	fifteen := 15
+	testData := []struct {
+		name       string
+		dataSource string
+		expected   *DataSourceDefinition
+		shouldErr  bool
+	}{
+		{
+			name:       "no data_source block",
+			dataSource: "",
+			expected:   nil,
+		},
+		{
+			name:       "empty data_source block",
+			dataSource: "data_source {}",
+			expected: &DataSourceDefinition{
+				Description: "Gets information about an existing Virtual Network",
+			},
+		},
+		{
+			name: "data_source block with a description and timeout",
+			dataSource: `data_source {
+          description        = "Gets information about a Virtual Network"
+          timeout_in_minutes = 15
+        }`,
+			expected: &DataSourceDefinition{
+				Description:      "Gets information about a Virtual Network",
+				TimeoutInMinutes: &fifteen,
+			},
+		},
+		{
+			name: "data_source block with an invalid timeout",
+			dataSource: `data_source {
+          timeout_in_minutes = 0
+        }`,
+			shouldErr: true,
+		},
+		{
+			name:       "multiple data_source blocks",
+			dataSource: "data_source {}\n        data_source {}",
+			shouldErr:  true,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("Test %q", v.name)
+
+		directory := t.TempDir()
+		config := `
+service "Network" {
+  terraform_package = "network"
+
+  api "2023-01-01" {
+    package "VirtualNetworks" {
+      definition "virtual_network" {
+        id                  = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Network/virtualNetworks/{virtualNetworkName}"
+        display_name        = "Virtual Network"
+        website_subcategory = "Network"
+        description         = "Manages a Virtual Network"
+        ` + v.dataSource + `
+      }
+    }
+  }
+}
+`
+		if err := os.WriteFile(filepath.Join(directory, "network.hcl"), []byte(config), 0644); err != nil {
+			t.Fatalf("writing the config: %+v", err)
+		}
+
+		actual, err := LoadFromDirectory(directory)
+		if err != nil {
+			if v.shouldErr {
+				continue
+			}
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if v.shouldErr {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		definition := actual.Services["Network"].ApiVersions["2023-01-01"].Packages["VirtualNetworks"].Definitions["virtual_network"]
+		if !reflect.DeepEqual(v.expected, definition.DataSource) {
+			t.Fatalf("expected the Data Source to be %+v but got %+v", v.expected, definition.DataSource)
+		}
+	}
+}
+
+func TestLoadFromDirectory_DataSourceWithPluginFramework(t *testing.T) {
+	directory := t.TempDir()
+	config := `
+service "Network" {
+  terraform_package   = "network"
+  terraform_framework = "plugin-framework"
+
+  api "2023-01-01" {
+    package "VirtualNetworks" {
+      definition "virtual_network" {
+        id                  = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Network/virtualNetworks/{virtualNetworkName}"
+        display_name        = "Virtual Network"
+        website_subcategory = "Network"
+        description         = "Manages a Virtual Network"
+        data_source {}
+      }
+    }
+  }
+}
+`
+	if err := os.WriteFile(filepath.Join(directory, "network.hcl"), []byte(config), 0644); err != nil {
+		t.Fatalf("writing the config: %+v", err)
+	}
+
+	if _, err := LoadFromDirectory(directory); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+}
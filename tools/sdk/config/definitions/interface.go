@@ -14,8 +14,21 @@ type ServiceDefinition struct {
 
 	// TerraformPackageName is the name of the associated Service Package within Terraform
 	TerraformPackageName string
+
+	// TerraformFramework is the Terraform framework which the Resources within this Service should be generated for.
+	TerraformFramework TerraformFramework
 }
 
+type TerraformFramework string
+
+const (
+	// PluginSdkTerraformFramework generates Typed Resources using the Terraform Plugin SDK.
+	PluginSdkTerraformFramework TerraformFramework = "plugin-sdk"
+
+	// PluginFrameworkTerraformFramework generates Resources using the Terraform Plugin Framework.
+	PluginFrameworkTerraformFramework TerraformFramework = "plugin-framework"
+)
+
 type ApiVersionDefinition struct {
 	// Packages is a map of ResourceName : PackageDefinition
 	Packages map[string]PackageDefinition
@@ -60,8 +73,19 @@ type ResourceDefinition struct {
 	// TestData contains specific values for the tests of this resource
 	TestData ResourceTestDataDefinition
 
-	// Overrides contains a mapping of properties that require renames or custom descriptions, for now
+	// Overrides contains a mapping of properties that require renames, custom descriptions or changes to their Schema
 	Overrides *[]Override
+
+	// DataSource specifies the Data Source which should be generated for this Resource, if any.
+	DataSource *DataSourceDefinition
+}
+
+type DataSourceDefinition struct {
+	// Description is the description for this Data Source
+	Description string
+
+	// TimeoutInMinutes optionally overrides the timeout for the Read function of this Data Source
+	TimeoutInMinutes *int
 }
 
 type Override struct {
@@ -74,6 +98,89 @@ type Override struct {
 	// Description defines a custom description for this field.
 	// If unspecified a description will be determined based on the field name.
 	Description *string
+
+	// ForceNew optionally overrides whether this field is ForceNew.
+	ForceNew *bool
+
+	// Sensitive optionally overrides whether this field is Sensitive.
+	Sensitive *bool
+
+	// Computed optionally overrides whether this field is Computed.
+	Computed *bool
+
+	// Optional optionally overrides whether this field is Optional, conflicts with Required.
+	Optional *bool
+
+	// Required optionally overrides whether this field is Required, conflicts with Optional.
+	Required *bool
+
+	// Default optionally specifies the default value for this field, which is parsed
+	// based on the type of the field (e.g. `true`, `10` or `Standard`).
+	Default *string
+
+	// Exclude specifies whether this field should be removed from the Schema.
+	Exclude bool
+
+	// CollectionType optionally overrides whether this field is a List or a Set.
+	CollectionType *OverrideCollectionType
+
+	// Validation optionally overrides the validation for this field.
+	Validation *OverrideValidation
+
+	// Mapping optionally overrides how the value for this field is mapped to/from the API.
+	Mapping *OverrideMapping
+}
+
+type OverrideCollectionType string
+
+const (
+	ListOverrideCollectionType OverrideCollectionType = "list"
+	SetOverrideCollectionType  OverrideCollectionType = "set"
+)
+
+type OverrideMappingType string
+
+const (
+	// BooleanEqualsOverrideMappingType exposes a Constant as a Boolean, which is `true` when the Constant
+	// has the value TrueValue and `false` when the Constant has the value FalseValue.
+	BooleanEqualsOverrideMappingType OverrideMappingType = "boolean_equals"
+
+	// BooleanInvertOverrideMappingType exposes a Boolean as the inverse of the value from the API
+	// (e.g. `public_network_access_enabled` for `disablePublicNetworkAccess`).
+	BooleanInvertOverrideMappingType OverrideMappingType = "boolean_invert"
+
+	// SubResourceIdOverrideMappingType exposes a nested Model containing only an `id` as a String.
+	SubResourceIdOverrideMappingType OverrideMappingType = "sub_resource_id"
+
+	// SubResourceIdListOverrideMappingType exposes a List of nested Models containing only an `id` as a
+	// List of Strings.
+	SubResourceIdListOverrideMappingType OverrideMappingType = "sub_resource_id_list"
+)
+
+type OverrideMapping struct {
+	// Type specifies the type of Mapping which should be used for this field.
+	Type OverrideMappingType
+
+	// TrueValue specifies the Constant value which `true` maps to/from, required for BooleanEqualsOverrideMappingType.
+	TrueValue *string
+
+	// FalseValue specifies the Constant value which `false` maps to/from, required for BooleanEqualsOverrideMappingType.
+	FalseValue *string
+}
+
+type OverrideValidation struct {
+	// PossibleValues specifies the list of values allowed for this field, which are parsed
+	// based on the type of the field.
+	PossibleValues *[]string
+
+	// Minimum specifies the minimum value (inclusive) allowed for this field.
+	Minimum *float64
+
+	// Maximum specifies the maximum value (inclusive) allowed for this field.
+	Maximum *float64
+
+	// Regex specifies a Regular Expression which the value for this field must match.
+	Regex *string
 }
 
 type ResourceTestDataDefinition struct {
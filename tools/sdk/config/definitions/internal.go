@@ -16,6 +16,10 @@ type serviceDefinition struct {
 
 	// TerraformPackageName is the name of the associated Service Package within Terraform
 	TerraformPackageName string `hcl:"terraform_package"`
+
+	// TerraformFramework is the Terraform framework which the Resources within this Service
+	// should be generated for - either `plugin-sdk` (the default) or `plugin-framework`.
+	TerraformFramework *string `hcl:"terraform_framework,optional"`
 }
 
 type apiVersionDefinition struct {
@@ -70,6 +74,19 @@ type resourceDefinition struct {
 
 	// Overrides contains a mapping of properties that require renames or custom descriptions, for now
 	Overrides []override `hcl:"overrides,block"`
+
+	// DataSource optionally specifies that a Data Source should also be generated for this Resource
+	DataSource []dataSourceDefinition `hcl:"data_source,block"`
+}
+
+type dataSourceDefinition struct {
+	// Description is the description for this Data Source.
+	// If unspecified this defaults to `Gets information about an existing {DisplayName}`.
+	Description *string `hcl:"description,optional"`
+
+	// TimeoutInMinutes optionally specifies the timeout for the Read function of this Data Source.
+	// If unspecified this defaults to the timeout for the Read function of the Resource.
+	TimeoutInMinutes *int `hcl:"timeout_in_minutes,optional"`
 }
 
 type override struct {
@@ -82,6 +99,61 @@ type override struct {
 	// Description defines a custom description for this field.
 	// If unspecified a description will be determined based on the field name.
 	Description *string `hcl:"description,optional"`
+
+	// ForceNew specifies whether this field should be ForceNew.
+	ForceNew *bool `hcl:"force_new,optional"`
+
+	// Sensitive specifies whether this field should be Sensitive.
+	Sensitive *bool `hcl:"sensitive,optional"`
+
+	// Computed specifies whether this field should be Computed.
+	Computed *bool `hcl:"computed,optional"`
+
+	// Optional specifies that this field should be Optional (rather than Required).
+	Optional *bool `hcl:"optional,optional"`
+
+	// Required specifies that this field should be Required (rather than Optional).
+	Required *bool `hcl:"required,optional"`
+
+	// Default specifies the default value for this field - which is parsed based on the type of the field.
+	Default *string `hcl:"default,optional"`
+
+	// Exclude specifies that this field should be removed from the Schema.
+	Exclude *bool `hcl:"exclude,optional"`
+
+	// CollectionType specifies whether this field should be a `list` or a `set`.
+	CollectionType *string `hcl:"collection_type,optional"`
+
+	// Validation specifies the validation which should be applied to this field.
+	Validation []overrideValidation `hcl:"validation,block"`
+
+	// Mapping specifies how the value for this field should be mapped to/from the API.
+	Mapping []overrideMapping `hcl:"mapping,block"`
+}
+
+type overrideMapping struct {
+	// Type specifies the type of Mapping (e.g. `boolean_equals`).
+	Type string `hcl:"type"`
+
+	// TrueValue specifies the Constant value which `true` maps to/from, used by `boolean_equals`.
+	TrueValue *string `hcl:"true_value,optional"`
+
+	// FalseValue specifies the Constant value which `false` maps to/from, used by `boolean_equals`.
+	FalseValue *string `hcl:"false_value,optional"`
+}
+
+type overrideValidation struct {
+	// PossibleValues specifies the list of values allowed for this field.
+	PossibleValues *[]string `hcl:"possible_values,optional"`
+
+	// Minimum specifies the minimum value (inclusive) allowed for this field.
+	Minimum *float64 `hcl:"minimum,optional"`
+
+	// Maximum specifies the maximum value (inclusive) allowed for this field.
+	Maximum *float64 `hcl:"maximum,optional"`
+
+	// Regex specifies a Regular Expression which the value for this field must match.
+	Regex *string `hcl:"regex,optional"`
 }
 
 type resourceTestDataDefinition struct {
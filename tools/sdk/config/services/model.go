@@ -18,6 +18,11 @@ type Service struct {
 	// Available is a list of the Versions for this Service which should be imported
 	Available []string `hcl:"available"`
 
+	// Tags is an optional map of Version (key) to the AutoRest Tag (value) defined within the `readme.md` for
+	// this Service (e.g. `package-2023-01`). When specified for a Version, the API Definitions used for that
+	// Version are the `input-file`s defined for this Tag, rather than every file within the Version directory.
+	Tags *map[string]string `hcl:"tag"`
+
 	// Ignore is a list of Versions which should be Ignored for this Service
 	// A version is automatically ignored if it's not defined in
 	Ignore *[]string `hcl:"ignore"`